@@ -1,6 +1,9 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"log"
 	"net"
 	"os"
@@ -8,12 +11,15 @@ import (
 	"syscall"
 	"time"
 
+	commonmd "github.com/nassabiq/golang-template/internal/shared/common/metadata"
 	appConfig "github.com/nassabiq/golang-template/internal/shared/config"
 	"github.com/nassabiq/golang-template/internal/shared/database"
 	"github.com/nassabiq/golang-template/internal/shared/helper"
+	"github.com/nassabiq/golang-template/internal/shared/pagination"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	authDomain "github.com/nassabiq/golang-template/internal/modules/auth/domain"
 	"github.com/nassabiq/golang-template/internal/modules/auth/event"
 	authHandler "github.com/nassabiq/golang-template/internal/modules/auth/handler"
 	authRepository "github.com/nassabiq/golang-template/internal/modules/auth/repository/postgres"
@@ -21,13 +27,30 @@ import (
 	authctx "github.com/nassabiq/golang-template/internal/shared/middleware/auth"
 	authpb "github.com/nassabiq/golang-template/proto/auth"
 
+	userEvent "github.com/nassabiq/golang-template/internal/modules/user/event"
 	userHandler "github.com/nassabiq/golang-template/internal/modules/user/handler"
 	userRepository "github.com/nassabiq/golang-template/internal/modules/user/repository"
 	userUsecase "github.com/nassabiq/golang-template/internal/modules/user/usecase"
 	userpb "github.com/nassabiq/golang-template/proto/user"
 
+	roleHandler "github.com/nassabiq/golang-template/internal/modules/role/handler"
+	roleRepository "github.com/nassabiq/golang-template/internal/modules/role/repository"
+	roleUsecase "github.com/nassabiq/golang-template/internal/modules/role/usecase"
+	rolepb "github.com/nassabiq/golang-template/proto/role"
+
+	organizationHandler "github.com/nassabiq/golang-template/internal/modules/organization/handler"
+	organizationRepository "github.com/nassabiq/golang-template/internal/modules/organization/repository"
+	organizationUsecase "github.com/nassabiq/golang-template/internal/modules/organization/usecase"
+	organizationpb "github.com/nassabiq/golang-template/proto/organization"
+
 	natsInfra "github.com/nassabiq/golang-template/internal/infrastructure/messaging/nats"
+	"github.com/nassabiq/golang-template/internal/infrastructure/oidc"
+	"github.com/nassabiq/golang-template/internal/infrastructure/passwordpolicy"
+	"github.com/nassabiq/golang-template/internal/infrastructure/rbac"
+	"github.com/nassabiq/golang-template/internal/infrastructure/revocation"
 	"github.com/nassabiq/golang-template/internal/infrastructure/token"
+	"github.com/nassabiq/golang-template/internal/infrastructure/totp"
+	"github.com/nassabiq/golang-template/internal/infrastructure/webauthn"
 )
 
 func main() {
@@ -36,33 +59,69 @@ func main() {
 	// =========================
 	cfg := appConfig.Load()
 
+	// Only these proxies may report the client address behind them
+	if err := commonmd.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		log.Fatalf("invalid TRUSTED_PROXIES: %v", err)
+	}
+
 	// =========================
 	// Initialize database
 	// =========================
 	db := database.NewPostgres(cfg.DatabaseUrl)
 	defer db.Close()
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	// =========================
-	// JWT Middleware
+	// JWT Keys & Middleware
 	// =========================
-	jwtSecret := os.Getenv("JWT_SECRET")
-	if jwtSecret == "" {
-		log.Fatal("JWT_SECRET is required")
+	keySet, err := token.LoadKeySet(cfg.JWTKeysDir)
+	if err != nil {
+		log.Fatalf("failed to load JWT keys from %s: %v", cfg.JWTKeysDir, err)
 	}
-
-	verifier := authctx.NewJWTVerifier(jwtSecret)
-
-	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(
-			authctx.UnaryServerInterceptor(verifier),
-		),
-	)
+	go keySet.Watch(ctx, cfg.JWTKeysReloadInterval)
 
 	// =========================
 	// Repository
 	// =========================
 	authRepo := authRepository.NewAuthRepository(db)
+	if cfg.MfaSecretKey != "" {
+		key, err := base64.StdEncoding.DecodeString(cfg.MfaSecretKey)
+		if err != nil {
+			log.Fatalf("invalid MFA_SECRET_KEY: %v", err)
+		}
+		mfaSecretCipher, err := totp.NewSecretCipher(key)
+		if err != nil {
+			log.Fatalf("invalid MFA_SECRET_KEY: %v", err)
+		}
+		authRepo.SetMfaSecretCipher(mfaSecretCipher)
+	} else if cfg.AppEnv == "local" {
+		log.Println("MFA_SECRET_KEY is not set, TOTP secrets are stored unencrypted")
+	} else {
+		log.Fatal("MFA_SECRET_KEY is required outside APP_ENV=local")
+	}
 	userRepo := userRepository.NewUserRepository(db)
+	roleRepo := roleRepository.NewRoleRepository(db)
+	organizationRepo := organizationRepository.NewOrganizationRepository(db)
+
+	// =========================
+	// Access token revocation
+	// =========================
+	denylist := revocation.NewDenylist(authRepo, cfg.TokenRevocationCacheTTL)
+	go denylist.Run(ctx, time.Hour)
+
+	verifier := authctx.NewJWTVerifier(keySet)
+	verifier.SetRevocationChecker(denylist)
+
+	// =========================
+	// Role permissions
+	// =========================
+	permissionCache := rbac.NewPermissionCache(roleRepo)
+	if err := permissionCache.Reload(ctx); err != nil {
+		log.Fatalf("failed to load role permissions: %v", err)
+	}
+	go permissionCache.Run(ctx, cfg.RBACCacheRefreshInterval)
 
 	// =========================
 	// NATS / JetStream
@@ -82,21 +141,95 @@ func main() {
 	// Event Publisher
 	// =========================
 	authEventPub := event.NewAuthPublisher(jetStreamBus)
+	userEventPub := userEvent.NewUserPublisher(jetStreamBus)
 
 	// =========================
 	// Usecase
 	// =========================
-	passwordHasher := &helper.BcryptHasher{}
+	passwordHasher, err := helper.NewPasswordHasher(helper.PasswordHasherConfig{
+		Algorithm: cfg.PasswordHashAlgorithm,
+		Argon2: helper.Argon2Params{
+			Memory:      cfg.Argon2Memory,
+			Iterations:  cfg.Argon2Iterations,
+			Parallelism: cfg.Argon2Parallelism,
+		},
+		BcryptCost: cfg.BcryptCost,
+	})
+	if err != nil {
+		log.Fatalf("invalid password hasher config: %v", err)
+	}
 	uuidGen := &helper.UUIDGenerator{}
-	tokenSvc := token.NewService(jwtSecret)
+	tokenSvc := token.NewService(keySet)
+	otpSvc := totp.NewService(cfg.MfaIssuer)
+	passwordPolicy := passwordpolicy.NewDefault(passwordpolicy.Config{
+		MinLength:           cfg.PasswordMinLength,
+		MaxLength:           cfg.PasswordMaxLength,
+		MinCharacterClasses: cfg.PasswordMinCharacterClasses,
+		RejectPersonalInfo:  cfg.PasswordRejectPersonalInfo,
+		RejectCommon:        cfg.PasswordRejectCommon,
+	})
 
 	authUC := authUsecase.NewAuthUsecase(authRepo, authEventPub)
 	authUC.SetPasswordHasher(passwordHasher)
 	authUC.SetUUIDGenerator(uuidGen)
 	authUC.SetTokenService(tokenSvc)
 	authUC.SetNowFunc(time.Now)
+	authUC.SetOTPService(otpSvc)
+	authUC.SetAccessTokenRevoker(denylist)
+	authUC.SetRequireEmailVerification(cfg.RequireEmailVerification)
+	authUC.SetPhoneDefaultCountryCode(cfg.PhoneDefaultCountryCode)
+	oidcProviders := make(map[string]authUsecase.OidcProvider, len(cfg.OidcProviders))
+	for _, provider := range cfg.OidcProviders {
+		oidcProviders[provider.Name] = oidc.NewProvider(oidc.Config{
+			Name:         provider.Name,
+			Issuer:       provider.Issuer,
+			ClientID:     provider.ClientID,
+			ClientSecret: provider.ClientSecret,
+			RedirectURL:  provider.RedirectURL,
+			Scopes:       provider.Scopes,
+		}, nil)
+	}
+	authUC.SetOidcProviders(oidcProviders)
+	if cfg.WebauthnRPID != "" {
+		if len(cfg.WebauthnOrigins) == 0 {
+			log.Fatal("WEBAUTHN_ORIGINS is required when WEBAUTHN_RP_ID is set")
+		}
+		authUC.SetWebauthn(webauthn.NewRelyingParty(webauthn.Config{
+			RPID:    cfg.WebauthnRPID,
+			RPName:  cfg.WebauthnRPName,
+			Origins: cfg.WebauthnOrigins,
+		}))
+	}
+	authUC.SetPasswordPolicy(passwordPolicy, cfg.PasswordHistorySize)
+	authUC.SetLoginThrottlePolicy(authDomain.LoginThrottlePolicy{
+		MaxFailedAttempts:  cfg.LoginMaxFailedAttempts,
+		LockoutDuration:    cfg.LoginLockoutDuration,
+		MaxLockoutDuration: cfg.LoginMaxLockoutDuration,
+		MaxIPFailures:      cfg.LoginMaxIPFailures,
+		IPWindow:           cfg.LoginIPWindow,
+	})
 
 	userUC := userUsecase.NewUserUsecase(userRepo, passwordHasher)
+	userUC.SetTokenRevoker(denylist)
+	userUC.SetPasswordPolicy(passwordPolicy)
+	userUC.SetTokenGenerator(passwordHasher)
+	userUC.SetEventPublisher(userEventPub)
+
+	roleUC := roleUsecase.NewRoleUsecase(roleRepo)
+	roleUC.SetPermissionCache(permissionCache)
+
+	organizationUC := organizationUsecase.NewOrganizationUsecase(organizationRepo)
+	organizationUC.SetTokenRevoker(denylist)
+
+	cursorKey := []byte(cfg.PaginationCursorSecret)
+	if len(cursorKey) == 0 {
+		log.Println("PAGINATION_CURSOR_SECRET is not set, pagination cursors will not survive a restart")
+		cursorKey = make([]byte, 32)
+		if _, err := rand.Read(cursorKey); err != nil {
+			log.Fatalf("failed to generate pagination cursor key: %v", err)
+		}
+	}
+	cursors := pagination.NewCodec(cursorKey)
 
 	// =========================
 	// GRPC Server
@@ -106,14 +239,29 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(
+			authctx.UnaryServerInterceptor(verifier, authUC, permissionCache, authUC, authUC),
+		),
+	)
+
 	// =========================
 	// Handler
 	// =========================
 	authSrv := authHandler.NewAuthHandler(authUC)
-	userSrv := userHandler.NewUserHandler(*userUC)
+	userSrv := userHandler.NewUserHandler(*userUC, cursors)
+	roleSrv := roleHandler.NewRoleHandler(roleUC)
+	organizationSrv := organizationHandler.NewOrganizationHandler(organizationUC)
 
 	authpb.RegisterAuthServiceServer(grpcServer, authSrv)
 	userpb.RegisterUserServiceServer(grpcServer, userSrv)
+	rolepb.RegisterRoleServiceServer(grpcServer, roleSrv)
+	organizationpb.RegisterOrganizationServiceServer(grpcServer, organizationSrv)
+
+	// Every RPC must declare who may call it
+	if err := authctx.CheckPolicies(grpcServer.GetServiceInfo()); err != nil {
+		log.Fatalf("authorization policy check failed: %v", err)
+	}
 
 	reflection.Register(grpcServer)
 
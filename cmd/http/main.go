@@ -17,6 +17,8 @@ import (
 	httpmw "github.com/nassabiq/golang-template/cmd/http/middleware"
 	"github.com/nassabiq/golang-template/internal/infrastructure/swagger"
 	authpb "github.com/nassabiq/golang-template/proto/auth"
+	organizationpb "github.com/nassabiq/golang-template/proto/organization"
+	rolepb "github.com/nassabiq/golang-template/proto/role"
 	userpb "github.com/nassabiq/golang-template/proto/user"
 )
 
@@ -44,11 +46,23 @@ func main() {
 
 	mux := runtime.NewServeMux(
 		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
-			if key == "Authorization" {
+			if key == "Authorization" || key == "X-Api-Key" || key == "X-Device-Id" {
 				return key, true
 			}
 			return runtime.DefaultHeaderMatcher(key)
 		}),
+		runtime.WithOutgoingHeaderMatcher(func(key string) (string, bool) {
+			switch key {
+			case "retry-after":
+				return "Retry-After", true
+			case "set-cookie":
+				return "Set-Cookie", true
+			case "location":
+				return "Location", true
+			}
+			return runtime.MetadataHeaderPrefix + key, true
+		}),
+		runtime.WithForwardResponseOption(httpmw.Redirect),
 	)
 
 	err := authpb.RegisterAuthServiceHandlerFromEndpoint(
@@ -75,20 +89,46 @@ func main() {
 		log.Fatal(err)
 	}
 
+	err = rolepb.RegisterRoleServiceHandlerFromEndpoint(
+		ctx,
+		mux,
+		grpcAddr,
+		[]grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		},
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = organizationpb.RegisterOrganizationServiceHandlerFromEndpoint(
+		ctx,
+		mux,
+		grpcAddr,
+		[]grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		},
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	// Create main HTTP mux
 	mainMux := http.NewServeMux()
 
 	// Register Swagger UI handler
 	swaggerFiles := map[string]string{
-		"auth": "docs/swagger/proto/auth/auth.swagger.json",
-		"user": "docs/swagger/proto/user/user.swagger.json",
+		"auth":         "docs/swagger/proto/auth/auth.swagger.json",
+		"user":         "docs/swagger/proto/user/user.swagger.json",
+		"role":         "docs/swagger/proto/role/role.swagger.json",
+		"organization": "docs/swagger/proto/organization/organization.swagger.json",
 	}
 	mainMux.Handle("/swagger/", http.StripPrefix("/swagger", swagger.MultiSwaggerHandler(swaggerFiles)))
 
 	// Register gRPC gateway handler for all other routes
 	mainMux.Handle("/", mux)
 
-	handler := httpmw.CORS(httpmw.Logging(mainMux))
+	handler := httpmw.CORS(httpmw.Logging(httpmw.FormToJSON("/oauth/token")(mainMux)))
 
 	server := &http.Server{
 		Addr:    ":" + httpPort,
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+)
+
+// FormToJSON rewrites form encoded POST bodies of the given paths into JSON,
+// so endpoints like the OAuth2 token endpoint accept the standard form body
+// while the gateway only decodes JSON.
+func FormToJSON(paths ...string) func(http.Handler) http.Handler {
+	allowed := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		allowed[path] = true
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+			if r.Method != http.MethodPost || !allowed[r.URL.Path] || mediaType != "application/x-www-form-urlencoded" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if err := r.ParseForm(); err != nil {
+				http.Error(w, "invalid form body", http.StatusBadRequest)
+				return
+			}
+
+			fields := make(map[string]string, len(r.PostForm))
+			for key := range r.PostForm {
+				fields[key] = r.PostForm.Get(key)
+			}
+
+			body, err := json.Marshal(fields)
+			if err != nil {
+				http.Error(w, "invalid form body", http.StatusBadRequest)
+				return
+			}
+
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			r.ContentLength = int64(len(body))
+			r.Header.Set("Content-Type", "application/json")
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/proto"
+)
+
+// Redirect is a gateway forward response option that turns responses carrying
+// a Location header into a 302, so RPCs like the OIDC start endpoint can send
+// the browser on to another site.
+func Redirect(ctx context.Context, w http.ResponseWriter, _ proto.Message) error {
+	md, ok := runtime.ServerMetadataFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	if values := md.HeaderMD.Get("location"); len(values) > 0 {
+		w.Header().Set("Location", values[0])
+		w.WriteHeader(http.StatusFound)
+	}
+
+	return nil
+}
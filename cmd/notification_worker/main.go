@@ -10,6 +10,7 @@ import (
 	"github.com/nassabiq/golang-template/internal/infrastructure/mail"
 	natsInfra "github.com/nassabiq/golang-template/internal/infrastructure/messaging/nats"
 	"github.com/nassabiq/golang-template/internal/infrastructure/registry"
+	"github.com/nassabiq/golang-template/internal/infrastructure/sms"
 	"github.com/nassabiq/golang-template/internal/infrastructure/subscribers"
 	appConfig "github.com/nassabiq/golang-template/internal/shared/config"
 	natsgo "github.com/nats-io/nats.go"
@@ -40,6 +41,14 @@ func main() {
 		log.Printf("stream creation note: %v", err)
 	}
 
+	_, err = js.AddStream(&natsgo.StreamConfig{
+		Name:     "USER",
+		Subjects: []string{"user.*"},
+	})
+	if err != nil {
+		log.Printf("stream creation note: %v", err)
+	}
+
 	// Initialize mailer
 	mailer := mail.NewSMTPMailer(
 		getEnv("SMTP_HOST", "localhost"),
@@ -47,23 +56,52 @@ func main() {
 		getEnv("SMTP_FROM", "noreply@app.local"),
 	)
 
+	smsSender, closeSMS := newSMSSender(getEnv("SMS_DRIVER", "log"), getEnv("SMS_LOG_FILE", ""))
+	defer closeSMS()
+
 	// Registry
 	reg := registry.New()
 
 	reg.Register(subscribers.NewForgotPasswordSubscriber(mailer))
-	// reg.Register(subscriber.NewUserRegisteredSubscriber(mailer))
+	reg.Register(subscribers.NewUserRegisteredSubscriber(mailer))
+	reg.Register(subscribers.NewEmailVerificationSubscriber(mailer))
+	reg.Register(subscribers.NewAccountLockedSubscriber(mailer))
+	reg.Register(subscribers.NewUserInvitedSubscriber(mailer))
+	reg.Register(subscribers.NewMagicLinkSubscriber(mailer))
+	reg.Register(subscribers.NewNewDeviceLoginSubscriber(mailer))
+	reg.Register(subscribers.NewSMSOtpSubscriber(smsSender))
 	// reg.Register(subscriber.NewPasswordChangedSubscriber(mailer))
 
 	reg.Run(js)
 
-	log.Println("📨 Email worker running...")
+	log.Println("📨 Notification worker running...")
 
 	// Graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	log.Println("🛑 Shutting down email worker...")
+	log.Println("🛑 Shutting down notification worker...")
+}
+
+// newSMSSender returns the configured SMS driver. The log driver appends to
+// logFile, or writes to stdout when it is empty.
+func newSMSSender(driver, logFile string) (sms.SMSSender, func()) {
+	switch driver {
+	case "log":
+		if logFile == "" {
+			return sms.NewLogSender(os.Stdout), func() {}
+		}
+
+		file, err := os.OpenFile(logFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+		if err != nil {
+			log.Fatalf("failed to open SMS log file: %v", err)
+		}
+		return sms.NewLogSender(file), func() { file.Close() }
+	default:
+		log.Fatalf("unknown SMS_DRIVER %q", driver)
+		return nil, nil
+	}
 }
 
 func getEnv(key, fallback string) string {
@@ -0,0 +1,260 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+// jwksRefreshInterval limits how often an unknown kid triggers a JWKS download
+const jwksRefreshInterval = time.Minute
+
+// Config is a relying-party registration at an OpenID Connect provider
+type Config struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+// Provider signs users in with the authorization code flow and PKCE. The
+// discovery document is fetched on first use and the JWKS whenever an ID token
+// is signed with a kid it has not seen, at most once per jwksRefreshInterval.
+type Provider struct {
+	config Config
+	client *http.Client
+	now    func() time.Time
+
+	mu            sync.Mutex
+	discovery     *discovery
+	keys          map[string]crypto.PublicKey
+	keysFetchedAt time.Time
+}
+
+func NewProvider(config Config, client *http.Client) *Provider {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{"openid", "email", "profile"}
+	}
+
+	return &Provider{config: config, client: client, now: time.Now}
+}
+
+// AuthorizationURL is where the browser is sent to sign in at the provider
+func (p *Provider) AuthorizationURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.config.ClientID},
+		"redirect_uri":          {p.config.RedirectURL},
+		"scope":                 {strings.Join(p.config.Scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {codeChallenge},
+		"code_challenge_method": {"S256"},
+	}
+
+	separator := "?"
+	if strings.Contains(doc.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+
+	return doc.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+// Exchange redeems the authorization code and returns the claims of the validated ID token
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*domain.OidcClaims, error) {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.config.RedirectURL},
+		"client_id":     {p.config.ClientID},
+		"client_secret": {p.config.ClientSecret},
+		"code_verifier": {codeVerifier},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, doc.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var token struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return nil, fmt.Errorf("token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token endpoint: %s %s", token.Error, token.ErrorDescription)
+	}
+	if token.IDToken == "" {
+		return nil, errors.New("token response has no id_token")
+	}
+
+	return p.verifyIDToken(ctx, doc, token.IDToken, nonce)
+}
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce         string `json:"nonce"`
+	AuthorizedBy  string `json:"azp"`
+	Email         string `json:"email"`
+	EmailVerified any    `json:"email_verified"`
+	Name          string `json:"name"`
+}
+
+// verifyIDToken checks the signature against the provider's JWKS and the
+// issuer, audience, expiry and nonce (OpenID Connect Core 3.1.3.7)
+func (p *Provider) verifyIDToken(ctx context.Context, doc *discovery, raw, nonce string) (*domain.OidcClaims, error) {
+	var claims idTokenClaims
+
+	_, err := jwt.ParseWithClaims(raw, &claims,
+		func(token *jwt.Token) (any, error) {
+			kid, _ := token.Header["kid"].(string)
+			return p.publicKey(ctx, doc, kid)
+		},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "EdDSA"}),
+		jwt.WithIssuer(doc.Issuer),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithTimeFunc(p.now),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("id token: %w", err)
+	}
+
+	if len(claims.Audience) > 1 && claims.AuthorizedBy != p.config.ClientID {
+		return nil, errors.New("id token: azp does not match client id")
+	}
+	if claims.Nonce == "" || claims.Nonce != nonce {
+		return nil, errors.New("id token: nonce mismatch")
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("id token: missing sub")
+	}
+
+	return &domain.OidcClaims{
+		Subject:       claims.Subject,
+		Email:         strings.ToLower(claims.Email),
+		EmailVerified: claims.EmailVerified == true || claims.EmailVerified == "true",
+		Name:          claims.Name,
+	}, nil
+}
+
+func (p *Provider) publicKey(ctx context.Context, doc *discovery, kid string) (crypto.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+
+	// The provider may have rotated its keys
+	if p.keys == nil || p.now().Sub(p.keysFetchedAt) >= jwksRefreshInterval {
+		keys, err := fetchJWKS(ctx, p.client, doc.JwksURI)
+		if err != nil {
+			return nil, err
+		}
+		p.keys, p.keysFetchedAt = keys, p.now()
+	}
+
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+
+	// Providers with a single key may omit the kid
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+func (p *Provider) discover(ctx context.Context) (*discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	issuer := strings.TrimSuffix(p.config.Issuer, "/")
+
+	var doc discovery
+	if err := getJSON(ctx, p.client, issuer+"/.well-known/openid-configuration", &doc); err != nil {
+		return nil, fmt.Errorf("oidc discovery for %s: %w", p.config.Name, err)
+	}
+
+	if strings.TrimSuffix(doc.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("oidc discovery for %s: issuer %q does not match %q", p.config.Name, doc.Issuer, p.config.Issuer)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JwksURI == "" {
+		return nil, fmt.Errorf("oidc discovery for %s: incomplete document", p.config.Name)
+	}
+
+	p.discovery = &doc
+	return p.discovery, nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, uri string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", uri, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(target)
+}
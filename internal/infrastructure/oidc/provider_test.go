@@ -0,0 +1,111 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/nassabiq/golang-template/internal/infrastructure/oidc"
+	"github.com/nassabiq/golang-template/internal/infrastructure/oidc/oidctest"
+)
+
+const codeVerifier = "dBjftJeZ4CVP-mJ0EolnlYEvXoyuTlbEX3H8KkXOlRM"
+
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// authorize starts a login at the fake provider and returns the authorization code
+func authorize(t *testing.T, fake *oidctest.Server, provider *oidc.Provider, nonce string) string {
+	t.Helper()
+
+	authURL, err := provider.AuthorizationURL(context.Background(), "state-123", nonce, codeChallenge(codeVerifier))
+	if err != nil {
+		t.Fatalf("AuthorizationURL() error = %v", err)
+	}
+
+	callback, err := fake.Authorize(authURL)
+	if err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+	if callback.Query().Get("state") != "state-123" {
+		t.Fatalf("callback state = %q", callback.Query().Get("state"))
+	}
+
+	return callback.Query().Get("code")
+}
+
+func newProvider(fake *oidctest.Server) *oidc.Provider {
+	return oidc.NewProvider(oidc.Config{
+		Name:         "fake",
+		Issuer:       fake.URL,
+		ClientID:     fake.ClientID,
+		ClientSecret: fake.ClientSecret,
+		RedirectURL:  "http://localhost:8080/auth/oidc/fake/callback",
+	}, nil)
+}
+
+// Test the authorization code flow returns the validated ID token claims
+func TestProvider_Exchange(t *testing.T) {
+	fake := oidctest.NewServer("client-123", "secret-123")
+	defer fake.Close()
+	provider := newProvider(fake)
+
+	code := authorize(t, fake, provider, "nonce-123")
+
+	claims, err := provider.Exchange(context.Background(), code, codeVerifier, "nonce-123")
+	if err != nil {
+		t.Fatalf("Exchange() error = %v", err)
+	}
+	if claims.Subject != "fake-subject" || claims.Email != "user@example.com" || !claims.EmailVerified || claims.Name != "Fake User" {
+		t.Errorf("Exchange() claims = %+v", claims)
+	}
+
+	if _, err := provider.Exchange(context.Background(), code, codeVerifier, "nonce-123"); err == nil {
+		t.Errorf("Exchange() accepted a used code")
+	}
+}
+
+// Test ID tokens that fail any check are refused
+func TestProvider_Exchange_Rejected(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(claims jwt.MapClaims)
+		verifier string
+		nonce    string
+	}{
+		{name: "nonce mismatch", nonce: "other-nonce"},
+		{name: "wrong PKCE verifier", verifier: "wrong-verifier-wrong-verifier-wrong-verifier"},
+		{name: "other audience", modify: func(claims jwt.MapClaims) { claims["aud"] = "other-client" }},
+		{name: "other issuer", modify: func(claims jwt.MapClaims) { claims["iss"] = "https://evil.example.com" }},
+		{name: "expired", modify: func(claims jwt.MapClaims) { claims["exp"] = time.Now().Add(-time.Minute).Unix() }},
+		{name: "missing nonce", modify: func(claims jwt.MapClaims) { delete(claims, "nonce") }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := oidctest.NewServer("client-123", "secret-123")
+			defer fake.Close()
+			fake.ModifyClaims = tt.modify
+			provider := newProvider(fake)
+
+			code := authorize(t, fake, provider, "nonce-123")
+
+			verifier, nonce := codeVerifier, "nonce-123"
+			if tt.verifier != "" {
+				verifier = tt.verifier
+			}
+			if tt.nonce != "" {
+				nonce = tt.nonce
+			}
+
+			if claims, err := provider.Exchange(context.Background(), code, verifier, nonce); err == nil {
+				t.Errorf("Exchange() = %+v, want error", claims)
+			}
+		})
+	}
+}
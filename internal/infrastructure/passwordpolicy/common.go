@@ -0,0 +1,49 @@
+package passwordpolicy
+
+import (
+	_ "embed"
+	"strings"
+	"sync"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+//go:embed common_passwords.txt
+var commonPasswordsFile string
+
+var (
+	commonPasswordsOnce sync.Once
+	commonPasswordSet   map[string]struct{}
+)
+
+// CommonPasswords rejects passwords found in the embedded list of common and breached passwords
+func CommonPasswords() Rule {
+	commonPasswordsOnce.Do(func() {
+		commonPasswordSet = parsePasswordList(commonPasswordsFile)
+	})
+
+	return RuleFunc(func(password string, _ domain.PasswordOwner) *domain.PasswordViolation {
+		if _, found := commonPasswordSet[strings.ToLower(password)]; !found {
+			return nil
+		}
+
+		return &domain.PasswordViolation{
+			Rule:    domain.PasswordRuleCommon,
+			Message: "password is too common",
+		}
+	})
+}
+
+func parsePasswordList(list string) map[string]struct{} {
+	set := make(map[string]struct{})
+
+	for _, line := range strings.Split(list, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		set[strings.ToLower(line)] = struct{}{}
+	}
+
+	return set
+}
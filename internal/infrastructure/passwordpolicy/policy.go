@@ -0,0 +1,67 @@
+package passwordpolicy
+
+import (
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+// Rule is a single password check. It returns nil when the password passes.
+type Rule interface {
+	Check(password string, owner domain.PasswordOwner) *domain.PasswordViolation
+}
+
+// Policy runs every rule and reports all violations at once so clients can show them together
+type Policy struct {
+	rules []Rule
+}
+
+// Config selects the rules of the default policy, zero values disable a rule
+type Config struct {
+	MinLength int
+	// Counted in bytes: password hashers cap their input (bcrypt at 72 bytes)
+	MaxLength int
+	// How many of lowercase, uppercase, digit and symbol the password must mix
+	MinCharacterClasses int
+	RejectPersonalInfo  bool
+	RejectCommon        bool
+}
+
+// New creates a policy from custom rules
+func New(rules ...Rule) *Policy {
+	return &Policy{rules: rules}
+}
+
+// NewDefault builds the standard rule set from the config
+func NewDefault(cfg Config) *Policy {
+	var rules []Rule
+
+	if cfg.MinLength > 0 {
+		rules = append(rules, MinLength(cfg.MinLength))
+	}
+	if cfg.MaxLength > 0 {
+		rules = append(rules, MaxLength(cfg.MaxLength))
+	}
+	if cfg.MinCharacterClasses > 0 {
+		rules = append(rules, CharacterClasses(cfg.MinCharacterClasses))
+	}
+	if cfg.RejectPersonalInfo {
+		rules = append(rules, NoPersonalInfo())
+	}
+	if cfg.RejectCommon {
+		rules = append(rules, CommonPasswords())
+	}
+
+	return New(rules...)
+}
+
+// Validate returns every rule the password breaks, nil when it is acceptable
+func (p *Policy) Validate(password string, owner domain.PasswordOwner) []domain.PasswordViolation {
+	var violations []domain.PasswordViolation
+
+	for _, rule := range p.rules {
+		if violation := rule.Check(password, owner); violation != nil {
+			violations = append(violations, *violation)
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,74 @@
+package passwordpolicy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+func rules(violations []domain.PasswordViolation) []string {
+	var names []string
+	for _, violation := range violations {
+		names = append(names, violation.Rule)
+	}
+	return names
+}
+
+// Test the default policy reports every broken rule
+func TestPolicy_Validate(t *testing.T) {
+	policy := NewDefault(Config{
+		MinLength:           10,
+		MaxLength:           72,
+		MinCharacterClasses: 3,
+		RejectPersonalInfo:  true,
+		RejectCommon:        true,
+	})
+	owner := domain.PasswordOwner{Name: "Budi Santoso", Email: "budi.s@example.com"}
+
+	tests := []struct {
+		name     string
+		password string
+		want     []string
+	}{
+		{name: "strong password", password: "Tr4ffic-Lamp-Cactus", want: nil},
+		{name: "too short", password: "Ab1!xyz", want: []string{domain.PasswordRuleTooShort}},
+		{name: "too long", password: "Aa1!" + strings.Repeat("x", 70), want: []string{domain.PasswordRuleTooLong}},
+		{name: "single character class", password: "correcthorsebattery", want: []string{domain.PasswordRuleCharacterClass}},
+		{name: "contains name", password: "Santoso-2024!", want: []string{domain.PasswordRulePersonalInfo}},
+		{name: "contains email local part", password: "Budi.S-Rocks1", want: []string{domain.PasswordRulePersonalInfo}},
+		{name: "common password", password: "P@ssw0rd", want: []string{domain.PasswordRuleTooShort, domain.PasswordRuleCommon}},
+		{name: "common password any case", password: "Password1234", want: []string{domain.PasswordRuleCommon}},
+		{name: "many violations", password: "budi", want: []string{domain.PasswordRuleTooShort, domain.PasswordRuleCharacterClass, domain.PasswordRulePersonalInfo}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rules(policy.Validate(tt.password, owner))
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Validate(%q) = %v, want %v", tt.password, got, tt.want)
+			}
+		})
+	}
+}
+
+// Test a zero config accepts anything
+func TestPolicy_EmptyConfig(t *testing.T) {
+	if violations := NewDefault(Config{}).Validate("a", domain.PasswordOwner{}); violations != nil {
+		t.Errorf("Validate() = %v, want nil", violations)
+	}
+}
+
+// Test the embedded list skips comments and blank lines
+func TestParsePasswordList(t *testing.T) {
+	set := parsePasswordList("# comment\n\nQwerty\n  letmein \n")
+	if len(set) != 2 {
+		t.Fatalf("parsePasswordList() = %v, want 2 entries", set)
+	}
+	if _, ok := set["qwerty"]; !ok {
+		t.Errorf("parsePasswordList() did not lowercase entries")
+	}
+	if _, ok := set["letmein"]; !ok {
+		t.Errorf("parsePasswordList() did not trim entries")
+	}
+}
@@ -0,0 +1,109 @@
+package passwordpolicy
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+// minPersonalInfoLen skips name parts and email local parts too short to matter ("al", "jo")
+const minPersonalInfoLen = 3
+
+// RuleFunc adapts a plain function to a Rule
+type RuleFunc func(password string, owner domain.PasswordOwner) *domain.PasswordViolation
+
+func (f RuleFunc) Check(password string, owner domain.PasswordOwner) *domain.PasswordViolation {
+	return f(password, owner)
+}
+
+// MinLength requires at least n characters
+func MinLength(n int) Rule {
+	return RuleFunc(func(password string, _ domain.PasswordOwner) *domain.PasswordViolation {
+		if utf8.RuneCountInString(password) >= n {
+			return nil
+		}
+
+		return &domain.PasswordViolation{
+			Rule:    domain.PasswordRuleTooShort,
+			Message: fmt.Sprintf("password must be at least %d characters", n),
+		}
+	})
+}
+
+// MaxLength caps the password at n bytes
+func MaxLength(n int) Rule {
+	return RuleFunc(func(password string, _ domain.PasswordOwner) *domain.PasswordViolation {
+		if len(password) <= n {
+			return nil
+		}
+
+		return &domain.PasswordViolation{
+			Rule:    domain.PasswordRuleTooLong,
+			Message: fmt.Sprintf("password must be at most %d bytes", n),
+		}
+	})
+}
+
+// CharacterClasses requires n of lowercase, uppercase, digit and symbol
+func CharacterClasses(n int) Rule {
+	return RuleFunc(func(password string, _ domain.PasswordOwner) *domain.PasswordViolation {
+		var lower, upper, digit, symbol bool
+
+		for _, r := range password {
+			switch {
+			case unicode.IsLower(r):
+				lower = true
+			case unicode.IsUpper(r):
+				upper = true
+			case unicode.IsDigit(r):
+				digit = true
+			default:
+				symbol = true
+			}
+		}
+
+		classes := 0
+		for _, present := range []bool{lower, upper, digit, symbol} {
+			if present {
+				classes++
+			}
+		}
+
+		if classes >= n {
+			return nil
+		}
+
+		return &domain.PasswordViolation{
+			Rule:    domain.PasswordRuleCharacterClass,
+			Message: fmt.Sprintf("password must mix at least %d of lowercase, uppercase, digits and symbols", n),
+		}
+	})
+}
+
+// NoPersonalInfo rejects passwords containing the owner's name or email address
+func NoPersonalInfo() Rule {
+	return RuleFunc(func(password string, owner domain.PasswordOwner) *domain.PasswordViolation {
+		lowered := strings.ToLower(password)
+
+		var parts []string
+		if email := strings.ToLower(owner.Email); email != "" {
+			local, _, _ := strings.Cut(email, "@")
+			parts = append(parts, email, local)
+		}
+		parts = append(parts, strings.Fields(strings.ToLower(owner.Name))...)
+
+		for _, part := range parts {
+			if utf8.RuneCountInString(part) >= minPersonalInfoLen && strings.Contains(lowered, part) {
+				return &domain.PasswordViolation{
+					Rule:    domain.PasswordRulePersonalInfo,
+					Message: "password must not contain your name or email address",
+				}
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,78 @@
+package rbac
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/role/domain"
+)
+
+// Store lists every role together with its granted permissions
+type Store interface {
+	List(ctx context.Context) ([]domain.Role, error)
+}
+
+// PermissionCache keeps the role → permission map in memory so the gRPC
+// interceptor never hits the database. Changes made through this instance
+// apply on Reload; changes made by another instance apply on the next refresh.
+type PermissionCache struct {
+	store Store
+
+	mu          sync.RWMutex
+	permissions map[string][]string
+}
+
+// NewPermissionCache creates an empty cache; call Reload before serving
+func NewPermissionCache(store Store) *PermissionCache {
+	return &PermissionCache{
+		store:       store,
+		permissions: make(map[string][]string),
+	}
+}
+
+// Permissions returns the permissions granted to roleID, none for an unknown role
+func (c *PermissionCache) Permissions(roleID string) []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.permissions[roleID]
+}
+
+// Reload replaces the cached map with the current one from the store.
+// On error the previous map is kept.
+func (c *PermissionCache) Reload(ctx context.Context) error {
+	roles, err := c.store.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	permissions := make(map[string][]string, len(roles))
+	for _, role := range roles {
+		permissions[role.ID] = role.Permissions
+	}
+
+	c.mu.Lock()
+	c.permissions = permissions
+	c.mu.Unlock()
+
+	return nil
+}
+
+// Run reloads the cache every interval until ctx is done
+func (c *PermissionCache) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.Reload(ctx); err != nil {
+				log.Printf("[RBAC] reload permissions failed: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/nassabiq/golang-template/internal/modules/role/domain"
+)
+
+type mockStore struct {
+	roles []domain.Role
+	err   error
+}
+
+func (m *mockStore) List(ctx context.Context) ([]domain.Role, error) {
+	return m.roles, m.err
+}
+
+func TestPermissionCache_Reload(t *testing.T) {
+	store := &mockStore{roles: []domain.Role{
+		{ID: "role-admin", Permissions: []string{"users.read", "users.delete"}},
+		{ID: "role-user"},
+	}}
+	cache := NewPermissionCache(store)
+
+	if got := cache.Permissions("role-admin"); got != nil {
+		t.Fatalf("Permissions() before Reload = %v, want none", got)
+	}
+
+	if err := cache.Reload(context.Background()); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+
+	if got := cache.Permissions("role-admin"); !slices.Equal(got, []string{"users.read", "users.delete"}) {
+		t.Errorf("Permissions(role-admin) = %v", got)
+	}
+	if got := cache.Permissions("role-user"); len(got) != 0 {
+		t.Errorf("Permissions(role-user) = %v, want none", got)
+	}
+	if got := cache.Permissions("role-unknown"); len(got) != 0 {
+		t.Errorf("Permissions(role-unknown) = %v, want none", got)
+	}
+
+	// A new role and a revoked permission show up after the next reload
+	store.roles = []domain.Role{
+		{ID: "role-admin", Permissions: []string{"users.read"}},
+		{ID: "role-support", Permissions: []string{"users.unlock"}},
+	}
+	if err := cache.Reload(context.Background()); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+
+	if got := cache.Permissions("role-admin"); !slices.Equal(got, []string{"users.read"}) {
+		t.Errorf("Permissions(role-admin) after revoke = %v", got)
+	}
+	if got := cache.Permissions("role-support"); !slices.Equal(got, []string{"users.unlock"}) {
+		t.Errorf("Permissions(role-support) = %v", got)
+	}
+}
+
+func TestPermissionCache_ReloadErrorKeepsPreviousMap(t *testing.T) {
+	store := &mockStore{roles: []domain.Role{{ID: "role-admin", Permissions: []string{"users.read"}}}}
+	cache := NewPermissionCache(store)
+
+	if err := cache.Reload(context.Background()); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+
+	store.err = errors.New("db down")
+	if err := cache.Reload(context.Background()); err == nil {
+		t.Fatal("Reload() error = nil, want store error")
+	}
+
+	if got := cache.Permissions("role-admin"); !slices.Equal(got, []string{"users.read"}) {
+		t.Errorf("Permissions(role-admin) = %v, want previous map kept", got)
+	}
+}
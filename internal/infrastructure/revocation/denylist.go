@@ -0,0 +1,333 @@
+package revocation
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+// Store persists revoked access tokens and sessions and the per-user and
+// per-membership token watermarks
+type Store interface {
+	StoreRevokedToken(ctx context.Context, token *domain.RevokedToken) error
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+	DeleteExpiredRevokedTokens(ctx context.Context) error
+	StoreRevokedSession(ctx context.Context, session *domain.RevokedSession) error
+	IsSessionRevoked(ctx context.Context, sessionID string) (bool, error)
+	DeleteExpiredRevokedSessions(ctx context.Context) error
+	SetTokensValidAfter(ctx context.Context, userID string, validAfter time.Time) error
+	// FindTokensValidAfter reports exists false for deleted and no longer active users
+	FindTokensValidAfter(ctx context.Context, userID string) (validAfter time.Time, exists bool, err error)
+	SetOrganizationTokensValidAfter(ctx context.Context, userID, organizationID string, validAfter time.Time) error
+	FindOrganizationTokensValidAfter(ctx context.Context, userID, organizationID string) (time.Time, error)
+}
+
+type tokenEntry struct {
+	revoked   bool
+	expiresAt time.Time
+}
+
+type userEntry struct {
+	validAfter time.Time
+	exists     bool
+	expiresAt  time.Time
+}
+
+type membershipEntry struct {
+	validAfter time.Time
+	expiresAt  time.Time
+}
+
+// Denylist answers "is this access token still valid" for the gRPC interceptor.
+// Lookups are cached in process for ttl, so a revocation made by another instance
+// takes effect within ttl; revocations made through this instance apply immediately.
+type Denylist struct {
+	store Store
+	ttl   time.Duration
+	now   func() time.Time
+
+	mu          sync.Mutex
+	tokens      map[string]tokenEntry
+	sessions    map[string]tokenEntry
+	users       map[string]userEntry
+	memberships map[string]membershipEntry
+}
+
+// NewDenylist creates a denylist caching store lookups for ttl
+func NewDenylist(store Store, ttl time.Duration) *Denylist {
+	return &Denylist{
+		store:       store,
+		ttl:         ttl,
+		now:         time.Now,
+		tokens:      make(map[string]tokenEntry),
+		sessions:    make(map[string]tokenEntry),
+		users:       make(map[string]userEntry),
+		memberships: make(map[string]membershipEntry),
+	}
+}
+
+// IsRevoked reports whether the token or its session was denylisted, was issued
+// before the user's watermark or, for a token acting in organizationID, before
+// their watermark there, or belongs to a user that no longer exists or is
+// suspended or deactivated
+func (d *Denylist) IsRevoked(ctx context.Context, jti, userID, organizationID, sessionID string, issuedAt time.Time) (bool, error) {
+	user, err := d.user(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	if !user.exists {
+		return true, nil
+	}
+
+	if issuedBefore(issuedAt, user.validAfter) {
+		return true, nil
+	}
+
+	if organizationID != "" {
+		membership, err := d.membership(ctx, userID, organizationID)
+		if err != nil {
+			return false, err
+		}
+
+		if issuedBefore(issuedAt, membership.validAfter) {
+			return true, nil
+		}
+	}
+
+	if sessionID != "" {
+		revoked, err := d.session(ctx, sessionID)
+		if err != nil || revoked {
+			return revoked, err
+		}
+	}
+
+	if jti == "" {
+		return false, nil
+	}
+
+	return d.token(ctx, jti)
+}
+
+// issuedBefore reports whether a token issued at issuedAt predates the
+// watermark. JWT timestamps are truncated to the second, so a token carrying the
+// watermark's own second may have been issued just before it and counts as
+// revoked; a token issued later in that second is refused too.
+func issuedBefore(issuedAt, validAfter time.Time) bool {
+	return !validAfter.IsZero() && !issuedAt.After(validAfter)
+}
+
+// RevokeAccessToken denylists a single access token until it expires
+func (d *Denylist) RevokeAccessToken(ctx context.Context, jti, userID string, expiresAt time.Time) error {
+	if err := d.store.StoreRevokedToken(ctx, &domain.RevokedToken{
+		JTI:       jti,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+		CreatedAt: d.now(),
+	}); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	d.tokens[jti] = tokenEntry{revoked: true, expiresAt: expiresAt}
+	d.mu.Unlock()
+
+	return nil
+}
+
+// RevokeSessionAccessTokens denylists every access token of the session until
+// expiresAt, which must outlive the last access token issued for it
+func (d *Denylist) RevokeSessionAccessTokens(ctx context.Context, sessionID, userID string, expiresAt time.Time) error {
+	if err := d.store.StoreRevokedSession(ctx, &domain.RevokedSession{
+		SessionID: sessionID,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+		CreatedAt: d.now(),
+	}); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	d.sessions[sessionID] = tokenEntry{revoked: true, expiresAt: expiresAt}
+	d.mu.Unlock()
+
+	return nil
+}
+
+// RevokeUserAccessTokens invalidates every access token of the user issued before the given time
+func (d *Denylist) RevokeUserAccessTokens(ctx context.Context, userID string, before time.Time) error {
+	if err := d.store.SetTokensValidAfter(ctx, userID, before); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	d.users[userID] = userEntry{validAfter: before, exists: true, expiresAt: d.now().Add(d.ttl)}
+	d.mu.Unlock()
+
+	return nil
+}
+
+// RevokeOrganizationAccessTokens invalidates the access tokens the user holds in
+// organizationID issued before the given time, leaving their other organizations alone
+func (d *Denylist) RevokeOrganizationAccessTokens(ctx context.Context, userID, organizationID string, before time.Time) error {
+	if err := d.store.SetOrganizationTokensValidAfter(ctx, userID, organizationID, before); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	d.memberships[membershipKey(userID, organizationID)] = membershipEntry{validAfter: before, expiresAt: d.now().Add(d.ttl)}
+	d.mu.Unlock()
+
+	return nil
+}
+
+// Run periodically drops expired cache entries and purges expired rows from the store
+func (d *Denylist) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.prune()
+
+			if err := d.store.DeleteExpiredRevokedTokens(ctx); err != nil {
+				log.Printf("[Revocation] purge expired tokens failed: %v", err)
+			}
+
+			if err := d.store.DeleteExpiredRevokedSessions(ctx); err != nil {
+				log.Printf("[Revocation] purge expired sessions failed: %v", err)
+			}
+		}
+	}
+}
+
+func (d *Denylist) user(ctx context.Context, userID string) (userEntry, error) {
+	d.mu.Lock()
+	entry, ok := d.users[userID]
+	d.mu.Unlock()
+
+	if ok && d.now().Before(entry.expiresAt) {
+		return entry, nil
+	}
+
+	validAfter, exists, err := d.store.FindTokensValidAfter(ctx, userID)
+	if err != nil {
+		return userEntry{}, err
+	}
+
+	entry = userEntry{validAfter: validAfter, exists: exists, expiresAt: d.now().Add(d.ttl)}
+
+	d.mu.Lock()
+	d.users[userID] = entry
+	d.mu.Unlock()
+
+	return entry, nil
+}
+
+func (d *Denylist) membership(ctx context.Context, userID, organizationID string) (membershipEntry, error) {
+	key := membershipKey(userID, organizationID)
+
+	d.mu.Lock()
+	entry, ok := d.memberships[key]
+	d.mu.Unlock()
+
+	if ok && d.now().Before(entry.expiresAt) {
+		return entry, nil
+	}
+
+	validAfter, err := d.store.FindOrganizationTokensValidAfter(ctx, userID, organizationID)
+	if err != nil {
+		return membershipEntry{}, err
+	}
+
+	entry = membershipEntry{validAfter: validAfter, expiresAt: d.now().Add(d.ttl)}
+
+	d.mu.Lock()
+	d.memberships[key] = entry
+	d.mu.Unlock()
+
+	return entry, nil
+}
+
+func membershipKey(userID, organizationID string) string {
+	return organizationID + "/" + userID
+}
+
+func (d *Denylist) token(ctx context.Context, jti string) (bool, error) {
+	d.mu.Lock()
+	entry, ok := d.tokens[jti]
+	d.mu.Unlock()
+
+	if ok && d.now().Before(entry.expiresAt) {
+		return entry.revoked, nil
+	}
+
+	revoked, err := d.store.IsTokenRevoked(ctx, jti)
+	if err != nil {
+		return false, err
+	}
+
+	d.mu.Lock()
+	d.tokens[jti] = tokenEntry{revoked: revoked, expiresAt: d.now().Add(d.ttl)}
+	d.mu.Unlock()
+
+	return revoked, nil
+}
+
+func (d *Denylist) session(ctx context.Context, sessionID string) (bool, error) {
+	d.mu.Lock()
+	entry, ok := d.sessions[sessionID]
+	d.mu.Unlock()
+
+	if ok && d.now().Before(entry.expiresAt) {
+		return entry.revoked, nil
+	}
+
+	revoked, err := d.store.IsSessionRevoked(ctx, sessionID)
+	if err != nil {
+		return false, err
+	}
+
+	d.mu.Lock()
+	d.sessions[sessionID] = tokenEntry{revoked: revoked, expiresAt: d.now().Add(d.ttl)}
+	d.mu.Unlock()
+
+	return revoked, nil
+}
+
+func (d *Denylist) prune() {
+	now := d.now()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for jti, entry := range d.tokens {
+		if !now.Before(entry.expiresAt) {
+			delete(d.tokens, jti)
+		}
+	}
+
+	for sessionID, entry := range d.sessions {
+		if !now.Before(entry.expiresAt) {
+			delete(d.sessions, sessionID)
+		}
+	}
+
+	for userID, entry := range d.users {
+		if !now.Before(entry.expiresAt) {
+			delete(d.users, userID)
+		}
+	}
+
+	for key, entry := range d.memberships {
+		if !now.Before(entry.expiresAt) {
+			delete(d.memberships, key)
+		}
+	}
+}
@@ -0,0 +1,285 @@
+package revocation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+type mockStore struct {
+	revoked     map[string]bool
+	sessions    map[string]bool
+	validAfter  map[string]time.Time
+	memberships map[string]time.Time
+	users       map[string]bool
+	tokenCalls  int
+	userCalls   int
+	failLookups bool
+}
+
+func newMockStore() *mockStore {
+	return &mockStore{
+		revoked:     make(map[string]bool),
+		sessions:    make(map[string]bool),
+		validAfter:  make(map[string]time.Time),
+		memberships: make(map[string]time.Time),
+		users:       map[string]bool{"user-123": true},
+	}
+}
+
+func (m *mockStore) StoreRevokedToken(ctx context.Context, token *domain.RevokedToken) error {
+	m.revoked[token.JTI] = true
+	return nil
+}
+
+func (m *mockStore) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	m.tokenCalls++
+	return m.revoked[jti], nil
+}
+
+func (m *mockStore) DeleteExpiredRevokedTokens(ctx context.Context) error {
+	return nil
+}
+
+func (m *mockStore) StoreRevokedSession(ctx context.Context, session *domain.RevokedSession) error {
+	m.sessions[session.SessionID] = true
+	return nil
+}
+
+func (m *mockStore) IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	return m.sessions[sessionID], nil
+}
+
+func (m *mockStore) DeleteExpiredRevokedSessions(ctx context.Context) error {
+	return nil
+}
+
+func (m *mockStore) SetTokensValidAfter(ctx context.Context, userID string, validAfter time.Time) error {
+	m.validAfter[userID] = validAfter
+	return nil
+}
+
+func (m *mockStore) FindTokensValidAfter(ctx context.Context, userID string) (time.Time, bool, error) {
+	m.userCalls++
+	if m.failLookups {
+		return time.Time{}, false, errors.New("database down")
+	}
+	return m.validAfter[userID], m.users[userID], nil
+}
+
+func (m *mockStore) SetOrganizationTokensValidAfter(ctx context.Context, userID, organizationID string, validAfter time.Time) error {
+	m.memberships[organizationID+"/"+userID] = validAfter
+	return nil
+}
+
+func (m *mockStore) FindOrganizationTokensValidAfter(ctx context.Context, userID, organizationID string) (time.Time, error) {
+	return m.memberships[organizationID+"/"+userID], nil
+}
+
+func setupDenylist() (*Denylist, *mockStore, *time.Time) {
+	store := newMockStore()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	denylist := NewDenylist(store, 30*time.Second)
+	denylist.now = func() time.Time { return now }
+
+	return denylist, store, &now
+}
+
+// Test IsRevoked
+func TestDenylist_IsRevoked(t *testing.T) {
+	issuedAt := time.Date(2024, 1, 1, 11, 55, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		jti            string
+		userID         string
+		organizationID string
+		sessionID      string
+		setup          func(*mockStore)
+		want           bool
+		wantErr        bool
+	}{
+		{name: "valid token", jti: "jti-1", userID: "user-123", want: false},
+		{
+			name:   "denylisted jti",
+			jti:    "jti-1",
+			userID: "user-123",
+			setup:  func(s *mockStore) { s.revoked["jti-1"] = true },
+			want:   true,
+		},
+		{
+			name:   "issued before watermark",
+			jti:    "jti-1",
+			userID: "user-123",
+			setup:  func(s *mockStore) { s.validAfter["user-123"] = issuedAt.Add(time.Minute) },
+			want:   true,
+		},
+		{
+			name:   "issued in the watermark's second",
+			jti:    "jti-1",
+			userID: "user-123",
+			setup:  func(s *mockStore) { s.validAfter["user-123"] = issuedAt.Add(500 * time.Millisecond) },
+			want:   true,
+		},
+		{
+			name:   "issued after watermark",
+			jti:    "jti-1",
+			userID: "user-123",
+			setup:  func(s *mockStore) { s.validAfter["user-123"] = issuedAt.Add(-time.Minute) },
+			want:   false,
+		},
+		{
+			name:           "issued before organization watermark",
+			jti:            "jti-1",
+			userID:         "user-123",
+			organizationID: "org-acme",
+			setup:          func(s *mockStore) { s.memberships["org-acme/user-123"] = issuedAt.Add(time.Minute) },
+			want:           true,
+		},
+		{
+			name:           "issued in the organization watermark's second",
+			jti:            "jti-1",
+			userID:         "user-123",
+			organizationID: "org-acme",
+			setup:          func(s *mockStore) { s.memberships["org-acme/user-123"] = issuedAt.Add(500 * time.Millisecond) },
+			want:           true,
+		},
+		{
+			name:           "other organization watermark",
+			jti:            "jti-1",
+			userID:         "user-123",
+			organizationID: "org-globex",
+			setup:          func(s *mockStore) { s.memberships["org-acme/user-123"] = issuedAt.Add(time.Minute) },
+			want:           false,
+		},
+		{
+			name:   "organization watermark ignored outside it",
+			jti:    "jti-1",
+			userID: "user-123",
+			setup:  func(s *mockStore) { s.memberships["org-acme/user-123"] = issuedAt.Add(time.Minute) },
+			want:   false,
+		},
+		{
+			name:      "revoked session",
+			jti:       "jti-1",
+			userID:    "user-123",
+			sessionID: "session-laptop",
+			setup:     func(s *mockStore) { s.sessions["session-laptop"] = true },
+			want:      true,
+		},
+		{
+			name:      "other session revoked",
+			jti:       "jti-1",
+			userID:    "user-123",
+			sessionID: "session-phone",
+			setup:     func(s *mockStore) { s.sessions["session-laptop"] = true },
+			want:      false,
+		},
+		{name: "deleted user", jti: "jti-1", userID: "user-deleted", want: true},
+		{
+			name:    "store unavailable",
+			jti:     "jti-1",
+			userID:  "user-123",
+			setup:   func(s *mockStore) { s.failLookups = true },
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			denylist, store, _ := setupDenylist()
+			if tt.setup != nil {
+				tt.setup(store)
+			}
+
+			got, err := denylist.IsRevoked(context.Background(), tt.jti, tt.userID, tt.organizationID, tt.sessionID, issuedAt)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IsRevoked() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("IsRevoked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test lookups are cached until the TTL passes
+func TestDenylist_Cache(t *testing.T) {
+	denylist, store, now := setupDenylist()
+	issuedAt := now.Add(-time.Minute)
+
+	for i := 0; i < 3; i++ {
+		if revoked, _ := denylist.IsRevoked(context.Background(), "jti-1", "user-123", "", "", issuedAt); revoked {
+			t.Fatalf("IsRevoked() = true for a valid token")
+		}
+	}
+	if store.tokenCalls != 1 || store.userCalls != 1 {
+		t.Errorf("IsRevoked() hit the store %d/%d times, want 1/1", store.tokenCalls, store.userCalls)
+	}
+
+	// revoked by another instance: visible once the cache entry expires
+	store.revoked["jti-1"] = true
+	if revoked, _ := denylist.IsRevoked(context.Background(), "jti-1", "user-123", "", "", issuedAt); revoked {
+		t.Errorf("IsRevoked() bypassed the cache")
+	}
+
+	*now = now.Add(31 * time.Second)
+	if revoked, _ := denylist.IsRevoked(context.Background(), "jti-1", "user-123", "", "", issuedAt); !revoked {
+		t.Errorf("IsRevoked() = false after the cache expired")
+	}
+}
+
+// Test revocations through the denylist apply immediately despite cached lookups
+func TestDenylist_RevokeAppliesImmediately(t *testing.T) {
+	denylist, _, now := setupDenylist()
+	issuedAt := now.Add(-time.Minute)
+
+	if revoked, _ := denylist.IsRevoked(context.Background(), "jti-1", "user-123", "", "", issuedAt); revoked {
+		t.Fatalf("IsRevoked() = true for a valid token")
+	}
+
+	if err := denylist.RevokeAccessToken(context.Background(), "jti-1", "user-123", now.Add(10*time.Minute)); err != nil {
+		t.Fatalf("RevokeAccessToken() error = %v", err)
+	}
+	if revoked, _ := denylist.IsRevoked(context.Background(), "jti-1", "user-123", "", "", issuedAt); !revoked {
+		t.Errorf("IsRevoked() = false right after RevokeAccessToken")
+	}
+
+	if revoked, _ := denylist.IsRevoked(context.Background(), "jti-2", "user-123", "", "", issuedAt); revoked {
+		t.Fatalf("IsRevoked() = true for another token")
+	}
+	if err := denylist.RevokeUserAccessTokens(context.Background(), "user-123", *now); err != nil {
+		t.Fatalf("RevokeUserAccessTokens() error = %v", err)
+	}
+	if revoked, _ := denylist.IsRevoked(context.Background(), "jti-2", "user-123", "", "", issuedAt); !revoked {
+		t.Errorf("IsRevoked() = false right after RevokeUserAccessTokens")
+	}
+	if revoked, _ := denylist.IsRevoked(context.Background(), "jti-3", "user-123", "", "", now.Add(time.Second)); revoked {
+		t.Errorf("IsRevoked() = true for a token issued after the watermark")
+	}
+
+	later := now.Add(time.Minute)
+	if err := denylist.RevokeOrganizationAccessTokens(context.Background(), "user-123", "org-acme", later); err != nil {
+		t.Fatalf("RevokeOrganizationAccessTokens() error = %v", err)
+	}
+	if revoked, _ := denylist.IsRevoked(context.Background(), "jti-4", "user-123", "org-acme", "", now.Add(time.Second)); !revoked {
+		t.Errorf("IsRevoked() = false right after RevokeOrganizationAccessTokens")
+	}
+	if revoked, _ := denylist.IsRevoked(context.Background(), "jti-4", "user-123", "org-globex", "", now.Add(time.Second)); revoked {
+		t.Errorf("IsRevoked() = true for a token acting in another organization")
+	}
+
+	if err := denylist.RevokeSessionAccessTokens(context.Background(), "session-laptop", "user-123", now.Add(time.Hour)); err != nil {
+		t.Fatalf("RevokeSessionAccessTokens() error = %v", err)
+	}
+	if revoked, _ := denylist.IsRevoked(context.Background(), "jti-5", "user-123", "", "session-laptop", later.Add(time.Second)); !revoked {
+		t.Errorf("IsRevoked() = false right after RevokeSessionAccessTokens")
+	}
+	if revoked, _ := denylist.IsRevoked(context.Background(), "jti-5", "user-123", "", "session-phone", later.Add(time.Second)); revoked {
+		t.Errorf("IsRevoked() = true for a token of another session")
+	}
+}
@@ -0,0 +1,28 @@
+package sms
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// LogSender writes messages to w instead of sending them, for local
+// development where there is no SMS gateway
+type LogSender struct {
+	mu  sync.Mutex
+	w   io.Writer
+	now func() time.Time
+}
+
+func NewLogSender(w io.Writer) *LogSender {
+	return &LogSender{w: w, now: time.Now}
+}
+
+func (sender *LogSender) Send(to, body string) error {
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	_, err := fmt.Fprintf(sender.w, "%s [SMS] to=%s body=%q\n", sender.now().Format(time.RFC3339), to, body)
+	return err
+}
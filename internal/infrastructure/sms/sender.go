@@ -0,0 +1,6 @@
+package sms
+
+// SMSSender delivers a text message to a phone number in E.164
+type SMSSender interface {
+	Send(to, body string) error
+}
@@ -0,0 +1,71 @@
+package subscribers
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/infrastructure/mail"
+	"github.com/nats-io/nats.go"
+)
+
+// AccountLockedSubscriber warns the user that their account was locked after repeated failed logins
+type AccountLockedSubscriber struct {
+	mailer mail.Mailer
+}
+
+func NewAccountLockedSubscriber(mailer mail.Mailer) *AccountLockedSubscriber {
+	return &AccountLockedSubscriber{mailer: mailer}
+}
+
+func (subscriber *AccountLockedSubscriber) Subject() string {
+	return "auth.account_locked"
+}
+
+func (subscriber *AccountLockedSubscriber) Durable() string {
+	return "email-account-locked"
+}
+
+func (sub *AccountLockedSubscriber) Subscribe(js nats.JetStreamContext) error {
+	_, err := js.Subscribe(sub.Subject(),
+		func(msg *nats.Msg) {
+			var event accountLockedEvent
+
+			if err := json.Unmarshal(msg.Data, &event); err != nil || event.Email == "" {
+				log.Printf("invalid account locked event: %v", err)
+				_ = msg.Term()
+				return
+			}
+
+			if err := sub.send(&event); err != nil {
+				log.Println(err)
+				return
+			}
+			msg.Ack()
+		},
+		nats.Durable(sub.Durable()),
+		nats.ManualAck(),
+	)
+	return err
+}
+
+type accountLockedEvent struct {
+	Name           string    `json:"name"`
+	Email          string    `json:"email"`
+	IPAddress      string    `json:"ip_address"`
+	FailedAttempts int       `json:"failed_attempts"`
+	LockedUntil    time.Time `json:"locked_until"`
+}
+
+func (sub *AccountLockedSubscriber) send(event *accountLockedEvent) error {
+	body := fmt.Sprintf(
+		"Halo %s,\n\nAkun kamu dikunci sementara setelah %d percobaan login gagal dari IP %s.\nKamu bisa mencoba login lagi setelah %s.\n\nJika ini bukan kamu, segera reset password.",
+		event.Name,
+		event.FailedAttempts,
+		event.IPAddress,
+		event.LockedUntil.Format(time.RFC1123),
+	)
+
+	return sub.mailer.Send(event.Email, "Akun Dikunci Sementara", body)
+}
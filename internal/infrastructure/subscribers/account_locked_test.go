@@ -0,0 +1,31 @@
+package subscribers
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// Test the lockout mail names the attempts, IP and unlock time
+func TestAccountLockedSubscriber_Send(t *testing.T) {
+	mailer := &mockMailer{}
+	subscriber := NewAccountLockedSubscriber(mailer)
+
+	if subscriber.Subject() != "auth.account_locked" || subscriber.Durable() != "email-account-locked" {
+		t.Errorf("AccountLockedSubscriber = %s/%s", subscriber.Subject(), subscriber.Durable())
+	}
+
+	err := subscriber.send(&accountLockedEvent{
+		Name:           "Test User",
+		Email:          "user@example.com",
+		IPAddress:      "10.0.0.1",
+		FailedAttempts: 5,
+		LockedUntil:    time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("send() error = %v", err)
+	}
+	if mailer.to != "user@example.com" || !strings.Contains(mailer.body, "5 percobaan") || !strings.Contains(mailer.body, "10.0.0.1") {
+		t.Errorf("send() mail to %s body %q", mailer.to, mailer.body)
+	}
+}
@@ -0,0 +1,125 @@
+package subscribers
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/nassabiq/golang-template/internal/infrastructure/mail"
+	"github.com/nats-io/nats.go"
+)
+
+// UserRegisteredSubscriber sends the welcome mail with the email verification link
+type UserRegisteredSubscriber struct {
+	mailer mail.Mailer
+}
+
+func NewUserRegisteredSubscriber(mailer mail.Mailer) *UserRegisteredSubscriber {
+	return &UserRegisteredSubscriber{mailer: mailer}
+}
+
+func (subscriber *UserRegisteredSubscriber) Subject() string {
+	return "auth.user_registered"
+}
+
+func (subscriber *UserRegisteredSubscriber) Durable() string {
+	return "email-user-registered"
+}
+
+func (sub *UserRegisteredSubscriber) Subscribe(js nats.JetStreamContext) error {
+	_, err := js.Subscribe(sub.Subject(),
+		func(msg *nats.Msg) {
+			event, err := parseVerificationEvent(msg.Data)
+			if err != nil {
+				// a malformed event never succeeds, drop it instead of redelivering
+				log.Println(err)
+				_ = msg.Term()
+				return
+			}
+
+			if err := sub.send(event); err != nil {
+				log.Println(err)
+				return
+			}
+			msg.Ack()
+		},
+		nats.Durable(sub.Durable()),
+		nats.ManualAck(),
+	)
+	return err
+}
+
+func (sub *UserRegisteredSubscriber) send(event *verificationEvent) error {
+	body := fmt.Sprintf("Halo %s,\n\nTerima kasih sudah mendaftar. Verifikasi email kamu dengan klik link berikut:\n\n%s", event.Name, verificationLink(event.Token))
+
+	return sub.mailer.Send(event.Email, "Verifikasi Email", body)
+}
+
+// EmailVerificationSubscriber re-sends the verification link on request
+type EmailVerificationSubscriber struct {
+	mailer mail.Mailer
+}
+
+func NewEmailVerificationSubscriber(mailer mail.Mailer) *EmailVerificationSubscriber {
+	return &EmailVerificationSubscriber{mailer: mailer}
+}
+
+func (subscriber *EmailVerificationSubscriber) Subject() string {
+	return "auth.email_verification_requested"
+}
+
+func (subscriber *EmailVerificationSubscriber) Durable() string {
+	return "email-verification-requested"
+}
+
+func (sub *EmailVerificationSubscriber) Subscribe(js nats.JetStreamContext) error {
+	_, err := js.Subscribe(sub.Subject(),
+		func(msg *nats.Msg) {
+			event, err := parseVerificationEvent(msg.Data)
+			if err != nil {
+				// a malformed event never succeeds, drop it instead of redelivering
+				log.Println(err)
+				_ = msg.Term()
+				return
+			}
+
+			if err := sub.send(event); err != nil {
+				log.Println(err)
+				return
+			}
+			msg.Ack()
+		},
+		nats.Durable(sub.Durable()),
+		nats.ManualAck(),
+	)
+	return err
+}
+
+func (sub *EmailVerificationSubscriber) send(event *verificationEvent) error {
+	body := fmt.Sprintf("Klik link berikut untuk verifikasi email:\n\n%s", verificationLink(event.Token))
+
+	return sub.mailer.Send(event.Email, "Verifikasi Email", body)
+}
+
+type verificationEvent struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Token string `json:"token"`
+}
+
+func parseVerificationEvent(data []byte) (*verificationEvent, error) {
+	var event verificationEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return nil, err
+	}
+
+	if event.Email == "" || event.Token == "" {
+		return nil, fmt.Errorf("invalid verification event: missing email or token")
+	}
+
+	return &event, nil
+}
+
+func verificationLink(token string) string {
+	return "http://localhost:3000/verify-email?token=" + token
+}
@@ -0,0 +1,46 @@
+package subscribers
+
+import (
+	"strings"
+	"testing"
+)
+
+// Test verification subscribers subjects and durables
+func TestEmailVerificationSubscribers_Subjects(t *testing.T) {
+	registered := NewUserRegisteredSubscriber(&mockMailer{})
+	if registered.Subject() != "auth.user_registered" || registered.Durable() != "email-user-registered" {
+		t.Errorf("UserRegisteredSubscriber = %s/%s", registered.Subject(), registered.Durable())
+	}
+
+	resend := NewEmailVerificationSubscriber(&mockMailer{})
+	if resend.Subject() != "auth.email_verification_requested" || resend.Durable() != "email-verification-requested" {
+		t.Errorf("EmailVerificationSubscriber = %s/%s", resend.Subject(), resend.Durable())
+	}
+}
+
+// Test the verification mail carries the link with the token
+func TestUserRegisteredSubscriber_Send(t *testing.T) {
+	mailer := &mockMailer{}
+	subscriber := NewUserRegisteredSubscriber(mailer)
+
+	event, err := parseVerificationEvent([]byte(`{"name":"Test User","email":"user@example.com","token":"verify-token"}`))
+	if err != nil {
+		t.Fatalf("parseVerificationEvent() error = %v", err)
+	}
+
+	if err := subscriber.send(event); err != nil {
+		t.Fatalf("send() error = %v", err)
+	}
+	if mailer.to != "user@example.com" || !strings.Contains(mailer.body, "verify-email?token=verify-token") {
+		t.Errorf("send() mail to %s body %q", mailer.to, mailer.body)
+	}
+}
+
+// Test malformed events are rejected
+func TestParseVerificationEvent_Invalid(t *testing.T) {
+	for _, data := range []string{`not json`, `{"email":"user@example.com"}`, `{"token":"verify-token"}`} {
+		if _, err := parseVerificationEvent([]byte(data)); err == nil {
+			t.Errorf("parseVerificationEvent(%s) expected error", data)
+		}
+	}
+}
@@ -115,13 +115,13 @@ func TestForgotPasswordIntegration_Example(t *testing.T) {
 		1. Setup:
 		   - Start NATS server
 		   - Create JetStream stream "AUTH"
-		   - Start mail worker
+		   - Start notification worker
 		   - Configure SMTP to capture emails
 
 		2. Test Steps:
 		   - Call auth usecase ForgotPassword("user@example.com")
 		   - Verify event published to NATS
-		   - Wait for mail worker to process
+		   - Wait for notification worker to process
 		   - Verify email received with reset link
 
 		3. Assertions:
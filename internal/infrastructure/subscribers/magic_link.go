@@ -0,0 +1,69 @@
+package subscribers
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/infrastructure/mail"
+	"github.com/nats-io/nats.go"
+)
+
+// MagicLinkSubscriber mails the passwordless sign-in link
+type MagicLinkSubscriber struct {
+	mailer mail.Mailer
+}
+
+func NewMagicLinkSubscriber(mailer mail.Mailer) *MagicLinkSubscriber {
+	return &MagicLinkSubscriber{mailer: mailer}
+}
+
+func (subscriber *MagicLinkSubscriber) Subject() string {
+	return "auth.magic_link_requested"
+}
+
+func (subscriber *MagicLinkSubscriber) Durable() string {
+	return "email-magic-link"
+}
+
+func (sub *MagicLinkSubscriber) Subscribe(js nats.JetStreamContext) error {
+	_, err := js.Subscribe(sub.Subject(),
+		func(msg *nats.Msg) {
+			var event magicLinkEvent
+
+			if err := json.Unmarshal(msg.Data, &event); err != nil || event.Email == "" || event.Token == "" {
+				log.Printf("invalid magic link event: %v", err)
+				_ = msg.Term()
+				return
+			}
+
+			if err := sub.send(&event); err != nil {
+				log.Println(err)
+				return
+			}
+			msg.Ack()
+		},
+		nats.Durable(sub.Durable()),
+		nats.ManualAck(),
+	)
+	return err
+}
+
+type magicLinkEvent struct {
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Token     string    `json:"token"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
+
+func (sub *MagicLinkSubscriber) send(event *magicLinkEvent) error {
+	body := fmt.Sprintf(
+		"Halo %s,\n\nKlik link berikut untuk masuk:\n\n%s\n\nLink hanya bisa dipakai sekali dari browser yang memintanya dan berlaku sampai %s. Abaikan email ini jika kamu tidak meminta link login.",
+		event.Name,
+		"http://localhost:3000/magic-link?token="+event.Token,
+		event.ExpiredAt.Format(time.RFC1123),
+	)
+
+	return sub.mailer.Send(event.Email, "Link Login", body)
+}
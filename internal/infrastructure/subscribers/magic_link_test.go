@@ -0,0 +1,30 @@
+package subscribers
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// Test the magic link mail carries the sign-in link
+func TestMagicLinkSubscriber_Send(t *testing.T) {
+	mailer := &mockMailer{}
+	subscriber := NewMagicLinkSubscriber(mailer)
+
+	if subscriber.Subject() != "auth.magic_link_requested" || subscriber.Durable() != "email-magic-link" {
+		t.Errorf("MagicLinkSubscriber = %s/%s", subscriber.Subject(), subscriber.Durable())
+	}
+
+	err := subscriber.send(&magicLinkEvent{
+		Name:      "Test User",
+		Email:     "user@example.com",
+		Token:     "magic-token-123",
+		ExpiredAt: time.Date(2024, 1, 1, 0, 15, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("send() error = %v", err)
+	}
+	if mailer.to != "user@example.com" || !strings.Contains(mailer.body, "magic-link?token=magic-token-123") {
+		t.Errorf("send() mail to %s body %q", mailer.to, mailer.body)
+	}
+}
@@ -0,0 +1,76 @@
+package subscribers
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/infrastructure/mail"
+	"github.com/nats-io/nats.go"
+)
+
+// NewDeviceLoginSubscriber asks the user whether a login from a device they
+// have not used before was them, with a link that ends that session
+type NewDeviceLoginSubscriber struct {
+	mailer mail.Mailer
+}
+
+func NewNewDeviceLoginSubscriber(mailer mail.Mailer) *NewDeviceLoginSubscriber {
+	return &NewDeviceLoginSubscriber{mailer: mailer}
+}
+
+func (subscriber *NewDeviceLoginSubscriber) Subject() string {
+	return "auth.new_device_login"
+}
+
+func (subscriber *NewDeviceLoginSubscriber) Durable() string {
+	return "email-new-device-login"
+}
+
+func (sub *NewDeviceLoginSubscriber) Subscribe(js nats.JetStreamContext) error {
+	_, err := js.Subscribe(sub.Subject(),
+		func(msg *nats.Msg) {
+			var event newDeviceLoginEvent
+
+			if err := json.Unmarshal(msg.Data, &event); err != nil || event.Email == "" || event.RevokeToken == "" {
+				log.Printf("invalid new device login event: %v", err)
+				_ = msg.Term()
+				return
+			}
+
+			if err := sub.send(&event); err != nil {
+				log.Println(err)
+				return
+			}
+			msg.Ack()
+		},
+		nats.Durable(sub.Durable()),
+		nats.ManualAck(),
+	)
+	return err
+}
+
+type newDeviceLoginEvent struct {
+	Name        string    `json:"name"`
+	Email       string    `json:"email"`
+	UserAgent   string    `json:"user_agent"`
+	IPAddress   string    `json:"ip_address"`
+	LoginAt     time.Time `json:"login_at"`
+	RevokeToken string    `json:"revoke_token"`
+	ExpiredAt   time.Time `json:"expired_at"`
+}
+
+func (sub *NewDeviceLoginSubscriber) send(event *newDeviceLoginEvent) error {
+	body := fmt.Sprintf(
+		"Halo %s,\n\nAkun kamu baru saja login dari device yang belum pernah dipakai sebelumnya:\n\nWaktu: %s\nIP: %s\nBrowser: %s\n\nJika ini kamu, abaikan email ini.\n\nJika bukan, klik link berikut untuk mengakhiri sesi tersebut, lalu segera ganti password:\n\n%s\n\nLink hanya bisa dipakai sekali dan berlaku sampai %s.",
+		event.Name,
+		event.LoginAt.Format(time.RFC1123),
+		event.IPAddress,
+		event.UserAgent,
+		"http://localhost:3000/devices/revoke?token="+event.RevokeToken,
+		event.ExpiredAt.Format(time.RFC1123),
+	)
+
+	return sub.mailer.Send(event.Email, "Apakah Ini Kamu?", body)
+}
@@ -0,0 +1,36 @@
+package subscribers
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// Test the new device mail describes the login and links to the revocation
+func TestNewDeviceLoginSubscriber_Send(t *testing.T) {
+	mailer := &mockMailer{}
+	subscriber := NewNewDeviceLoginSubscriber(mailer)
+
+	if subscriber.Subject() != "auth.new_device_login" || subscriber.Durable() != "email-new-device-login" {
+		t.Errorf("NewDeviceLoginSubscriber = %s/%s", subscriber.Subject(), subscriber.Durable())
+	}
+
+	err := subscriber.send(&newDeviceLoginEvent{
+		Name:        "Test User",
+		Email:       "user@example.com",
+		UserAgent:   "okhttp/4.12",
+		IPAddress:   "198.51.100.20",
+		LoginAt:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		RevokeToken: "revoke-token",
+		ExpiredAt:   time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("send() error = %v", err)
+	}
+	if mailer.to != "user@example.com" || !strings.Contains(mailer.body, "198.51.100.20") || !strings.Contains(mailer.body, "okhttp/4.12") {
+		t.Errorf("send() mail to %s body %q", mailer.to, mailer.body)
+	}
+	if !strings.Contains(mailer.body, "/devices/revoke?token=revoke-token") {
+		t.Errorf("send() body %q has no revocation link", mailer.body)
+	}
+}
@@ -0,0 +1,67 @@
+package subscribers
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/nassabiq/golang-template/internal/infrastructure/sms"
+	"github.com/nats-io/nats.go"
+)
+
+// SMSOtpSubscriber texts one-time codes for phone verification and phone login
+type SMSOtpSubscriber struct {
+	sender sms.SMSSender
+}
+
+func NewSMSOtpSubscriber(sender sms.SMSSender) *SMSOtpSubscriber {
+	return &SMSOtpSubscriber{sender: sender}
+}
+
+func (subscriber *SMSOtpSubscriber) Subject() string {
+	return "auth.sms_otp_requested"
+}
+
+func (subscriber *SMSOtpSubscriber) Durable() string {
+	return "sms-otp"
+}
+
+func (sub *SMSOtpSubscriber) Subscribe(js nats.JetStreamContext) error {
+	_, err := js.Subscribe(sub.Subject(),
+		func(msg *nats.Msg) {
+			var event smsOtpEvent
+
+			if err := json.Unmarshal(msg.Data, &event); err != nil || event.Phone == "" || event.Code == "" {
+				log.Printf("invalid sms otp event: %v", err)
+				_ = msg.Term()
+				return
+			}
+
+			if err := sub.send(&event); err != nil {
+				log.Println(err)
+				return
+			}
+			msg.Ack()
+		},
+		nats.Durable(sub.Durable()),
+		nats.ManualAck(),
+	)
+	return err
+}
+
+type smsOtpEvent struct {
+	Phone   string `json:"phone"`
+	Code    string `json:"code"`
+	Purpose string `json:"purpose"`
+}
+
+func (sub *SMSOtpSubscriber) send(event *smsOtpEvent) error {
+	action := "verifikasi nomor HP"
+	if event.Purpose == "login" {
+		action = "login"
+	}
+
+	body := fmt.Sprintf("Kode %s kamu: %s. Jangan berikan kode ini kepada siapa pun.", action, event.Code)
+
+	return sub.sender.Send(event.Phone, body)
+}
@@ -0,0 +1,28 @@
+package subscribers
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/nassabiq/golang-template/internal/infrastructure/sms"
+)
+
+// Test the OTP is texted to the phone number through the log driver
+func TestSMSOtpSubscriber_Send(t *testing.T) {
+	var out bytes.Buffer
+	subscriber := NewSMSOtpSubscriber(sms.NewLogSender(&out))
+
+	if subscriber.Subject() != "auth.sms_otp_requested" || subscriber.Durable() != "sms-otp" {
+		t.Errorf("SMSOtpSubscriber = %s/%s", subscriber.Subject(), subscriber.Durable())
+	}
+
+	if err := subscriber.send(&smsOtpEvent{Phone: "+6281234567890", Code: "042917", Purpose: "login"}); err != nil {
+		t.Fatalf("send() error = %v", err)
+	}
+
+	line := out.String()
+	if !strings.Contains(line, "to=+6281234567890") || !strings.Contains(line, "042917") || !strings.Contains(line, "login") {
+		t.Errorf("send() wrote %q", line)
+	}
+}
@@ -0,0 +1,67 @@
+package subscribers
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/infrastructure/mail"
+	"github.com/nats-io/nats.go"
+)
+
+// UserInvitedSubscriber mails the link an invited user accepts the invitation with
+type UserInvitedSubscriber struct {
+	mailer mail.Mailer
+}
+
+func NewUserInvitedSubscriber(mailer mail.Mailer) *UserInvitedSubscriber {
+	return &UserInvitedSubscriber{mailer: mailer}
+}
+
+func (subscriber *UserInvitedSubscriber) Subject() string {
+	return "user.invited"
+}
+
+func (subscriber *UserInvitedSubscriber) Durable() string {
+	return "email-user-invited"
+}
+
+func (sub *UserInvitedSubscriber) Subscribe(js nats.JetStreamContext) error {
+	_, err := js.Subscribe(sub.Subject(),
+		func(msg *nats.Msg) {
+			var event userInvitedEvent
+
+			if err := json.Unmarshal(msg.Data, &event); err != nil || event.Email == "" || event.Token == "" {
+				log.Printf("invalid user invited event: %v", err)
+				_ = msg.Term()
+				return
+			}
+
+			if err := sub.send(&event); err != nil {
+				log.Println(err)
+				return
+			}
+			msg.Ack()
+		},
+		nats.Durable(sub.Durable()),
+		nats.ManualAck(),
+	)
+	return err
+}
+
+type userInvitedEvent struct {
+	Email     string    `json:"email"`
+	Token     string    `json:"token"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
+
+func (sub *UserInvitedSubscriber) send(event *userInvitedEvent) error {
+	body := fmt.Sprintf(
+		"Halo,\n\nKamu diundang untuk bergabung. Buat akun kamu dengan klik link berikut:\n\n%s\n\nUndangan berlaku sampai %s.",
+		"http://localhost:3000/accept-invitation?token="+event.Token,
+		event.ExpiredAt.Format(time.RFC1123),
+	)
+
+	return sub.mailer.Send(event.Email, "Undangan Bergabung", body)
+}
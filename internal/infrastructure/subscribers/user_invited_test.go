@@ -0,0 +1,29 @@
+package subscribers
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// Test the invitation mail carries the accept link and expiry
+func TestUserInvitedSubscriber_Send(t *testing.T) {
+	mailer := &mockMailer{}
+	subscriber := NewUserInvitedSubscriber(mailer)
+
+	if subscriber.Subject() != "user.invited" || subscriber.Durable() != "email-user-invited" {
+		t.Errorf("UserInvitedSubscriber = %s/%s", subscriber.Subject(), subscriber.Durable())
+	}
+
+	err := subscriber.send(&userInvitedEvent{
+		Email:     "invited@example.com",
+		Token:     "invite-token-123",
+		ExpiredAt: time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("send() error = %v", err)
+	}
+	if mailer.to != "invited@example.com" || !strings.Contains(mailer.body, "accept-invitation?token=invite-token-123") {
+		t.Errorf("send() mail to %s body %q", mailer.to, mailer.body)
+	}
+}
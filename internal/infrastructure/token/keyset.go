@@ -0,0 +1,225 @@
+package token
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+var ErrNoSigningKey = errors.New("no private key found in key set")
+
+// key is a single entry of the key set. Retired keys only have a public part.
+type key struct {
+	kid     string
+	method  jwt.SigningMethod
+	private crypto.Signer
+	public  crypto.PublicKey
+}
+
+// KeySet holds the JWT signing keys loaded from PEM files in a directory.
+// Each file is named <kid>.pem and holds either a private key (RSA or Ed25519)
+// or, for retired keys, only the public key. The private key with the
+// greatest kid signs new tokens; every key in the set verifies.
+type KeySet struct {
+	dir string
+
+	mu     sync.RWMutex
+	keys   map[string]*key
+	active *key
+}
+
+// LoadKeySet reads all keys from dir
+func LoadKeySet(dir string) (*KeySet, error) {
+	keySet := &KeySet{dir: dir}
+	if err := keySet.Reload(); err != nil {
+		return nil, err
+	}
+
+	return keySet, nil
+}
+
+// Reload re-reads the key directory. On error the previously loaded keys stay in use.
+func (k *KeySet) Reload() error {
+	files, err := filepath.Glob(filepath.Join(k.dir, "*.pem"))
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]*key, len(files))
+	var kids []string
+
+	for _, file := range files {
+		kid := strings.TrimSuffix(filepath.Base(file), ".pem")
+
+		entry, err := loadKey(kid, file)
+		if err != nil {
+			return fmt.Errorf("load key %s: %w", kid, err)
+		}
+
+		keys[kid] = entry
+		kids = append(kids, kid)
+	}
+
+	sort.Strings(kids)
+
+	var active *key
+	for i := len(kids) - 1; i >= 0; i-- {
+		if keys[kids[i]].private != nil {
+			active = keys[kids[i]]
+			break
+		}
+	}
+
+	if active == nil {
+		return ErrNoSigningKey
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.keys = keys
+	k.active = active
+
+	return nil
+}
+
+// Watch reloads the key set every interval until ctx is cancelled, so keys can be rotated without a restart
+func (k *KeySet) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := k.Reload(); err != nil {
+				log.Printf("[Token] reload keys from %s failed: %v", k.dir, err)
+			}
+		}
+	}
+}
+
+// PublicKey returns the verification key for kid
+func (k *KeySet) PublicKey(kid string) (crypto.PublicKey, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	entry, ok := k.keys[kid]
+	if !ok {
+		return nil, false
+	}
+
+	return entry.public, true
+}
+
+// PublicKeys returns every key of the set as a JSON Web Key
+func (k *KeySet) PublicKeys() []domain.JSONWebKey {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	kids := make([]string, 0, len(k.keys))
+	for kid := range k.keys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	result := make([]domain.JSONWebKey, 0, len(kids))
+	for _, kid := range kids {
+		entry := k.keys[kid]
+		jwk := domain.JSONWebKey{
+			Kid: entry.kid,
+			Use: "sig",
+			Alg: entry.method.Alg(),
+		}
+
+		switch public := entry.public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		}
+
+		result = append(result, jwk)
+	}
+
+	return result
+}
+
+func (k *KeySet) signingKey() *key {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	return k.active
+}
+
+func loadKey(kid, file string) (*key, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	var parsed any
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		parsed, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &key{kid: kid}
+
+	if signer, ok := parsed.(crypto.Signer); ok {
+		entry.private = signer
+		entry.public = signer.Public()
+	} else {
+		entry.public = parsed
+	}
+
+	switch entry.public.(type) {
+	case *rsa.PublicKey:
+		entry.method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		entry.method = jwt.SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", entry.public)
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,170 @@
+package token
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	middleware "github.com/nassabiq/golang-template/internal/shared/middleware/auth"
+)
+
+func writeEd25519Key(t *testing.T, dir, kid string) ed25519.PublicKey {
+	t.Helper()
+
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writePEM(t, dir, kid, "PRIVATE KEY", der)
+	return public
+}
+
+func writeRSAKey(t *testing.T, dir, kid string) *rsa.PrivateKey {
+	t.Helper()
+
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writePEM(t, dir, kid, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(private))
+	return private
+}
+
+func writePEM(t *testing.T, dir, kid, blockType string, der []byte) {
+	t.Helper()
+
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	if err := os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Test LoadKeySet picks the greatest kid with a private key as the signing key
+func TestLoadKeySet(t *testing.T) {
+	dir := t.TempDir()
+
+	rsaKey := writeRSAKey(t, dir, "2026-01")
+	writeEd25519Key(t, dir, "2026-02")
+
+	// a retired key only keeps its public part
+	der, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writePEM(t, dir, "2026-03", "PUBLIC KEY", der)
+
+	keySet, err := LoadKeySet(dir)
+	if err != nil {
+		t.Fatalf("LoadKeySet() error = %v", err)
+	}
+
+	if active := keySet.signingKey(); active.kid != "2026-02" || active.method.Alg() != "EdDSA" {
+		t.Errorf("LoadKeySet() active key = %s (%s), want 2026-02 (EdDSA)", active.kid, active.method.Alg())
+	}
+
+	jwks := keySet.PublicKeys()
+	if len(jwks) != 3 {
+		t.Fatalf("PublicKeys() returned %d keys, want 3", len(jwks))
+	}
+	if jwks[0].Kty != "RSA" || jwks[0].Alg != "RS256" || jwks[0].E != "AQAB" || jwks[0].N == "" {
+		t.Errorf("PublicKeys() RSA key = %+v", jwks[0])
+	}
+	if jwks[1].Kty != "OKP" || jwks[1].Crv != "Ed25519" || jwks[1].X == "" {
+		t.Errorf("PublicKeys() Ed25519 key = %+v", jwks[1])
+	}
+}
+
+// Test LoadKeySet fails without a private key
+func TestLoadKeySet_NoSigningKey(t *testing.T) {
+	if _, err := LoadKeySet(t.TempDir()); err != ErrNoSigningKey {
+		t.Errorf("LoadKeySet() error = %v, want %v", err, ErrNoSigningKey)
+	}
+}
+
+// Test tokens signed before a rotation keep verifying after it
+func TestKeySet_Rotation(t *testing.T) {
+	dir := t.TempDir()
+	writeRSAKey(t, dir, "2026-01")
+
+	keySet, err := LoadKeySet(dir)
+	if err != nil {
+		t.Fatalf("LoadKeySet() error = %v", err)
+	}
+
+	svc := NewService(keySet)
+	verifier := middleware.NewJWTVerifier(keySet)
+
+	oldToken, err := svc.GenerateAccessToken(domain.AccessTokenClaims{UserID: "user-123", Role: "3", SessionID: "session-1"})
+	if err != nil {
+		t.Fatalf("GenerateAccessToken() error = %v", err)
+	}
+
+	writeEd25519Key(t, dir, "2026-02")
+	if err := keySet.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+
+	newToken, err := svc.GenerateAccessToken(domain.AccessTokenClaims{UserID: "user-123", Role: "3"})
+	if err != nil {
+		t.Fatalf("GenerateAccessToken() error = %v", err)
+	}
+
+	claims, err := verifier.Verify(context.Background(), oldToken)
+	if err != nil {
+		t.Fatalf("Verify() old token error = %v", err)
+	}
+	if claims.UserID != "user-123" || claims.SessionID != "session-1" {
+		t.Errorf("Verify() old token claims = %+v", claims)
+	}
+
+	if _, err := verifier.Verify(context.Background(), newToken); err != nil {
+		t.Errorf("Verify() new token error = %v", err)
+	}
+
+	// dropping a key from the directory stops its tokens from verifying
+	if err := os.Remove(filepath.Join(dir, "2026-01.pem")); err != nil {
+		t.Fatal(err)
+	}
+	if err := keySet.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if _, err := verifier.Verify(context.Background(), oldToken); err == nil {
+		t.Errorf("Verify() accepted token of a removed key")
+	}
+}
+
+// Test Reload keeps the previous keys when the directory is broken
+func TestKeySet_ReloadKeepsKeysOnError(t *testing.T) {
+	dir := t.TempDir()
+	writeEd25519Key(t, dir, "2026-01")
+
+	keySet, err := LoadKeySet(dir)
+	if err != nil {
+		t.Fatalf("LoadKeySet() error = %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "2026-02.pem"), []byte("not a key"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := keySet.Reload(); err == nil {
+		t.Fatalf("Reload() expected error for invalid PEM")
+	}
+
+	if _, ok := keySet.PublicKey("2026-01"); !ok {
+		t.Errorf("Reload() dropped keys after a failed reload")
+	}
+}
@@ -5,38 +5,114 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
 )
 
 // Service provides JWT token generation
 type Service struct {
-	secret          []byte
+	keys            *KeySet
 	accessTokenTTL  time.Duration
 	refreshTokenTTL time.Duration
 }
 
-// NewService creates a new token service
-func NewService(secret string) *Service {
+// NewService creates a new token service signing with the active key of the key set
+func NewService(keys *KeySet) *Service {
 	return &Service{
-		secret:          []byte(secret),
+		keys:            keys,
 		accessTokenTTL:  15 * time.Minute,
 		refreshTokenTTL: 7 * 24 * time.Hour,
 	}
 }
 
 // GenerateAccessToken creates a new JWT access token
-func (s *Service) GenerateAccessToken(userID, role string) (string, error) {
+func (s *Service) GenerateAccessToken(input domain.AccessTokenClaims) (string, error) {
+	jti, err := generateTokenID()
+	if err != nil {
+		return "", err
+	}
+
+	ttl := s.accessTokenTTL
+	if input.TTL > 0 {
+		ttl = input.TTL
+	}
+
 	claims := jwt.MapClaims{
-		"sub":  userID,
-		"role": role,
-		"exp":  time.Now().Add(s.accessTokenTTL).Unix(),
+		"jti":  jti,
+		"sub":  input.UserID,
+		"role": input.Role,
+		"sid":  input.SessionID,
+		"exp":  time.Now().Add(ttl).Unix(),
 		"iat":  time.Now().Unix(),
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(s.secret)
+	if input.OrganizationID != "" {
+		claims["org_id"] = input.OrganizationID
+	}
+
+	// OIDC auth_time: refreshed tokens keep the time of the original login
+	if !input.AuthTime.IsZero() {
+		claims["auth_time"] = input.AuthTime.Unix()
+	}
+
+	// RFC 8693 actor claim: sub is the impersonated user, act.sub the admin
+	if input.ActorID != "" {
+		claims["act"] = map[string]string{"sub": input.ActorID}
+	}
+
+	return s.sign(claims)
+}
+
+// GenerateServiceToken creates an access token for a service client.
+// It has no role; the space separated "scope" claim lists what it may call.
+func (s *Service) GenerateServiceToken(input domain.ServiceTokenClaims) (string, time.Duration, error) {
+	jti, err := generateTokenID()
+	if err != nil {
+		return "", 0, err
+	}
+
+	claims := jwt.MapClaims{
+		"jti":       jti,
+		"sub":       input.ClientID,
+		"client_id": input.ClientID,
+		"scope":     strings.Join(input.Scopes, " "),
+		"exp":       time.Now().Add(s.accessTokenTTL).Unix(),
+		"iat":       time.Now().Unix(),
+	}
+
+	token, err := s.sign(claims)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return token, s.accessTokenTTL, nil
+}
+
+func (s *Service) sign(claims jwt.MapClaims) (string, error) {
+	key := s.keys.signingKey()
+
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = key.kid
+
+	return token.SignedString(key.private)
+}
+
+// PublicKeys returns the verification keys, published as the JWKS document
+func (s *Service) PublicKeys() []domain.JSONWebKey {
+	return s.keys.PublicKeys()
+}
+
+// generateTokenID returns a random jti so single access tokens can be revoked
+func generateTokenID() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bytes), nil
 }
 
 // GenerateRefreshToken creates a new refresh token
@@ -0,0 +1,118 @@
+package token
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	middleware "github.com/nassabiq/golang-template/internal/shared/middleware/auth"
+)
+
+// Test service tokens verify as scoped claims without a user
+func TestService_GenerateServiceToken(t *testing.T) {
+	dir := t.TempDir()
+	writeEd25519Key(t, dir, "2026-01")
+
+	keySet, err := LoadKeySet(dir)
+	if err != nil {
+		t.Fatalf("LoadKeySet() error = %v", err)
+	}
+
+	svc := NewService(keySet)
+	verifier := middleware.NewJWTVerifier(keySet)
+
+	token, expiresIn, err := svc.GenerateServiceToken(domain.ServiceTokenClaims{
+		ClientID: "worker",
+		Scopes:   []string{domain.ScopeUsersRead, domain.ScopeUsersWrite},
+	})
+	if err != nil {
+		t.Fatalf("GenerateServiceToken() error = %v", err)
+	}
+	if expiresIn != 15*time.Minute {
+		t.Errorf("GenerateServiceToken() expires in = %v", expiresIn)
+	}
+
+	claims, err := verifier.Verify(context.Background(), token)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if claims.ClientID != "worker" || claims.UserID != "" || claims.Role != "" || !claims.Scoped || len(claims.Scopes) != 2 {
+		t.Errorf("Verify() claims = %+v", claims)
+	}
+
+	if err := middleware.RequireScope(domain.ScopeUsersRead, domain.PermissionUsersRead)(middleware.WithClaims(context.Background(), claims)); err != nil {
+		t.Errorf("RequireScope() error = %v for a granted scope", err)
+	}
+	if err := middleware.RequireScope(domain.ScopeSessionsRead, domain.PermissionUsersRead)(middleware.WithClaims(context.Background(), claims)); err == nil {
+		t.Errorf("RequireScope() allowed a scope the service does not hold")
+	}
+}
+
+// Test impersonation tokens carry the actor and their own lifetime
+func TestService_GenerateAccessToken_Impersonation(t *testing.T) {
+	dir := t.TempDir()
+	writeEd25519Key(t, dir, "2026-01")
+
+	keySet, err := LoadKeySet(dir)
+	if err != nil {
+		t.Fatalf("LoadKeySet() error = %v", err)
+	}
+
+	svc := NewService(keySet)
+	verifier := middleware.NewJWTVerifier(keySet)
+
+	token, err := svc.GenerateAccessToken(domain.AccessTokenClaims{
+		UserID:  "user-123",
+		Role:    string(domain.RoleIDUser),
+		ActorID: "admin-123",
+		TTL:     5 * time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("GenerateAccessToken() error = %v", err)
+	}
+
+	claims, err := verifier.Verify(context.Background(), token)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if claims.UserID != "user-123" || claims.ActorID != "admin-123" {
+		t.Errorf("Verify() claims = %+v", claims)
+	}
+	if lifetime := claims.ExpiresAt.Sub(claims.IssuedAt); lifetime != 5*time.Minute {
+		t.Errorf("Verify() token lifetime = %v, want %v", lifetime, 5*time.Minute)
+	}
+}
+
+func TestService_GenerateAccessToken_AuthTime(t *testing.T) {
+	dir := t.TempDir()
+	writeEd25519Key(t, dir, "2026-01")
+
+	keySet, err := LoadKeySet(dir)
+	if err != nil {
+		t.Fatalf("LoadKeySet() error = %v", err)
+	}
+
+	svc := NewService(keySet)
+	verifier := middleware.NewJWTVerifier(keySet)
+	authTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	for _, input := range []time.Time{authTime, {}} {
+		token, err := svc.GenerateAccessToken(domain.AccessTokenClaims{
+			UserID:   "user-123",
+			Role:     string(domain.RoleIDUser),
+			AuthTime: input,
+		})
+		if err != nil {
+			t.Fatalf("GenerateAccessToken() error = %v", err)
+		}
+
+		claims, err := verifier.Verify(context.Background(), token)
+		if err != nil {
+			t.Fatalf("Verify() error = %v", err)
+		}
+		if !claims.AuthTime.Equal(input) {
+			t.Errorf("Verify() auth time = %v, want %v", claims.AuthTime, input)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package totp
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// Test a sealed secret opens to the original and hides it
+func TestSecretCipher_SealOpen(t *testing.T) {
+	c, err := NewSecretCipher(bytes.Repeat([]byte{1}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sealed, err := c.Seal(rfcSecret)
+	if err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+	if strings.Contains(sealed, rfcSecret) || !strings.HasPrefix(sealed, sealedPrefix) {
+		t.Fatalf("Seal() = %q", sealed)
+	}
+
+	if again, _ := c.Seal(rfcSecret); again == sealed {
+		t.Errorf("Seal() reused a nonce")
+	}
+
+	got, err := c.Open(sealed)
+	if err != nil || got != rfcSecret {
+		t.Errorf("Open() = %q, %v, want %q", got, err, rfcSecret)
+	}
+}
+
+// Test Open passes plain secrets through and rejects tampered or foreign ones
+func TestSecretCipher_Open(t *testing.T) {
+	c, _ := NewSecretCipher(bytes.Repeat([]byte{1}, 32))
+	other, _ := NewSecretCipher(bytes.Repeat([]byte{2}, 32))
+
+	if got, err := c.Open(rfcSecret); err != nil || got != rfcSecret {
+		t.Errorf("Open() plain secret = %q, %v", got, err)
+	}
+
+	sealed, _ := other.Seal(rfcSecret)
+	if _, err := c.Open(sealed); !errors.Is(err, ErrInvalidSealedSecret) {
+		t.Errorf("Open() with another key error = %v, want %v", err, ErrInvalidSealedSecret)
+	}
+
+	if _, err := c.Open(sealedPrefix + "%%%"); !errors.Is(err, ErrInvalidSealedSecret) {
+		t.Errorf("Open() malformed error = %v, want %v", err, ErrInvalidSealedSecret)
+	}
+}
+
+// Test the key must be 32 bytes
+func TestNewSecretCipher_KeyLength(t *testing.T) {
+	if _, err := NewSecretCipher([]byte("short")); err == nil {
+		t.Errorf("NewSecretCipher() accepted a 5 byte key")
+	}
+}
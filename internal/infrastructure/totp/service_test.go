@@ -0,0 +1,91 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// base32 of the RFC 6238 SHA1 test seed "12345678901234567890"
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// Test GenerateCode against RFC 6238 Appendix B vectors (truncated to 6 digits)
+func TestService_GenerateCode(t *testing.T) {
+	svc := NewService("Test")
+
+	tests := []struct {
+		name string
+		unix int64
+		want string
+	}{
+		{name: "T=59", unix: 59, want: "287082"},
+		{name: "T=1111111109", unix: 1111111109, want: "081804"},
+		{name: "T=1111111111", unix: 1111111111, want: "050471"},
+		{name: "T=1234567890", unix: 1234567890, want: "005924"},
+		{name: "T=2000000000", unix: 2000000000, want: "279037"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := svc.GenerateCode(rfcSecret, time.Unix(tt.unix, 0))
+			if err != nil {
+				t.Fatalf("GenerateCode() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("GenerateCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test Validate
+func TestService_Validate(t *testing.T) {
+	svc := NewService("Test")
+	now := time.Unix(1111111111, 0)
+
+	tests := []struct {
+		name   string
+		code   string
+		at     time.Time
+		wantOK bool
+	}{
+		{name: "success - current step", code: "050471", at: now, wantOK: true},
+		{name: "success - previous step within skew", code: "050471", at: now.Add(30 * time.Second), wantOK: true},
+		{name: "failure - outside skew", code: "050471", at: now.Add(90 * time.Second), wantOK: false},
+		{name: "failure - wrong code", code: "123456", at: now, wantOK: false},
+		{name: "failure - wrong length", code: "0504", at: now, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := svc.Validate(rfcSecret, tt.code, tt.at)
+			if ok != tt.wantOK {
+				t.Errorf("Validate() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && step != 1111111111/30 {
+				t.Errorf("Validate() step = %v, want %v", step, 1111111111/30)
+			}
+		})
+	}
+}
+
+// Test GenerateSecret and ProvisioningURI
+func TestService_GenerateSecret(t *testing.T) {
+	svc := NewService("Golang Template")
+
+	secret, err := svc.GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() error = %v", err)
+	}
+	if len(secret) != 32 {
+		t.Errorf("GenerateSecret() length = %v, want 32", len(secret))
+	}
+
+	uri := svc.ProvisioningURI(secret, "admin@app.com")
+	if !strings.HasPrefix(uri, "otpauth://totp/Golang%20Template:admin@app.com?") {
+		t.Errorf("ProvisioningURI() = %v", uri)
+	}
+	if !strings.Contains(uri, "secret="+secret) {
+		t.Errorf("ProvisioningURI() missing secret: %v", uri)
+	}
+}
@@ -0,0 +1,166 @@
+package webauthn
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// maxCBORDepth bounds nesting so a hostile attestation cannot exhaust the stack
+const maxCBORDepth = 16
+
+var errCBORTruncated = errors.New("cbor: unexpected end of data")
+
+// decodeCBOR decodes the first CBOR item in data and returns it with the number
+// of bytes it used. Only the subset WebAuthn needs is supported: integers, byte
+// and text strings, arrays, maps, booleans and null, all of definite length.
+// Integers decode to int64, maps to map[any]any keyed by int64 or string.
+func decodeCBOR(data []byte) (any, int, error) {
+	d := cborDecoder{data: data}
+	value, err := d.decode(0)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return value, d.offset, nil
+}
+
+type cborDecoder struct {
+	data   []byte
+	offset int
+}
+
+func (d *cborDecoder) decode(depth int) (any, error) {
+	if depth > maxCBORDepth {
+		return nil, errors.New("cbor: nesting too deep")
+	}
+
+	major, arg, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+
+	switch major {
+	case 0:
+		if arg > 1<<63-1 {
+			return nil, errors.New("cbor: integer overflow")
+		}
+		return int64(arg), nil
+	case 1:
+		if arg > 1<<63-1 {
+			return nil, errors.New("cbor: integer overflow")
+		}
+		return -1 - int64(arg), nil
+	case 2:
+		return d.bytes(arg)
+	case 3:
+		raw, err := d.bytes(arg)
+		if err != nil {
+			return nil, err
+		}
+		return string(raw), nil
+	case 4:
+		if arg > uint64(len(d.data)-d.offset) {
+			return nil, errCBORTruncated
+		}
+		items := make([]any, 0, arg)
+		for i := uint64(0); i < arg; i++ {
+			item, err := d.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	case 5:
+		if arg > uint64(len(d.data)-d.offset) {
+			return nil, errCBORTruncated
+		}
+		entries := make(map[any]any, arg)
+		for i := uint64(0); i < arg; i++ {
+			key, err := d.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, fmt.Errorf("cbor: unsupported map key %T", key)
+			}
+			if _, ok := entries[key]; ok {
+				return nil, fmt.Errorf("cbor: duplicate map key %v", key)
+			}
+			value, err := d.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			entries[key] = value
+		}
+		return entries, nil
+	case 7:
+		switch arg {
+		case 20:
+			return false, nil
+		case 21:
+			return true, nil
+		case 22:
+			return nil, nil
+		}
+	}
+
+	return nil, fmt.Errorf("cbor: unsupported item (major type %d)", major)
+}
+
+// head reads an item's initial byte and its argument
+func (d *cborDecoder) head() (byte, uint64, error) {
+	if d.offset >= len(d.data) {
+		return 0, 0, errCBORTruncated
+	}
+
+	initial := d.data[d.offset]
+	d.offset++
+	major, info := initial>>5, initial&0x1f
+
+	var size int
+	switch {
+	case info < 24:
+		return major, uint64(info), nil
+	case info == 24:
+		size = 1
+	case info == 25:
+		size = 2
+	case info == 26:
+		size = 4
+	case info == 27:
+		size = 8
+	default:
+		return 0, 0, errors.New("cbor: indefinite length items are not supported")
+	}
+
+	if len(d.data)-d.offset < size {
+		return 0, 0, errCBORTruncated
+	}
+	raw := d.data[d.offset : d.offset+size]
+	d.offset += size
+
+	switch size {
+	case 1:
+		return major, uint64(raw[0]), nil
+	case 2:
+		return major, uint64(binary.BigEndian.Uint16(raw)), nil
+	case 4:
+		return major, uint64(binary.BigEndian.Uint32(raw)), nil
+	default:
+		return major, binary.BigEndian.Uint64(raw), nil
+	}
+}
+
+func (d *cborDecoder) bytes(length uint64) ([]byte, error) {
+	if length > uint64(len(d.data)-d.offset) {
+		return nil, errCBORTruncated
+	}
+
+	raw := d.data[d.offset : d.offset+int(length)]
+	d.offset += int(length)
+	return raw, nil
+}
@@ -0,0 +1,114 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// COSE algorithm identifiers offered to authenticators, in order of preference
+const (
+	algES256 = -7
+	algEdDSA = -8
+	algRS256 = -257
+)
+
+// COSE_Key labels and values (RFC 9053)
+const (
+	coseKty = 1
+	coseAlg = 3
+
+	coseCrv = -1
+	coseX   = -2
+	coseY   = -3
+	coseN   = -1
+	coseE   = -2
+
+	ktyOKP = 1
+	ktyEC2 = 2
+	ktyRSA = 3
+
+	crvP256    = 1
+	crvEd25519 = 6
+)
+
+var supportedAlgorithms = []int{algES256, algEdDSA, algRS256}
+
+// coseKey is a credential public key decoded from its COSE_Key encoding
+type coseKey struct {
+	alg int64
+	key crypto.PublicKey
+}
+
+func parseCOSEKey(raw []byte) (*coseKey, error) {
+	value, _, err := decodeCBOR(raw)
+	if err != nil {
+		return nil, err
+	}
+	entries, ok := value.(map[any]any)
+	if !ok {
+		return nil, errors.New("cose key is not a map")
+	}
+
+	kty, _ := entries[int64(coseKty)].(int64)
+	alg, _ := entries[int64(coseAlg)].(int64)
+
+	switch {
+	case kty == ktyEC2 && alg == algES256:
+		crv, _ := entries[int64(coseCrv)].(int64)
+		x, _ := entries[int64(coseX)].([]byte)
+		y, _ := entries[int64(coseY)].([]byte)
+		if crv != crvP256 || len(x) != 32 || len(y) != 32 {
+			return nil, errors.New("invalid ES256 key")
+		}
+		key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !key.Curve.IsOnCurve(key.X, key.Y) {
+			return nil, errors.New("ES256 key is not on the curve")
+		}
+		return &coseKey{alg: alg, key: key}, nil
+	case kty == ktyOKP && alg == algEdDSA:
+		crv, _ := entries[int64(coseCrv)].(int64)
+		x, _ := entries[int64(coseX)].([]byte)
+		if crv != crvEd25519 || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid EdDSA key")
+		}
+		return &coseKey{alg: alg, key: ed25519.PublicKey(x)}, nil
+	case kty == ktyRSA && alg == algRS256:
+		n, _ := entries[int64(coseN)].([]byte)
+		e, _ := entries[int64(coseE)].([]byte)
+		if len(n) < 256 || len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("invalid RS256 key")
+		}
+		return &coseKey{alg: alg, key: &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported cose key (kty %d, alg %d)", kty, alg)
+}
+
+// verify checks signature over data with the key's algorithm
+func (k *coseKey) verify(data, signature []byte) error {
+	digest := sha256.Sum256(data)
+
+	switch key := k.key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], signature) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, data, signature) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature)
+	}
+
+	return errors.New("unsupported key")
+}
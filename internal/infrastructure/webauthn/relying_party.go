@@ -0,0 +1,364 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+// ceremonyTimeout is how long the browser waits for the authenticator
+const ceremonyTimeout = 5 * time.Minute
+
+// Authenticator data flags
+const (
+	flagUserPresent       = 0x01
+	flagUserVerified      = 0x04
+	flagAttestedData      = 0x40
+	flagExtensionIncluded = 0x80
+)
+
+// Config is the relying party the credentials are scoped to. RPID is the
+// registrable domain, and Origins lists every origin the browser may run the
+// ceremony from, such as https://app.example.com.
+type Config struct {
+	RPID    string
+	RPName  string
+	Origins []string
+}
+
+// RelyingParty builds the options for navigator.credentials.create and get, and
+// verifies what the authenticator answers. Attestation is not requested, so a
+// new credential is trusted as much as the session that registers it.
+type RelyingParty struct {
+	config   Config
+	rpIDHash [32]byte
+}
+
+func NewRelyingParty(config Config) *RelyingParty {
+	return &RelyingParty{config: config, rpIDHash: sha256.Sum256([]byte(config.RPID))}
+}
+
+type credentialDescriptor struct {
+	Type       string   `json:"type"`
+	ID         string   `json:"id"`
+	Transports []string `json:"transports,omitempty"`
+}
+
+// CreationOptions is the PublicKeyCredentialCreationOptions JSON for a
+// discoverable credential that verifies the user, excluding those already registered
+func (rp *RelyingParty) CreationOptions(user domain.WebauthnUser, challenge []byte, exclude []domain.WebauthnCredential) ([]byte, error) {
+	params := make([]map[string]any, len(supportedAlgorithms))
+	for i, alg := range supportedAlgorithms {
+		params[i] = map[string]any{"type": "public-key", "alg": alg}
+	}
+
+	return json.Marshal(map[string]any{
+		"rp": map[string]string{"id": rp.config.RPID, "name": rp.config.RPName},
+		"user": map[string]string{
+			"id":          encode([]byte(user.ID)),
+			"name":        user.Name,
+			"displayName": user.DisplayName,
+		},
+		"challenge":          encode(challenge),
+		"pubKeyCredParams":   params,
+		"timeout":            ceremonyTimeout.Milliseconds(),
+		"excludeCredentials": descriptors(exclude),
+		"authenticatorSelection": map[string]any{
+			"residentKey":        "required",
+			"requireResidentKey": true,
+			"userVerification":   "required",
+		},
+		"attestation": "none",
+	})
+}
+
+// RequestOptions is the PublicKeyCredentialRequestOptions JSON. An empty allow
+// list lets the user pick any discoverable credential for this relying party.
+func (rp *RelyingParty) RequestOptions(challenge []byte, allow []domain.WebauthnCredential, requireUserVerification bool) ([]byte, error) {
+	userVerification := "preferred"
+	if requireUserVerification {
+		userVerification = "required"
+	}
+
+	return json.Marshal(map[string]any{
+		"challenge":        encode(challenge),
+		"rpId":             rp.config.RPID,
+		"timeout":          ceremonyTimeout.Milliseconds(),
+		"allowCredentials": descriptors(allow),
+		"userVerification": userVerification,
+	})
+}
+
+type registrationResponse struct {
+	RawID    string `json:"rawId"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string   `json:"clientDataJSON"`
+		AttestationObject string   `json:"attestationObject"`
+		Transports        []string `json:"transports"`
+	} `json:"response"`
+}
+
+// VerifyRegistration checks a navigator.credentials.create response. The
+// challenge it answers is returned for the caller to match against the one it issued.
+func (rp *RelyingParty) VerifyRegistration(response []byte) (*domain.WebauthnRegistration, error) {
+	var credential registrationResponse
+	if err := json.Unmarshal(response, &credential); err != nil {
+		return nil, fmt.Errorf("registration response: %w", err)
+	}
+	if credential.Type != "public-key" {
+		return nil, errors.New("registration response: not a public key credential")
+	}
+
+	rawID, err := decode(credential.RawID)
+	if err != nil {
+		return nil, fmt.Errorf("rawId: %w", err)
+	}
+	clientDataJSON, err := decode(credential.Response.ClientDataJSON)
+	if err != nil {
+		return nil, fmt.Errorf("clientDataJSON: %w", err)
+	}
+	attestationObject, err := decode(credential.Response.AttestationObject)
+	if err != nil {
+		return nil, fmt.Errorf("attestationObject: %w", err)
+	}
+
+	challenge, err := rp.verifyClientData(clientDataJSON, "webauthn.create")
+	if err != nil {
+		return nil, err
+	}
+
+	value, _, err := decodeCBOR(attestationObject)
+	if err != nil {
+		return nil, fmt.Errorf("attestationObject: %w", err)
+	}
+	attestation, ok := value.(map[any]any)
+	if !ok {
+		return nil, errors.New("attestationObject is not a map")
+	}
+	rawAuthData, ok := attestation["authData"].([]byte)
+	if !ok {
+		return nil, errors.New("attestationObject has no authData")
+	}
+
+	authData, err := rp.parseAuthenticatorData(rawAuthData, true)
+	if err != nil {
+		return nil, err
+	}
+	if authData.flags&flagAttestedData == 0 {
+		return nil, errors.New("authenticator data has no attested credential")
+	}
+	if !bytes.Equal(authData.credentialID, rawID) {
+		return nil, errors.New("credential id does not match rawId")
+	}
+	if _, err := parseCOSEKey(authData.publicKey); err != nil {
+		return nil, err
+	}
+
+	return &domain.WebauthnRegistration{
+		Challenge:    challenge,
+		CredentialID: authData.credentialID,
+		PublicKey:    authData.publicKey,
+		SignCount:    authData.signCount,
+		Transports:   credential.Response.Transports,
+	}, nil
+}
+
+type assertionResponse struct {
+	RawID    string `json:"rawId"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string `json:"clientDataJSON"`
+		AuthenticatorData string `json:"authenticatorData"`
+		Signature         string `json:"signature"`
+		UserHandle        string `json:"userHandle"`
+	} `json:"response"`
+}
+
+// ParseAssertion decodes a navigator.credentials.get response so the caller can
+// load the credential and challenge it names before VerifyAssertion
+func (rp *RelyingParty) ParseAssertion(response []byte) (*domain.WebauthnAssertion, error) {
+	var credential assertionResponse
+	if err := json.Unmarshal(response, &credential); err != nil {
+		return nil, fmt.Errorf("assertion response: %w", err)
+	}
+	if credential.Type != "public-key" {
+		return nil, errors.New("assertion response: not a public key credential")
+	}
+
+	var assertion domain.WebauthnAssertion
+	fields := []struct {
+		name  string
+		value string
+		into  *[]byte
+	}{
+		{"rawId", credential.RawID, &assertion.CredentialID},
+		{"clientDataJSON", credential.Response.ClientDataJSON, &assertion.ClientDataJSON},
+		{"authenticatorData", credential.Response.AuthenticatorData, &assertion.AuthenticatorData},
+		{"signature", credential.Response.Signature, &assertion.Signature},
+		{"userHandle", credential.Response.UserHandle, &assertion.UserHandle},
+	}
+	for _, field := range fields {
+		raw, err := decode(field.value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", field.name, err)
+		}
+		*field.into = raw
+	}
+
+	var clientData clientData
+	if err := json.Unmarshal(assertion.ClientDataJSON, &clientData); err != nil {
+		return nil, fmt.Errorf("clientDataJSON: %w", err)
+	}
+	challenge, err := decode(clientData.Challenge)
+	if err != nil {
+		return nil, fmt.Errorf("challenge: %w", err)
+	}
+	assertion.Challenge = challenge
+
+	return &assertion, nil
+}
+
+// VerifyAssertion checks the assertion was signed by the credential's key and
+// returns the authenticator's new signature counter
+func (rp *RelyingParty) VerifyAssertion(assertion *domain.WebauthnAssertion, publicKey []byte, requireUserVerification bool) (uint32, error) {
+	if _, err := rp.verifyClientData(assertion.ClientDataJSON, "webauthn.get"); err != nil {
+		return 0, err
+	}
+
+	authData, err := rp.parseAuthenticatorData(assertion.AuthenticatorData, requireUserVerification)
+	if err != nil {
+		return 0, err
+	}
+
+	key, err := parseCOSEKey(publicKey)
+	if err != nil {
+		return 0, err
+	}
+
+	clientDataHash := sha256.Sum256(assertion.ClientDataJSON)
+	signed := append(slices.Clone(assertion.AuthenticatorData), clientDataHash[:]...)
+	if err := key.verify(signed, assertion.Signature); err != nil {
+		return 0, err
+	}
+
+	return authData.signCount, nil
+}
+
+type clientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+// verifyClientData checks the ceremony type and origin, and returns the challenge
+func (rp *RelyingParty) verifyClientData(raw []byte, ceremony string) ([]byte, error) {
+	var data clientData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("clientDataJSON: %w", err)
+	}
+	if data.Type != ceremony {
+		return nil, fmt.Errorf("clientDataJSON: type %q, want %q", data.Type, ceremony)
+	}
+	if !slices.Contains(rp.config.Origins, data.Origin) {
+		return nil, fmt.Errorf("clientDataJSON: origin %q is not allowed", data.Origin)
+	}
+	if data.CrossOrigin {
+		return nil, errors.New("clientDataJSON: cross-origin ceremonies are not allowed")
+	}
+
+	challenge, err := decode(data.Challenge)
+	if err != nil {
+		return nil, fmt.Errorf("challenge: %w", err)
+	}
+
+	return challenge, nil
+}
+
+type authenticatorData struct {
+	flags        byte
+	signCount    uint32
+	credentialID []byte
+	publicKey    []byte
+}
+
+// parseAuthenticatorData checks the RP ID hash and the user presence and
+// verification flags, and splits out the attested credential when there is one
+func (rp *RelyingParty) parseAuthenticatorData(raw []byte, requireUserVerification bool) (*authenticatorData, error) {
+	if len(raw) < 37 {
+		return nil, errors.New("authenticator data is too short")
+	}
+	if !bytes.Equal(raw[:32], rp.rpIDHash[:]) {
+		return nil, errors.New("authenticator data is for another relying party")
+	}
+
+	data := authenticatorData{flags: raw[32], signCount: binary.BigEndian.Uint32(raw[33:37])}
+	if data.flags&flagUserPresent == 0 {
+		return nil, errors.New("user was not present")
+	}
+	if requireUserVerification && data.flags&flagUserVerified == 0 {
+		return nil, errors.New("user was not verified")
+	}
+
+	rest := raw[37:]
+	if data.flags&flagAttestedData != 0 {
+		// aaguid (16) and credential id length (2)
+		if len(rest) < 18 {
+			return nil, errors.New("attested credential data is too short")
+		}
+		idLength := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if idLength == 0 || idLength > 1023 || len(rest) < idLength {
+			return nil, errors.New("invalid credential id length")
+		}
+		data.credentialID = rest[:idLength]
+		rest = rest[idLength:]
+
+		_, used, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, fmt.Errorf("credential public key: %w", err)
+		}
+		data.publicKey = rest[:used]
+		rest = rest[used:]
+	}
+	if data.flags&flagExtensionIncluded != 0 {
+		_, used, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, fmt.Errorf("extensions: %w", err)
+		}
+		rest = rest[used:]
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("authenticator data has trailing bytes")
+	}
+
+	return &data, nil
+}
+
+func descriptors(credentials []domain.WebauthnCredential) []credentialDescriptor {
+	list := make([]credentialDescriptor, len(credentials))
+	for i, credential := range credentials {
+		list[i] = credentialDescriptor{Type: "public-key", ID: encode(credential.CredentialID), Transports: credential.Transports}
+	}
+
+	return list
+}
+
+func encode(raw []byte) string {
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decode accepts base64url with or without padding, as browsers differ
+func decode(value string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+}
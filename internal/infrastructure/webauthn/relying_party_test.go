@@ -0,0 +1,220 @@
+package webauthn_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/nassabiq/golang-template/internal/infrastructure/webauthn"
+	"github.com/nassabiq/golang-template/internal/infrastructure/webauthn/webauthntest"
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+const origin = "https://app.example.com"
+
+var user = domain.WebauthnUser{ID: "user-123", Name: "user@example.com", DisplayName: "Test User"}
+
+func newRelyingParty() *webauthn.RelyingParty {
+	return webauthn.NewRelyingParty(webauthn.Config{
+		RPID:    "example.com",
+		RPName:  "Example",
+		Origins: []string{origin},
+	})
+}
+
+// register creates a credential on the authenticator and verifies it
+func register(t *testing.T, rp *webauthn.RelyingParty, authenticator *webauthntest.Authenticator) *domain.WebauthnRegistration {
+	t.Helper()
+
+	options, err := rp.CreationOptions(user, []byte("registration-challenge"), nil)
+	if err != nil {
+		t.Fatalf("CreationOptions() error = %v", err)
+	}
+	response, err := authenticator.Create(options)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	registration, err := rp.VerifyRegistration(response)
+	if err != nil {
+		t.Fatalf("VerifyRegistration() error = %v", err)
+	}
+
+	return registration
+}
+
+// Test a registered credential can sign assertions, and the counter moves forward
+func TestRelyingParty_RegisterAndAssert(t *testing.T) {
+	rp := newRelyingParty()
+	authenticator := webauthntest.NewAuthenticator("example.com", origin)
+
+	registration := register(t, rp, authenticator)
+	if string(registration.Challenge) != "registration-challenge" {
+		t.Errorf("registration challenge = %q", registration.Challenge)
+	}
+	if len(registration.CredentialID) == 0 || len(registration.PublicKey) == 0 {
+		t.Fatalf("registration = %+v", registration)
+	}
+
+	credential := domain.WebauthnCredential{CredentialID: registration.CredentialID, PublicKey: registration.PublicKey}
+	for want := uint32(1); want <= 2; want++ {
+		options, err := rp.RequestOptions([]byte("login-challenge"), nil, true)
+		if err != nil {
+			t.Fatalf("RequestOptions() error = %v", err)
+		}
+		response, err := authenticator.Get(options)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+
+		assertion, err := rp.ParseAssertion(response)
+		if err != nil {
+			t.Fatalf("ParseAssertion() error = %v", err)
+		}
+		if string(assertion.Challenge) != "login-challenge" || string(assertion.UserHandle) != user.ID {
+			t.Errorf("assertion challenge = %q, user handle = %q", assertion.Challenge, assertion.UserHandle)
+		}
+		if !bytes.Equal(assertion.CredentialID, credential.CredentialID) {
+			t.Errorf("assertion credential id does not match the registration")
+		}
+
+		signCount, err := rp.VerifyAssertion(assertion, credential.PublicKey, true)
+		if err != nil {
+			t.Fatalf("VerifyAssertion() error = %v", err)
+		}
+		if signCount != want {
+			t.Errorf("VerifyAssertion() sign count = %d, want %d", signCount, want)
+		}
+	}
+}
+
+// Test the registration options exclude credentials the user already has
+func TestRelyingParty_CreationOptions_Exclude(t *testing.T) {
+	rp := newRelyingParty()
+	authenticator := webauthntest.NewAuthenticator("example.com", origin)
+	registration := register(t, rp, authenticator)
+
+	options, err := rp.CreationOptions(user, []byte("challenge"), []domain.WebauthnCredential{{CredentialID: registration.CredentialID}})
+	if err != nil {
+		t.Fatalf("CreationOptions() error = %v", err)
+	}
+	if _, err := authenticator.Create(options); err == nil {
+		t.Errorf("Create() registered an excluded authenticator again")
+	}
+}
+
+// Test registrations from another origin, relying party or without user verification are refused
+func TestRelyingParty_VerifyRegistration_Rejected(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(authenticator *webauthntest.Authenticator)
+		tamper func(response map[string]any)
+	}{
+		{name: "other origin", modify: func(a *webauthntest.Authenticator) { a.Origin = "https://evil.example.com" }},
+		{name: "other relying party", modify: func(a *webauthntest.Authenticator) { a.RPID = "evil.example.com" }},
+		{name: "user not verified", modify: func(a *webauthntest.Authenticator) { a.UserVerified = false }},
+		{name: "rawId mismatch", tamper: func(response map[string]any) { response["rawId"] = "b3RoZXItaWQ" }},
+		{name: "not a public key", tamper: func(response map[string]any) { response["type"] = "password" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rp := newRelyingParty()
+			authenticator := webauthntest.NewAuthenticator("example.com", origin)
+			if tt.modify != nil {
+				tt.modify(authenticator)
+			}
+
+			options, err := rp.CreationOptions(user, []byte("challenge"), nil)
+			if err != nil {
+				t.Fatalf("CreationOptions() error = %v", err)
+			}
+			// the software authenticator checks the rp id like a browser would, so sign as if it matched
+			rpID := authenticator.RPID
+			options = bytes.Replace(options, []byte(`"id":"example.com"`), []byte(`"id":"`+rpID+`"`), 1)
+			response, err := authenticator.Create(options)
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			if tt.tamper != nil {
+				response = tamper(t, response, tt.tamper)
+			}
+
+			if registration, err := rp.VerifyRegistration(response); err == nil {
+				t.Errorf("VerifyRegistration() = %+v, want error", registration)
+			}
+		})
+	}
+}
+
+// Test assertions that fail any check are refused
+func TestRelyingParty_VerifyAssertion_Rejected(t *testing.T) {
+	rp := newRelyingParty()
+	authenticator := webauthntest.NewAuthenticator("example.com", origin)
+	registration := register(t, rp, authenticator)
+	other := register(t, rp, webauthntest.NewAuthenticator("example.com", origin))
+
+	tests := []struct {
+		name      string
+		modify    func(authenticator *webauthntest.Authenticator)
+		tamper    func(assertion *domain.WebauthnAssertion)
+		publicKey []byte
+		requireUV bool
+	}{
+		{name: "other origin", modify: func(a *webauthntest.Authenticator) { a.Origin = "https://evil.example.com" }},
+		{name: "user not verified", modify: func(a *webauthntest.Authenticator) { a.UserVerified = false }, requireUV: true},
+		{name: "signed by another key", publicKey: other.PublicKey},
+		{name: "tampered client data", tamper: func(a *domain.WebauthnAssertion) { a.ClientDataJSON = append(a.ClientDataJSON, ' ') }},
+		{name: "truncated authenticator data", tamper: func(a *domain.WebauthnAssertion) { a.AuthenticatorData = a.AuthenticatorData[:36] }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			authenticator.Origin, authenticator.UserVerified = origin, true
+			if tt.modify != nil {
+				tt.modify(authenticator)
+			}
+
+			options, err := rp.RequestOptions([]byte("login-challenge"), nil, tt.requireUV)
+			if err != nil {
+				t.Fatalf("RequestOptions() error = %v", err)
+			}
+			response, err := authenticator.Get(options)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			assertion, err := rp.ParseAssertion(response)
+			if err != nil {
+				t.Fatalf("ParseAssertion() error = %v", err)
+			}
+			if tt.tamper != nil {
+				tt.tamper(assertion)
+			}
+
+			publicKey := registration.PublicKey
+			if tt.publicKey != nil {
+				publicKey = tt.publicKey
+			}
+			if _, err := rp.VerifyAssertion(assertion, publicKey, tt.requireUV); err == nil {
+				t.Errorf("VerifyAssertion() accepted the assertion")
+			}
+		})
+	}
+}
+
+func tamper(t *testing.T, response []byte, modify func(map[string]any)) []byte {
+	t.Helper()
+
+	var decoded map[string]any
+	if err := json.Unmarshal(response, &decoded); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+	modify(decoded)
+
+	tampered, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatalf("marshal response: %v", err)
+	}
+
+	return tampered
+}
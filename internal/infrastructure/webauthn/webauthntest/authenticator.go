@@ -0,0 +1,239 @@
+// Package webauthntest provides a software authenticator for tests
+package webauthntest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Authenticator is a platform authenticator holding discoverable ES256
+// credentials in memory. It plays the browser's part too: Create and Get take
+// the options JSON the relying party built and return the PublicKeyCredential
+// JSON the browser would post back, with "none" attestation.
+type Authenticator struct {
+	RPID string
+	// Origin is written to the client data, as the page running the ceremony
+	Origin string
+	// UserVerified sets the UV flag, as if the user entered a PIN or used biometrics
+	UserVerified bool
+
+	mu          sync.Mutex
+	credentials []*credential
+}
+
+type credential struct {
+	id         []byte
+	userHandle []byte
+	key        *ecdsa.PrivateKey
+	signCount  uint32
+}
+
+func NewAuthenticator(rpID, origin string) *Authenticator {
+	return &Authenticator{RPID: rpID, Origin: origin, UserVerified: true}
+}
+
+type credentialParam struct {
+	Alg int `json:"alg"`
+}
+
+type creationOptions struct {
+	Challenge string `json:"challenge"`
+	RP        struct {
+		ID string `json:"id"`
+	} `json:"rp"`
+	User struct {
+		ID string `json:"id"`
+	} `json:"user"`
+	PubKeyCredParams   []credentialParam `json:"pubKeyCredParams"`
+	ExcludeCredentials []struct {
+		ID string `json:"id"`
+	} `json:"excludeCredentials"`
+}
+
+// Create answers navigator.credentials.create with a new credential
+func (a *Authenticator) Create(options []byte) ([]byte, error) {
+	var opts creationOptions
+	if err := json.Unmarshal(options, &opts); err != nil {
+		return nil, err
+	}
+	if opts.RP.ID != a.RPID {
+		return nil, fmt.Errorf("rp id %q, authenticator is for %q", opts.RP.ID, a.RPID)
+	}
+	if !slices.Contains(opts.PubKeyCredParams, credentialParam{Alg: -7}) {
+		return nil, errors.New("ES256 is not offered")
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, excluded := range opts.ExcludeCredentials {
+		if a.find(excluded.ID) != nil {
+			return nil, errors.New("credential already registered")
+		}
+	}
+
+	userHandle, err := decode(opts.User.ID)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	cred := &credential{id: id, userHandle: userHandle, key: key}
+	a.credentials = append(a.credentials, cred)
+
+	// aaguid, credential id length, credential id and COSE_Key
+	attested := make([]byte, 16, 16+2+len(id))
+	attested = binary.BigEndian.AppendUint16(attested, uint16(len(id)))
+	attested = append(attested, id...)
+	attested = append(attested, encodeCBOR(map[int]any{
+		1:  2,
+		3:  -7,
+		-1: 1,
+		-2: key.X.FillBytes(make([]byte, 32)),
+		-3: key.Y.FillBytes(make([]byte, 32)),
+	})...)
+
+	authData := a.authenticatorData(cred, 0x40)
+	authData = append(authData, attested...)
+
+	attestationObject := encodeCBOR(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": authData,
+	})
+
+	return json.Marshal(map[string]any{
+		"id":    encode(id),
+		"rawId": encode(id),
+		"type":  "public-key",
+		"response": map[string]any{
+			"clientDataJSON":    encode(a.clientData("webauthn.create", opts.Challenge)),
+			"attestationObject": encode(attestationObject),
+			"transports":        []string{"internal"},
+		},
+		"authenticatorAttachment": "platform",
+		"clientExtensionResults":  map[string]any{},
+	})
+}
+
+type requestOptions struct {
+	Challenge        string `json:"challenge"`
+	RPID             string `json:"rpId"`
+	AllowCredentials []struct {
+		ID string `json:"id"`
+	} `json:"allowCredentials"`
+}
+
+// Get answers navigator.credentials.get with the first allowed credential, or
+// the oldest one when the relying party lets the user pick
+func (a *Authenticator) Get(options []byte) ([]byte, error) {
+	var opts requestOptions
+	if err := json.Unmarshal(options, &opts); err != nil {
+		return nil, err
+	}
+	if opts.RPID != a.RPID {
+		return nil, fmt.Errorf("rp id %q, authenticator is for %q", opts.RPID, a.RPID)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var cred *credential
+	for _, allowed := range opts.AllowCredentials {
+		if cred = a.find(allowed.ID); cred != nil {
+			break
+		}
+	}
+	if len(opts.AllowCredentials) == 0 && len(a.credentials) > 0 {
+		cred = a.credentials[0]
+	}
+	if cred == nil {
+		return nil, errors.New("no credential for this relying party")
+	}
+
+	cred.signCount++
+	authData := a.authenticatorData(cred, 0)
+	clientDataJSON := a.clientData("webauthn.get", opts.Challenge)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(slices.Clone(authData), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, cred.key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(map[string]any{
+		"id":    encode(cred.id),
+		"rawId": encode(cred.id),
+		"type":  "public-key",
+		"response": map[string]any{
+			"clientDataJSON":    encode(clientDataJSON),
+			"authenticatorData": encode(authData),
+			"signature":         encode(signature),
+			"userHandle":        encode(cred.userHandle),
+		},
+		"authenticatorAttachment": "platform",
+		"clientExtensionResults":  map[string]any{},
+	})
+}
+
+func (a *Authenticator) find(id string) *credential {
+	raw, err := decode(id)
+	if err != nil {
+		return nil
+	}
+	for _, cred := range a.credentials {
+		if string(cred.id) == string(raw) {
+			return cred
+		}
+	}
+
+	return nil
+}
+
+// authenticatorData is the RP ID hash, flags and signature counter
+func (a *Authenticator) authenticatorData(cred *credential, flags byte) []byte {
+	flags |= 0x01
+	if a.UserVerified {
+		flags |= 0x04
+	}
+
+	rpIDHash := sha256.Sum256([]byte(a.RPID))
+	data := append(rpIDHash[:], flags)
+	return binary.BigEndian.AppendUint32(data, cred.signCount)
+}
+
+func (a *Authenticator) clientData(ceremony, challenge string) []byte {
+	data, _ := json.Marshal(map[string]any{
+		"type":        ceremony,
+		"challenge":   challenge,
+		"origin":      a.Origin,
+		"crossOrigin": false,
+	})
+
+	return data
+}
+
+func encode(raw []byte) string {
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decode(value string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+}
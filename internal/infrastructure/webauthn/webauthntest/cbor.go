@@ -0,0 +1,70 @@
+package webauthntest
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// encodeCBOR encodes the few item types an authenticator sends. Map keys are
+// written in the canonical order: shorter encodings first, then bytewise.
+func encodeCBOR(value any) []byte {
+	switch v := value.(type) {
+	case int:
+		if v < 0 {
+			return head(1, uint64(-1-v))
+		}
+		return head(0, uint64(v))
+	case []byte:
+		return append(head(2, uint64(len(v))), v...)
+	case string:
+		return append(head(3, uint64(len(v))), v...)
+	case map[int]any:
+		entries := make([][2][]byte, 0, len(v))
+		for key, item := range v {
+			entries = append(entries, [2][]byte{encodeCBOR(key), encodeCBOR(item)})
+		}
+		return encodeMap(entries)
+	case map[string]any:
+		entries := make([][2][]byte, 0, len(v))
+		for key, item := range v {
+			entries = append(entries, [2][]byte{encodeCBOR(key), encodeCBOR(item)})
+		}
+		return encodeMap(entries)
+	}
+
+	panic(fmt.Sprintf("webauthntest: cannot encode %T", value))
+}
+
+func encodeMap(entries [][2][]byte) []byte {
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i][0], entries[j][0]
+		if len(a) != len(b) {
+			return len(a) < len(b)
+		}
+		return string(a) < string(b)
+	})
+
+	out := head(5, uint64(len(entries)))
+	for _, entry := range entries {
+		out = append(out, entry[0]...)
+		out = append(out, entry[1]...)
+	}
+
+	return out
+}
+
+func head(major byte, arg uint64) []byte {
+	switch {
+	case arg < 24:
+		return []byte{major<<5 | byte(arg)}
+	case arg <= 0xff:
+		return []byte{major<<5 | 24, byte(arg)}
+	case arg <= 0xffff:
+		return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(arg))
+	case arg <= 0xffffffff:
+		return binary.BigEndian.AppendUint32([]byte{major<<5 | 26}, uint32(arg))
+	}
+
+	return binary.BigEndian.AppendUint64([]byte{major<<5 | 27}, arg)
+}
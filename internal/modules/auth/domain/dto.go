@@ -1,23 +1,293 @@
 package domain
 
+import "time"
+
 type RegisterInput struct {
 	Name                 string
 	Email                string
 	Password             string
 	PasswordConfirmation string
+	// Phone is optional, in any format helper.NormalizePhone accepts
+	Phone string
+}
+
+type ClientInfo struct {
+	UserAgent string
+	IPAddress string
+	// DeviceID is an opaque identifier the client keeps for the device, sent as
+	// the X-Device-Id header or the device_id cookie
+	DeviceID string
+}
+
+type LogoutInput struct {
+	RefreshToken string
+	// Access token of the request, denylisted until it expires
+	AccessTokenID        string
+	AccessTokenExpiresAt time.Time
+}
+
+// LoginThrottlePolicy configures brute-force protection of Login.
+// A zero MaxFailedAttempts or MaxIPFailures disables that check.
+type LoginThrottlePolicy struct {
+	// Failed attempts before the account is locked
+	MaxFailedAttempts int
+	// Length of the first lockout, doubled on every consecutive lockout
+	LockoutDuration    time.Duration
+	MaxLockoutDuration time.Duration
+	// Failed attempts allowed per client IP within IPWindow
+	MaxIPFailures int
+	IPWindow      time.Duration
 }
 
 type LoginInput struct {
 	Email    string
 	Password string
+	Client   ClientInfo
+}
+
+// JSONWebKey is a public verification key as published in the JWKS document (RFC 7517)
+type JSONWebKey struct {
+	Kty string
+	Kid string
+	Use string
+	Alg string
+	N   string
+	E   string
+	Crv string
+	X   string
+}
+
+type CreateApiKeyInput struct {
+	UserID        string
+	Name          string
+	Scopes        []string
+	ExpiresInDays int
+
+	// The organization the creating request acts in, the key is bound to it
+	OrganizationID string
+}
+
+// CreatedApiKey carries the plain key, returned only once at creation
+type CreatedApiKey struct {
+	ApiKey *ApiKey
+	Key    string
+}
+
+// ApiKeyIdentity is the caller resolved from a valid API key
+type ApiKeyIdentity struct {
+	KeyID          string
+	UserID         string
+	OrganizationID string
+	// The owner's role in OrganizationID, or their own role for a global key
+	RoleID string
+	Scopes []string
+}
+
+// ClientCredentialsInput is an OAuth2 client credentials token request
+type ClientCredentialsInput struct {
+	ClientID     string
+	ClientSecret string
+	// Requested scopes, defaults to all scopes of the client
+	Scopes []string
+}
+
+// ServiceTokenClaims are the claims embedded in a service client's access token
+type ServiceTokenClaims struct {
+	ClientID string
+	Scopes   []string
+}
+
+type ServiceTokenOutput struct {
+	AccessToken string
+	ExpiresIn   time.Duration
+	Scopes      []string
+}
+
+// AccessTokenClaims are the claims embedded in a signed access token
+type AccessTokenClaims struct {
+	UserID         string
+	Role           string
+	SessionID      string
+	OrganizationID string
+	// Admin acting as UserID, sent as the "act" claim of impersonation tokens
+	ActorID string
+	// Overrides the default access token lifetime when set
+	TTL time.Duration
+	// When the user last proved their credentials, sent as the "auth_time" claim
+	AuthTime time.Time
+}
+
+// ImpersonateInput lets a super admin act as another user
+type ImpersonateInput struct {
+	ActorID string
+	UserID  string
+	Client  ClientInfo
+}
+
+// ImpersonationOutput is a short-lived access token without a refresh token
+type ImpersonationOutput struct {
+	AccessToken string
+	ExpiresIn   time.Duration
 }
 
 type AuthOutput struct {
-	AccessToken  string
-	RefreshToken string
+	AccessToken    string
+	RefreshToken   string
+	MfaRequired    bool
+	MfaToken       string
+	MfaMethods     []string
+	SessionID      string
+	OrganizationID string
+}
+
+// SwitchOrganizationInput ends the current session and starts one in another organization
+type SwitchOrganizationInput struct {
+	UserID         string
+	SessionID      string
+	OrganizationID string
+	// Access token of the request, denylisted until it expires
+	AccessTokenID        string
+	AccessTokenExpiresAt time.Time
+	// auth_time of the request's access token, carried into the new session
+	AuthTime time.Time
+	Client   ClientInfo
+}
+
+// ReauthenticateInput confirms the user's password, or their MFA code, within
+// an existing session
+type ReauthenticateInput struct {
+	UserID         string
+	SessionID      string
+	OrganizationID string
+	Password       string
+	MfaCode        string
+	Client         ClientInfo
 }
 
 type ResetPasswordInput struct {
 	Token       string
 	NewPassword string
 }
+
+type ConsumeMagicLinkInput struct {
+	Token string
+	// BrowserCode was handed to the browser that requested the link
+	BrowserCode string
+	Client      ClientInfo
+}
+
+type PhoneLoginInput struct {
+	Phone  string
+	Code   string
+	Client ClientInfo
+}
+
+type AcceptInvitationInput struct {
+	Token                string
+	Name                 string
+	Password             string
+	PasswordConfirmation string
+}
+
+// Password policy rules reported in PasswordViolation.Rule
+const (
+	PasswordRuleTooShort       = "PASSWORD_TOO_SHORT"
+	PasswordRuleTooLong        = "PASSWORD_TOO_LONG"
+	PasswordRuleCharacterClass = "PASSWORD_CHARACTER_CLASSES"
+	PasswordRulePersonalInfo   = "PASSWORD_CONTAINS_PERSONAL_INFO"
+	PasswordRuleCommon         = "PASSWORD_TOO_COMMON"
+	PasswordRuleReused         = "PASSWORD_REUSED"
+)
+
+// PasswordOwner is who the password is for, checked so it does not contain their name or email
+type PasswordOwner struct {
+	Name  string
+	Email string
+}
+
+type PasswordViolation struct {
+	Rule    string
+	Message string
+}
+
+type MfaEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+type VerifyMfaInput struct {
+	MfaToken string
+	Code     string
+	Client   ClientInfo
+}
+
+// OidcClaims are the validated ID token claims of an OpenID Connect login
+type OidcClaims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// OidcStart is where to send the browser, and the code that binds the login to it
+type OidcStart struct {
+	AuthorizationURL string
+	BrowserCode      string
+}
+
+type OidcCallbackInput struct {
+	Provider string
+	Code     string
+	State    string
+	// BrowserCode was handed to the browser that started the login
+	BrowserCode string
+	Client      ClientInfo
+}
+
+// Second factors reported in AuthOutput.MfaMethods, any of which completes the MFA challenge
+const (
+	MfaMethodTotp     = "totp"
+	MfaMethodWebauthn = "webauthn"
+)
+
+// WebauthnUser is who a new credential is created for
+type WebauthnUser struct {
+	ID          string
+	Name        string
+	DisplayName string
+}
+
+// WebauthnRegistration is a verified attestation response creating a credential
+type WebauthnRegistration struct {
+	Challenge    []byte
+	CredentialID []byte
+	PublicKey    []byte
+	SignCount    uint32
+	Transports   []string
+}
+
+// WebauthnAssertion is a parsed assertion response. Its signature is only
+// checked once the credential it names has been loaded.
+type WebauthnAssertion struct {
+	Challenge         []byte
+	CredentialID      []byte
+	UserHandle        []byte
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+}
+
+type FinishWebauthnRegistrationInput struct {
+	UserID string
+	Name   string
+	// Credential is the PublicKeyCredential JSON from navigator.credentials.create
+	Credential []byte
+}
+
+type FinishWebauthnLoginInput struct {
+	// Credential is the PublicKeyCredential JSON from navigator.credentials.get
+	Credential []byte
+	// MfaToken is set when the passkey completes an MFA challenge instead of signing in alone
+	MfaToken string
+	Client   ClientInfo
+}
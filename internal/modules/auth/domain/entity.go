@@ -1,22 +1,160 @@
 package domain
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 type User struct {
-	ID           string
-	Name         string
-	Email        string
-	PasswordHash string
-	RoleID       string
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID              string
+	Name            string
+	Email           string
+	PasswordHash    string
+	RoleID          string
+	EmailVerifiedAt *time.Time
+	// Phone is in E.164, empty when the user has none
+	Phone           string
+	PhoneVerifiedAt *time.Time
+	Status          UserStatus
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type UserStatus string
+
+const (
+	// UserStatusPending users were invited and have no password until they accept
+	UserStatusPending UserStatus = "pending"
+	UserStatusActive  UserStatus = "active"
+	// UserStatusSuspended users are locked out by an admin until reactivated
+	UserStatusSuspended UserStatus = "suspended"
+	// UserStatusDeactivated users are closed accounts, kept instead of deleted
+	UserStatusDeactivated UserStatus = "deactivated"
+)
+
+// userStatusTransitions lists the statuses a user can move to from each status
+var userStatusTransitions = map[UserStatus][]UserStatus{
+	UserStatusPending:     {UserStatusActive},
+	UserStatusActive:      {UserStatusSuspended, UserStatusDeactivated},
+	UserStatusSuspended:   {UserStatusActive, UserStatusDeactivated},
+	UserStatusDeactivated: {UserStatusActive},
+}
+
+// CanTransitionTo reports whether a user in this status can be moved to next
+func (status UserStatus) CanTransitionTo(next UserStatus) bool {
+	return slices.Contains(userStatusTransitions[status], next)
 }
 
 type RefreshToken struct {
 	ID        string
 	UserID    string
+	FamilyID  string
+	ParentID  string
 	TokenHash string
 	Revoked   bool
+	// Why the token was revoked, empty while it is not
+	RevokedReason RefreshTokenRevokeReason
+	UserAgent     string
+	IPAddress     string
+	// Organization the session acts in, empty when the user belongs to none
+	OrganizationID string
+	// When the user last proved their credentials for the session; rotation
+	// keeps it, so refreshing never counts as authenticating again
+	AuthenticatedAt time.Time
+	ExpiresAt       time.Time
+	LastUsedAt      time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// RefreshTokenRevokeReason records why a refresh token stopped working
+type RefreshTokenRevokeReason string
+
+const (
+	// RefreshTokenRotated tokens were exchanged for a new one: one presented
+	// again means someone kept a copy
+	RefreshTokenRotated RefreshTokenRevokeReason = "rotated"
+	// RefreshTokenLoggedOut tokens ended with a logout
+	RefreshTokenLoggedOut RefreshTokenRevokeReason = "logged_out"
+	// RefreshTokenEnded tokens ended with their session or every session of
+	// the user, e.g. on a password reset or suspension
+	RefreshTokenEnded RefreshTokenRevokeReason = "ended"
+)
+
+// RevokedToken is a denylisted access token, kept until the token would have expired anyway
+type RevokedToken struct {
+	JTI       string
+	UserID    string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// RevokedSession denylists every access token carrying the session's sid
+type RevokedSession struct {
+	SessionID string
+	UserID    string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// ServiceClient is a backend service authenticating with the client credentials grant.
+// Its tokens carry the client's scopes instead of a user role.
+type ServiceClient struct {
+	ID         string
+	ClientID   string
+	Name       string
+	SecretHash string
+	Scopes     []string
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// ApiKey is a personal access token for machine clients. Only the hash of the key is stored.
+type ApiKey struct {
+	ID         string
+	UserID     string
+	Name       string
+	Prefix     string
+	KeyHash    string
+	Scopes     []string
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+
+	// The organization the key acts in, with the owner's role there; empty for a
+	// key acting with the owner's own role
+	OrganizationID string
+}
+
+// Session is the client-facing view of a refresh token family (one per login)
+type Session struct {
+	ID         string
+	UserAgent  string
+	IPAddress  string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+	Current    bool
+}
+
+// LoginLockout tracks failed password attempts of an account. LockoutCount
+// grows with every lock so consecutive lockouts last exponentially longer.
+type LoginLockout struct {
+	UserID         string
+	FailedAttempts int
+	LockoutCount   int
+	LockedUntil    *time.Time
+	UpdatedAt      time.Time
+}
+
+type EmailVerification struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	Used      bool
 	ExpiresAt time.Time
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -31,3 +169,180 @@ type PasswordReset struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+// UserInvitation lets a pending user choose their name and password
+type UserInvitation struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	Used      bool
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// MagicLink signs a user in from the link mailed to them, in the browser holding BrowserCode
+type MagicLink struct {
+	ID              string
+	UserID          string
+	TokenHash       string
+	BrowserCodeHash string
+	Used            bool
+	ExpiresAt       time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// UserIdentity links a user to their account at an OpenID Connect provider
+type UserIdentity struct {
+	ID        string
+	UserID    string
+	Provider  string
+	Subject   string
+	Email     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// OidcState is an OpenID Connect login waiting for the provider's callback
+type OidcState struct {
+	ID              string
+	Provider        string
+	StateHash       string
+	BrowserCodeHash string
+	Nonce           string
+	CodeVerifier    string
+	Used            bool
+	ExpiresAt       time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// WebauthnCredential is a passkey or security key registered to a user
+type WebauthnCredential struct {
+	ID           string
+	UserID       string
+	CredentialID []byte
+	// PublicKey is COSE_Key encoded, as the authenticator sent it
+	PublicKey  []byte
+	SignCount  uint32
+	Transports []string
+	Name       string
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// WebauthnChallenge is a ceremony waiting for the authenticator's response.
+// UserID is empty for a passwordless login, where the credential names the user.
+type WebauthnChallenge struct {
+	ID            string
+	UserID        string
+	Purpose       WebauthnPurpose
+	ChallengeHash string
+	Used          bool
+	ExpiresAt     time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type WebauthnPurpose string
+
+const (
+	WebauthnPurposeRegistration WebauthnPurpose = "registration"
+	WebauthnPurposeLogin        WebauthnPurpose = "login"
+	WebauthnPurposeMfa          WebauthnPurpose = "mfa"
+)
+
+// UserDevice is a device the user logged in from, identified by the hash of
+// its fingerprint. RevokeTokenHash is set when its first login raised a new
+// device alert, and ends SessionID when the user answers it was not them.
+type UserDevice struct {
+	ID                   string
+	UserID               string
+	Fingerprint          string
+	UserAgent            string
+	IPAddress            string
+	SessionID            string
+	RevokeTokenHash      string
+	RevokeTokenExpiresAt *time.Time
+	FirstSeenAt          time.Time
+	LastSeenAt           time.Time
+}
+
+// PhoneOtp is a code sent by SMS to Phone, which is not necessarily the user's current number
+type PhoneOtp struct {
+	ID        string
+	UserID    string
+	Phone     string
+	Purpose   PhoneOtpPurpose
+	CodeHash  string
+	Attempts  int
+	Used      bool
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type PhoneOtpPurpose string
+
+const (
+	PhoneOtpPurposeVerify PhoneOtpPurpose = "verify"
+	PhoneOtpPurposeLogin  PhoneOtpPurpose = "login"
+)
+
+type UserMfa struct {
+	UserID       string
+	Secret       string
+	Enabled      bool
+	LastUsedStep int64
+	ConfirmedAt  *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type MfaRecoveryCode struct {
+	ID        string
+	UserID    string
+	CodeHash  string
+	Used      bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type MfaChallenge struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	Attempts  int
+	Used      bool
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type PasswordHistory struct {
+	ID           string
+	UserID       string
+	PasswordHash string
+	CreatedAt    time.Time
+}
+
+// OrganizationMember is a user's membership, and role, in one organization
+type OrganizationMember struct {
+	OrganizationID string
+	UserID         string
+	RoleID         string
+}
+
+// AuditLog records an action an admin took on behalf of a user
+type AuditLog struct {
+	ID        string
+	ActorID   string
+	UserID    string
+	Action    string
+	Details   string
+	IPAddress string
+	UserAgent string
+	CreatedAt time.Time
+}
@@ -1,19 +1,94 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"strings"
+	"time"
+)
 
 var (
-	ErrInvalidCredentials   = errors.New("invalid credentials")
-	ErrUserNotFound         = errors.New("user not found")
-	ErrUserAlreadyExists    = errors.New("user already exists")
-	ErrInvalidRefreshToken  = errors.New("invalid refresh token")
-	ErrInvalidToken         = errors.New("invalid token")
-	ErrTokenExpired         = errors.New("token expired")
-	ErrTokenRevoked         = errors.New("token revoked")
-	ErrPasswordResetExpired = errors.New("password reset expired")
-	ErrPasswordResetUsed    = errors.New("password reset used")
-	ErrPasswordUpdateFailed = errors.New("password update failed")
-	ErrPasswordNotMatch     = errors.New("password not match")
-	ErrEmailAlreadyUsed     = errors.New("email already registered")
-	ErrWeakPassword         = errors.New("weak password")
+	ErrInvalidCredentials    = errors.New("invalid credentials")
+	ErrUserNotFound          = errors.New("user not found")
+	ErrUserAlreadyExists     = errors.New("user already exists")
+	ErrInvalidRefreshToken   = errors.New("invalid refresh token")
+	ErrInvalidToken          = errors.New("invalid token")
+	ErrTokenExpired          = errors.New("token expired")
+	ErrTokenRevoked          = errors.New("token revoked")
+	ErrRefreshTokenReused    = errors.New("refresh token reused")
+	ErrSessionNotFound       = errors.New("session not found")
+	ErrPasswordResetExpired  = errors.New("password reset expired")
+	ErrPasswordResetUsed     = errors.New("password reset used")
+	ErrPasswordUpdateFailed  = errors.New("password update failed")
+	ErrPasswordNotMatch      = errors.New("password not match")
+	ErrEmailAlreadyUsed      = errors.New("email already registered")
+	ErrEmailNotVerified      = errors.New("email not verified")
+	ErrEmailAlreadyVerified  = errors.New("email already verified")
+	ErrWeakPassword          = errors.New("weak password")
+	ErrMfaAlreadyEnabled     = errors.New("mfa already enabled")
+	ErrMfaNotEnabled         = errors.New("mfa not enabled")
+	ErrMfaNotEnrolled        = errors.New("mfa not enrolled")
+	ErrInvalidMfaCode        = errors.New("invalid mfa code")
+	ErrInvalidMfaChallenge   = errors.New("invalid mfa challenge")
+	ErrApiKeyNotFound        = errors.New("api key not found")
+	ErrInvalidApiKey         = errors.New("invalid api key")
+	ErrInvalidScope          = errors.New("invalid scope")
+	ErrInvalidClient         = errors.New("invalid client credentials")
+	ErrTooManyAttempts       = errors.New("too many failed login attempts")
+	ErrNotOrganizationMember = errors.New("not a member of this organization")
+	ErrInvalidPhone          = errors.New("invalid phone number")
+	ErrPhoneAlreadyUsed      = errors.New("phone already registered")
+	ErrPhoneNotSet           = errors.New("phone number not set")
+	ErrPhoneAlreadyVerified  = errors.New("phone already verified")
+	ErrInvalidOtp            = errors.New("invalid otp code")
+	ErrOtpRecentlySent       = errors.New("otp recently sent, try again later")
+	ErrUnknownOidcProvider   = errors.New("unknown oidc provider")
+	ErrInvalidOidcState      = errors.New("invalid oidc state")
+	ErrOidcLoginFailed       = errors.New("oidc login failed")
+	ErrOidcEmailNotVerified  = errors.New("oidc provider did not verify the email address")
+	ErrCannotImpersonate     = errors.New("user cannot be impersonated")
+	ErrAccountSuspended      = errors.New("account suspended")
+	ErrAccountDeactivated    = errors.New("account deactivated")
+	ErrInvalidStatusChange   = errors.New("user status cannot change that way")
+	ErrCannotChangeOwnStatus = errors.New("cannot change the status of your own account")
+	ErrStatusInOrganization  = errors.New("account status cannot be changed inside an organization")
+	ErrProfileInOrganization = errors.New("name and email cannot be changed inside an organization")
+	ErrUserOutranksCaller    = errors.New("cannot manage a user with permissions you do not hold")
+	ErrWebauthnNotConfigured = errors.New("webauthn is not configured")
+	ErrInvalidWebauthn       = errors.New("invalid webauthn response")
+	ErrWebauthnChallenge     = errors.New("invalid webauthn challenge")
+	ErrWebauthnRegistered    = errors.New("webauthn credential already registered")
+	ErrWebauthnNotFound      = errors.New("webauthn credential not found")
+	ErrWebauthnCloned        = errors.New("webauthn authenticator may have been cloned")
+	ErrInvalidDeviceToken    = errors.New("invalid device revocation token")
 )
+
+// WeakPasswordError lists every password policy rule a new password breaks
+type WeakPasswordError struct {
+	Violations []PasswordViolation
+}
+
+func (e *WeakPasswordError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		messages[i] = violation.Message
+	}
+
+	return ErrWeakPassword.Error() + ": " + strings.Join(messages, "; ")
+}
+
+func (e *WeakPasswordError) Unwrap() error {
+	return ErrWeakPassword
+}
+
+// LoginThrottledError is returned while an account is locked or the client IP is throttled
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottledError) Error() string {
+	return ErrTooManyAttempts.Error()
+}
+
+func (e *LoginThrottledError) Unwrap() error {
+	return ErrTooManyAttempts
+}
@@ -0,0 +1,19 @@
+package domain
+
+// Permissions required by RPC policies (auth.v1.policy). Roles are granted permissions through the
+// role_permissions table, so a new role only needs rows, not a deploy.
+const (
+	PermissionUsersRead        = "users.read"
+	PermissionUsersCreate      = "users.create"
+	PermissionUsersUpdate      = "users.update"
+	PermissionUsersDelete      = "users.delete"
+	PermissionUsersUnlock      = "users.unlock"
+	PermissionUsersImpersonate = "users.impersonate"
+	PermissionUsersSuspend     = "users.suspend"
+	PermissionRolesRead        = "roles.read"
+	PermissionRolesManage      = "roles.manage"
+
+	PermissionOrganizationsManage = "organizations.manage"
+	PermissionMembersRead         = "members.read"
+	PermissionMembersManage       = "members.manage"
+)
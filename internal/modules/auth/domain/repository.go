@@ -1,23 +1,163 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type AuthRepository interface {
 	CreateUser(ctx context.Context, user *User) error
+	// FindUserByEmail matches the address case-insensitively
 	FindUserByEmail(ctx context.Context, email string) (*User, error)
 	FindUserByID(ctx context.Context, id string) (*User, error)
+	FindUserByVerifiedPhone(ctx context.Context, phone string) (*User, error)
 
 	// ===== REFRESH TOKEN =====
 	StoreRefreshToken(ctx context.Context, token *RefreshToken) error
 	FindValidRefreshToken(ctx context.Context, tokenHash string) (*RefreshToken, error)
-	RevokeRefreshToken(ctx context.Context, tokenHash string) error
+	FindRefreshTokenByHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	// RevokeRefreshToken reports false when the token was already revoked, e.g.
+	// by a concurrent refresh
+	RevokeRefreshToken(ctx context.Context, tokenHash string, reason RefreshTokenRevokeReason) (bool, error)
+	RevokeRefreshTokenFamily(ctx context.Context, familyID string) error
 	RevokeAllRefreshTokens(ctx context.Context, userID string) error
 
+	// ===== SESSIONS =====
+	ListActiveSessions(ctx context.Context, userID string) ([]Session, error)
+	RevokeSession(ctx context.Context, userID, sessionID string) (bool, error)
+
+	// ===== DEVICES =====
+	FindUserDevice(ctx context.Context, userID, fingerprint string) (*UserDevice, error)
+	CountUserDevices(ctx context.Context, userID string) (int, error)
+	// StoreUserDevice reports false when the device is already known
+	StoreUserDevice(ctx context.Context, device *UserDevice) (bool, error)
+	TouchUserDevice(ctx context.Context, id, ipAddress string, seenAt time.Time) error
+	FindUserDeviceByRevokeToken(ctx context.Context, tokenHash string) (*UserDevice, error)
+	// DeleteUserDevice reports false when the device was already removed
+	DeleteUserDevice(ctx context.Context, id string) (bool, error)
+
+	// ===== ACCESS TOKEN REVOCATION =====
+	StoreRevokedToken(ctx context.Context, token *RevokedToken) error
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+	DeleteExpiredRevokedTokens(ctx context.Context) error
+	StoreRevokedSession(ctx context.Context, session *RevokedSession) error
+	IsSessionRevoked(ctx context.Context, sessionID string) (bool, error)
+	DeleteExpiredRevokedSessions(ctx context.Context) error
+	SetTokensValidAfter(ctx context.Context, userID string, validAfter time.Time) error
+	// FindTokensValidAfter reports exists false for deleted and no longer active users
+	FindTokensValidAfter(ctx context.Context, userID string) (validAfter time.Time, exists bool, err error)
+	SetOrganizationTokensValidAfter(ctx context.Context, userID, organizationID string, validAfter time.Time) error
+	// FindOrganizationTokensValidAfter returns the zero time when the user's
+	// tokens in the organization were never revoked
+	FindOrganizationTokensValidAfter(ctx context.Context, userID, organizationID string) (time.Time, error)
+
+	// ===== API KEYS =====
+	StoreApiKey(ctx context.Context, key *ApiKey) error
+	FindApiKeyByHash(ctx context.Context, keyHash string) (*ApiKey, error)
+	ListApiKeys(ctx context.Context, userID string) ([]ApiKey, error)
+	RevokeApiKey(ctx context.Context, userID, id string) (bool, error)
+	TouchApiKey(ctx context.Context, id string, usedAt time.Time) error
+
+	// ===== SERVICE CLIENTS =====
+	FindServiceClient(ctx context.Context, clientID string) (*ServiceClient, error)
+
+	// ===== PASSWORD HISTORY =====
+	ListPasswordHistory(ctx context.Context, userID string, limit int) ([]string, error)
+	StorePasswordHistory(ctx context.Context, entry *PasswordHistory) error
+	PrunePasswordHistory(ctx context.Context, userID string, keep int) error
+
+	// ===== LOGIN THROTTLING =====
+	FindLoginLockout(ctx context.Context, userID string) (*LoginLockout, error)
+	IncrementFailedLogins(ctx context.Context, userID string, at time.Time) (attempts int, lockouts int, err error)
+	LockAccount(ctx context.Context, userID string, until time.Time, at time.Time) error
+	DeleteLoginLockout(ctx context.Context, userID string) error
+	IncrementLoginIPFailures(ctx context.Context, ipAddress string, at time.Time, windowStart time.Time) (int, time.Time, error)
+	FindLoginIPFailures(ctx context.Context, ipAddress string, windowStart time.Time) (int, time.Time, error)
+
+	// ===== EMAIL VERIFICATION =====
+	StoreEmailVerification(ctx context.Context, verification *EmailVerification) error
+	FindValidEmailVerification(ctx context.Context, tokenHash string) (*EmailVerification, error)
+	MarkEmailVerificationUsed(ctx context.Context, id string) error
+	MarkUserEmailVerified(ctx context.Context, userID string, verifiedAt time.Time) error
+
+	// ===== PHONE VERIFICATION =====
+	// StorePhoneOtp marks the user's earlier codes for the same purpose used
+	StorePhoneOtp(ctx context.Context, otp *PhoneOtp) error
+	// FindLatestPhoneOtp returns the user's most recent unused code for purpose, expired or not
+	FindLatestPhoneOtp(ctx context.Context, userID string, purpose PhoneOtpPurpose) (*PhoneOtp, error)
+	// IncrementPhoneOtpAttempts returns the attempts counted so far, or 0 when the code was already used
+	IncrementPhoneOtpAttempts(ctx context.Context, id string) (int, error)
+	// MarkPhoneOtpUsed reports false when the code was already used
+	MarkPhoneOtpUsed(ctx context.Context, id string) (bool, error)
+	MarkUserPhoneVerified(ctx context.Context, userID, phone string, verifiedAt time.Time) error
+
+	// ===== OPENID CONNECT =====
+	StoreOidcState(ctx context.Context, state *OidcState) error
+	FindValidOidcState(ctx context.Context, stateHash string) (*OidcState, error)
+	// MarkOidcStateUsed reports false when the state was already used
+	MarkOidcStateUsed(ctx context.Context, id string) (bool, error)
+	FindUserIdentity(ctx context.Context, provider, subject string) (*UserIdentity, error)
+	StoreUserIdentity(ctx context.Context, identity *UserIdentity) error
+	// CreateUserWithIdentity creates a user with a verified email address signed up through a provider
+	CreateUserWithIdentity(ctx context.Context, user *User, identity *UserIdentity) error
+
+	// ===== WEBAUTHN =====
+	StoreWebauthnChallenge(ctx context.Context, challenge *WebauthnChallenge) error
+	FindValidWebauthnChallenge(ctx context.Context, challengeHash string) (*WebauthnChallenge, error)
+	// MarkWebauthnChallengeUsed reports false when the challenge was already used
+	MarkWebauthnChallengeUsed(ctx context.Context, id string) (bool, error)
+	StoreWebauthnCredential(ctx context.Context, credential *WebauthnCredential) error
+	FindWebauthnCredential(ctx context.Context, credentialID []byte) (*WebauthnCredential, error)
+	ListWebauthnCredentials(ctx context.Context, userID string) ([]WebauthnCredential, error)
+	UpdateWebauthnCredentialUsage(ctx context.Context, id string, signCount uint32, usedAt time.Time) error
+	DeleteWebauthnCredential(ctx context.Context, userID, id string) (bool, error)
+
 	// ===== PASSWORD RESET =====
 	StorePasswordReset(ctx context.Context, pr *PasswordReset) error
 	FindValidPasswordReset(ctx context.Context, tokenHash string) (*PasswordReset, error)
 	MarkPasswordResetUsed(ctx context.Context, id string) error
 
+	// Magic links
+	StoreMagicLink(ctx context.Context, link *MagicLink) error
+	FindValidMagicLink(ctx context.Context, tokenHash string) (*MagicLink, error)
+	// FindLatestMagicLink returns the user's most recent link, used or expired or not
+	FindLatestMagicLink(ctx context.Context, userID string) (*MagicLink, error)
+	// MarkMagicLinkUsed reports false when the link was already used
+	MarkMagicLinkUsed(ctx context.Context, id string) (bool, error)
+
+	// User invitations
+	FindValidInvitation(ctx context.Context, tokenHash string) (*UserInvitation, error)
+	// AcceptInvitation activates the invited user with their chosen name and password
+	AcceptInvitation(ctx context.Context, invitation *UserInvitation, name, passwordHash string, acceptedAt time.Time) error
+
 	// ===== PASSWORD UPDATE =====
 	UpdateUserPassword(ctx context.Context, userID, newHash string) error
+	// UpgradeUserPasswordHash replaces the hash only while it still equals oldHash,
+	// so a rehash cannot overwrite a password changed in the meantime
+	UpgradeUserPasswordHash(ctx context.Context, userID, oldHash, newHash string) (bool, error)
+
+	// ===== ORGANIZATIONS =====
+	FindOrganizationMember(ctx context.Context, organizationID, userID string) (*OrganizationMember, error)
+	// FindDefaultOrganizationMember returns the membership a fresh login acts in
+	FindDefaultOrganizationMember(ctx context.Context, userID string) (*OrganizationMember, error)
+
+	// ===== MFA =====
+	UpsertUserMfa(ctx context.Context, mfa *UserMfa) error
+	FindUserMfa(ctx context.Context, userID string) (*UserMfa, error)
+	EnableUserMfa(ctx context.Context, userID string, step int64) error
+	// UpdateMfaLastUsedStep reports false when step is not past the last used one
+	UpdateMfaLastUsedStep(ctx context.Context, userID string, step int64) (bool, error)
+	DeleteUserMfa(ctx context.Context, userID string) error
+	ReplaceMfaRecoveryCodes(ctx context.Context, userID string, codes []*MfaRecoveryCode) error
+	UseMfaRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error)
+	StoreMfaChallenge(ctx context.Context, challenge *MfaChallenge) error
+	FindValidMfaChallenge(ctx context.Context, tokenHash string) (*MfaChallenge, error)
+	// IncrementMfaChallengeAttempts returns the attempts counted so far, or 0 when
+	// the challenge was used or already had maxAttempts
+	IncrementMfaChallengeAttempts(ctx context.Context, id string, maxAttempts int) (int, error)
+	// MarkMfaChallengeUsed reports false when the challenge was already used
+	MarkMfaChallengeUsed(ctx context.Context, id string) (bool, error)
+
+	// ===== AUDIT =====
+	StoreAuditLog(ctx context.Context, log *AuditLog) error
 }
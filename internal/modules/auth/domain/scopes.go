@@ -0,0 +1,18 @@
+package domain
+
+// Scopes limit what a scoped credential (API key) may call.
+// Access tokens from a password login are not scoped.
+const (
+	ScopeUsersRead     = "users:read"
+	ScopeUsersWrite    = "users:write"
+	ScopeSessionsRead  = "sessions:read"
+	ScopeSessionsWrite = "sessions:write"
+)
+
+// KnownScopes lists every scope a credential can be granted
+var KnownScopes = map[string]bool{
+	ScopeUsersRead:     true,
+	ScopeUsersWrite:    true,
+	ScopeSessionsRead:  true,
+	ScopeSessionsWrite: true,
+}
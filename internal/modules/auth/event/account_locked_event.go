@@ -0,0 +1,14 @@
+package event
+
+import "time"
+
+const AccountLockedSubject = "auth.account_locked"
+
+type AccountLockedEvent struct {
+	UserID         string    `json:"user_id"`
+	Name           string    `json:"name"`
+	Email          string    `json:"email"`
+	IPAddress      string    `json:"ip_address"`
+	FailedAttempts int       `json:"failed_attempts"`
+	LockedUntil    time.Time `json:"locked_until"`
+}
@@ -0,0 +1,13 @@
+package event
+
+import "time"
+
+const MagicLinkRequestedSubject = "auth.magic_link_requested"
+
+type MagicLinkRequestedEvent struct {
+	UserID    string    `json:"user_id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Token     string    `json:"token"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
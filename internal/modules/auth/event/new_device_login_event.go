@@ -0,0 +1,16 @@
+package event
+
+import "time"
+
+const NewDeviceLoginSubject = "auth.new_device_login"
+
+type NewDeviceLoginEvent struct {
+	UserID      string    `json:"user_id"`
+	Name        string    `json:"name"`
+	Email       string    `json:"email"`
+	UserAgent   string    `json:"user_agent"`
+	IPAddress   string    `json:"ip_address"`
+	LoginAt     time.Time `json:"login_at"`
+	RevokeToken string    `json:"revoke_token"`
+	ExpiredAt   time.Time `json:"expired_at"`
+}
@@ -23,3 +23,73 @@ func (publisher *Publisher) ForgotPassword(payload ForgotPasswordEvent) error {
 
 	return publisher.bus.Publish(ForgotPasswordSubject, data)
 }
+
+func (publisher *Publisher) RefreshTokenReused(payload RefreshTokenReusedEvent) error {
+	data, err := json.Marshal(payload)
+
+	if err != nil {
+		return err
+	}
+
+	return publisher.bus.Publish(RefreshTokenReusedSubject, data)
+}
+
+func (publisher *Publisher) UserRegistered(payload UserRegisteredEvent) error {
+	data, err := json.Marshal(payload)
+
+	if err != nil {
+		return err
+	}
+
+	return publisher.bus.Publish(UserRegisteredSubject, data)
+}
+
+func (publisher *Publisher) EmailVerificationRequested(payload EmailVerificationRequestedEvent) error {
+	data, err := json.Marshal(payload)
+
+	if err != nil {
+		return err
+	}
+
+	return publisher.bus.Publish(EmailVerificationRequestedSubject, data)
+}
+
+func (publisher *Publisher) AccountLocked(payload AccountLockedEvent) error {
+	data, err := json.Marshal(payload)
+
+	if err != nil {
+		return err
+	}
+
+	return publisher.bus.Publish(AccountLockedSubject, data)
+}
+
+func (publisher *Publisher) MagicLinkRequested(payload MagicLinkRequestedEvent) error {
+	data, err := json.Marshal(payload)
+
+	if err != nil {
+		return err
+	}
+
+	return publisher.bus.Publish(MagicLinkRequestedSubject, data)
+}
+
+func (publisher *Publisher) SMSOtpRequested(payload SMSOtpRequestedEvent) error {
+	data, err := json.Marshal(payload)
+
+	if err != nil {
+		return err
+	}
+
+	return publisher.bus.Publish(SMSOtpRequestedSubject, data)
+}
+
+func (publisher *Publisher) NewDeviceLogin(payload NewDeviceLoginEvent) error {
+	data, err := json.Marshal(payload)
+
+	if err != nil {
+		return err
+	}
+
+	return publisher.bus.Publish(NewDeviceLoginSubject, data)
+}
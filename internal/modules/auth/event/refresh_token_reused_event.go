@@ -0,0 +1,12 @@
+package event
+
+import "time"
+
+const RefreshTokenReusedSubject = "auth.refresh_token_reused"
+
+type RefreshTokenReusedEvent struct {
+	UserID     string    `json:"user_id"`
+	FamilyID   string    `json:"family_id"`
+	TokenID    string    `json:"token_id"`
+	DetectedAt time.Time `json:"detected_at"`
+}
@@ -0,0 +1,15 @@
+package event
+
+import "time"
+
+const SMSOtpRequestedSubject = "auth.sms_otp_requested"
+
+// SMSOtpRequestedEvent carries a one-time code to send by SMS. Purpose is
+// "verify" or "login".
+type SMSOtpRequestedEvent struct {
+	UserID    string    `json:"user_id"`
+	Phone     string    `json:"phone"`
+	Code      string    `json:"code"`
+	Purpose   string    `json:"purpose"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
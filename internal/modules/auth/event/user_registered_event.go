@@ -0,0 +1,26 @@
+package event
+
+import "time"
+
+const (
+	UserRegisteredSubject             = "auth.user_registered"
+	EmailVerificationRequestedSubject = "auth.email_verification_requested"
+)
+
+// UserRegisteredEvent is published after sign up, with the token that verifies the email address
+type UserRegisteredEvent struct {
+	UserID    string    `json:"user_id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Token     string    `json:"token"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
+
+// EmailVerificationRequestedEvent is published when a user asks for a new verification email
+type EmailVerificationRequestedEvent struct {
+	UserID    string    `json:"user_id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Token     string    `json:"token"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
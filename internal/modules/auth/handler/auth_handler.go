@@ -2,21 +2,65 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"log"
+	"net/http"
+	"strings"
 
 	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/shared/common/metadata"
+	middleware "github.com/nassabiq/golang-template/internal/shared/middleware/auth"
+	"github.com/nassabiq/golang-template/internal/shared/tenant"
 	authpb "github.com/nassabiq/golang-template/proto/auth"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type AuthUsecaseInterface interface {
 	Register(ctx context.Context, req domain.RegisterInput) error
 	Login(ctx context.Context, req domain.LoginInput) (*domain.AuthOutput, error)
-	RefreshToken(ctx context.Context, token string) (*domain.AuthOutput, error)
-	Logout(ctx context.Context, refreshToken string) error
+	RefreshToken(ctx context.Context, token string, client domain.ClientInfo) (*domain.AuthOutput, error)
+	Logout(ctx context.Context, req domain.LogoutInput) error
+	LogoutAll(ctx context.Context, userID string) error
+	ListSessions(ctx context.Context, userID, currentSessionID string) ([]domain.Session, error)
+	RevokeSession(ctx context.Context, userID, sessionID string) error
+	RevokeDeviceSession(ctx context.Context, token string) error
 	ForgotPassword(ctx context.Context, email string) error
 	ResetPassword(ctx context.Context, req domain.ResetPasswordInput) error
+	AcceptInvitation(ctx context.Context, req domain.AcceptInvitationInput) error
+	RequestMagicLink(ctx context.Context, email string) (string, error)
+	ConsumeMagicLink(ctx context.Context, req domain.ConsumeMagicLinkInput) (*domain.AuthOutput, error)
+	RequestPhoneVerification(ctx context.Context, userID, phone string) error
+	VerifyPhone(ctx context.Context, userID, code string) error
+	RequestPhoneLogin(ctx context.Context, phone string) error
+	LoginWithPhone(ctx context.Context, req domain.PhoneLoginInput) (*domain.AuthOutput, error)
+	StartOidcLogin(ctx context.Context, provider string) (*domain.OidcStart, error)
+	CompleteOidcLogin(ctx context.Context, req domain.OidcCallbackInput) (*domain.AuthOutput, error)
+	VerifyEmail(ctx context.Context, token string) error
+	ResendVerification(ctx context.Context, email string) error
+	EnrollMfa(ctx context.Context, userID string) (*domain.MfaEnrollment, error)
+	ConfirmMfa(ctx context.Context, userID, code string) ([]string, error)
+	DisableMfa(ctx context.Context, userID, code string) error
+	VerifyMfa(ctx context.Context, req domain.VerifyMfaInput) (*domain.AuthOutput, error)
+	GetJwks(ctx context.Context) []domain.JSONWebKey
+	CreateApiKey(ctx context.Context, req domain.CreateApiKeyInput) (*domain.CreatedApiKey, error)
+	ListApiKeys(ctx context.Context, userID string) ([]domain.ApiKey, error)
+	RevokeApiKey(ctx context.Context, userID, id string) error
+	IssueServiceToken(ctx context.Context, req domain.ClientCredentialsInput) (*domain.ServiceTokenOutput, error)
+	UnlockAccount(ctx context.Context, userID string) error
+	Impersonate(ctx context.Context, req domain.ImpersonateInput) (*domain.ImpersonationOutput, error)
+	SwitchOrganization(ctx context.Context, req domain.SwitchOrganizationInput) (*domain.AuthOutput, error)
+	Reauthenticate(ctx context.Context, req domain.ReauthenticateInput) (*domain.AuthOutput, error)
+	BeginWebauthnRegistration(ctx context.Context, userID string) ([]byte, error)
+	FinishWebauthnRegistration(ctx context.Context, req domain.FinishWebauthnRegistrationInput) (*domain.WebauthnCredential, error)
+	BeginWebauthnLogin(ctx context.Context, mfaToken string) ([]byte, error)
+	FinishWebauthnLogin(ctx context.Context, req domain.FinishWebauthnLoginInput) (*domain.AuthOutput, error)
+	ListWebauthnCredentials(ctx context.Context, userID string) ([]domain.WebauthnCredential, error)
+	DeleteWebauthnCredential(ctx context.Context, userID, id string) error
 }
 
 type AuthHandler struct {
@@ -42,11 +86,17 @@ func (h *AuthHandler) Register(
 		Email:                req.Email,
 		Password:             req.Password,
 		PasswordConfirmation: req.PasswordConfirmation,
+		Phone:                req.Phone,
 	}); err != nil {
+		var weak *domain.WeakPasswordError
+		if errors.As(err, &weak) {
+			return nil, weakPasswordStatus(weak)
+		}
+
 		switch err {
-		case domain.ErrUserAlreadyExists, domain.ErrEmailAlreadyUsed:
+		case domain.ErrUserAlreadyExists, domain.ErrEmailAlreadyUsed, domain.ErrPhoneAlreadyUsed:
 			return nil, status.Error(codes.AlreadyExists, err.Error())
-		case domain.ErrPasswordNotMatch:
+		case domain.ErrPasswordNotMatch, domain.ErrInvalidPhone:
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
 			log.Printf("[Auth] Register error: %v", err)
@@ -68,22 +118,30 @@ func (h *AuthHandler) Login(
 	result, err := h.authUC.Login(ctx, domain.LoginInput{
 		Email:    req.Email,
 		Password: req.Password,
+		Client:   clientInfo(ctx),
 	})
 
 	if err != nil {
+		var throttled *domain.LoginThrottledError
+		if errors.As(err, &throttled) {
+			metadata.SetRetryAfter(ctx, throttled.RetryAfter)
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+
 		switch err {
 		case domain.ErrInvalidCredentials:
 			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case domain.ErrEmailNotVerified:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case domain.ErrAccountSuspended, domain.ErrAccountDeactivated:
+			return nil, status.Error(codes.PermissionDenied, err.Error())
 		default:
 			log.Printf("[Auth] Login error: %v", err)
 			return nil, status.Error(codes.Internal, "internal error")
 		}
 	}
 
-	return &authpb.AuthResponse{
-		AccessToken:  result.AccessToken,
-		RefreshToken: result.RefreshToken,
-	}, nil
+	return toAuthResponse(result), nil
 }
 
 func (h *AuthHandler) Refresh(
@@ -95,22 +153,21 @@ func (h *AuthHandler) Refresh(
 		return nil, status.Error(codes.InvalidArgument, "refresh token required")
 	}
 
-	result, err := h.authUC.RefreshToken(ctx, req.RefreshToken)
+	result, err := h.authUC.RefreshToken(ctx, req.RefreshToken, clientInfo(ctx))
 
 	if err != nil {
 		switch err {
-		case domain.ErrInvalidRefreshToken, domain.ErrTokenExpired:
+		case domain.ErrInvalidRefreshToken, domain.ErrInvalidToken, domain.ErrTokenExpired, domain.ErrRefreshTokenReused:
 			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case domain.ErrAccountSuspended, domain.ErrAccountDeactivated:
+			return nil, status.Error(codes.PermissionDenied, err.Error())
 		default:
 			log.Printf("[Auth] Refresh error: %v", err)
 			return nil, status.Error(codes.Internal, "internal error")
 		}
 	}
 
-	return &authpb.AuthResponse{
-		AccessToken:  result.AccessToken,
-		RefreshToken: result.RefreshToken,
-	}, nil
+	return toAuthResponse(result), nil
 }
 
 func (h *AuthHandler) Logout(
@@ -122,7 +179,13 @@ func (h *AuthHandler) Logout(
 		return nil, status.Error(codes.InvalidArgument, "refresh token required")
 	}
 
-	if err := h.authUC.Logout(ctx, req.RefreshToken); err != nil {
+	input := domain.LogoutInput{RefreshToken: req.RefreshToken}
+	if claims, ok := middleware.ClaimsFromContext(ctx); ok {
+		input.AccessTokenID = claims.ID
+		input.AccessTokenExpiresAt = claims.ExpiresAt
+	}
+
+	if err := h.authUC.Logout(ctx, input); err != nil {
 		log.Printf("[Auth] Logout error: %v", err)
 		return nil, status.Error(codes.Internal, "internal error")
 	}
@@ -130,6 +193,106 @@ func (h *AuthHandler) Logout(
 	return &authpb.MessageResponse{Message: "Logout successful"}, nil
 }
 
+func (h *AuthHandler) LogoutAll(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*authpb.MessageResponse, error) {
+
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := h.authUC.LogoutAll(ctx, userID); err != nil {
+		log.Printf("[Auth] LogoutAll error: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &authpb.MessageResponse{Message: "Logged out from all devices"}, nil
+}
+
+func (h *AuthHandler) ListSessions(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*authpb.ListSessionsResponse, error) {
+
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	sessions, err := h.authUC.ListSessions(ctx, userID, middleware.SessionFromContext(ctx))
+
+	if err != nil {
+		log.Printf("[Auth] ListSessions error: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	result := make([]*authpb.Session, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, &authpb.Session{
+			Id:         session.ID,
+			UserAgent:  session.UserAgent,
+			IpAddress:  session.IPAddress,
+			CreatedAt:  timestamppb.New(session.CreatedAt),
+			LastUsedAt: timestamppb.New(session.LastUsedAt),
+			ExpiresAt:  timestamppb.New(session.ExpiresAt),
+			Current:    session.Current,
+		})
+	}
+
+	return &authpb.ListSessionsResponse{Sessions: result}, nil
+}
+
+func (h *AuthHandler) RevokeSession(
+	ctx context.Context,
+	req *authpb.RevokeSessionRequest,
+) (*authpb.MessageResponse, error) {
+
+	if req.GetSessionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := h.authUC.RevokeSession(ctx, userID, req.SessionId); err != nil {
+		switch err {
+		case domain.ErrSessionNotFound:
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			log.Printf("[Auth] RevokeSession error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &authpb.MessageResponse{Message: "Session revoked"}, nil
+}
+
+func (h *AuthHandler) RevokeDeviceSession(
+	ctx context.Context,
+	req *authpb.RevokeDeviceSessionRequest,
+) (*authpb.MessageResponse, error) {
+
+	if req.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	if err := h.authUC.RevokeDeviceSession(ctx, req.Token); err != nil {
+		switch err {
+		case domain.ErrInvalidDeviceToken:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			log.Printf("[Auth] RevokeDeviceSession error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &authpb.MessageResponse{Message: "Device session revoked"}, nil
+}
+
 func (h *AuthHandler) ForgotPassword(
 	ctx context.Context,
 	req *authpb.ForgotPasswordRequest,
@@ -166,6 +329,11 @@ func (h *AuthHandler) ResetPassword(
 		Token:       req.Token,
 		NewPassword: req.NewPassword,
 	}); err != nil {
+		var weak *domain.WeakPasswordError
+		if errors.As(err, &weak) {
+			return nil, weakPasswordStatus(weak)
+		}
+
 		switch err {
 		case domain.ErrInvalidToken, domain.ErrPasswordResetExpired, domain.ErrPasswordResetUsed:
 			return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -177,3 +345,1073 @@ func (h *AuthHandler) ResetPassword(
 
 	return &authpb.MessageResponse{Message: "Password reset successful"}, nil
 }
+
+func (h *AuthHandler) AcceptInvitation(
+	ctx context.Context,
+	req *authpb.AcceptInvitationRequest,
+) (*authpb.MessageResponse, error) {
+
+	if req.GetToken() == "" || req.GetName() == "" || req.GetPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token, name and password are required")
+	}
+
+	if err := h.authUC.AcceptInvitation(ctx, domain.AcceptInvitationInput{
+		Token:                req.Token,
+		Name:                 req.Name,
+		Password:             req.Password,
+		PasswordConfirmation: req.PasswordConfirmation,
+	}); err != nil {
+		var weak *domain.WeakPasswordError
+		if errors.As(err, &weak) {
+			return nil, weakPasswordStatus(weak)
+		}
+
+		switch err {
+		case domain.ErrInvalidToken, domain.ErrPasswordNotMatch:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			log.Printf("[Auth] AcceptInvitation error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &authpb.MessageResponse{Message: "Invitation accepted"}, nil
+}
+
+func (h *AuthHandler) RequestMagicLink(
+	ctx context.Context,
+	req *authpb.RequestMagicLinkRequest,
+) (*authpb.MagicLinkResponse, error) {
+
+	if req.GetEmail() == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	browserCode, err := h.authUC.RequestMagicLink(ctx, req.Email)
+
+	if err != nil {
+		log.Printf("[Auth] RequestMagicLink error: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &authpb.MagicLinkResponse{
+		Message:     "If your email is registered, you will receive a sign-in link",
+		BrowserCode: browserCode,
+	}, nil
+}
+
+func (h *AuthHandler) ConsumeMagicLink(
+	ctx context.Context,
+	req *authpb.ConsumeMagicLinkRequest,
+) (*authpb.AuthResponse, error) {
+
+	if req.GetToken() == "" || req.GetBrowserCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token and browser_code are required")
+	}
+
+	result, err := h.authUC.ConsumeMagicLink(ctx, domain.ConsumeMagicLinkInput{
+		Token:       req.Token,
+		BrowserCode: req.BrowserCode,
+		Client:      clientInfo(ctx),
+	})
+
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidToken:
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case domain.ErrAccountSuspended, domain.ErrAccountDeactivated:
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			log.Printf("[Auth] ConsumeMagicLink error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return toAuthResponse(result), nil
+}
+
+func (h *AuthHandler) VerifyEmail(
+	ctx context.Context,
+	req *authpb.VerifyEmailRequest,
+) (*authpb.MessageResponse, error) {
+
+	if req.GetToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	if err := h.authUC.VerifyEmail(ctx, req.Token); err != nil {
+		switch err {
+		case domain.ErrInvalidToken:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			log.Printf("[Auth] VerifyEmail error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &authpb.MessageResponse{Message: "Email verified"}, nil
+}
+
+func (h *AuthHandler) ResendVerification(
+	ctx context.Context,
+	req *authpb.ResendVerificationRequest,
+) (*authpb.MessageResponse, error) {
+
+	if req.GetEmail() == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	if err := h.authUC.ResendVerification(ctx, req.Email); err != nil {
+		switch err {
+		case domain.ErrUserNotFound, domain.ErrEmailAlreadyVerified:
+			// Same answer either way to prevent email enumeration
+		default:
+			log.Printf("[Auth] ResendVerification error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &authpb.MessageResponse{Message: "If your email is registered and not verified yet, you will receive a verification link"}, nil
+}
+
+func (h *AuthHandler) RequestPhoneVerification(
+	ctx context.Context,
+	req *authpb.RequestPhoneVerificationRequest,
+) (*authpb.MessageResponse, error) {
+
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := h.authUC.RequestPhoneVerification(ctx, userID, req.GetPhone()); err != nil {
+		switch err {
+		case domain.ErrInvalidPhone, domain.ErrPhoneNotSet:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case domain.ErrPhoneAlreadyVerified, domain.ErrPhoneAlreadyUsed:
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		case domain.ErrOtpRecentlySent:
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		case domain.ErrUserNotFound:
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			log.Printf("[Auth] RequestPhoneVerification error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &authpb.MessageResponse{Message: "Verification code sent"}, nil
+}
+
+func (h *AuthHandler) VerifyPhone(
+	ctx context.Context,
+	req *authpb.VerifyPhoneRequest,
+) (*authpb.MessageResponse, error) {
+
+	if req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := h.authUC.VerifyPhone(ctx, userID, req.Code); err != nil {
+		switch err {
+		case domain.ErrInvalidOtp:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case domain.ErrPhoneAlreadyUsed:
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		default:
+			log.Printf("[Auth] VerifyPhone error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &authpb.MessageResponse{Message: "Phone verified"}, nil
+}
+
+func (h *AuthHandler) RequestPhoneLogin(
+	ctx context.Context,
+	req *authpb.RequestPhoneLoginRequest,
+) (*authpb.MessageResponse, error) {
+
+	if req.GetPhone() == "" {
+		return nil, status.Error(codes.InvalidArgument, "phone is required")
+	}
+
+	if err := h.authUC.RequestPhoneLogin(ctx, req.Phone); err != nil {
+		switch err {
+		case domain.ErrInvalidPhone:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			log.Printf("[Auth] RequestPhoneLogin error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &authpb.MessageResponse{Message: "If your phone number is registered, you will receive a login code"}, nil
+}
+
+func (h *AuthHandler) LoginWithPhone(
+	ctx context.Context,
+	req *authpb.LoginWithPhoneRequest,
+) (*authpb.AuthResponse, error) {
+
+	if req.GetPhone() == "" || req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "phone and code are required")
+	}
+
+	result, err := h.authUC.LoginWithPhone(ctx, domain.PhoneLoginInput{
+		Phone:  req.Phone,
+		Code:   req.Code,
+		Client: clientInfo(ctx),
+	})
+
+	if err != nil {
+		var throttled *domain.LoginThrottledError
+		if errors.As(err, &throttled) {
+			metadata.SetRetryAfter(ctx, throttled.RetryAfter)
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+
+		switch err {
+		case domain.ErrInvalidCredentials:
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case domain.ErrInvalidPhone:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case domain.ErrEmailNotVerified:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case domain.ErrAccountSuspended, domain.ErrAccountDeactivated:
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			log.Printf("[Auth] LoginWithPhone error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return toAuthResponse(result), nil
+}
+
+// oidcBrowserCodeCookie binds an OIDC login to the browser that started it
+const oidcBrowserCodeCookie = "oidc_browser_code"
+
+func (h *AuthHandler) StartOidcLogin(
+	ctx context.Context,
+	req *authpb.StartOidcLoginRequest,
+) (*authpb.StartOidcLoginResponse, error) {
+
+	result, err := h.authUC.StartOidcLogin(ctx, req.GetProvider())
+
+	if err != nil {
+		switch err {
+		case domain.ErrUnknownOidcProvider:
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			log.Printf("[Auth] StartOidcLogin error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	metadata.SetCookie(ctx, &http.Cookie{
+		Name:     oidcBrowserCodeCookie,
+		Value:    result.BrowserCode,
+		Path:     "/auth/oidc/",
+		MaxAge:   600,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	metadata.SetRedirect(ctx, result.AuthorizationURL)
+
+	return &authpb.StartOidcLoginResponse{
+		AuthorizationUrl: result.AuthorizationURL,
+		BrowserCode:      result.BrowserCode,
+	}, nil
+}
+
+func (h *AuthHandler) OidcCallback(
+	ctx context.Context,
+	req *authpb.OidcCallbackRequest,
+) (*authpb.AuthResponse, error) {
+
+	if req.GetError() != "" {
+		return nil, status.Errorf(codes.Unauthenticated, "provider returned error: %s", req.GetError())
+	}
+
+	browserCode := req.GetBrowserCode()
+	if browserCode == "" {
+		browserCode = metadata.Cookie(ctx, oidcBrowserCodeCookie)
+	}
+
+	if req.GetCode() == "" || req.GetState() == "" || browserCode == "" {
+		return nil, status.Error(codes.InvalidArgument, "code, state and browser_code are required")
+	}
+
+	// The state is single use, so the cookie is done with whatever the outcome
+	metadata.SetCookie(ctx, &http.Cookie{
+		Name:     oidcBrowserCodeCookie,
+		Path:     "/auth/oidc/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	result, err := h.authUC.CompleteOidcLogin(ctx, domain.OidcCallbackInput{
+		Provider:    req.Provider,
+		Code:        req.Code,
+		State:       req.State,
+		BrowserCode: browserCode,
+		Client:      clientInfo(ctx),
+	})
+
+	if err != nil {
+		if errors.Is(err, domain.ErrOidcLoginFailed) {
+			log.Printf("[Auth] OidcCallback error: %v", err)
+			return nil, status.Error(codes.Unauthenticated, domain.ErrOidcLoginFailed.Error())
+		}
+
+		switch err {
+		case domain.ErrUnknownOidcProvider:
+			return nil, status.Error(codes.NotFound, err.Error())
+		case domain.ErrInvalidOidcState, domain.ErrInvalidCredentials:
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case domain.ErrOidcEmailNotVerified:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case domain.ErrAccountSuspended, domain.ErrAccountDeactivated:
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			log.Printf("[Auth] OidcCallback error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return toAuthResponse(result), nil
+}
+
+func (h *AuthHandler) EnrollMfa(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*authpb.EnrollMfaResponse, error) {
+
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	result, err := h.authUC.EnrollMfa(ctx, userID)
+
+	if err != nil {
+		switch err {
+		case domain.ErrMfaAlreadyEnabled:
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		case domain.ErrUserNotFound:
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			log.Printf("[Auth] EnrollMfa error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &authpb.EnrollMfaResponse{
+		Secret:          result.Secret,
+		ProvisioningUri: result.ProvisioningURI,
+	}, nil
+}
+
+func (h *AuthHandler) ConfirmMfa(
+	ctx context.Context,
+	req *authpb.ConfirmMfaRequest,
+) (*authpb.ConfirmMfaResponse, error) {
+
+	if req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	recoveryCodes, err := h.authUC.ConfirmMfa(ctx, userID, req.Code)
+
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidMfaCode:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case domain.ErrMfaNotEnrolled, domain.ErrMfaAlreadyEnabled:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			log.Printf("[Auth] ConfirmMfa error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &authpb.ConfirmMfaResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (h *AuthHandler) DisableMfa(
+	ctx context.Context,
+	req *authpb.DisableMfaRequest,
+) (*authpb.MessageResponse, error) {
+
+	if req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := h.authUC.DisableMfa(ctx, userID, req.Code); err != nil {
+		switch err {
+		case domain.ErrInvalidMfaCode:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case domain.ErrMfaNotEnabled:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			log.Printf("[Auth] DisableMfa error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &authpb.MessageResponse{Message: "MFA disabled"}, nil
+}
+
+func (h *AuthHandler) VerifyMfa(
+	ctx context.Context,
+	req *authpb.VerifyMfaRequest,
+) (*authpb.AuthResponse, error) {
+
+	if req.GetMfaToken() == "" || req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "mfa_token and code are required")
+	}
+
+	result, err := h.authUC.VerifyMfa(ctx, domain.VerifyMfaInput{
+		MfaToken: req.MfaToken,
+		Code:     req.Code,
+		Client:   clientInfo(ctx),
+	})
+
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidMfaChallenge, domain.ErrInvalidMfaCode, domain.ErrMfaNotEnabled:
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case domain.ErrAccountSuspended, domain.ErrAccountDeactivated:
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			log.Printf("[Auth] VerifyMfa error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return toAuthResponse(result), nil
+}
+
+func (h *AuthHandler) GetJwks(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*authpb.JwksResponse, error) {
+
+	keys := h.authUC.GetJwks(ctx)
+
+	result := make([]*authpb.Jwk, 0, len(keys))
+	for _, key := range keys {
+		jwk := &authpb.Jwk{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+		}
+
+		switch key.Kty {
+		case "RSA":
+			jwk.N = &key.N
+			jwk.E = &key.E
+		case "OKP":
+			jwk.Crv = &key.Crv
+			jwk.X = &key.X
+		}
+
+		result = append(result, jwk)
+	}
+
+	return &authpb.JwksResponse{Keys: result}, nil
+}
+
+func (h *AuthHandler) CreateApiKey(
+	ctx context.Context,
+	req *authpb.CreateApiKeyRequest,
+) (*authpb.CreateApiKeyResponse, error) {
+
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	// A key created inside an organization only ever acts there
+	organizationID, _ := tenant.OrganizationFromContext(ctx)
+
+	result, err := h.authUC.CreateApiKey(ctx, domain.CreateApiKeyInput{
+		UserID:         userID,
+		OrganizationID: organizationID,
+		Name:           req.Name,
+		Scopes:         req.Scopes,
+		ExpiresInDays:  int(req.ExpiresInDays),
+	})
+
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidScope:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			log.Printf("[Auth] CreateApiKey error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &authpb.CreateApiKeyResponse{
+		ApiKey: toApiKey(result.ApiKey),
+		Key:    result.Key,
+	}, nil
+}
+
+func (h *AuthHandler) ListApiKeys(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*authpb.ListApiKeysResponse, error) {
+
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	keys, err := h.authUC.ListApiKeys(ctx, userID)
+
+	if err != nil {
+		log.Printf("[Auth] ListApiKeys error: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	result := make([]*authpb.ApiKey, 0, len(keys))
+	for i := range keys {
+		result = append(result, toApiKey(&keys[i]))
+	}
+
+	return &authpb.ListApiKeysResponse{ApiKeys: result}, nil
+}
+
+func (h *AuthHandler) RevokeApiKey(
+	ctx context.Context,
+	req *authpb.RevokeApiKeyRequest,
+) (*authpb.MessageResponse, error) {
+
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := h.authUC.RevokeApiKey(ctx, userID, req.Id); err != nil {
+		switch err {
+		case domain.ErrApiKeyNotFound:
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			log.Printf("[Auth] RevokeApiKey error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &authpb.MessageResponse{Message: "API key revoked"}, nil
+}
+
+// Token implements the OAuth2 token endpoint for the client credentials grant
+func (h *AuthHandler) Token(
+	ctx context.Context,
+	req *authpb.TokenRequest,
+) (*authpb.TokenResponse, error) {
+
+	if req.GetGrantType() != "client_credentials" {
+		return nil, status.Error(codes.InvalidArgument, "unsupported grant_type")
+	}
+
+	clientID, clientSecret := req.GetClientId(), req.GetClientSecret()
+	if username, password, ok := metadata.BasicAuth(ctx); ok {
+		clientID, clientSecret = username, password
+	}
+
+	if clientID == "" || clientSecret == "" {
+		return nil, status.Error(codes.InvalidArgument, "client_id and client_secret required")
+	}
+
+	result, err := h.authUC.IssueServiceToken(ctx, domain.ClientCredentialsInput{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Scopes:       strings.Fields(req.GetScope()),
+	})
+
+	if err != nil {
+		switch err {
+		case domain.ErrInvalidClient:
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case domain.ErrInvalidScope:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			log.Printf("[Auth] Token error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &authpb.TokenResponse{
+		AccessToken: result.AccessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(result.ExpiresIn.Seconds()),
+		Scope:       strings.Join(result.Scopes, " "),
+	}, nil
+}
+
+// UnlockAccount lets an admin lift a login lockout before it expires
+func (h *AuthHandler) UnlockAccount(
+	ctx context.Context,
+	req *authpb.UnlockAccountRequest,
+) (*authpb.MessageResponse, error) {
+
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	if err := h.authUC.UnlockAccount(ctx, req.UserId); err != nil {
+		switch err {
+		case domain.ErrUserNotFound:
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			log.Printf("[Auth] UnlockAccount error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &authpb.MessageResponse{Message: "account unlocked"}, nil
+}
+
+// Impersonate issues a short-lived token for support to act as another user
+func (h *AuthHandler) Impersonate(
+	ctx context.Context,
+	req *authpb.ImpersonateRequest,
+) (*authpb.ImpersonateResponse, error) {
+
+	actorID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	result, err := h.authUC.Impersonate(ctx, domain.ImpersonateInput{
+		ActorID: actorID,
+		UserID:  req.UserId,
+		Client:  clientInfo(ctx),
+	})
+
+	if err != nil {
+		switch err {
+		case domain.ErrUserNotFound:
+			return nil, status.Error(codes.NotFound, err.Error())
+		case domain.ErrCannotImpersonate:
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			log.Printf("[Auth] Impersonate error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &authpb.ImpersonateResponse{
+		AccessToken: result.AccessToken,
+		ExpiresIn:   int64(result.ExpiresIn.Seconds()),
+	}, nil
+}
+
+// SwitchOrganization re-issues the caller's tokens for another organization they belong to
+func (h *AuthHandler) SwitchOrganization(
+	ctx context.Context,
+	req *authpb.SwitchOrganizationRequest,
+) (*authpb.AuthResponse, error) {
+
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if req.GetOrganizationId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+
+	input := domain.SwitchOrganizationInput{
+		UserID:         userID,
+		SessionID:      middleware.SessionFromContext(ctx),
+		OrganizationID: req.OrganizationId,
+		Client:         clientInfo(ctx),
+	}
+	if claims, ok := middleware.ClaimsFromContext(ctx); ok {
+		input.AccessTokenID = claims.ID
+		input.AccessTokenExpiresAt = claims.ExpiresAt
+		input.AuthTime = claims.AuthTime
+	}
+
+	result, err := h.authUC.SwitchOrganization(ctx, input)
+
+	if err != nil {
+		switch err {
+		case domain.ErrNotOrganizationMember:
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case domain.ErrUserNotFound:
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case domain.ErrAccountSuspended, domain.ErrAccountDeactivated:
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			log.Printf("[Auth] SwitchOrganization error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return toAuthResponse(result), nil
+}
+
+// Reauthenticate confirms the caller's password or MFA code and returns an
+// access token for the same session that satisfies recent-authentication policies
+func (h *AuthHandler) Reauthenticate(
+	ctx context.Context,
+	req *authpb.ReauthenticateRequest,
+) (*authpb.ReauthenticateResponse, error) {
+
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if req.GetPassword() == "" && req.GetMfaCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "password or mfa_code is required")
+	}
+
+	input := domain.ReauthenticateInput{
+		UserID:    userID,
+		SessionID: middleware.SessionFromContext(ctx),
+		Password:  req.Password,
+		MfaCode:   req.MfaCode,
+		Client:    clientInfo(ctx),
+	}
+	if claims, ok := middleware.ClaimsFromContext(ctx); ok {
+		input.OrganizationID = claims.OrganizationID
+	}
+
+	result, err := h.authUC.Reauthenticate(ctx, input)
+
+	if err != nil {
+		var throttled *domain.LoginThrottledError
+		if errors.As(err, &throttled) {
+			metadata.SetRetryAfter(ctx, throttled.RetryAfter)
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+
+		switch err {
+		case domain.ErrInvalidCredentials, domain.ErrUserNotFound:
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case domain.ErrAccountSuspended, domain.ErrAccountDeactivated:
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			log.Printf("[Auth] Reauthenticate error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &authpb.ReauthenticateResponse{AccessToken: result.AccessToken}, nil
+}
+
+func (h *AuthHandler) BeginWebauthnRegistration(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*authpb.WebauthnOptionsResponse, error) {
+
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	options, err := h.authUC.BeginWebauthnRegistration(ctx, userID)
+
+	if err != nil {
+		switch err {
+		case domain.ErrWebauthnNotConfigured:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case domain.ErrUserNotFound:
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case domain.ErrAccountSuspended, domain.ErrAccountDeactivated:
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			log.Printf("[Auth] BeginWebauthnRegistration error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return toWebauthnOptions(options)
+}
+
+func (h *AuthHandler) FinishWebauthnRegistration(
+	ctx context.Context,
+	req *authpb.FinishWebauthnRegistrationRequest,
+) (*authpb.WebauthnCredentialResponse, error) {
+
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	credential, err := credentialJSON(req.GetCredential())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.authUC.FinishWebauthnRegistration(ctx, domain.FinishWebauthnRegistrationInput{
+		UserID:     userID,
+		Name:       req.Name,
+		Credential: credential,
+	})
+
+	if err != nil {
+		switch err {
+		case domain.ErrWebauthnNotConfigured:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case domain.ErrInvalidWebauthn, domain.ErrWebauthnChallenge:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case domain.ErrWebauthnRegistered:
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		default:
+			log.Printf("[Auth] FinishWebauthnRegistration error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &authpb.WebauthnCredentialResponse{Credential: toWebauthnCredential(result)}, nil
+}
+
+func (h *AuthHandler) BeginWebauthnLogin(
+	ctx context.Context,
+	req *authpb.BeginWebauthnLoginRequest,
+) (*authpb.WebauthnOptionsResponse, error) {
+
+	options, err := h.authUC.BeginWebauthnLogin(ctx, req.GetMfaToken())
+
+	if err != nil {
+		switch err {
+		case domain.ErrWebauthnNotConfigured:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case domain.ErrInvalidMfaChallenge:
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case domain.ErrWebauthnNotFound:
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			log.Printf("[Auth] BeginWebauthnLogin error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return toWebauthnOptions(options)
+}
+
+func (h *AuthHandler) FinishWebauthnLogin(
+	ctx context.Context,
+	req *authpb.FinishWebauthnLoginRequest,
+) (*authpb.AuthResponse, error) {
+
+	credential, err := credentialJSON(req.GetCredential())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.authUC.FinishWebauthnLogin(ctx, domain.FinishWebauthnLoginInput{
+		Credential: credential,
+		MfaToken:   req.MfaToken,
+		Client:     clientInfo(ctx),
+	})
+
+	if err != nil {
+		switch err {
+		case domain.ErrWebauthnNotConfigured:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case domain.ErrInvalidWebauthn, domain.ErrWebauthnChallenge, domain.ErrInvalidMfaChallenge, domain.ErrUserNotFound:
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case domain.ErrWebauthnCloned:
+			log.Printf("[Auth] FinishWebauthnLogin refused: %v", err)
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		case domain.ErrEmailNotVerified:
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case domain.ErrAccountSuspended, domain.ErrAccountDeactivated:
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		default:
+			log.Printf("[Auth] FinishWebauthnLogin error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return toAuthResponse(result), nil
+}
+
+func (h *AuthHandler) ListWebauthnCredentials(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*authpb.ListWebauthnCredentialsResponse, error) {
+
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	credentials, err := h.authUC.ListWebauthnCredentials(ctx, userID)
+
+	if err != nil {
+		log.Printf("[Auth] ListWebauthnCredentials error: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	result := make([]*authpb.WebauthnCredential, 0, len(credentials))
+	for i := range credentials {
+		result = append(result, toWebauthnCredential(&credentials[i]))
+	}
+
+	return &authpb.ListWebauthnCredentialsResponse{Credentials: result}, nil
+}
+
+func (h *AuthHandler) DeleteWebauthnCredential(
+	ctx context.Context,
+	req *authpb.DeleteWebauthnCredentialRequest,
+) (*authpb.MessageResponse, error) {
+
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := h.authUC.DeleteWebauthnCredential(ctx, userID, req.Id); err != nil {
+		switch err {
+		case domain.ErrWebauthnNotFound:
+			return nil, status.Error(codes.NotFound, err.Error())
+		default:
+			log.Printf("[Auth] DeleteWebauthnCredential error: %v", err)
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return &authpb.MessageResponse{Message: "Passkey deleted"}, nil
+}
+
+func clientInfo(ctx context.Context) domain.ClientInfo {
+	userAgent, ipAddress := metadata.ClientInfo(ctx)
+	return domain.ClientInfo{
+		UserAgent: userAgent,
+		IPAddress: ipAddress,
+		DeviceID:  metadata.DeviceID(ctx),
+	}
+}
+
+func toAuthResponse(result *domain.AuthOutput) *authpb.AuthResponse {
+	return &authpb.AuthResponse{
+		AccessToken:    result.AccessToken,
+		RefreshToken:   result.RefreshToken,
+		MfaRequired:    result.MfaRequired,
+		MfaToken:       result.MfaToken,
+		MfaMethods:     result.MfaMethods,
+		OrganizationId: result.OrganizationID,
+	}
+}
+
+func toApiKey(key *domain.ApiKey) *authpb.ApiKey {
+	result := &authpb.ApiKey{
+		Id:             key.ID,
+		Name:           key.Name,
+		Prefix:         key.Prefix,
+		Scopes:         key.Scopes,
+		CreatedAt:      timestamppb.New(key.CreatedAt),
+		ExpiresAt:      timestamppb.New(key.ExpiresAt),
+		OrganizationId: key.OrganizationID,
+	}
+
+	if key.LastUsedAt != nil {
+		result.LastUsedAt = timestamppb.New(*key.LastUsedAt)
+	}
+
+	return result
+}
+
+// toWebauthnOptions carries the options JSON to the browser as-is
+func toWebauthnOptions(options []byte) (*authpb.WebauthnOptionsResponse, error) {
+	publicKey := &structpb.Struct{}
+	if err := publicKey.UnmarshalJSON(options); err != nil {
+		log.Printf("[Auth] webauthn options error: %v", err)
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &authpb.WebauthnOptionsResponse{PublicKey: publicKey}, nil
+}
+
+// credentialJSON turns the PublicKeyCredential posted by the browser back into JSON
+func credentialJSON(credential *structpb.Struct) ([]byte, error) {
+	if credential == nil {
+		return nil, status.Error(codes.InvalidArgument, "credential is required")
+	}
+
+	raw, err := credential.MarshalJSON()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid credential")
+	}
+
+	return raw, nil
+}
+
+func toWebauthnCredential(credential *domain.WebauthnCredential) *authpb.WebauthnCredential {
+	result := &authpb.WebauthnCredential{
+		Id:         credential.ID,
+		Name:       credential.Name,
+		Transports: credential.Transports,
+		CreatedAt:  timestamppb.New(credential.CreatedAt),
+	}
+
+	if credential.LastUsedAt != nil {
+		result.LastUsedAt = timestamppb.New(*credential.LastUsedAt)
+	}
+
+	return result
+}
+
+// weakPasswordStatus reports each broken password rule as a field violation
+func weakPasswordStatus(weak *domain.WeakPasswordError) error {
+	violations := make([]*errdetails.BadRequest_FieldViolation, len(weak.Violations))
+	for i, violation := range weak.Violations {
+		violations[i] = &errdetails.BadRequest_FieldViolation{
+			Field:       "password",
+			Reason:      violation.Rule,
+			Description: violation.Message,
+		}
+	}
+
+	st, err := status.New(codes.InvalidArgument, weak.Error()).WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, weak.Error())
+	}
+
+	return st.Err()
+}
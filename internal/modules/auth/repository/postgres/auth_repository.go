@@ -4,14 +4,24 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
+	"github.com/lib/pq"
 	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
 	"github.com/nassabiq/golang-template/internal/shared/helper"
 )
 
+// SecretCipher encrypts values the repository keeps at rest
+type SecretCipher interface {
+	Seal(plain string) (string, error)
+	Open(sealed string) (string, error)
+}
+
 type AuthRepository struct {
 	db      *sql.DB
 	queries map[string]string
+	// Encrypts TOTP secrets, they are stored in plain text while nil
+	mfaSecretCipher SecretCipher
 }
 
 func NewAuthRepository(db *sql.DB) *AuthRepository {
@@ -21,6 +31,10 @@ func NewAuthRepository(db *sql.DB) *AuthRepository {
 	}
 }
 
+func (repository *AuthRepository) SetMfaSecretCipher(cipher SecretCipher) {
+	repository.mfaSecretCipher = cipher
+}
+
 func (repository *AuthRepository) query(name string) string {
 	query, ok := repository.queries[name]
 
@@ -40,7 +54,7 @@ func (repository *AuthRepository) CreateUser(ctx context.Context, user *domain.U
 
 	// RUN QUERY
 	_, err := repository.db.ExecContext(ctx, repository.query("CreateUser"),
-		user.ID, user.Name, user.Email, user.PasswordHash, user.RoleID, user.CreatedAt, user.UpdatedAt,
+		user.ID, user.Name, user.Email, user.PasswordHash, user.RoleID, user.CreatedAt, user.UpdatedAt, user.Phone,
 	)
 
 	if err != nil {
@@ -54,8 +68,22 @@ func (repository *AuthRepository) FindUserByEmail(ctx context.Context, email str
 	// RUN QUERY
 	row := repository.db.QueryRowContext(ctx, repository.query("FindUserByEmail"), email)
 
-	var user domain.User
-	if err := row.Scan(&user.ID, &user.Name, &user.Email, &user.PasswordHash, &user.RoleID, &user.CreatedAt, &user.UpdatedAt); err != nil {
+	return scanUser(row)
+}
+
+func (repository *AuthRepository) FindUserByID(ctx context.Context, id string) (*domain.User, error) {
+	// RUN QUERY
+	row := repository.db.QueryRowContext(ctx, repository.query("FindUserByID"), id)
+
+	return scanUser(row)
+}
+
+func (repository *AuthRepository) FindUserByVerifiedPhone(ctx context.Context, phone string) (*domain.User, error) {
+	// RUN QUERY
+	row := repository.db.QueryRowContext(ctx, repository.query("FindUserByVerifiedPhone"), phone)
+
+	user, err := scanUser(row)
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
 		}
@@ -63,25 +91,48 @@ func (repository *AuthRepository) FindUserByEmail(ctx context.Context, email str
 		return nil, err
 	}
 
-	return &user, nil
+	return user, nil
 }
 
-func (repository *AuthRepository) FindUserByID(ctx context.Context, id string) (*domain.User, error) {
-	// RUN QUERY
-	row := repository.db.QueryRowContext(ctx, repository.query("FindUserByID"), id)
-
+func scanUser(row rowScanner) (*domain.User, error) {
 	var user domain.User
-	if err := row.Scan(&user.ID, &user.Name, &user.Email, &user.PasswordHash, &user.RoleID, &user.CreatedAt, &user.UpdatedAt); err != nil {
+	var emailVerifiedAt, phoneVerifiedAt sql.NullTime
+	var phone sql.NullString
+
+	if err := row.Scan(
+		&user.ID,
+		&user.Name,
+		&user.Email,
+		&user.PasswordHash,
+		&user.RoleID,
+		&emailVerifiedAt,
+		&phone,
+		&phoneVerifiedAt,
+		&user.Status,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	); err != nil {
 		return nil, err
 	}
 
+	if emailVerifiedAt.Valid {
+		user.EmailVerifiedAt = &emailVerifiedAt.Time
+	}
+
+	user.Phone = phone.String
+	if phoneVerifiedAt.Valid {
+		user.PhoneVerifiedAt = &phoneVerifiedAt.Time
+	}
+
 	return &user, nil
 }
 
 func (repository *AuthRepository) StoreRefreshToken(ctx context.Context, token *domain.RefreshToken) error {
 	// RUN QUERY
 	_, err := repository.db.ExecContext(ctx, repository.query("StoreRefreshToken"),
-		token.ID, token.UserID, token.TokenHash, token.Revoked, token.ExpiresAt, token.CreatedAt, token.UpdatedAt,
+		token.ID, token.UserID, token.FamilyID, token.ParentID, token.TokenHash, token.Revoked,
+		token.UserAgent, token.IPAddress, token.ExpiresAt, token.LastUsedAt, token.CreatedAt, token.UpdatedAt,
+		token.OrganizationID, nullTime(token.AuthenticatedAt),
 	)
 
 	if err != nil {
@@ -95,15 +146,35 @@ func (repository *AuthRepository) FindValidRefreshToken(ctx context.Context, tok
 	// RUN QUERY
 	row := repository.db.QueryRowContext(ctx, repository.query("FindValidRefreshToken"), tokenHash)
 
+	return scanRefreshToken(row)
+}
+
+func (repository *AuthRepository) FindRefreshTokenByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	// RUN QUERY
+	row := repository.db.QueryRowContext(ctx, repository.query("FindRefreshTokenByHash"), tokenHash)
+
+	return scanRefreshToken(row)
+}
+
+func scanRefreshToken(row *sql.Row) (*domain.RefreshToken, error) {
 	var token domain.RefreshToken
+	var authenticatedAt sql.NullTime
 	if err := row.Scan(
 		&token.ID,
 		&token.UserID,
+		&token.FamilyID,
+		&token.ParentID,
 		&token.TokenHash,
 		&token.Revoked,
+		&token.RevokedReason,
+		&token.UserAgent,
+		&token.IPAddress,
 		&token.ExpiresAt,
+		&token.LastUsedAt,
 		&token.CreatedAt,
 		&token.UpdatedAt,
+		&token.OrganizationID,
+		&authenticatedAt,
 	); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -111,48 +182,168 @@ func (repository *AuthRepository) FindValidRefreshToken(ctx context.Context, tok
 		return nil, err
 	}
 
+	token.AuthenticatedAt = authenticatedAt.Time
 	return &token, nil
 }
 
-func (repository *AuthRepository) RevokeRefreshToken(ctx context.Context, tokenHash string) error {
+// nullTime stores a zero time as NULL
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func (repository *AuthRepository) RevokeRefreshToken(ctx context.Context, tokenHash string, reason domain.RefreshTokenRevokeReason) (bool, error) {
+	// RUN QUERY
+	result, err := repository.db.ExecContext(ctx, repository.query("RevokeRefreshToken"), tokenHash, reason)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (repository *AuthRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
 	// RUN QUERY
-	_, err := repository.db.ExecContext(ctx, repository.query("RevokeRefreshToken"), tokenHash)
+	_, err := repository.db.ExecContext(ctx, repository.query("RevokeRefreshTokenFamily"), familyID, domain.RefreshTokenEnded)
 	return err
 }
 
 func (repository *AuthRepository) RevokeAllRefreshTokens(ctx context.Context, userID string) error {
 	// RUN QUERY
-	_, err := repository.db.ExecContext(ctx, repository.query("RevokeAllRefreshTokens"), userID)
+	_, err := repository.db.ExecContext(ctx, repository.query("RevokeAllRefreshTokens"), userID, domain.RefreshTokenEnded)
 	return err
 }
 
-func (repository *AuthRepository) StorePasswordReset(ctx context.Context, passwordReset *domain.PasswordReset) error {
+func (repository *AuthRepository) ListActiveSessions(ctx context.Context, userID string) ([]domain.Session, error) {
 	// RUN QUERY
-	_, err := repository.db.ExecContext(ctx, repository.query("StorePasswordReset"),
-		passwordReset.ID,
-		passwordReset.UserID,
-		passwordReset.TokenHash,
-		passwordReset.ExpiresAt,
-		passwordReset.CreatedAt,
-		passwordReset.UpdatedAt,
+	rows, err := repository.db.QueryContext(ctx, repository.query("ListActiveSessions"), userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []domain.Session
+	for rows.Next() {
+		var session domain.Session
+		if err := rows.Scan(
+			&session.ID,
+			&session.UserAgent,
+			&session.IPAddress,
+			&session.CreatedAt,
+			&session.LastUsedAt,
+			&session.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, rows.Err()
+}
+
+func (repository *AuthRepository) RevokeSession(ctx context.Context, userID, sessionID string) (bool, error) {
+	// RUN QUERY
+	result, err := repository.db.ExecContext(ctx, repository.query("RevokeSession"), userID, sessionID, domain.RefreshTokenEnded)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (repository *AuthRepository) FindUserDevice(ctx context.Context, userID, fingerprint string) (*domain.UserDevice, error) {
+	// RUN QUERY
+	row := repository.db.QueryRowContext(ctx, repository.query("FindUserDevice"), userID, fingerprint)
+
+	return scanUserDevice(row)
+}
+
+func (repository *AuthRepository) CountUserDevices(ctx context.Context, userID string) (int, error) {
+	var count int
+
+	// RUN QUERY
+	if err := repository.db.QueryRowContext(ctx, repository.query("CountUserDevices"), userID).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (repository *AuthRepository) StoreUserDevice(ctx context.Context, device *domain.UserDevice) (bool, error) {
+	var revokeTokenExpiresAt sql.NullTime
+	if device.RevokeTokenExpiresAt != nil {
+		revokeTokenExpiresAt = nullTime(*device.RevokeTokenExpiresAt)
+	}
+
+	// RUN QUERY
+	result, err := repository.db.ExecContext(ctx, repository.query("StoreUserDevice"),
+		device.ID, device.UserID, device.Fingerprint, device.UserAgent, device.IPAddress, device.SessionID,
+		device.RevokeTokenHash, revokeTokenExpiresAt, device.FirstSeenAt, device.LastSeenAt,
 	)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
 
+func (repository *AuthRepository) TouchUserDevice(ctx context.Context, id, ipAddress string, seenAt time.Time) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("TouchUserDevice"), ipAddress, seenAt, id)
 	return err
 }
 
-func (repository *AuthRepository) FindValidPasswordReset(ctx context.Context, tokenHash string) (*domain.PasswordReset, error) {
+func (repository *AuthRepository) FindUserDeviceByRevokeToken(ctx context.Context, tokenHash string) (*domain.UserDevice, error) {
 	// RUN QUERY
-	row := repository.db.QueryRowContext(ctx, repository.query("FindValidPasswordReset"), tokenHash)
+	row := repository.db.QueryRowContext(ctx, repository.query("FindUserDeviceByRevokeToken"), tokenHash)
+
+	return scanUserDevice(row)
+}
+
+func (repository *AuthRepository) DeleteUserDevice(ctx context.Context, id string) (bool, error) {
+	// RUN QUERY
+	result, err := repository.db.ExecContext(ctx, repository.query("DeleteUserDevice"), id)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func scanUserDevice(row rowScanner) (*domain.UserDevice, error) {
+	var device domain.UserDevice
+	var revokeTokenExpiresAt sql.NullTime
 
-	var passwordReset domain.PasswordReset
 	if err := row.Scan(
-		&passwordReset.ID,
-		&passwordReset.UserID,
-		&passwordReset.TokenHash,
-		&passwordReset.ExpiresAt,
-		&passwordReset.Used,
-		&passwordReset.CreatedAt,
-		&passwordReset.UpdatedAt,
+		&device.ID,
+		&device.UserID,
+		&device.Fingerprint,
+		&device.UserAgent,
+		&device.IPAddress,
+		&device.SessionID,
+		&device.RevokeTokenHash,
+		&revokeTokenExpiresAt,
+		&device.FirstSeenAt,
+		&device.LastSeenAt,
 	); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -160,17 +351,1158 @@ func (repository *AuthRepository) FindValidPasswordReset(ctx context.Context, to
 		return nil, err
 	}
 
-	return &passwordReset, nil
+	if revokeTokenExpiresAt.Valid {
+		device.RevokeTokenExpiresAt = &revokeTokenExpiresAt.Time
+	}
+
+	return &device, nil
 }
 
-func (repository *AuthRepository) MarkPasswordResetUsed(ctx context.Context, id string) error {
+func (repository *AuthRepository) StoreRevokedToken(ctx context.Context, token *domain.RevokedToken) error {
 	// RUN QUERY
-	_, err := repository.db.ExecContext(ctx, repository.query("MarkPasswordResetUsed"), id)
+	_, err := repository.db.ExecContext(ctx, repository.query("StoreRevokedToken"),
+		token.JTI, token.UserID, token.ExpiresAt, token.CreatedAt,
+	)
 	return err
 }
 
-func (repository *AuthRepository) UpdateUserPassword(ctx context.Context, userID, newHash string) error {
+func (repository *AuthRepository) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	var revoked bool
+
 	// RUN QUERY
-	_, err := repository.db.ExecContext(ctx, repository.query("UpdateUserPassword"), newHash, userID)
+	if err := repository.db.QueryRowContext(ctx, repository.query("IsTokenRevoked"), jti).Scan(&revoked); err != nil {
+		return false, err
+	}
+
+	return revoked, nil
+}
+
+func (repository *AuthRepository) DeleteExpiredRevokedTokens(ctx context.Context) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("DeleteExpiredRevokedTokens"))
+	return err
+}
+
+func (repository *AuthRepository) StoreRevokedSession(ctx context.Context, session *domain.RevokedSession) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("StoreRevokedSession"),
+		session.SessionID, session.UserID, session.ExpiresAt, session.CreatedAt,
+	)
+	return err
+}
+
+func (repository *AuthRepository) IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	var revoked bool
+
+	// RUN QUERY
+	if err := repository.db.QueryRowContext(ctx, repository.query("IsSessionRevoked"), sessionID).Scan(&revoked); err != nil {
+		return false, err
+	}
+
+	return revoked, nil
+}
+
+func (repository *AuthRepository) DeleteExpiredRevokedSessions(ctx context.Context) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("DeleteExpiredRevokedSessions"))
+	return err
+}
+
+func (repository *AuthRepository) SetTokensValidAfter(ctx context.Context, userID string, validAfter time.Time) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("SetTokensValidAfter"), validAfter, userID)
+	return err
+}
+
+// FindTokensValidAfter returns the user's token watermark; exists is false once the user is deleted
+func (repository *AuthRepository) FindTokensValidAfter(ctx context.Context, userID string) (time.Time, bool, error) {
+	var validAfter sql.NullTime
+
+	// RUN QUERY
+	if err := repository.db.QueryRowContext(ctx, repository.query("FindTokensValidAfter"), userID).Scan(&validAfter); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, false, nil
+		}
+		return time.Time{}, false, err
+	}
+
+	return validAfter.Time, true, nil
+}
+
+func (repository *AuthRepository) SetOrganizationTokensValidAfter(ctx context.Context, userID, organizationID string, validAfter time.Time) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("SetOrganizationTokensValidAfter"), organizationID, userID, validAfter)
+	return err
+}
+
+func (repository *AuthRepository) FindOrganizationTokensValidAfter(ctx context.Context, userID, organizationID string) (time.Time, error) {
+	var validAfter time.Time
+
+	// RUN QUERY
+	err := repository.db.QueryRowContext(ctx, repository.query("FindOrganizationTokensValidAfter"), organizationID, userID).Scan(&validAfter)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+
+	return validAfter, nil
+}
+
+func (repository *AuthRepository) StoreApiKey(ctx context.Context, key *domain.ApiKey) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("StoreApiKey"),
+		key.ID, key.UserID, key.Name, key.Prefix, key.KeyHash, pq.Array(key.Scopes), key.ExpiresAt, key.CreatedAt, key.UpdatedAt, key.OrganizationID,
+	)
+	return err
+}
+
+func (repository *AuthRepository) FindApiKeyByHash(ctx context.Context, keyHash string) (*domain.ApiKey, error) {
+	// RUN QUERY
+	row := repository.db.QueryRowContext(ctx, repository.query("FindApiKeyByHash"), keyHash)
+
+	key, err := scanApiKey(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func (repository *AuthRepository) ListApiKeys(ctx context.Context, userID string) ([]domain.ApiKey, error) {
+	// RUN QUERY
+	rows, err := repository.db.QueryContext(ctx, repository.query("ListApiKeys"), userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []domain.ApiKey
+	for rows.Next() {
+		key, err := scanApiKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, *key)
+	}
+
+	return keys, rows.Err()
+}
+
+func (repository *AuthRepository) RevokeApiKey(ctx context.Context, userID, id string) (bool, error) {
+	// RUN QUERY
+	result, err := repository.db.ExecContext(ctx, repository.query("RevokeApiKey"), userID, id)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (repository *AuthRepository) TouchApiKey(ctx context.Context, id string, usedAt time.Time) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("TouchApiKey"), usedAt, id)
+	return err
+}
+
+func (repository *AuthRepository) FindServiceClient(ctx context.Context, clientID string) (*domain.ServiceClient, error) {
+	var client domain.ServiceClient
+	var revokedAt sql.NullTime
+
+	// RUN QUERY
+	err := repository.db.QueryRowContext(ctx, repository.query("FindServiceClient"), clientID).Scan(
+		&client.ID,
+		&client.ClientID,
+		&client.Name,
+		&client.SecretHash,
+		pq.Array(&client.Scopes),
+		&revokedAt,
+		&client.CreatedAt,
+		&client.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if revokedAt.Valid {
+		client.RevokedAt = &revokedAt.Time
+	}
+
+	return &client, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanApiKey(row rowScanner) (*domain.ApiKey, error) {
+	var key domain.ApiKey
+	var lastUsedAt, revokedAt sql.NullTime
+
+	if err := row.Scan(
+		&key.ID,
+		&key.UserID,
+		&key.Name,
+		&key.Prefix,
+		&key.KeyHash,
+		pq.Array(&key.Scopes),
+		&key.ExpiresAt,
+		&lastUsedAt,
+		&revokedAt,
+		&key.CreatedAt,
+		&key.UpdatedAt,
+		&key.OrganizationID,
+	); err != nil {
+		return nil, err
+	}
+
+	if lastUsedAt.Valid {
+		key.LastUsedAt = &lastUsedAt.Time
+	}
+	if revokedAt.Valid {
+		key.RevokedAt = &revokedAt.Time
+	}
+
+	return &key, nil
+}
+
+func (repository *AuthRepository) ListPasswordHistory(ctx context.Context, userID string, limit int) ([]string, error) {
+	// RUN QUERY
+	rows, err := repository.db.QueryContext(ctx, repository.query("ListPasswordHistory"), userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hashes []string
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+
+	return hashes, rows.Err()
+}
+
+func (repository *AuthRepository) StorePasswordHistory(ctx context.Context, entry *domain.PasswordHistory) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("StorePasswordHistory"),
+		entry.ID,
+		entry.UserID,
+		entry.PasswordHash,
+		entry.CreatedAt,
+	)
+
+	return err
+}
+
+func (repository *AuthRepository) PrunePasswordHistory(ctx context.Context, userID string, keep int) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("PrunePasswordHistory"), userID, keep)
+	return err
+}
+
+func (repository *AuthRepository) FindLoginLockout(ctx context.Context, userID string) (*domain.LoginLockout, error) {
+	var lockout domain.LoginLockout
+	var lockedUntil, updatedAt sql.NullTime
+
+	// RUN QUERY
+	err := repository.db.QueryRowContext(ctx, repository.query("FindLoginLockout"), userID).Scan(
+		&lockout.UserID,
+		&lockout.FailedAttempts,
+		&lockout.LockoutCount,
+		&lockedUntil,
+		&updatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if lockedUntil.Valid {
+		lockout.LockedUntil = &lockedUntil.Time
+	}
+	lockout.UpdatedAt = updatedAt.Time
+
+	return &lockout, nil
+}
+
+func (repository *AuthRepository) IncrementFailedLogins(ctx context.Context, userID string, at time.Time) (int, int, error) {
+	var attempts, lockouts int
+
+	// RUN QUERY
+	err := repository.db.QueryRowContext(ctx, repository.query("IncrementFailedLogins"), userID, at).Scan(&attempts, &lockouts)
+
+	return attempts, lockouts, err
+}
+
+func (repository *AuthRepository) LockAccount(ctx context.Context, userID string, until time.Time, at time.Time) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("LockAccount"), until, at, userID)
+	return err
+}
+
+func (repository *AuthRepository) DeleteLoginLockout(ctx context.Context, userID string) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("DeleteLoginLockout"), userID)
+	return err
+}
+
+func (repository *AuthRepository) IncrementLoginIPFailures(ctx context.Context, ipAddress string, at time.Time, windowStart time.Time) (int, time.Time, error) {
+	var attempts int
+	var startedAt time.Time
+
+	// RUN QUERY
+	err := repository.db.QueryRowContext(ctx, repository.query("IncrementLoginIPFailures"), ipAddress, at, windowStart).Scan(&attempts, &startedAt)
+
+	return attempts, startedAt, err
+}
+
+func (repository *AuthRepository) FindLoginIPFailures(ctx context.Context, ipAddress string, windowStart time.Time) (int, time.Time, error) {
+	var attempts int
+	var startedAt time.Time
+
+	// RUN QUERY
+	err := repository.db.QueryRowContext(ctx, repository.query("FindLoginIPFailures"), ipAddress, windowStart).Scan(&attempts, &startedAt)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, time.Time{}, nil
+	}
+
+	return attempts, startedAt, err
+}
+
+func (repository *AuthRepository) StoreEmailVerification(ctx context.Context, verification *domain.EmailVerification) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("StoreEmailVerification"),
+		verification.ID,
+		verification.UserID,
+		verification.TokenHash,
+		verification.ExpiresAt,
+		verification.CreatedAt,
+		verification.UpdatedAt,
+	)
+
+	return err
+}
+
+func (repository *AuthRepository) FindValidEmailVerification(ctx context.Context, tokenHash string) (*domain.EmailVerification, error) {
+	// RUN QUERY
+	row := repository.db.QueryRowContext(ctx, repository.query("FindValidEmailVerification"), tokenHash)
+
+	var verification domain.EmailVerification
+	if err := row.Scan(
+		&verification.ID,
+		&verification.UserID,
+		&verification.TokenHash,
+		&verification.ExpiresAt,
+		&verification.Used,
+		&verification.CreatedAt,
+		&verification.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &verification, nil
+}
+
+func (repository *AuthRepository) MarkEmailVerificationUsed(ctx context.Context, id string) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("MarkEmailVerificationUsed"), id)
+	return err
+}
+
+func (repository *AuthRepository) MarkUserEmailVerified(ctx context.Context, userID string, verifiedAt time.Time) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("MarkUserEmailVerified"), verifiedAt, userID)
+	return err
+}
+
+func (repository *AuthRepository) StorePhoneOtp(ctx context.Context, otp *domain.PhoneOtp) error {
+	tx, err := repository.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// A new code replaces every earlier one for the same purpose
+	if _, err := tx.ExecContext(ctx, repository.query("InvalidatePhoneOtps"), otp.UserID, otp.Purpose, otp.CreatedAt); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, repository.query("StorePhoneOtp"),
+		otp.ID, otp.UserID, otp.Phone, otp.Purpose, otp.CodeHash, otp.ExpiresAt, otp.CreatedAt, otp.UpdatedAt,
+	); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (repository *AuthRepository) FindLatestPhoneOtp(ctx context.Context, userID string, purpose domain.PhoneOtpPurpose) (*domain.PhoneOtp, error) {
+	// RUN QUERY
+	row := repository.db.QueryRowContext(ctx, repository.query("FindLatestPhoneOtp"), userID, purpose)
+
+	var otp domain.PhoneOtp
+	if err := row.Scan(
+		&otp.ID,
+		&otp.UserID,
+		&otp.Phone,
+		&otp.Purpose,
+		&otp.CodeHash,
+		&otp.Attempts,
+		&otp.Used,
+		&otp.ExpiresAt,
+		&otp.CreatedAt,
+		&otp.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &otp, nil
+}
+
+func (repository *AuthRepository) IncrementPhoneOtpAttempts(ctx context.Context, id string) (int, error) {
+	// RUN QUERY
+	var attempts int
+	if err := repository.db.QueryRowContext(ctx, repository.query("IncrementPhoneOtpAttempts"), id).Scan(&attempts); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return attempts, nil
+}
+
+func (repository *AuthRepository) MarkPhoneOtpUsed(ctx context.Context, id string) (bool, error) {
+	// RUN QUERY
+	result, err := repository.db.ExecContext(ctx, repository.query("MarkPhoneOtpUsed"), id)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (repository *AuthRepository) MarkUserPhoneVerified(ctx context.Context, userID, phone string, verifiedAt time.Time) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("MarkUserPhoneVerified"), phone, verifiedAt, userID)
+
+	// Another account verified the number first
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return domain.ErrPhoneAlreadyUsed
+	}
+
+	return err
+}
+
+func (repository *AuthRepository) StoreOidcState(ctx context.Context, state *domain.OidcState) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("StoreOidcState"),
+		state.ID, state.Provider, state.StateHash, state.BrowserCodeHash, state.Nonce, state.CodeVerifier,
+		state.ExpiresAt, state.CreatedAt, state.UpdatedAt,
+	)
+	return err
+}
+
+func (repository *AuthRepository) FindValidOidcState(ctx context.Context, stateHash string) (*domain.OidcState, error) {
+	// RUN QUERY
+	row := repository.db.QueryRowContext(ctx, repository.query("FindValidOidcState"), stateHash)
+
+	var state domain.OidcState
+	if err := row.Scan(
+		&state.ID,
+		&state.Provider,
+		&state.StateHash,
+		&state.BrowserCodeHash,
+		&state.Nonce,
+		&state.CodeVerifier,
+		&state.Used,
+		&state.ExpiresAt,
+		&state.CreatedAt,
+		&state.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &state, nil
+}
+
+func (repository *AuthRepository) MarkOidcStateUsed(ctx context.Context, id string) (bool, error) {
+	// RUN QUERY
+	result, err := repository.db.ExecContext(ctx, repository.query("MarkOidcStateUsed"), id)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (repository *AuthRepository) StoreWebauthnChallenge(ctx context.Context, challenge *domain.WebauthnChallenge) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("StoreWebauthnChallenge"),
+		challenge.ID, challenge.UserID, challenge.Purpose, challenge.ChallengeHash,
+		challenge.ExpiresAt, challenge.CreatedAt, challenge.UpdatedAt,
+	)
+	return err
+}
+
+func (repository *AuthRepository) FindValidWebauthnChallenge(ctx context.Context, challengeHash string) (*domain.WebauthnChallenge, error) {
+	// RUN QUERY
+	row := repository.db.QueryRowContext(ctx, repository.query("FindValidWebauthnChallenge"), challengeHash)
+
+	var challenge domain.WebauthnChallenge
+	if err := row.Scan(
+		&challenge.ID,
+		&challenge.UserID,
+		&challenge.Purpose,
+		&challenge.ChallengeHash,
+		&challenge.Used,
+		&challenge.ExpiresAt,
+		&challenge.CreatedAt,
+		&challenge.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &challenge, nil
+}
+
+func (repository *AuthRepository) MarkWebauthnChallengeUsed(ctx context.Context, id string) (bool, error) {
+	// RUN QUERY
+	result, err := repository.db.ExecContext(ctx, repository.query("MarkWebauthnChallengeUsed"), id)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (repository *AuthRepository) StoreWebauthnCredential(ctx context.Context, credential *domain.WebauthnCredential) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("StoreWebauthnCredential"),
+		credential.ID, credential.UserID, credential.CredentialID, credential.PublicKey, int64(credential.SignCount),
+		pq.Array(credential.Transports), credential.Name, credential.CreatedAt, credential.UpdatedAt,
+	)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return domain.ErrWebauthnRegistered
+	}
+
+	return err
+}
+
+func (repository *AuthRepository) FindWebauthnCredential(ctx context.Context, credentialID []byte) (*domain.WebauthnCredential, error) {
+	// RUN QUERY
+	row := repository.db.QueryRowContext(ctx, repository.query("FindWebauthnCredential"), credentialID)
+
+	credential, err := scanWebauthnCredential(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return credential, nil
+}
+
+func (repository *AuthRepository) ListWebauthnCredentials(ctx context.Context, userID string) ([]domain.WebauthnCredential, error) {
+	// RUN QUERY
+	rows, err := repository.db.QueryContext(ctx, repository.query("ListWebauthnCredentials"), userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var credentials []domain.WebauthnCredential
+	for rows.Next() {
+		credential, err := scanWebauthnCredential(rows)
+		if err != nil {
+			return nil, err
+		}
+		credentials = append(credentials, *credential)
+	}
+
+	return credentials, rows.Err()
+}
+
+func (repository *AuthRepository) UpdateWebauthnCredentialUsage(ctx context.Context, id string, signCount uint32, usedAt time.Time) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("UpdateWebauthnCredentialUsage"), int64(signCount), usedAt, id)
+	return err
+}
+
+func (repository *AuthRepository) DeleteWebauthnCredential(ctx context.Context, userID, id string) (bool, error) {
+	// RUN QUERY
+	result, err := repository.db.ExecContext(ctx, repository.query("DeleteWebauthnCredential"), userID, id)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func scanWebauthnCredential(row rowScanner) (*domain.WebauthnCredential, error) {
+	var credential domain.WebauthnCredential
+	var signCount int64
+	var lastUsedAt sql.NullTime
+
+	if err := row.Scan(
+		&credential.ID,
+		&credential.UserID,
+		&credential.CredentialID,
+		&credential.PublicKey,
+		&signCount,
+		pq.Array(&credential.Transports),
+		&credential.Name,
+		&lastUsedAt,
+		&credential.CreatedAt,
+		&credential.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	credential.SignCount = uint32(signCount)
+	if lastUsedAt.Valid {
+		credential.LastUsedAt = &lastUsedAt.Time
+	}
+
+	return &credential, nil
+}
+
+func (repository *AuthRepository) FindUserIdentity(ctx context.Context, provider, subject string) (*domain.UserIdentity, error) {
+	// RUN QUERY
+	row := repository.db.QueryRowContext(ctx, repository.query("FindUserIdentity"), provider, subject)
+
+	var identity domain.UserIdentity
+	if err := row.Scan(
+		&identity.ID,
+		&identity.UserID,
+		&identity.Provider,
+		&identity.Subject,
+		&identity.Email,
+		&identity.CreatedAt,
+		&identity.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &identity, nil
+}
+
+func (repository *AuthRepository) StoreUserIdentity(ctx context.Context, identity *domain.UserIdentity) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("StoreUserIdentity"),
+		identity.ID, identity.UserID, identity.Provider, identity.Subject, identity.Email, identity.CreatedAt, identity.UpdatedAt,
+	)
+	return err
+}
+
+func (repository *AuthRepository) CreateUserWithIdentity(ctx context.Context, user *domain.User, identity *domain.UserIdentity) error {
+	tx, err := repository.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, repository.query("CreateUser"),
+		user.ID, user.Name, user.Email, user.PasswordHash, user.RoleID, user.CreatedAt, user.UpdatedAt, user.Phone,
+	)
+
+	// Someone registered the email address since the lookup
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return domain.ErrEmailAlreadyUsed
+	}
+	if err != nil {
+		return err
+	}
+
+	if user.EmailVerifiedAt != nil {
+		if _, err := tx.ExecContext(ctx, repository.query("MarkUserEmailVerified"), *user.EmailVerifiedAt, user.ID); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, repository.query("StoreUserIdentity"),
+		identity.ID, identity.UserID, identity.Provider, identity.Subject, identity.Email, identity.CreatedAt, identity.UpdatedAt,
+	); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (repository *AuthRepository) StorePasswordReset(ctx context.Context, passwordReset *domain.PasswordReset) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("StorePasswordReset"),
+		passwordReset.ID,
+		passwordReset.UserID,
+		passwordReset.TokenHash,
+		passwordReset.ExpiresAt,
+		passwordReset.CreatedAt,
+		passwordReset.UpdatedAt,
+	)
+
+	return err
+}
+
+func (repository *AuthRepository) FindValidPasswordReset(ctx context.Context, tokenHash string) (*domain.PasswordReset, error) {
+	// RUN QUERY
+	row := repository.db.QueryRowContext(ctx, repository.query("FindValidPasswordReset"), tokenHash)
+
+	var passwordReset domain.PasswordReset
+	if err := row.Scan(
+		&passwordReset.ID,
+		&passwordReset.UserID,
+		&passwordReset.TokenHash,
+		&passwordReset.ExpiresAt,
+		&passwordReset.Used,
+		&passwordReset.CreatedAt,
+		&passwordReset.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &passwordReset, nil
+}
+
+func (repository *AuthRepository) MarkPasswordResetUsed(ctx context.Context, id string) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("MarkPasswordResetUsed"), id)
+	return err
+}
+
+func (repository *AuthRepository) StoreMagicLink(ctx context.Context, link *domain.MagicLink) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("StoreMagicLink"),
+		link.ID, link.UserID, link.TokenHash, link.BrowserCodeHash, link.ExpiresAt, link.CreatedAt, link.UpdatedAt,
+	)
+	return err
+}
+
+func (repository *AuthRepository) FindValidMagicLink(ctx context.Context, tokenHash string) (*domain.MagicLink, error) {
+	// RUN QUERY
+	row := repository.db.QueryRowContext(ctx, repository.query("FindValidMagicLink"), tokenHash)
+
+	var link domain.MagicLink
+	if err := row.Scan(
+		&link.ID,
+		&link.UserID,
+		&link.TokenHash,
+		&link.BrowserCodeHash,
+		&link.ExpiresAt,
+		&link.Used,
+		&link.CreatedAt,
+		&link.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &link, nil
+}
+
+func (repository *AuthRepository) FindLatestMagicLink(ctx context.Context, userID string) (*domain.MagicLink, error) {
+	// RUN QUERY
+	row := repository.db.QueryRowContext(ctx, repository.query("FindLatestMagicLink"), userID)
+
+	var link domain.MagicLink
+	if err := row.Scan(
+		&link.ID,
+		&link.UserID,
+		&link.TokenHash,
+		&link.BrowserCodeHash,
+		&link.ExpiresAt,
+		&link.Used,
+		&link.CreatedAt,
+		&link.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &link, nil
+}
+
+func (repository *AuthRepository) MarkMagicLinkUsed(ctx context.Context, id string) (bool, error) {
+	// RUN QUERY
+	result, err := repository.db.ExecContext(ctx, repository.query("MarkMagicLinkUsed"), id)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (repository *AuthRepository) FindValidInvitation(ctx context.Context, tokenHash string) (*domain.UserInvitation, error) {
+	// RUN QUERY
+	row := repository.db.QueryRowContext(ctx, repository.query("FindValidInvitation"), tokenHash)
+
+	var invitation domain.UserInvitation
+	if err := row.Scan(
+		&invitation.ID,
+		&invitation.UserID,
+		&invitation.TokenHash,
+		&invitation.ExpiresAt,
+		&invitation.Used,
+		&invitation.CreatedAt,
+		&invitation.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &invitation, nil
+}
+
+func (repository *AuthRepository) AcceptInvitation(ctx context.Context, invitation *domain.UserInvitation, name, passwordHash string, acceptedAt time.Time) error {
+	tx, err := repository.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, repository.query("ActivateInvitedUser"), name, passwordHash, acceptedAt, invitation.UserID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// Accepted already, by a concurrent request
+	if rowsAffected == 0 {
+		return domain.ErrInvalidToken
+	}
+
+	if _, err := tx.ExecContext(ctx, repository.query("MarkInvitationUsed"), acceptedAt, invitation.ID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (repository *AuthRepository) UpdateUserPassword(ctx context.Context, userID, newHash string) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("UpdateUserPassword"), newHash, userID)
+	return err
+}
+
+func (repository *AuthRepository) UpgradeUserPasswordHash(ctx context.Context, userID, oldHash, newHash string) (bool, error) {
+	// RUN QUERY
+	result, err := repository.db.ExecContext(ctx, repository.query("UpgradeUserPasswordHash"), newHash, userID, oldHash)
+
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func (repository *AuthRepository) FindOrganizationMember(ctx context.Context, organizationID, userID string) (*domain.OrganizationMember, error) {
+	// RUN QUERY
+	row := repository.db.QueryRowContext(ctx, repository.query("FindOrganizationMember"), organizationID, userID)
+
+	return scanOrganizationMember(row)
+}
+
+func (repository *AuthRepository) FindDefaultOrganizationMember(ctx context.Context, userID string) (*domain.OrganizationMember, error) {
+	// RUN QUERY
+	row := repository.db.QueryRowContext(ctx, repository.query("FindDefaultOrganizationMember"), userID)
+
+	return scanOrganizationMember(row)
+}
+
+func scanOrganizationMember(row *sql.Row) (*domain.OrganizationMember, error) {
+	var member domain.OrganizationMember
+	if err := row.Scan(&member.OrganizationID, &member.UserID, &member.RoleID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &member, nil
+}
+
+func (repository *AuthRepository) UpsertUserMfa(ctx context.Context, mfa *domain.UserMfa) error {
+	secret := mfa.Secret
+	if repository.mfaSecretCipher != nil {
+		sealed, err := repository.mfaSecretCipher.Seal(secret)
+		if err != nil {
+			return err
+		}
+		secret = sealed
+	}
+
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("UpsertUserMfa"),
+		mfa.UserID, secret, mfa.CreatedAt, mfa.UpdatedAt,
+	)
+	return err
+}
+
+func (repository *AuthRepository) FindUserMfa(ctx context.Context, userID string) (*domain.UserMfa, error) {
+	// RUN QUERY
+	row := repository.db.QueryRowContext(ctx, repository.query("FindUserMfa"), userID)
+
+	var mfa domain.UserMfa
+	var confirmedAt sql.NullTime
+	if err := row.Scan(
+		&mfa.UserID,
+		&mfa.Secret,
+		&mfa.Enabled,
+		&mfa.LastUsedStep,
+		&confirmedAt,
+		&mfa.CreatedAt,
+		&mfa.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if confirmedAt.Valid {
+		mfa.ConfirmedAt = &confirmedAt.Time
+	}
+
+	if repository.mfaSecretCipher != nil {
+		secret, err := repository.mfaSecretCipher.Open(mfa.Secret)
+		if err != nil {
+			return nil, err
+		}
+		mfa.Secret = secret
+	}
+
+	return &mfa, nil
+}
+
+func (repository *AuthRepository) EnableUserMfa(ctx context.Context, userID string, step int64) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("EnableUserMfa"), userID, step)
+	return err
+}
+
+func (repository *AuthRepository) UpdateMfaLastUsedStep(ctx context.Context, userID string, step int64) (bool, error) {
+	// RUN QUERY
+	result, err := repository.db.ExecContext(ctx, repository.query("UpdateMfaLastUsedStep"), userID, step)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (repository *AuthRepository) DeleteUserMfa(ctx context.Context, userID string) error {
+	tx, err := repository.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, repository.query("DeleteMfaRecoveryCodes"), userID); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, repository.query("DeleteUserMfa"), userID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (repository *AuthRepository) ReplaceMfaRecoveryCodes(ctx context.Context, userID string, codes []*domain.MfaRecoveryCode) error {
+	tx, err := repository.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, repository.query("DeleteMfaRecoveryCodes"), userID); err != nil {
+		return err
+	}
+
+	for _, code := range codes {
+		if _, err := tx.ExecContext(ctx, repository.query("StoreMfaRecoveryCode"),
+			code.ID, code.UserID, code.CodeHash, code.CreatedAt, code.UpdatedAt,
+		); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (repository *AuthRepository) UseMfaRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error) {
+	// RUN QUERY
+	result, err := repository.db.ExecContext(ctx, repository.query("UseMfaRecoveryCode"), userID, codeHash)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (repository *AuthRepository) StoreMfaChallenge(ctx context.Context, challenge *domain.MfaChallenge) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("StoreMfaChallenge"),
+		challenge.ID,
+		challenge.UserID,
+		challenge.TokenHash,
+		challenge.ExpiresAt,
+		challenge.CreatedAt,
+		challenge.UpdatedAt,
+	)
+	return err
+}
+
+func (repository *AuthRepository) FindValidMfaChallenge(ctx context.Context, tokenHash string) (*domain.MfaChallenge, error) {
+	// RUN QUERY
+	row := repository.db.QueryRowContext(ctx, repository.query("FindValidMfaChallenge"), tokenHash)
+
+	var challenge domain.MfaChallenge
+	if err := row.Scan(
+		&challenge.ID,
+		&challenge.UserID,
+		&challenge.TokenHash,
+		&challenge.Attempts,
+		&challenge.Used,
+		&challenge.ExpiresAt,
+		&challenge.CreatedAt,
+		&challenge.UpdatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &challenge, nil
+}
+
+func (repository *AuthRepository) IncrementMfaChallengeAttempts(ctx context.Context, id string, maxAttempts int) (int, error) {
+	// RUN QUERY
+	var attempts int
+	if err := repository.db.QueryRowContext(ctx, repository.query("IncrementMfaChallengeAttempts"), id, maxAttempts).Scan(&attempts); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return attempts, nil
+}
+
+func (repository *AuthRepository) MarkMfaChallengeUsed(ctx context.Context, id string) (bool, error) {
+	// RUN QUERY
+	result, err := repository.db.ExecContext(ctx, repository.query("MarkMfaChallengeUsed"), id)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (repository *AuthRepository) StoreAuditLog(ctx context.Context, log *domain.AuditLog) error {
+	// RUN QUERY
+	_, err := repository.db.ExecContext(ctx, repository.query("StoreAuditLog"),
+		log.ID, log.ActorID, log.UserID, log.Action, log.Details, log.IPAddress, log.UserAgent, log.CreatedAt,
+	)
 	return err
 }
@@ -4,10 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
 	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
 )
 
@@ -45,12 +47,13 @@ func TestAuthRepository_CreateUser(t *testing.T) {
 				Email:        "test@example.com",
 				PasswordHash: "hashed-password",
 				RoleID:       "user",
+				Phone:        "+6281234567890",
 				CreatedAt:    time.Now(),
 				UpdatedAt:    time.Now(),
 			},
 			mock: func() {
 				mock.ExpectExec("INSERT INTO users").
-					WithArgs("user-123", "Test User", "test@example.com", "hashed-password", "user", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs("user-123", "Test User", "test@example.com", "hashed-password", "user", sqlmock.AnyArg(), sqlmock.AnyArg(), "+6281234567890").
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			wantErr: false,
@@ -68,7 +71,7 @@ func TestAuthRepository_CreateUser(t *testing.T) {
 			},
 			mock: func() {
 				mock.ExpectExec("INSERT INTO users").
-					WithArgs("user-123", "Test User", "existing@example.com", "hashed-password", "user", sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs("user-123", "Test User", "existing@example.com", "hashed-password", "user", sqlmock.AnyArg(), sqlmock.AnyArg(), "").
 					WillReturnError(errors.New("duplicate key value violates unique constraint"))
 			},
 			wantErr: true,
@@ -108,23 +111,36 @@ func TestAuthRepository_FindUserByEmail(t *testing.T) {
 			name:  "success - user found",
 			email: "test@example.com",
 			mock: func() {
-				// Query returns: id, email, password, role_id, role_name, created_at (6 columns)
-				// But repo.Scan expects 7 fields including Name and UpdatedAt
-				rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role_id", "created_at", "updated_at"}).
-					AddRow("user-123", "Test User", "test@example.com", "hashed-password", "user", fixedTime, fixedTime)
+				rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role_id", "email_verified_at", "phone", "phone_verified_at", "status", "created_at", "updated_at"}).
+					AddRow("user-123", "Test User", "test@example.com", "hashed-password", "user", fixedTime, nil, nil, "active", fixedTime, fixedTime)
 				mock.ExpectQuery("SELECT (.+) FROM users").
 					WithArgs("test@example.com").
 					WillReturnRows(rows)
 			},
 			want: &domain.User{
-				ID:           "user-123",
-				Name:         "Test User",
-				Email:        "test@example.com",
-				PasswordHash: "hashed-password",
-				RoleID:       "user",
-				CreatedAt:    fixedTime,
-				UpdatedAt:    fixedTime,
+				ID:              "user-123",
+				Name:            "Test User",
+				Email:           "test@example.com",
+				PasswordHash:    "hashed-password",
+				RoleID:          "user",
+				EmailVerifiedAt: &fixedTime,
+				Status:          domain.UserStatusActive,
+				CreatedAt:       fixedTime,
+				UpdatedAt:       fixedTime,
+			},
+			wantErr: false,
+		},
+		{
+			name:  "success - email matched regardless of case",
+			email: "Test@Example.com",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role_id", "email_verified_at", "phone", "phone_verified_at", "status", "created_at", "updated_at"}).
+					AddRow("user-123", "Test User", "test@example.com", "hashed-password", "user", fixedTime, nil, nil, "active", fixedTime, fixedTime)
+				mock.ExpectQuery(`SELECT (.+) FROM users WHERE lower\(email\) = lower\(\$1\)`).
+					WithArgs("Test@Example.com").
+					WillReturnRows(rows)
 			},
+			want:    &domain.User{ID: "user-123", Email: "test@example.com"},
 			wantErr: false,
 		},
 		{
@@ -148,8 +164,11 @@ func TestAuthRepository_FindUserByEmail(t *testing.T) {
 				t.Errorf("FindUserByEmail() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if (got == nil) != (tt.want == nil) {
+				t.Errorf("FindUserByEmail() = %v, want %v", got, tt.want)
+			}
 			if tt.want != nil && got != nil {
-				if got.ID != tt.want.ID || got.Email != tt.want.Email {
+				if got.ID != tt.want.ID || got.Email != tt.want.Email || got.EmailVerifiedAt == nil {
 					t.Errorf("FindUserByEmail() = %v, want %v", got, tt.want)
 				}
 			}
@@ -179,20 +198,22 @@ func TestAuthRepository_FindUserByID(t *testing.T) {
 			name: "success - user found",
 			id:   "user-123",
 			mock: func() {
-				rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role_id", "created_at", "updated_at"}).
-					AddRow("user-123", "Test User", "test@example.com", "hashed-password", "user", fixedTime, fixedTime)
+				rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role_id", "email_verified_at", "phone", "phone_verified_at", "status", "created_at", "updated_at"}).
+					AddRow("user-123", "Test User", "test@example.com", "hashed-password", "user", fixedTime, nil, nil, "active", fixedTime, fixedTime)
 				mock.ExpectQuery("SELECT (.+) FROM users").
 					WithArgs("user-123").
 					WillReturnRows(rows)
 			},
 			want: &domain.User{
-				ID:           "user-123",
-				Name:         "Test User",
-				Email:        "test@example.com",
-				PasswordHash: "hashed-password",
-				RoleID:       "user",
-				CreatedAt:    fixedTime,
-				UpdatedAt:    fixedTime,
+				ID:              "user-123",
+				Name:            "Test User",
+				Email:           "test@example.com",
+				PasswordHash:    "hashed-password",
+				RoleID:          "user",
+				EmailVerifiedAt: &fixedTime,
+				Status:          domain.UserStatusActive,
+				CreatedAt:       fixedTime,
+				UpdatedAt:       fixedTime,
 			},
 			wantErr: false,
 		},
@@ -246,18 +267,24 @@ func TestAuthRepository_StoreRefreshToken(t *testing.T) {
 		{
 			name: "success - store token",
 			token: &domain.RefreshToken{
-				ID:        "token-123",
-				UserID:    "user-123",
-				TokenHash: "hash-123",
-				Revoked:   false,
-				ExpiresAt: fixedTime.Add(24 * time.Hour),
-				CreatedAt: fixedTime,
-				UpdatedAt: fixedTime,
+				ID:              "token-123",
+				UserID:          "user-123",
+				FamilyID:        "token-123",
+				TokenHash:       "hash-123",
+				Revoked:         false,
+				UserAgent:       "Mozilla/5.0",
+				IPAddress:       "203.0.113.7",
+				OrganizationID:  "org-1",
+				AuthenticatedAt: fixedTime,
+				ExpiresAt:       fixedTime.Add(24 * time.Hour),
+				LastUsedAt:      fixedTime,
+				CreatedAt:       fixedTime,
+				UpdatedAt:       fixedTime,
 			},
 			mock: func() {
-				// Query has 7 params: id, user_id, token_hash, revoked, expires_at, created_at, updated_at
+				// Query has 14 params: id, user_id, family_id, parent_id, token_hash, revoked, user_agent, ip_address, expires_at, last_used_at, created_at, updated_at, organization_id, authenticated_at
 				mock.ExpectExec("INSERT INTO refresh_tokens").
-					WithArgs("token-123", "user-123", "hash-123", false, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs("token-123", "user-123", "token-123", "", "hash-123", false, "Mozilla/5.0", "203.0.113.7", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "org-1", sql.NullTime{Time: fixedTime, Valid: true}).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			wantErr: false,
@@ -297,21 +324,22 @@ func TestAuthRepository_FindValidRefreshToken(t *testing.T) {
 			name:      "success - token found",
 			tokenHash: "hash-123",
 			mock: func() {
-				// Scan expects 7 fields: ID, UserID, TokenHash, Revoked, ExpiresAt, CreatedAt, UpdatedAt
-				rows := sqlmock.NewRows([]string{"id", "user_id", "token_hash", "revoked", "expires_at", "created_at", "updated_at"}).
-					AddRow("token-123", "user-123", "hash-123", false, fixedTime.Add(24*time.Hour), fixedTime, fixedTime)
+				// Scan expects 15 fields: ID, UserID, FamilyID, ParentID, TokenHash, Revoked, RevokedReason, UserAgent, IPAddress, ExpiresAt, LastUsedAt, CreatedAt, UpdatedAt, OrganizationID, AuthenticatedAt
+				rows := sqlmock.NewRows([]string{"id", "user_id", "family_id", "parent_id", "token_hash", "revoked", "revoked_reason", "user_agent", "ip_address", "expires_at", "last_used_at", "created_at", "updated_at", "organization_id", "authenticated_at"}).
+					AddRow("token-123", "user-123", "family-123", "", "hash-123", false, "", "Mozilla/5.0", "203.0.113.7", fixedTime.Add(24*time.Hour), fixedTime, fixedTime, fixedTime, "", fixedTime)
 				mock.ExpectQuery("SELECT (.+) FROM refresh_tokens").
 					WithArgs("hash-123").
 					WillReturnRows(rows)
 			},
 			want: &domain.RefreshToken{
-				ID:        "token-123",
-				UserID:    "user-123",
-				TokenHash: "hash-123",
-				Revoked:   false,
-				ExpiresAt: fixedTime.Add(24 * time.Hour),
-				CreatedAt: fixedTime,
-				UpdatedAt: fixedTime,
+				ID:              "token-123",
+				UserID:          "user-123",
+				TokenHash:       "hash-123",
+				Revoked:         false,
+				AuthenticatedAt: fixedTime,
+				ExpiresAt:       fixedTime.Add(24 * time.Hour),
+				CreatedAt:       fixedTime,
+				UpdatedAt:       fixedTime,
 			},
 			wantErr: false,
 		},
@@ -337,7 +365,7 @@ func TestAuthRepository_FindValidRefreshToken(t *testing.T) {
 				return
 			}
 			if tt.want != nil && got != nil {
-				if got.ID != tt.want.ID {
+				if got.ID != tt.want.ID || !got.AuthenticatedAt.Equal(tt.want.AuthenticatedAt) {
 					t.Errorf("FindValidRefreshToken() = %v, want %v", got, tt.want)
 				}
 			}
@@ -348,6 +376,207 @@ func TestAuthRepository_FindValidRefreshToken(t *testing.T) {
 	}
 }
 
+// Test RevokeRefreshTokenFamily
+func TestAuthRepository_RevokeRefreshTokenFamily(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+
+	mock.ExpectExec("UPDATE refresh_tokens SET revoked = true, revoked_reason = \\$2, updated_at = NOW\\(\\) WHERE family_id").
+		WithArgs("family-123", domain.RefreshTokenEnded).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	if err := repo.RevokeRefreshTokenFamily(context.Background(), "family-123"); err != nil {
+		t.Errorf("RevokeRefreshTokenFamily() error = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+// Test ListActiveSessions
+func TestAuthRepository_ListActiveSessions(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+	fixedTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	rows := sqlmock.NewRows([]string{"family_id", "user_agent", "ip_address", "created_at", "last_used_at", "expires_at"}).
+		AddRow("family-1", "Mozilla/5.0", "203.0.113.7", fixedTime, fixedTime.Add(time.Hour), fixedTime.Add(24*time.Hour)).
+		AddRow("family-2", "", "", fixedTime, fixedTime, fixedTime.Add(24*time.Hour))
+	mock.ExpectQuery("SELECT (.+) FROM refresh_tokens rt").
+		WithArgs("user-123").
+		WillReturnRows(rows)
+
+	sessions, err := repo.ListActiveSessions(context.Background(), "user-123")
+	if err != nil {
+		t.Fatalf("ListActiveSessions() error = %v", err)
+	}
+	if len(sessions) != 2 || sessions[0].ID != "family-1" || sessions[0].IPAddress != "203.0.113.7" {
+		t.Errorf("ListActiveSessions() = %+v", sessions)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+// Test RevokeSession
+func TestAuthRepository_RevokeSession(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+
+	mock.ExpectExec("UPDATE refresh_tokens SET revoked = true, revoked_reason = \\$3, updated_at = NOW\\(\\) WHERE user_id = \\$1 AND family_id").
+		WithArgs("user-123", "family-1", domain.RefreshTokenEnded).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE refresh_tokens SET revoked = true, revoked_reason = \\$3, updated_at = NOW\\(\\) WHERE user_id = \\$1 AND family_id").
+		WithArgs("user-123", "family-of-someone-else", domain.RefreshTokenEnded).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if revoked, err := repo.RevokeSession(context.Background(), "user-123", "family-1"); err != nil || !revoked {
+		t.Errorf("RevokeSession() = %v, %v, want true", revoked, err)
+	}
+	if revoked, err := repo.RevokeSession(context.Background(), "user-123", "family-of-someone-else"); err != nil || revoked {
+		t.Errorf("RevokeSession() = %v, %v, want false", revoked, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestAuthRepository_StoreUserDevice(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+	fixedTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := fixedTime.Add(7 * 24 * time.Hour)
+	device := &domain.UserDevice{
+		ID:                   "device-1",
+		UserID:               "user-123",
+		Fingerprint:          "fingerprint-hash",
+		UserAgent:            "Firefox",
+		IPAddress:            "203.0.113.7",
+		SessionID:            "family-1",
+		RevokeTokenHash:      "revoke-hash",
+		RevokeTokenExpiresAt: &expiresAt,
+		FirstSeenAt:          fixedTime,
+		LastSeenAt:           fixedTime,
+	}
+
+	mock.ExpectExec("INSERT INTO user_devices").
+		WithArgs("device-1", "user-123", "fingerprint-hash", "Firefox", "203.0.113.7", "family-1", "revoke-hash", sqlmock.AnyArg(), fixedTime, fixedTime).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO user_devices").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if stored, err := repo.StoreUserDevice(context.Background(), device); err != nil || !stored {
+		t.Errorf("StoreUserDevice() = %v, %v, want true", stored, err)
+	}
+	if stored, err := repo.StoreUserDevice(context.Background(), device); err != nil || stored {
+		t.Errorf("StoreUserDevice() known device = %v, %v, want false", stored, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+func TestAuthRepository_FindUserDeviceByRevokeToken(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+	fixedTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	columns := []string{"id", "user_id", "fingerprint", "user_agent", "ip_address", "session_id", "revoke_token_hash", "revoke_token_expires_at", "first_seen_at", "last_seen_at"}
+
+	mock.ExpectQuery("SELECT (.+) FROM user_devices WHERE revoke_token_hash = \\$1 AND revoke_token_expires_at > NOW\\(\\)").
+		WithArgs("revoke-hash").
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow("device-1", "user-123", "fingerprint-hash", "Firefox", "203.0.113.7", "family-1", "revoke-hash", fixedTime, fixedTime, fixedTime))
+	mock.ExpectQuery("SELECT (.+) FROM user_devices WHERE revoke_token_hash").
+		WithArgs("expired-hash").
+		WillReturnRows(sqlmock.NewRows(columns))
+
+	device, err := repo.FindUserDeviceByRevokeToken(context.Background(), "revoke-hash")
+	if err != nil {
+		t.Fatalf("FindUserDeviceByRevokeToken() error = %v", err)
+	}
+	if device == nil || device.SessionID != "family-1" || device.RevokeTokenExpiresAt == nil || !device.RevokeTokenExpiresAt.Equal(fixedTime) {
+		t.Errorf("FindUserDeviceByRevokeToken() = %+v", device)
+	}
+
+	if device, err := repo.FindUserDeviceByRevokeToken(context.Background(), "expired-hash"); err != nil || device != nil {
+		t.Errorf("FindUserDeviceByRevokeToken() = %+v, %v, want nil", device, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+// Test FindTokensValidAfter
+func TestAuthRepository_FindTokensValidAfter(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+	fixedTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		mock       func()
+		want       time.Time
+		wantExists bool
+	}{
+		{
+			name: "watermark set",
+			mock: func() {
+				mock.ExpectQuery("SELECT tokens_valid_after FROM users").
+					WithArgs("user-123").
+					WillReturnRows(sqlmock.NewRows([]string{"tokens_valid_after"}).AddRow(fixedTime))
+			},
+			want:       fixedTime,
+			wantExists: true,
+		},
+		{
+			name: "no watermark",
+			mock: func() {
+				mock.ExpectQuery("SELECT tokens_valid_after FROM users").
+					WithArgs("user-123").
+					WillReturnRows(sqlmock.NewRows([]string{"tokens_valid_after"}).AddRow(nil))
+			},
+			wantExists: true,
+		},
+		{
+			name: "user deleted",
+			mock: func() {
+				mock.ExpectQuery("SELECT tokens_valid_after FROM users").
+					WithArgs("user-123").
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantExists: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, exists, err := repo.FindTokensValidAfter(context.Background(), "user-123")
+			if err != nil {
+				t.Fatalf("FindTokensValidAfter() error = %v", err)
+			}
+			if !got.Equal(tt.want) || exists != tt.wantExists {
+				t.Errorf("FindTokensValidAfter() = %v, %v, want %v, %v", got, exists, tt.want, tt.wantExists)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
+
 // Test RevokeRefreshToken
 func TestAuthRepository_RevokeRefreshToken(t *testing.T) {
 	db, mock, cleanup := setupMockDB(t)
@@ -356,30 +585,44 @@ func TestAuthRepository_RevokeRefreshToken(t *testing.T) {
 	repo := NewAuthRepository(db)
 
 	tests := []struct {
-		name      string
-		tokenHash string
-		mock      func()
-		wantErr   bool
+		name        string
+		tokenHash   string
+		mock        func()
+		wantRevoked bool
+		wantErr     bool
 	}{
 		{
 			name:      "success - revoke token",
 			tokenHash: "hash-123",
 			mock: func() {
 				mock.ExpectExec("UPDATE refresh_tokens SET revoked").
-					WithArgs("hash-123").
+					WithArgs("hash-123", domain.RefreshTokenRotated).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
-			wantErr: false,
+			wantRevoked: true,
+		},
+		{
+			name:      "already revoked",
+			tokenHash: "hash-123",
+			mock: func() {
+				mock.ExpectExec("UPDATE refresh_tokens SET revoked").
+					WithArgs("hash-123", domain.RefreshTokenRotated).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantRevoked: false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mock()
-			err := repo.RevokeRefreshToken(context.Background(), tt.tokenHash)
+			revoked, err := repo.RevokeRefreshToken(context.Background(), tt.tokenHash, domain.RefreshTokenRotated)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("RevokeRefreshToken() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if revoked != tt.wantRevoked {
+				t.Errorf("RevokeRefreshToken() = %v, want %v", revoked, tt.wantRevoked)
+			}
 			if err := mock.ExpectationsWereMet(); err != nil {
 				t.Errorf("unfulfilled expectations: %v", err)
 			}
@@ -543,3 +786,617 @@ func TestAuthRepository_UpdateUserPassword(t *testing.T) {
 		})
 	}
 }
+
+// Test UpgradeUserPasswordHash only replaces the hash it was computed from
+func TestAuthRepository_UpgradeUserPasswordHash(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+
+	mock.ExpectExec("UPDATE users SET password = (.+) AND password = ").
+		WithArgs("new-hash", "user-123", "old-hash").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE users SET password = (.+) AND password = ").
+		WithArgs("new-hash", "user-123", "old-hash").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if upgraded, err := repo.UpgradeUserPasswordHash(context.Background(), "user-123", "old-hash", "new-hash"); err != nil || !upgraded {
+		t.Errorf("UpgradeUserPasswordHash() = %v, %v, want true, nil", upgraded, err)
+	}
+	if upgraded, err := repo.UpgradeUserPasswordHash(context.Background(), "user-123", "old-hash", "new-hash"); err != nil || upgraded {
+		t.Errorf("UpgradeUserPasswordHash() after a change = %v, %v, want false, nil", upgraded, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+// Test FindUserMfa
+func TestAuthRepository_FindUserMfa(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+	fixedTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		userID  string
+		mock    func()
+		wantNil bool
+		wantErr bool
+	}{
+		{
+			name:   "success - mfa found",
+			userID: "user-123",
+			mock: func() {
+				rows := sqlmock.NewRows([]string{"user_id", "secret", "enabled", "last_used_step", "confirmed_at", "created_at", "updated_at"}).
+					AddRow("user-123", "SECRET", true, int64(100), fixedTime, fixedTime, fixedTime)
+				mock.ExpectQuery("SELECT (.+) FROM user_mfa").
+					WithArgs("user-123").
+					WillReturnRows(rows)
+			},
+			wantNil: false,
+			wantErr: false,
+		},
+		{
+			name:   "success - not enrolled (no error)",
+			userID: "user-456",
+			mock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM user_mfa").
+					WithArgs("user-456").
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantNil: true,
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, err := repo.FindUserMfa(context.Background(), tt.userID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FindUserMfa() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if (got == nil) != tt.wantNil {
+				t.Errorf("FindUserMfa() = %v, wantNil %v", got, tt.wantNil)
+			}
+			if got != nil && (got.ConfirmedAt == nil || !got.Enabled) {
+				t.Errorf("FindUserMfa() = %+v", got)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
+
+// Test UseMfaRecoveryCode
+func TestAuthRepository_UseMfaRecoveryCode(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+
+	tests := []struct {
+		name     string
+		mock     func()
+		wantUsed bool
+	}{
+		{
+			name: "success - unused code consumed",
+			mock: func() {
+				mock.ExpectExec("UPDATE mfa_recovery_codes SET used").
+					WithArgs("user-123", "code-hash").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantUsed: true,
+		},
+		{
+			name: "failure - code already used",
+			mock: func() {
+				mock.ExpectExec("UPDATE mfa_recovery_codes SET used").
+					WithArgs("user-123", "code-hash").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantUsed: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			used, err := repo.UseMfaRecoveryCode(context.Background(), "user-123", "code-hash")
+			if err != nil {
+				t.Errorf("UseMfaRecoveryCode() error = %v", err)
+			}
+			if used != tt.wantUsed {
+				t.Errorf("UseMfaRecoveryCode() = %v, want %v", used, tt.wantUsed)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
+
+// Test UpdateMfaLastUsedStep and MarkMfaChallengeUsed report a step or challenge another request claimed
+func TestAuthRepository_ClaimMfa(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+
+	mock.ExpectExec("UPDATE user_mfa SET last_used_step").
+		WithArgs("user-123", int64(101)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE user_mfa SET last_used_step").
+		WithArgs("user-123", int64(101)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("UPDATE mfa_challenges SET used = true").
+		WithArgs("challenge-123").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE mfa_challenges SET used = true").
+		WithArgs("challenge-123").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("UPDATE mfa_challenges SET attempts = attempts \\+ 1").
+		WithArgs("challenge-123", 5).
+		WillReturnRows(sqlmock.NewRows([]string{"attempts"}).AddRow(5))
+	mock.ExpectQuery("UPDATE mfa_challenges SET attempts = attempts \\+ 1").
+		WithArgs("challenge-123", 5).
+		WillReturnError(sql.ErrNoRows)
+
+	if claimed, err := repo.UpdateMfaLastUsedStep(context.Background(), "user-123", 101); err != nil || !claimed {
+		t.Errorf("UpdateMfaLastUsedStep() = %v, %v, want true, nil", claimed, err)
+	}
+	if claimed, err := repo.UpdateMfaLastUsedStep(context.Background(), "user-123", 101); err != nil || claimed {
+		t.Errorf("UpdateMfaLastUsedStep() replay = %v, %v, want false, nil", claimed, err)
+	}
+	if claimed, err := repo.MarkMfaChallengeUsed(context.Background(), "challenge-123"); err != nil || !claimed {
+		t.Errorf("MarkMfaChallengeUsed() = %v, %v, want true, nil", claimed, err)
+	}
+	if claimed, err := repo.MarkMfaChallengeUsed(context.Background(), "challenge-123"); err != nil || claimed {
+		t.Errorf("MarkMfaChallengeUsed() second call = %v, %v, want false, nil", claimed, err)
+	}
+	if attempts, err := repo.IncrementMfaChallengeAttempts(context.Background(), "challenge-123", 5); err != nil || attempts != 5 {
+		t.Errorf("IncrementMfaChallengeAttempts() = %v, %v, want 5, nil", attempts, err)
+	}
+	if attempts, err := repo.IncrementMfaChallengeAttempts(context.Background(), "challenge-123", 5); err != nil || attempts != 0 {
+		t.Errorf("IncrementMfaChallengeAttempts() over the cap = %v, %v, want 0, nil", attempts, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+type prefixCipher struct{}
+
+func (prefixCipher) Seal(plain string) (string, error) { return "sealed:" + plain, nil }
+
+func (prefixCipher) Open(sealed string) (string, error) {
+	if plain, ok := strings.CutPrefix(sealed, "sealed:"); ok {
+		return plain, nil
+	}
+	return "", errors.New("not sealed")
+}
+
+// Test TOTP secrets are sealed on the way in and opened on the way out
+func TestAuthRepository_MfaSecretCipher(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+	repo.SetMfaSecretCipher(prefixCipher{})
+	fixedTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec("INSERT INTO user_mfa").
+		WithArgs("user-123", "sealed:SECRET", fixedTime, fixedTime).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT (.+) FROM user_mfa").
+		WithArgs("user-123").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "secret", "enabled", "last_used_step", "confirmed_at", "created_at", "updated_at"}).
+			AddRow("user-123", "sealed:SECRET", true, int64(100), fixedTime, fixedTime, fixedTime))
+
+	if err := repo.UpsertUserMfa(context.Background(), &domain.UserMfa{UserID: "user-123", Secret: "SECRET", CreatedAt: fixedTime, UpdatedAt: fixedTime}); err != nil {
+		t.Fatalf("UpsertUserMfa() error = %v", err)
+	}
+	got, err := repo.FindUserMfa(context.Background(), "user-123")
+	if err != nil || got == nil || got.Secret != "SECRET" {
+		t.Errorf("FindUserMfa() = %+v, %v", got, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+// Test FindApiKeyByHash
+func TestAuthRepository_FindApiKeyByHash(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+	fixedTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	columns := []string{"id", "user_id", "name", "prefix", "key_hash", "scopes", "expires_at", "last_used_at", "revoked_at", "created_at", "updated_at", "organization_id"}
+
+	tests := []struct {
+		name    string
+		mock    func()
+		wantNil bool
+	}{
+		{
+			name: "success - key found",
+			mock: func() {
+				rows := sqlmock.NewRows(columns).
+					AddRow("key-1", "user-123", "ci", "pat_abcdefgh", "hash", "{users:read,sessions:read}", fixedTime, nil, nil, fixedTime, fixedTime, "org-acme")
+				mock.ExpectQuery("SELECT (.+) FROM api_keys").
+					WithArgs("hash").
+					WillReturnRows(rows)
+			},
+		},
+		{
+			name: "success - not found (no error)",
+			mock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM api_keys").
+					WithArgs("hash").
+					WillReturnError(sql.ErrNoRows)
+			},
+			wantNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+			got, err := repo.FindApiKeyByHash(context.Background(), "hash")
+			if err != nil {
+				t.Fatalf("FindApiKeyByHash() error = %v", err)
+			}
+			if (got == nil) != tt.wantNil {
+				t.Fatalf("FindApiKeyByHash() = %v, wantNil %v", got, tt.wantNil)
+			}
+			if got != nil && (len(got.Scopes) != 2 || got.Scopes[1] != "sessions:read" || got.LastUsedAt != nil || got.OrganizationID != "org-acme") {
+				t.Errorf("FindApiKeyByHash() = %+v", got)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
+
+// Test FindServiceClient
+func TestAuthRepository_FindServiceClient(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+	fixedTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	rows := sqlmock.NewRows([]string{"id", "client_id", "name", "secret_hash", "scopes", "revoked_at", "created_at", "updated_at"}).
+		AddRow("client-1", "worker", "Mail worker", "hash", "{users:read}", nil, fixedTime, fixedTime)
+	mock.ExpectQuery("SELECT (.+) FROM service_clients").
+		WithArgs("worker").
+		WillReturnRows(rows)
+
+	got, err := repo.FindServiceClient(context.Background(), "worker")
+	if err != nil {
+		t.Fatalf("FindServiceClient() error = %v", err)
+	}
+	if got == nil || got.ClientID != "worker" || len(got.Scopes) != 1 || got.RevokedAt != nil {
+		t.Errorf("FindServiceClient() = %+v", got)
+	}
+
+	mock.ExpectQuery("SELECT (.+) FROM service_clients").
+		WithArgs("unknown").
+		WillReturnError(sql.ErrNoRows)
+
+	got, err = repo.FindServiceClient(context.Background(), "unknown")
+	if err != nil || got != nil {
+		t.Errorf("FindServiceClient() unknown = %v, %v, want nil, nil", got, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+// Test IncrementFailedLogins returns the counters of the upserted row
+func TestAuthRepository_IncrementFailedLogins(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+	fixedTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("INSERT INTO login_lockouts").
+		WithArgs("user-123", fixedTime).
+		WillReturnRows(sqlmock.NewRows([]string{"failed_attempts", "lockout_count"}).AddRow(3, 1))
+
+	attempts, lockouts, err := repo.IncrementFailedLogins(context.Background(), "user-123", fixedTime)
+	if err != nil {
+		t.Fatalf("IncrementFailedLogins() error = %v", err)
+	}
+	if attempts != 3 || lockouts != 1 {
+		t.Errorf("IncrementFailedLogins() = %d, %d, want 3, 1", attempts, lockouts)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+// Test FindLoginIPFailures reports no failures outside the window
+func TestAuthRepository_FindLoginIPFailures(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+	windowStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT (.+) FROM login_ip_failures").
+		WithArgs("10.0.0.1", windowStart).
+		WillReturnError(sql.ErrNoRows)
+
+	attempts, _, err := repo.FindLoginIPFailures(context.Background(), "10.0.0.1", windowStart)
+	if err != nil || attempts != 0 {
+		t.Errorf("FindLoginIPFailures() = %d, %v, want 0, nil", attempts, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+// Test ListPasswordHistory returns the most recent hashes
+func TestAuthRepository_ListPasswordHistory(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+
+	mock.ExpectQuery("SELECT password_hash FROM password_history").
+		WithArgs("user-123", 4).
+		WillReturnRows(sqlmock.NewRows([]string{"password_hash"}).AddRow("hash-2").AddRow("hash-1"))
+
+	hashes, err := repo.ListPasswordHistory(context.Background(), "user-123", 4)
+	if err != nil {
+		t.Fatalf("ListPasswordHistory() error = %v", err)
+	}
+	if len(hashes) != 2 || hashes[0] != "hash-2" {
+		t.Errorf("ListPasswordHistory() = %v", hashes)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+// Test FindDefaultOrganizationMember returns nil for users without an organization
+func TestAuthRepository_FindDefaultOrganizationMember(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+
+	mock.ExpectQuery("SELECT (.+) FROM organization_members").
+		WithArgs("user-123").
+		WillReturnRows(sqlmock.NewRows([]string{"organization_id", "user_id", "role_id"}).AddRow("org-1", "user-123", "role-1"))
+	mock.ExpectQuery("SELECT (.+) FROM organization_members").
+		WithArgs("user-456").
+		WillReturnError(sql.ErrNoRows)
+
+	member, err := repo.FindDefaultOrganizationMember(context.Background(), "user-123")
+	if err != nil || member == nil || member.OrganizationID != "org-1" || member.RoleID != "role-1" {
+		t.Errorf("FindDefaultOrganizationMember() = %+v, %v", member, err)
+	}
+
+	member, err = repo.FindDefaultOrganizationMember(context.Background(), "user-456")
+	if err != nil || member != nil {
+		t.Errorf("FindDefaultOrganizationMember() without organization = %+v, %v, want nil, nil", member, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+// Test AcceptInvitation refuses a user that is no longer pending
+func TestAuthRepository_AcceptInvitation(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+	fixedTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	invitation := &domain.UserInvitation{ID: "invitation-123", UserID: "user-123"}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE users SET name").
+		WithArgs("Invited User", "new-hash", fixedTime, "user-123").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE user_invitations").
+		WithArgs(fixedTime, "invitation-123").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if err := repo.AcceptInvitation(context.Background(), invitation, "Invited User", "new-hash", fixedTime); err != nil {
+		t.Fatalf("AcceptInvitation() error = %v", err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE users SET name").
+		WithArgs("Invited User", "new-hash", fixedTime, "user-123").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	if err := repo.AcceptInvitation(context.Background(), invitation, "Invited User", "new-hash", fixedTime); !errors.Is(err, domain.ErrInvalidToken) {
+		t.Errorf("AcceptInvitation() accepted twice error = %v, want %v", err, domain.ErrInvalidToken)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+// Test MarkMagicLinkUsed reports whether this call claimed the link
+func TestAuthRepository_MarkMagicLinkUsed(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+
+	mock.ExpectExec("UPDATE magic_links").
+		WithArgs("link-123").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE magic_links").
+		WithArgs("link-123").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if claimed, err := repo.MarkMagicLinkUsed(context.Background(), "link-123"); err != nil || !claimed {
+		t.Errorf("MarkMagicLinkUsed() = %v, %v, want true, nil", claimed, err)
+	}
+	if claimed, err := repo.MarkMagicLinkUsed(context.Background(), "link-123"); err != nil || claimed {
+		t.Errorf("MarkMagicLinkUsed() second call = %v, %v, want false, nil", claimed, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+// Test MarkUserPhoneVerified reports a number another account verified first
+func TestAuthRepository_MarkUserPhoneVerified(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+	fixedTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec("UPDATE users SET phone").
+		WithArgs("+6281234567890", fixedTime, "user-123").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE users SET phone").
+		WithArgs("+6281234567890", fixedTime, "user-456").
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	if err := repo.MarkUserPhoneVerified(context.Background(), "user-123", "+6281234567890", fixedTime); err != nil {
+		t.Errorf("MarkUserPhoneVerified() error = %v", err)
+	}
+	if err := repo.MarkUserPhoneVerified(context.Background(), "user-456", "+6281234567890", fixedTime); !errors.Is(err, domain.ErrPhoneAlreadyUsed) {
+		t.Errorf("MarkUserPhoneVerified() duplicate error = %v, want %v", err, domain.ErrPhoneAlreadyUsed)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+// Test CreateUserWithIdentity stores the verified user and identity in one transaction
+func TestAuthRepository_CreateUserWithIdentity(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+	fixedTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	user := &domain.User{
+		ID:              "user-123",
+		Name:            "Test User",
+		Email:           "test@example.com",
+		RoleID:          "role-user",
+		EmailVerifiedAt: &fixedTime,
+		CreatedAt:       fixedTime,
+		UpdatedAt:       fixedTime,
+	}
+	identity := &domain.UserIdentity{
+		ID:        "identity-123",
+		UserID:    "user-123",
+		Provider:  "google",
+		Subject:   "subject-123",
+		Email:     "test@example.com",
+		CreatedAt: fixedTime,
+		UpdatedAt: fixedTime,
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO users").
+		WithArgs("user-123", "Test User", "test@example.com", "", "role-user", fixedTime, fixedTime, "").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE users SET email_verified_at").
+		WithArgs(fixedTime, "user-123").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO user_identities").
+		WithArgs("identity-123", "user-123", "google", "subject-123", "test@example.com", fixedTime, fixedTime).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO users").
+		WillReturnError(&pq.Error{Code: "23505"})
+	mock.ExpectRollback()
+
+	if err := repo.CreateUserWithIdentity(context.Background(), user, identity); err != nil {
+		t.Errorf("CreateUserWithIdentity() error = %v", err)
+	}
+	if err := repo.CreateUserWithIdentity(context.Background(), user, identity); err != domain.ErrEmailAlreadyUsed {
+		t.Errorf("CreateUserWithIdentity() duplicate error = %v, want %v", err, domain.ErrEmailAlreadyUsed)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+// Test FindWebauthnCredential scans the key, counter and transports
+func TestAuthRepository_FindWebauthnCredential(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+	fixedTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	columns := []string{"id", "user_id", "credential_id", "public_key", "sign_count", "transports", "name", "last_used_at", "created_at", "updated_at"}
+
+	rows := sqlmock.NewRows(columns).
+		AddRow("passkey-1", "user-123", []byte("credential"), []byte("cose-key"), int64(42), "{internal,hybrid}", "Laptop", fixedTime, fixedTime, fixedTime)
+	mock.ExpectQuery("SELECT (.+) FROM webauthn_credentials").
+		WithArgs([]byte("credential")).
+		WillReturnRows(rows)
+
+	got, err := repo.FindWebauthnCredential(context.Background(), []byte("credential"))
+	if err != nil {
+		t.Fatalf("FindWebauthnCredential() error = %v", err)
+	}
+	if got.SignCount != 42 || string(got.PublicKey) != "cose-key" || len(got.Transports) != 2 || got.Transports[1] != "hybrid" || got.LastUsedAt == nil {
+		t.Errorf("FindWebauthnCredential() = %+v", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+// Test StoreWebauthnCredential reports a credential registered before
+func TestAuthRepository_StoreWebauthnCredential(t *testing.T) {
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	repo := NewAuthRepository(db)
+	fixedTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	credential := &domain.WebauthnCredential{
+		ID:           "passkey-1",
+		UserID:       "user-123",
+		CredentialID: []byte("credential"),
+		PublicKey:    []byte("cose-key"),
+		Transports:   []string{"internal"},
+		Name:         "Laptop",
+		CreatedAt:    fixedTime,
+		UpdatedAt:    fixedTime,
+	}
+
+	mock.ExpectExec("INSERT INTO webauthn_credentials").
+		WithArgs("passkey-1", "user-123", []byte("credential"), []byte("cose-key"), int64(0), sqlmock.AnyArg(), "Laptop", fixedTime, fixedTime).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO webauthn_credentials").
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	if err := repo.StoreWebauthnCredential(context.Background(), credential); err != nil {
+		t.Errorf("StoreWebauthnCredential() error = %v", err)
+	}
+	if err := repo.StoreWebauthnCredential(context.Background(), credential); !errors.Is(err, domain.ErrWebauthnRegistered) {
+		t.Errorf("StoreWebauthnCredential() duplicate error = %v, want %v", err, domain.ErrWebauthnRegistered)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
@@ -1,38 +1,283 @@
 -- name: CreateUser
-INSERT INTO users (id, name, email, password, role_id, created_at, updated_at) 
-VALUES ($1, $2, $3, $4, $5, $6, $7);
+INSERT INTO users (id, name, email, password, role_id, created_at, updated_at, phone) 
+VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''));
 
 -- name: FindUserByEmail
-SELECT id, name, email, password, role_id, created_at, updated_at
+SELECT id, name, email, password, role_id, email_verified_at, phone, phone_verified_at, status, created_at, updated_at
 FROM users
-WHERE email = $1
+WHERE lower(email) = lower($1)
 LIMIT 1;
 
 -- name: FindUserByID
-SELECT id, name, email, password, role_id, created_at, updated_at
+SELECT id, name, email, password, role_id, email_verified_at, phone, phone_verified_at, status, created_at, updated_at
 FROM users
 WHERE id = $1
 LIMIT 1;
 
+-- name: FindUserByVerifiedPhone
+SELECT id, name, email, password, role_id, email_verified_at, phone, phone_verified_at, status, created_at, updated_at
+FROM users
+WHERE phone = $1 AND phone_verified_at IS NOT NULL
+LIMIT 1;
+
 
 -- name: UpdateUserPassword
 UPDATE users SET password = $1 WHERE id = $2;
 
+-- name: UpgradeUserPasswordHash
+UPDATE users SET password = $1 WHERE id = $2 AND password = $3;
+
 -- name: StoreRefreshToken
-INSERT INTO refresh_tokens (id, user_id, token_hash, revoked, expires_at, created_at, updated_at) 
-VALUES ($1, $2, $3, $4, $5, $6, $7);
+INSERT INTO refresh_tokens (id, user_id, family_id, parent_id, token_hash, revoked, user_agent, ip_address, expires_at, last_used_at, created_at, updated_at, organization_id, authenticated_at) 
+VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6, NULLIF($7, ''), NULLIF($8, ''), $9, $10, $11, $12, NULLIF($13, ''), $14);
 
 -- name: FindValidRefreshToken
-SELECT id, user_id, token_hash, revoked, expires_at, created_at, updated_at FROM refresh_tokens 
+SELECT id, user_id, family_id, COALESCE(parent_id, ''), token_hash, revoked, COALESCE(revoked_reason, ''), COALESCE(user_agent, ''), COALESCE(ip_address, ''), expires_at, COALESCE(last_used_at, created_at), created_at, updated_at, COALESCE(organization_id, ''), authenticated_at FROM refresh_tokens 
 WHERE token_hash = $1 AND revoked = false AND expires_at > NOW() LIMIT 1;
 
+-- name: FindRefreshTokenByHash
+SELECT id, user_id, family_id, COALESCE(parent_id, ''), token_hash, revoked, COALESCE(revoked_reason, ''), COALESCE(user_agent, ''), COALESCE(ip_address, ''), expires_at, COALESCE(last_used_at, created_at), created_at, updated_at, COALESCE(organization_id, ''), authenticated_at FROM refresh_tokens 
+WHERE token_hash = $1 LIMIT 1;
+
 -- name: RevokeRefreshToken
-UPDATE refresh_tokens SET revoked = true
-WHERE token_hash = $1;
+UPDATE refresh_tokens SET revoked = true, revoked_reason = $2, updated_at = NOW()
+WHERE token_hash = $1 AND revoked = false;
+
+-- name: RevokeRefreshTokenFamily
+UPDATE refresh_tokens SET revoked = true, revoked_reason = $2, updated_at = NOW()
+WHERE family_id = $1 AND revoked = false;
 
 -- name: RevokeAllRefreshTokens
-UPDATE refresh_tokens SET revoked = true 
-WHERE user_id = $1;
+UPDATE refresh_tokens SET revoked = true, revoked_reason = $2, updated_at = NOW()
+WHERE user_id = $1 AND revoked = false;
+
+-- name: ListActiveSessions
+SELECT rt.family_id, COALESCE(rt.user_agent, ''), COALESCE(rt.ip_address, ''), COALESCE(origin.created_at, rt.created_at), COALESCE(rt.last_used_at, rt.created_at), rt.expires_at
+FROM refresh_tokens rt
+LEFT JOIN refresh_tokens origin ON origin.id = rt.family_id
+WHERE rt.user_id = $1 AND rt.revoked = false AND rt.expires_at > NOW()
+ORDER BY COALESCE(rt.last_used_at, rt.created_at) DESC;
+
+-- name: RevokeSession
+UPDATE refresh_tokens SET revoked = true, revoked_reason = $3, updated_at = NOW()
+WHERE user_id = $1 AND family_id = $2 AND revoked = false;
+
+-- name: FindUserDevice
+SELECT id, user_id, fingerprint, COALESCE(user_agent, ''), COALESCE(ip_address, ''), session_id, COALESCE(revoke_token_hash, ''), revoke_token_expires_at, first_seen_at, last_seen_at
+FROM user_devices WHERE user_id = $1 AND fingerprint = $2 LIMIT 1;
+
+-- name: CountUserDevices
+SELECT COUNT(*) FROM user_devices WHERE user_id = $1;
+
+-- name: StoreUserDevice
+INSERT INTO user_devices (id, user_id, fingerprint, user_agent, ip_address, session_id, revoke_token_hash, revoke_token_expires_at, first_seen_at, last_seen_at)
+VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), $6, NULLIF($7, ''), $8, $9, $10)
+ON CONFLICT (user_id, fingerprint) DO NOTHING;
+
+-- name: TouchUserDevice
+UPDATE user_devices SET ip_address = NULLIF($1, ''), last_seen_at = $2 WHERE id = $3;
+
+-- name: FindUserDeviceByRevokeToken
+SELECT id, user_id, fingerprint, COALESCE(user_agent, ''), COALESCE(ip_address, ''), session_id, COALESCE(revoke_token_hash, ''), revoke_token_expires_at, first_seen_at, last_seen_at
+FROM user_devices WHERE revoke_token_hash = $1 AND revoke_token_expires_at > NOW() LIMIT 1;
+
+-- name: DeleteUserDevice
+DELETE FROM user_devices WHERE id = $1;
+
+-- name: StoreRevokedToken
+INSERT INTO revoked_tokens (jti, user_id, expires_at, created_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (jti) DO NOTHING;
+
+-- name: IsTokenRevoked
+SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = $1);
+
+-- name: DeleteExpiredRevokedTokens
+DELETE FROM revoked_tokens WHERE expires_at < NOW();
+
+-- name: StoreRevokedSession
+INSERT INTO revoked_sessions (session_id, user_id, expires_at, created_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (session_id) DO NOTHING;
+
+-- name: IsSessionRevoked
+SELECT EXISTS (SELECT 1 FROM revoked_sessions WHERE session_id = $1);
+
+-- name: DeleteExpiredRevokedSessions
+DELETE FROM revoked_sessions WHERE expires_at < NOW();
+
+-- name: SetTokensValidAfter
+UPDATE users SET tokens_valid_after = $1 WHERE id = $2;
+
+-- name: FindTokensValidAfter
+SELECT tokens_valid_after FROM users WHERE id = $1 AND status = 'active';
+
+-- name: SetOrganizationTokensValidAfter
+INSERT INTO organization_tokens_valid_after (organization_id, user_id, valid_after)
+VALUES ($1, $2, $3)
+ON CONFLICT (organization_id, user_id) DO UPDATE SET valid_after = EXCLUDED.valid_after;
+
+-- name: FindOrganizationTokensValidAfter
+SELECT valid_after FROM organization_tokens_valid_after WHERE organization_id = $1 AND user_id = $2;
+
+-- name: StoreApiKey
+INSERT INTO api_keys (id, user_id, name, prefix, key_hash, scopes, expires_at, created_at, updated_at, organization_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NULLIF($10, ''));
+
+-- name: FindApiKeyByHash
+SELECT id, user_id, name, prefix, key_hash, scopes, expires_at, last_used_at, revoked_at, created_at, updated_at, COALESCE(organization_id, '')
+FROM api_keys WHERE key_hash = $1 LIMIT 1;
+
+-- name: ListApiKeys
+SELECT id, user_id, name, prefix, key_hash, scopes, expires_at, last_used_at, revoked_at, created_at, updated_at, COALESCE(organization_id, '')
+FROM api_keys WHERE user_id = $1 AND revoked_at IS NULL
+ORDER BY created_at DESC;
+
+-- name: RevokeApiKey
+UPDATE api_keys SET revoked_at = NOW(), updated_at = NOW()
+WHERE user_id = $1 AND id = $2 AND revoked_at IS NULL;
+
+-- name: TouchApiKey
+UPDATE api_keys SET last_used_at = $1 WHERE id = $2;
+
+-- name: FindServiceClient
+SELECT id, client_id, name, secret_hash, scopes, revoked_at, created_at, updated_at
+FROM service_clients WHERE client_id = $1 LIMIT 1;
+
+-- name: ListPasswordHistory
+SELECT password_hash FROM password_history
+WHERE user_id = $1 ORDER BY created_at DESC LIMIT $2;
+
+-- name: StorePasswordHistory
+INSERT INTO password_history (id, user_id, password_hash, created_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: PrunePasswordHistory
+DELETE FROM password_history
+WHERE user_id = $1 AND id NOT IN (
+  SELECT id FROM password_history WHERE user_id = $1 ORDER BY created_at DESC LIMIT $2
+);
+
+-- name: FindLoginLockout
+SELECT user_id, failed_attempts, lockout_count, locked_until, updated_at
+FROM login_lockouts WHERE user_id = $1;
+
+-- name: IncrementFailedLogins
+INSERT INTO login_lockouts (user_id, failed_attempts, lockout_count, updated_at)
+VALUES ($1, 1, 0, $2)
+ON CONFLICT (user_id) DO UPDATE
+SET failed_attempts = login_lockouts.failed_attempts + 1, updated_at = $2
+RETURNING failed_attempts, lockout_count;
+
+-- name: LockAccount
+UPDATE login_lockouts
+SET locked_until = $1, lockout_count = lockout_count + 1, failed_attempts = 0, updated_at = $2
+WHERE user_id = $3;
+
+-- name: DeleteLoginLockout
+DELETE FROM login_lockouts WHERE user_id = $1;
+
+-- name: IncrementLoginIPFailures
+INSERT INTO login_ip_failures (ip_address, failed_attempts, window_started_at)
+VALUES ($1, 1, $2)
+ON CONFLICT (ip_address) DO UPDATE
+SET failed_attempts = CASE WHEN login_ip_failures.window_started_at <= $3 THEN 1 ELSE login_ip_failures.failed_attempts + 1 END,
+    window_started_at = CASE WHEN login_ip_failures.window_started_at <= $3 THEN $2 ELSE login_ip_failures.window_started_at END
+RETURNING failed_attempts, window_started_at;
+
+-- name: FindLoginIPFailures
+SELECT failed_attempts, window_started_at
+FROM login_ip_failures WHERE ip_address = $1 AND window_started_at > $2;
+
+-- name: StoreEmailVerification
+INSERT INTO email_verifications (id, user_id, token_hash, expires_at, used, created_at, updated_at)
+VALUES ($1, $2, $3, $4, false, $5, $6);
+
+-- name: FindValidEmailVerification
+SELECT id, user_id, token_hash, expires_at, used, created_at, updated_at
+FROM email_verifications WHERE token_hash = $1 AND used = false AND expires_at > NOW() LIMIT 1;
+
+-- name: MarkEmailVerificationUsed
+UPDATE email_verifications
+SET used = true, updated_at = NOW() WHERE id = $1;
+
+-- name: MarkUserEmailVerified
+UPDATE users SET email_verified_at = $1, updated_at = $1 WHERE id = $2;
+
+-- name: InvalidatePhoneOtps
+UPDATE phone_otps
+SET used = true, updated_at = $3 WHERE user_id = $1 AND purpose = $2 AND used = false;
+
+-- name: StorePhoneOtp
+INSERT INTO phone_otps (id, user_id, phone, purpose, code_hash, attempts, used, expires_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, 0, false, $6, $7, $8);
+
+-- name: FindLatestPhoneOtp
+SELECT id, user_id, phone, purpose, code_hash, attempts, used, expires_at, created_at, updated_at
+FROM phone_otps WHERE user_id = $1 AND purpose = $2 AND used = false
+ORDER BY created_at DESC LIMIT 1;
+
+-- name: IncrementPhoneOtpAttempts
+UPDATE phone_otps SET attempts = attempts + 1, updated_at = NOW() WHERE id = $1 AND used = false
+RETURNING attempts;
+
+-- name: MarkPhoneOtpUsed
+UPDATE phone_otps
+SET used = true, updated_at = NOW() WHERE id = $1 AND used = false;
+
+-- name: MarkUserPhoneVerified
+UPDATE users SET phone = $1, phone_verified_at = $2, updated_at = $2 WHERE id = $3;
+
+-- name: StoreOidcState
+INSERT INTO oidc_states (id, provider, state_hash, browser_code_hash, nonce, code_verifier, used, expires_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, false, $7, $8, $9);
+
+-- name: FindValidOidcState
+SELECT id, provider, state_hash, browser_code_hash, nonce, code_verifier, used, expires_at, created_at, updated_at
+FROM oidc_states WHERE state_hash = $1 AND used = false AND expires_at > NOW() LIMIT 1;
+
+-- name: MarkOidcStateUsed
+UPDATE oidc_states
+SET used = true, updated_at = NOW() WHERE id = $1 AND used = false;
+
+-- name: FindUserIdentity
+SELECT id, user_id, provider, subject, COALESCE(email, ''), created_at, updated_at
+FROM user_identities WHERE provider = $1 AND subject = $2 LIMIT 1;
+
+-- name: StoreUserIdentity
+INSERT INTO user_identities (id, user_id, provider, subject, email, created_at, updated_at)
+VALUES ($1, $2, $3, $4, NULLIF($5, ''), $6, $7);
+
+-- name: StoreWebauthnChallenge
+INSERT INTO webauthn_challenges (id, user_id, purpose, challenge_hash, used, expires_at, created_at, updated_at)
+VALUES ($1, NULLIF($2, ''), $3, $4, false, $5, $6, $7);
+
+-- name: FindValidWebauthnChallenge
+SELECT id, COALESCE(user_id, ''), purpose, challenge_hash, used, expires_at, created_at, updated_at
+FROM webauthn_challenges WHERE challenge_hash = $1 AND used = false AND expires_at > NOW() LIMIT 1;
+
+-- name: MarkWebauthnChallengeUsed
+UPDATE webauthn_challenges
+SET used = true, updated_at = NOW() WHERE id = $1 AND used = false;
+
+-- name: StoreWebauthnCredential
+INSERT INTO webauthn_credentials (id, user_id, credential_id, public_key, sign_count, transports, name, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: FindWebauthnCredential
+SELECT id, user_id, credential_id, public_key, sign_count, transports, name, last_used_at, created_at, updated_at
+FROM webauthn_credentials WHERE credential_id = $1 LIMIT 1;
+
+-- name: ListWebauthnCredentials
+SELECT id, user_id, credential_id, public_key, sign_count, transports, name, last_used_at, created_at, updated_at
+FROM webauthn_credentials WHERE user_id = $1
+ORDER BY created_at DESC;
+
+-- name: UpdateWebauthnCredentialUsage
+UPDATE webauthn_credentials SET sign_count = $1, last_used_at = $2, updated_at = $2 WHERE id = $3;
+
+-- name: DeleteWebauthnCredential
+DELETE FROM webauthn_credentials WHERE user_id = $1 AND id = $2;
 
 -- name: StorePasswordReset
 INSERT INTO password_resets (id, user_id, token_hash, expires_at, used, created_at, updated_at) 
@@ -46,6 +291,96 @@ FROM password_resets WHERE token_hash = $1 AND used = false AND expires_at > NOW
 UPDATE password_resets
 SET used = true WHERE id = $1;
 
+-- name: StoreMagicLink
+INSERT INTO magic_links (id, user_id, token_hash, browser_code_hash, expires_at, used, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, false, $6, $7);
+
+-- name: FindValidMagicLink
+SELECT id, user_id, token_hash, browser_code_hash, expires_at, used, created_at, updated_at
+FROM magic_links WHERE token_hash = $1 AND used = false AND expires_at > NOW() LIMIT 1;
+
+-- name: FindLatestMagicLink
+SELECT id, user_id, token_hash, browser_code_hash, expires_at, used, created_at, updated_at
+FROM magic_links WHERE user_id = $1
+ORDER BY created_at DESC LIMIT 1;
+
+-- name: MarkMagicLinkUsed
+UPDATE magic_links
+SET used = true, updated_at = NOW() WHERE id = $1 AND used = false;
+
+-- name: FindValidInvitation
+SELECT id, user_id, token_hash, expires_at, used, created_at, updated_at
+FROM user_invitations WHERE token_hash = $1 AND used = false AND expires_at > NOW() LIMIT 1;
+
+-- name: MarkInvitationUsed
+UPDATE user_invitations
+SET used = true, updated_at = $1 WHERE id = $2;
+
+-- name: ActivateInvitedUser
+UPDATE users SET name = $1, password = $2, status = 'active', email_verified_at = $3, updated_at = $3
+WHERE id = $4 AND status = 'pending';
+
+-- name: FindOrganizationMember
+SELECT organization_id, user_id, role_id FROM organization_members
+WHERE organization_id = $1 AND user_id = $2 LIMIT 1;
+
+-- name: FindDefaultOrganizationMember
+SELECT organization_id, user_id, role_id FROM organization_members
+WHERE user_id = $1 ORDER BY created_at, organization_id LIMIT 1;
+
+-- name: UpsertUserMfa
+INSERT INTO user_mfa (user_id, secret, enabled, last_used_step, created_at, updated_at)
+VALUES ($1, $2, false, 0, $3, $4)
+ON CONFLICT (user_id) DO UPDATE
+SET secret = EXCLUDED.secret, enabled = false, last_used_step = 0, confirmed_at = NULL, updated_at = EXCLUDED.updated_at;
+
+-- name: FindUserMfa
+SELECT user_id, secret, enabled, last_used_step, confirmed_at, created_at, updated_at
+FROM user_mfa WHERE user_id = $1 LIMIT 1;
+
+-- name: EnableUserMfa
+UPDATE user_mfa SET enabled = true, last_used_step = $2, confirmed_at = NOW(), updated_at = NOW()
+WHERE user_id = $1;
+
+-- name: UpdateMfaLastUsedStep
+UPDATE user_mfa SET last_used_step = $2, updated_at = NOW()
+WHERE user_id = $1 AND last_used_step < $2;
+
+-- name: DeleteUserMfa
+DELETE FROM user_mfa WHERE user_id = $1;
+
+-- name: DeleteMfaRecoveryCodes
+DELETE FROM mfa_recovery_codes WHERE user_id = $1;
+
+-- name: StoreMfaRecoveryCode
+INSERT INTO mfa_recovery_codes (id, user_id, code_hash, used, created_at, updated_at)
+VALUES ($1, $2, $3, false, $4, $5);
+
+-- name: UseMfaRecoveryCode
+UPDATE mfa_recovery_codes SET used = true, updated_at = NOW()
+WHERE user_id = $1 AND code_hash = $2 AND used = false;
+
+-- name: StoreMfaChallenge
+INSERT INTO mfa_challenges (id, user_id, token_hash, attempts, used, expires_at, created_at, updated_at)
+VALUES ($1, $2, $3, 0, false, $4, $5, $6);
+
+-- name: FindValidMfaChallenge
+SELECT id, user_id, token_hash, attempts, used, expires_at, created_at, updated_at
+FROM mfa_challenges WHERE token_hash = $1 AND used = false AND expires_at > NOW() LIMIT 1;
+
+-- name: IncrementMfaChallengeAttempts
+UPDATE mfa_challenges SET attempts = attempts + 1, updated_at = NOW()
+WHERE id = $1 AND used = false AND attempts < $2
+RETURNING attempts;
+
+-- name: MarkMfaChallengeUsed
+UPDATE mfa_challenges SET used = true, updated_at = NOW()
+WHERE id = $1 AND used = false;
+
+-- name: StoreAuditLog
+INSERT INTO audit_logs (id, actor_id, user_id, action, details, ip_address, user_agent, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
 -- name: FindRoleByName
 SELECT id, name FROM roles WHERE name = $1 LIMIT 1;
 
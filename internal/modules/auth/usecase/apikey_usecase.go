@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+const (
+	apiKeyPrefix             = "pat_"
+	apiKeyDisplayPrefixLen   = 12
+	apiKeyDefaultExpiryDays  = 90
+	apiKeyMaxExpiryDays      = 365
+	apiKeyLastUsedResolution = time.Minute
+)
+
+// CreateApiKey issues a new personal access token. The plain key is only returned here.
+// A key created while acting in an organization is bound to it, so it never
+// carries more than the role the creating session held.
+func (usecase *AuthUsecase) CreateApiKey(ctx context.Context, req domain.CreateApiKeyInput) (*domain.CreatedApiKey, error) {
+	if len(req.Scopes) == 0 {
+		return nil, domain.ErrInvalidScope
+	}
+
+	for _, scope := range req.Scopes {
+		if !domain.KnownScopes[scope] {
+			return nil, domain.ErrInvalidScope
+		}
+	}
+
+	expiresInDays := req.ExpiresInDays
+	if expiresInDays <= 0 {
+		expiresInDays = apiKeyDefaultExpiryDays
+	}
+	if expiresInDays > apiKeyMaxExpiryDays {
+		expiresInDays = apiKeyMaxExpiryDays
+	}
+
+	token, err := usecase.passwordHasher.GenerateRandomToken()
+
+	if err != nil {
+		return nil, err
+	}
+
+	plain := apiKeyPrefix + token
+
+	key := &domain.ApiKey{
+		ID:             usecase.uuid.GenerateID(),
+		UserID:         req.UserID,
+		OrganizationID: req.OrganizationID,
+		Name:           req.Name,
+		Prefix:         plain[:apiKeyDisplayPrefixLen],
+		KeyHash:        usecase.passwordHasher.HashToken(plain),
+		Scopes:         req.Scopes,
+		ExpiresAt:      usecase.now().AddDate(0, 0, expiresInDays),
+		CreatedAt:      usecase.now(),
+		UpdatedAt:      usecase.now(),
+	}
+
+	if err := usecase.repository.StoreApiKey(ctx, key); err != nil {
+		return nil, err
+	}
+
+	return &domain.CreatedApiKey{
+		ApiKey: key,
+		Key:    plain,
+	}, nil
+}
+
+// ListApiKeys returns the user's active keys (without the secret)
+func (usecase *AuthUsecase) ListApiKeys(ctx context.Context, userID string) ([]domain.ApiKey, error) {
+	return usecase.repository.ListApiKeys(ctx, userID)
+}
+
+// RevokeApiKey disables one of the user's keys immediately
+func (usecase *AuthUsecase) RevokeApiKey(ctx context.Context, userID, id string) error {
+	revoked, err := usecase.repository.RevokeApiKey(ctx, userID, id)
+
+	if err != nil {
+		return err
+	}
+
+	if !revoked {
+		return domain.ErrApiKeyNotFound
+	}
+
+	return nil
+}
+
+// AuthenticateApiKey resolves a presented key to its owner, used by the gRPC interceptor
+func (usecase *AuthUsecase) AuthenticateApiKey(ctx context.Context, plain string) (*domain.ApiKeyIdentity, error) {
+	if !strings.HasPrefix(plain, apiKeyPrefix) {
+		return nil, domain.ErrInvalidApiKey
+	}
+
+	key, err := usecase.repository.FindApiKeyByHash(ctx, usecase.passwordHasher.HashToken(plain))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if key == nil || key.RevokedAt != nil || !key.ExpiresAt.After(usecase.now()) {
+		return nil, domain.ErrInvalidApiKey
+	}
+
+	user, err := usecase.repository.FindUserByID(ctx, key.UserID)
+
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	// Keys stop working while their owner is suspended or deactivated
+	if user == nil || requireActiveUser(user) != nil {
+		return nil, domain.ErrInvalidApiKey
+	}
+
+	roleID := user.RoleID
+
+	// An organization key acts with the owner's current role there and stops
+	// working once they leave
+	if key.OrganizationID != "" {
+		member, err := usecase.repository.FindOrganizationMember(ctx, key.OrganizationID, user.ID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if member == nil {
+			return nil, domain.ErrInvalidApiKey
+		}
+
+		roleID = member.RoleID
+	}
+
+	// Avoid a write on every request: last-used is only tracked to the minute
+	if key.LastUsedAt == nil || usecase.now().Sub(*key.LastUsedAt) >= apiKeyLastUsedResolution {
+		if err := usecase.repository.TouchApiKey(ctx, key.ID, usecase.now()); err != nil {
+			return nil, err
+		}
+	}
+
+	return &domain.ApiKeyIdentity{
+		KeyID:          key.ID,
+		UserID:         user.ID,
+		OrganizationID: key.OrganizationID,
+		RoleID:         roleID,
+		Scopes:         key.Scopes,
+	}, nil
+}
@@ -0,0 +1,226 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+// Test CreateApiKey
+func TestAuthUsecase_CreateApiKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         domain.CreateApiKeyInput
+		wantExpiresAt time.Time
+		wantErr       error
+	}{
+		{
+			name:          "success - default expiry",
+			input:         domain.CreateApiKeyInput{UserID: "user-123", Name: "ci", Scopes: []string{domain.ScopeUsersRead}},
+			wantExpiresAt: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "success - expiry capped",
+			input:         domain.CreateApiKeyInput{UserID: "user-123", Name: "ci", Scopes: []string{domain.ScopeUsersRead}, ExpiresInDays: 1000},
+			wantExpiresAt: time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "failure - no scopes",
+			input:   domain.CreateApiKeyInput{UserID: "user-123", Name: "ci"},
+			wantErr: domain.ErrInvalidScope,
+		},
+		{
+			name:    "failure - unknown scope",
+			input:   domain.CreateApiKeyInput{UserID: "user-123", Name: "ci", Scopes: []string{"admin"}},
+			wantErr: domain.ErrInvalidScope,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, _, _, _, _ := setupTestUsecase()
+
+			result, err := uc.CreateApiKey(context.Background(), tt.input)
+			if err != tt.wantErr {
+				t.Fatalf("CreateApiKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if result.Key != "pat_random-token" {
+				t.Errorf("CreateApiKey() key = %q", result.Key)
+			}
+
+			stored := repo.apiKeys["test-uuid-123"]
+			if stored == nil || stored.KeyHash != "sha256-pat_random-token" || stored.Prefix != "pat_random-t" {
+				t.Fatalf("CreateApiKey() stored key = %+v", stored)
+			}
+			if !stored.ExpiresAt.Equal(tt.wantExpiresAt) {
+				t.Errorf("CreateApiKey() expires at = %v, want %v", stored.ExpiresAt, tt.wantExpiresAt)
+			}
+		})
+	}
+}
+
+var errDatabaseDown = errors.New("database down")
+
+// Test AuthenticateApiKey
+func TestAuthUsecase_AuthenticateApiKey(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	revokedAt := now.Add(-time.Hour)
+	recentlyUsed := now.Add(-10 * time.Second)
+
+	tests := []struct {
+		name         string
+		key          string
+		apiKey       *domain.ApiKey
+		findUser     func(id string) (*domain.User, error)
+		wantErr      error
+		wantLastUsed time.Time
+	}{
+		{
+			name:         "success - valid key",
+			key:          "pat_secret",
+			apiKey:       &domain.ApiKey{ID: "key-1", UserID: "user-123", KeyHash: "sha256-pat_secret", Scopes: []string{domain.ScopeUsersRead}, ExpiresAt: now.Add(time.Hour)},
+			wantLastUsed: now,
+		},
+		{
+			name:         "success - last used not rewritten within a minute",
+			key:          "pat_secret",
+			apiKey:       &domain.ApiKey{ID: "key-1", UserID: "user-123", KeyHash: "sha256-pat_secret", ExpiresAt: now.Add(time.Hour), LastUsedAt: &recentlyUsed},
+			wantLastUsed: recentlyUsed,
+		},
+		{
+			name:    "failure - wrong prefix",
+			key:     "secret",
+			wantErr: domain.ErrInvalidApiKey,
+		},
+		{
+			name:    "failure - unknown key",
+			key:     "pat_unknown",
+			wantErr: domain.ErrInvalidApiKey,
+		},
+		{
+			name:    "failure - expired",
+			key:     "pat_secret",
+			apiKey:  &domain.ApiKey{ID: "key-1", UserID: "user-123", KeyHash: "sha256-pat_secret", ExpiresAt: now},
+			wantErr: domain.ErrInvalidApiKey,
+		},
+		{
+			name:    "failure - revoked",
+			key:     "pat_secret",
+			apiKey:  &domain.ApiKey{ID: "key-1", UserID: "user-123", KeyHash: "sha256-pat_secret", ExpiresAt: now.Add(time.Hour), RevokedAt: &revokedAt},
+			wantErr: domain.ErrInvalidApiKey,
+		},
+		{
+			name:   "failure - owner suspended",
+			key:    "pat_secret",
+			apiKey: &domain.ApiKey{ID: "key-1", UserID: "user-123", KeyHash: "sha256-pat_secret", ExpiresAt: now.Add(time.Hour)},
+			findUser: func(id string) (*domain.User, error) {
+				return &domain.User{ID: id, Status: domain.UserStatusSuspended}, nil
+			},
+			wantErr: domain.ErrInvalidApiKey,
+		},
+		{
+			name:   "failure - owner deleted",
+			key:    "pat_secret",
+			apiKey: &domain.ApiKey{ID: "key-1", UserID: "user-123", KeyHash: "sha256-pat_secret", ExpiresAt: now.Add(time.Hour)},
+			findUser: func(id string) (*domain.User, error) {
+				return nil, sql.ErrNoRows
+			},
+			wantErr: domain.ErrInvalidApiKey,
+		},
+		{
+			name:   "failure - owner lookup fails",
+			key:    "pat_secret",
+			apiKey: &domain.ApiKey{ID: "key-1", UserID: "user-123", KeyHash: "sha256-pat_secret", ExpiresAt: now.Add(time.Hour)},
+			findUser: func(id string) (*domain.User, error) {
+				return nil, errDatabaseDown
+			},
+			wantErr: errDatabaseDown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, _, _, _, _ := setupTestUsecase()
+			repo.users["user-123"] = &domain.User{ID: "user-123", RoleID: string(domain.RoleIDUser)}
+			if tt.apiKey != nil {
+				repo.apiKeys = map[string]*domain.ApiKey{tt.apiKey.ID: tt.apiKey}
+			}
+			repo.findUserByID = tt.findUser
+
+			identity, err := uc.AuthenticateApiKey(context.Background(), tt.key)
+			if err != tt.wantErr {
+				t.Fatalf("AuthenticateApiKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if identity.UserID != "user-123" || identity.RoleID != string(domain.RoleIDUser) || identity.KeyID != "key-1" {
+				t.Errorf("AuthenticateApiKey() identity = %+v", identity)
+			}
+			if tt.apiKey.LastUsedAt == nil || !tt.apiKey.LastUsedAt.Equal(tt.wantLastUsed) {
+				t.Errorf("AuthenticateApiKey() last used = %v, want %v", tt.apiKey.LastUsedAt, tt.wantLastUsed)
+			}
+		})
+	}
+}
+
+// Test a key bound to an organization acts with the owner's role there until they leave it
+func TestAuthUsecase_AuthenticateApiKey_Organization(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	repo.users["user-123"] = &domain.User{ID: "user-123", RoleID: string(domain.RoleIDUser)}
+	repo.organizationMembers = []*domain.OrganizationMember{{OrganizationID: "org-acme", UserID: "user-123", RoleID: "role-org-admin"}}
+
+	created, err := uc.CreateApiKey(context.Background(), domain.CreateApiKeyInput{UserID: "user-123", OrganizationID: "org-acme", Name: "ci", Scopes: []string{domain.ScopeUsersRead}})
+	if err != nil {
+		t.Fatalf("CreateApiKey() error = %v", err)
+	}
+	if created.ApiKey.OrganizationID != "org-acme" {
+		t.Fatalf("CreateApiKey() organization = %q, want org-acme", created.ApiKey.OrganizationID)
+	}
+
+	identity, err := uc.AuthenticateApiKey(context.Background(), created.Key)
+	if err != nil {
+		t.Fatalf("AuthenticateApiKey() error = %v", err)
+	}
+	if identity.OrganizationID != "org-acme" || identity.RoleID != "role-org-admin" {
+		t.Errorf("AuthenticateApiKey() identity = %+v", identity)
+	}
+
+	repo.organizationMembers = nil
+	if _, err := uc.AuthenticateApiKey(context.Background(), created.Key); err != domain.ErrInvalidApiKey {
+		t.Errorf("AuthenticateApiKey() after leaving error = %v, want %v", err, domain.ErrInvalidApiKey)
+	}
+}
+
+// Test RevokeApiKey only revokes the caller's own keys
+func TestAuthUsecase_RevokeApiKey(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	repo.apiKeys = map[string]*domain.ApiKey{
+		"key-1": {ID: "key-1", UserID: "user-123"},
+		"key-2": {ID: "key-2", UserID: "user-456"},
+	}
+
+	if err := uc.RevokeApiKey(context.Background(), "user-123", "key-2"); err != domain.ErrApiKeyNotFound {
+		t.Errorf("RevokeApiKey() other user's key error = %v, want %v", err, domain.ErrApiKeyNotFound)
+	}
+	if err := uc.RevokeApiKey(context.Background(), "user-123", "key-1"); err != nil {
+		t.Fatalf("RevokeApiKey() error = %v", err)
+	}
+	if repo.apiKeys["key-1"].RevokedAt == nil {
+		t.Errorf("RevokeApiKey() did not revoke the key")
+	}
+
+	keys, _ := uc.ListApiKeys(context.Background(), "user-123")
+	if len(keys) != 0 {
+		t.Errorf("ListApiKeys() returned %d keys after revoke, want 0", len(keys))
+	}
+}
@@ -10,17 +10,39 @@ import (
 )
 
 type TokenService interface {
-	GenerateAccessToken(userID, role string) (string, error)
+	GenerateAccessToken(claims domain.AccessTokenClaims) (string, error)
+	GenerateServiceToken(claims domain.ServiceTokenClaims) (token string, expiresIn time.Duration, err error)
 	GenerateRefreshToken() (plain string, hash string, err error)
+	PublicKeys() []domain.JSONWebKey
 }
 
 type PasswordHasher interface {
 	HashPassword(password string) (string, error)
 	VerifyPassword(password, hash string) bool
+	// NeedsRehash reports hashes made with an outdated algorithm or parameters
+	NeedsRehash(hash string) bool
 	GenerateRandomToken() (string, error)
 	HashToken(token string) string
 }
 
+type OTPService interface {
+	GenerateSecret() (string, error)
+	ProvisioningURI(secret, account string) string
+	Validate(secret, code string, t time.Time) (step int64, ok bool)
+}
+
+// AccessTokenRevoker invalidates access tokens before they expire
+type AccessTokenRevoker interface {
+	RevokeAccessToken(ctx context.Context, jti, userID string, expiresAt time.Time) error
+	RevokeSessionAccessTokens(ctx context.Context, sessionID, userID string, expiresAt time.Time) error
+	RevokeUserAccessTokens(ctx context.Context, userID string, before time.Time) error
+}
+
+// PasswordPolicy reports every rule a new password breaks
+type PasswordPolicy interface {
+	Validate(password string, owner domain.PasswordOwner) []domain.PasswordViolation
+}
+
 type AuthUsecase struct {
 	repository     domain.AuthRepository
 	token          TokenService
@@ -28,6 +50,21 @@ type AuthUsecase struct {
 	passwordHasher PasswordHasher
 	uuid           helper.UUIDGeneratorInterface
 	eventPub       *event.Publisher
+	otp            OTPService
+	tokenRevoker   AccessTokenRevoker
+	passwordPolicy PasswordPolicy
+
+	// Login refuses accounts whose email address was not verified yet
+	requireEmailVerification bool
+	loginThrottle            domain.LoginThrottlePolicy
+	// A new password must differ from this many previous ones, the current included
+	passwordHistorySize int
+	// Calling code for phone numbers entered in national format
+	phoneDefaultCountryCode string
+	// OpenID Connect providers by name
+	oidcProviders map[string]OidcProvider
+	// Passkeys are unavailable while nil
+	webauthn WebauthnRelyingParty
 }
 
 func NewAuthUsecase(repository domain.AuthRepository, pub *event.Publisher) *AuthUsecase {
@@ -53,6 +90,32 @@ func (usecase *AuthUsecase) SetNowFunc(now func() time.Time) {
 	usecase.now = now
 }
 
+func (usecase *AuthUsecase) SetOTPService(otp OTPService) {
+	usecase.otp = otp
+}
+
+// GetJwks returns the public keys that verify our access tokens
+func (usecase *AuthUsecase) GetJwks(ctx context.Context) []domain.JSONWebKey {
+	return usecase.token.PublicKeys()
+}
+
+func (usecase *AuthUsecase) SetAccessTokenRevoker(revoker AccessTokenRevoker) {
+	usecase.tokenRevoker = revoker
+}
+
+func (usecase *AuthUsecase) SetRequireEmailVerification(required bool) {
+	usecase.requireEmailVerification = required
+}
+
+func (usecase *AuthUsecase) SetPasswordPolicy(policy PasswordPolicy, historySize int) {
+	usecase.passwordPolicy = policy
+	usecase.passwordHistorySize = historySize
+}
+
+func (usecase *AuthUsecase) SetLoginThrottlePolicy(policy domain.LoginThrottlePolicy) {
+	usecase.loginThrottle = policy
+}
+
 func (usecase *AuthUsecase) Register(ctx context.Context, req domain.RegisterInput) error {
 
 	isExists, _ := usecase.repository.FindUserByEmail(ctx, req.Email)
@@ -65,6 +128,33 @@ func (usecase *AuthUsecase) Register(ctx context.Context, req domain.RegisterInp
 		return domain.ErrPasswordNotMatch
 	}
 
+	if err := usecase.checkPassword(ctx, req.Password, &domain.User{Name: req.Name, Email: req.Email}); err != nil {
+		return err
+	}
+
+	var phone string
+
+	if req.Phone != "" {
+		normalized, err := usecase.normalizePhone(req.Phone)
+
+		if err != nil {
+			return err
+		}
+
+		// Only a verified number belongs to someone, an unverified one is just a claim
+		owner, err := usecase.repository.FindUserByVerifiedPhone(ctx, normalized)
+
+		if err != nil {
+			return err
+		}
+
+		if owner != nil {
+			return domain.ErrPhoneAlreadyUsed
+		}
+
+		phone = normalized
+	}
+
 	hash, err := usecase.passwordHasher.HashPassword(req.Password)
 
 	if err != nil {
@@ -75,23 +165,126 @@ func (usecase *AuthUsecase) Register(ctx context.Context, req domain.RegisterInp
 		ID:           usecase.uuid.GenerateID(),
 		Name:         req.Name,
 		Email:        req.Email,
+		Phone:        phone,
 		PasswordHash: hash,
 		RoleID:       string(domain.RoleIDUser), // default: user role
 		CreatedAt:    usecase.now(),
 		UpdatedAt:    usecase.now(),
 	}
 
-	return usecase.repository.CreateUser(ctx, user)
+	if err := usecase.repository.CreateUser(ctx, user); err != nil {
+		return err
+	}
+
+	token, expiredAt, err := usecase.createEmailVerification(ctx, user)
+
+	if err != nil {
+		return err
+	}
+
+	_ = usecase.eventPub.UserRegistered(event.UserRegisteredEvent{
+		UserID:    user.ID,
+		Name:      user.Name,
+		Email:     user.Email,
+		Token:     token,
+		ExpiredAt: expiredAt,
+	})
+
+	return nil
 }
 
 func (usecase *AuthUsecase) Login(ctx context.Context, req domain.LoginInput) (*domain.AuthOutput, error) {
+	if err := usecase.checkIPThrottle(ctx, req.Client.IPAddress); err != nil {
+		return nil, err
+	}
+
 	user, err := usecase.repository.FindUserByEmail(ctx, req.Email)
 
-	if err != nil || user == nil || !usecase.passwordHasher.VerifyPassword(req.Password, user.PasswordHash) {
-		return nil, domain.ErrInvalidCredentials
+	// Invited users have no password until they accept
+	if err != nil || user == nil || user.Status == domain.UserStatusPending {
+		return nil, usecase.recordFailedLogin(ctx, nil, req.Client)
+	}
+
+	lockout, err := usecase.checkAccountLockout(ctx, user.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !usecase.passwordHasher.VerifyPassword(req.Password, user.PasswordHash) {
+		return nil, usecase.recordFailedLogin(ctx, user, req.Client)
+	}
+
+	usecase.upgradePasswordHash(ctx, user, req.Password)
+
+	if usecase.requireEmailVerification && user.EmailVerifiedAt == nil {
+		return nil, domain.ErrEmailNotVerified
+	}
+
+	methods, err := usecase.mfaMethods(ctx, user.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Failed attempts stand until the second factor passes too
+	if len(methods) > 0 {
+		return usecase.createMfaChallenge(ctx, user, methods)
+	}
+
+	if lockout != nil {
+		if err := usecase.repository.DeleteLoginLockout(ctx, user.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return usecase.issueTokens(ctx, user, req.Client)
+}
+
+// issueTokens creates a new access/refresh token pair for an authenticated user, starting a new token family (session)
+// in the user's default organization, and records the device the session was opened from
+func (usecase *AuthUsecase) issueTokens(ctx context.Context, user *domain.User, client domain.ClientInfo) (*domain.AuthOutput, error) {
+	member, err := usecase.repository.FindDefaultOrganizationMember(ctx, user.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := usecase.issueTokensInFamily(ctx, user, member, client, "", "", usecase.now())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := usecase.recordDevice(ctx, user, client, result.SessionID); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// issueTokensInFamily rotates into an existing family when familyID is set, linking the new token to its parent.
+// With a membership the tokens act in its organization, carrying the user's role there.
+// authTime is when the user last proved their credentials for the session.
+func (usecase *AuthUsecase) issueTokensInFamily(ctx context.Context, user *domain.User, member *domain.OrganizationMember, client domain.ClientInfo, familyID, parentID string, authTime time.Time) (*domain.AuthOutput, error) {
+	if err := requireActiveUser(user); err != nil {
+		return nil, err
 	}
 
-	accessToken, err := usecase.token.GenerateAccessToken(user.ID, user.RoleID)
+	id := usecase.uuid.GenerateID()
+	if familyID == "" {
+		familyID = id
+	}
+
+	role, organizationID := memberRole(user, member)
+
+	accessToken, err := usecase.token.GenerateAccessToken(domain.AccessTokenClaims{
+		UserID:         user.ID,
+		Role:           role,
+		SessionID:      familyID,
+		OrganizationID: organizationID,
+		AuthTime:       authTime,
+	})
 
 	if err != nil {
 		return nil, err
@@ -104,63 +297,159 @@ func (usecase *AuthUsecase) Login(ctx context.Context, req domain.LoginInput) (*
 	}
 
 	if err := usecase.repository.StoreRefreshToken(ctx, &domain.RefreshToken{
-		ID:        usecase.uuid.GenerateID(),
-		UserID:    user.ID,
-		TokenHash: refreshTokenHash,
-		ExpiresAt: usecase.now().Add(time.Hour * 24 * 7),
-		CreatedAt: usecase.now(),
-		UpdatedAt: usecase.now(),
+		ID:              id,
+		UserID:          user.ID,
+		FamilyID:        familyID,
+		ParentID:        parentID,
+		TokenHash:       refreshTokenHash,
+		UserAgent:       client.UserAgent,
+		IPAddress:       client.IPAddress,
+		OrganizationID:  organizationID,
+		AuthenticatedAt: authTime,
+		ExpiresAt:       usecase.now().Add(time.Hour * 24 * 7),
+		LastUsedAt:      usecase.now(),
+		CreatedAt:       usecase.now(),
+		UpdatedAt:       usecase.now(),
 	}); err != nil {
 		return nil, err
 	}
 
 	return &domain.AuthOutput{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		AccessToken:    accessToken,
+		RefreshToken:   refreshToken,
+		SessionID:      familyID,
+		OrganizationID: organizationID,
 	}, nil
 }
 
-func (usecase *AuthUsecase) RefreshToken(ctx context.Context, token string) (*domain.AuthOutput, error) {
+// memberRole returns the role and organization tokens act with: the user's role
+// in the organization when they are a member of one, their own role otherwise
+func memberRole(user *domain.User, member *domain.OrganizationMember) (role, organizationID string) {
+	if member != nil {
+		return member.RoleID, member.OrganizationID
+	}
+
+	return user.RoleID, ""
+}
+
+// UserRole returns the user's own role, which global RPCs authorize with
+// whatever organization the token acts in
+func (usecase *AuthUsecase) UserRole(ctx context.Context, userID string) (string, error) {
+	user, err := usecase.repository.FindUserByID(ctx, userID)
+
+	if err != nil {
+		return "", err
+	}
+
+	if user == nil {
+		return "", domain.ErrUserNotFound
+	}
+
+	return user.RoleID, nil
+}
+
+// requireActiveUser refuses sessions to users an admin suspended or deactivated
+func requireActiveUser(user *domain.User) error {
+	switch user.Status {
+	case domain.UserStatusPending:
+		return domain.ErrInvalidCredentials
+	case domain.UserStatusSuspended:
+		return domain.ErrAccountSuspended
+	case domain.UserStatusDeactivated:
+		return domain.ErrAccountDeactivated
+	}
+
+	return nil
+}
+
+func (usecase *AuthUsecase) RefreshToken(ctx context.Context, token string, client domain.ClientInfo) (*domain.AuthOutput, error) {
 	hashedToken := usecase.passwordHasher.HashToken(token)
-	refreshToken, err := usecase.repository.FindValidRefreshToken(ctx, hashedToken)
+	refreshToken, err := usecase.repository.FindRefreshTokenByHash(ctx, hashedToken)
 
 	if err != nil || refreshToken == nil {
 		return nil, domain.ErrInvalidToken
 	}
 
-	usecase.repository.RevokeRefreshToken(ctx, refreshToken.TokenHash)
+	if refreshToken.Revoked {
+		if refreshToken.RevokedReason == domain.RefreshTokenRotated {
+			return nil, usecase.refreshTokenReused(ctx, refreshToken)
+		}
+
+		// Logged out, or ended by a password reset or from another session: the
+		// session is over, nobody stole it
+		return nil, domain.ErrInvalidToken
+	}
+
+	if refreshToken.ExpiresAt.Before(usecase.now()) {
+		return nil, domain.ErrTokenExpired
+	}
+
+	user, err := usecase.repository.FindUserByID(ctx, refreshToken.UserID)
+
+	if err != nil || user == nil {
+		return nil, domain.ErrInvalidToken
+	}
 
-	accessToken, err := usecase.token.GenerateAccessToken(refreshToken.UserID, "")
+	if err := requireActiveUser(user); err != nil {
+		return nil, err
+	}
 
+	// Of two refreshes racing with the same token only one rotates it
+	rotated, err := usecase.repository.RevokeRefreshToken(ctx, refreshToken.TokenHash, domain.RefreshTokenRotated)
 	if err != nil {
 		return nil, err
 	}
 
-	plain, hashNew, err := usecase.token.GenerateRefreshToken()
+	if !rotated {
+		return nil, usecase.refreshTokenReused(ctx, refreshToken)
+	}
+
+	// Keep the device details of the session when the caller doesn't send them
+	if client.UserAgent == "" {
+		client.UserAgent = refreshToken.UserAgent
+	}
+	if client.IPAddress == "" {
+		client.IPAddress = refreshToken.IPAddress
+	}
+
+	member, err := usecase.sessionMember(ctx, user.ID, refreshToken.OrganizationID)
 
 	if err != nil {
 		return nil, err
 	}
 
-	usecase.repository.StoreRefreshToken(ctx, &domain.RefreshToken{
-		ID:        usecase.uuid.GenerateID(),
-		UserID:    refreshToken.UserID,
-		TokenHash: hashNew,
-		ExpiresAt: usecase.now().Add(time.Hour * 24 * 7),
-		CreatedAt: usecase.now(),
-		UpdatedAt: usecase.now(),
+	return usecase.issueTokensInFamily(ctx, user, member, client, refreshToken.FamilyID, refreshToken.ID, refreshToken.AuthenticatedAt)
+}
+
+// refreshTokenReused handles a rotated token being presented again: someone
+// still holds a copy, so every token descended from the same login is killed.
+func (usecase *AuthUsecase) refreshTokenReused(ctx context.Context, refreshToken *domain.RefreshToken) error {
+	// Suspension revokes every session of the user; that is not a stolen copy
+	if user, err := usecase.repository.FindUserByID(ctx, refreshToken.UserID); err == nil && user != nil {
+		if err := requireActiveUser(user); err != nil {
+			return err
+		}
+	}
+
+	if err := usecase.repository.RevokeRefreshTokenFamily(ctx, refreshToken.FamilyID); err != nil {
+		return err
+	}
+
+	_ = usecase.eventPub.RefreshTokenReused(event.RefreshTokenReusedEvent{
+		UserID:     refreshToken.UserID,
+		FamilyID:   refreshToken.FamilyID,
+		TokenID:    refreshToken.ID,
+		DetectedAt: usecase.now(),
 	})
 
-	return &domain.AuthOutput{
-		AccessToken:  accessToken,
-		RefreshToken: plain,
-	}, nil
+	return domain.ErrRefreshTokenReused
 }
 
 func (usecase *AuthUsecase) ForgotPassword(ctx context.Context, email string) error {
 	user, err := usecase.repository.FindUserByEmail(ctx, email)
 
-	if err != nil || user == nil {
+	// A pending user sets their first password through the invitation
+	if err != nil || user == nil || user.Status == domain.UserStatusPending {
 		return domain.ErrUserNotFound
 	}
 
@@ -217,27 +506,58 @@ func (usecase *AuthUsecase) ResetPassword(ctx context.Context, req domain.ResetP
 		return domain.ErrUserNotFound
 	}
 
+	if err := usecase.checkPassword(ctx, req.NewPassword, user); err != nil {
+		return err
+	}
+
 	hashedPassword, err := usecase.passwordHasher.HashPassword(req.NewPassword)
 
 	if err != nil {
 		return err
 	}
 
+	previousHash := user.PasswordHash
 	user.PasswordHash = hashedPassword
 
-	usecase.repository.UpdateUserPassword(ctx, user.ID, hashedPassword)
-	usecase.repository.MarkPasswordResetUsed(ctx, user.ID)
-	usecase.repository.RevokeRefreshToken(ctx, passwordReset.TokenHash)
+	if err := usecase.repository.UpdateUserPassword(ctx, user.ID, hashedPassword); err != nil {
+		return err
+	}
 
-	return nil
+	if err := usecase.rememberPassword(ctx, user.ID, previousHash); err != nil {
+		return err
+	}
+
+	if err := usecase.repository.MarkPasswordResetUsed(ctx, passwordReset.ID); err != nil {
+		return err
+	}
+
+	// Whoever knew the old password must lose access: end all sessions and outstanding access tokens
+	if err := usecase.repository.RevokeAllRefreshTokens(ctx, user.ID); err != nil {
+		return err
+	}
+
+	return usecase.tokenRevoker.RevokeUserAccessTokens(ctx, user.ID, usecase.now())
 }
 
-func (usecase *AuthUsecase) Logout(ctx context.Context, refreshToken string) error {
-	hashedToken := usecase.passwordHasher.HashToken(refreshToken)
+func (usecase *AuthUsecase) Logout(ctx context.Context, req domain.LogoutInput) error {
+	hashedToken := usecase.passwordHasher.HashToken(req.RefreshToken)
 	token, err := usecase.repository.FindValidRefreshToken(ctx, hashedToken)
 	if err != nil || token == nil {
 		return domain.ErrInvalidToken
 	}
 
-	return usecase.repository.RevokeRefreshToken(ctx, hashedToken)
+	revoked, err := usecase.repository.RevokeRefreshToken(ctx, hashedToken, domain.RefreshTokenLoggedOut)
+	if err != nil {
+		return err
+	}
+
+	if !revoked {
+		return domain.ErrInvalidToken
+	}
+
+	if req.AccessTokenID == "" {
+		return nil
+	}
+
+	return usecase.tokenRevoker.RevokeAccessToken(ctx, req.AccessTokenID, token.UserID, req.AccessTokenExpiresAt)
 }
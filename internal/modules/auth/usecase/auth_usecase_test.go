@@ -2,7 +2,9 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,12 +17,35 @@ type mockAuthRepository struct {
 	users                  map[string]*domain.User
 	refreshTokens          map[string]*domain.RefreshToken
 	passwordResets         map[string]*domain.PasswordReset
+	emailVerifications     map[string]*domain.EmailVerification
+	userMfa                map[string]*domain.UserMfa
+	recoveryCodes          map[string]*domain.MfaRecoveryCode
+	mfaChallenges          map[string]*domain.MfaChallenge
+	revokedTokens          map[string]*domain.RevokedToken
+	revokedSessions        map[string]*domain.RevokedSession
+	tokensValidAfter       map[string]time.Time
+	apiKeys                map[string]*domain.ApiKey
+	serviceClients         map[string]*domain.ServiceClient
+	loginLockouts          map[string]*domain.LoginLockout
+	loginIPFailures        map[string]*mockIPFailures
+	passwordHistory        map[string][]*domain.PasswordHistory
+	organizationMembers    []*domain.OrganizationMember
+	invitations            map[string]*domain.UserInvitation
+	magicLinks             map[string]*domain.MagicLink
+	phoneOtps              []*domain.PhoneOtp
+	oidcStates             map[string]*domain.OidcState
+	webauthnChallenges     map[string]*domain.WebauthnChallenge
+	webauthnCredentials    []*domain.WebauthnCredential
+	userDevices            []*domain.UserDevice
+	identities             []*domain.UserIdentity
+	auditLogs              []*domain.AuditLog
 	findUserByEmail        func(email string) (*domain.User, error)
 	findUserByID           func(id string) (*domain.User, error)
 	createUser             func(user *domain.User) error
 	storeRefreshToken      func(token *domain.RefreshToken) error
 	findValidRefreshToken  func(tokenHash string) (*domain.RefreshToken, error)
-	revokeRefreshToken     func(tokenHash string) error
+	findRefreshTokenByHash func(tokenHash string) (*domain.RefreshToken, error)
+	revokeRefreshToken     func(tokenHash string, reason domain.RefreshTokenRevokeReason) (bool, error)
 	storePasswordReset     func(pr *domain.PasswordReset) error
 	findValidPasswordReset func(tokenHash string) (*domain.PasswordReset, error)
 	markPasswordResetUsed  func(id string) error
@@ -43,11 +68,11 @@ func (m *mockAuthRepository) FindUserByEmail(ctx context.Context, email string)
 		return m.findUserByEmail(email)
 	}
 	for _, u := range m.users {
-		if u.Email == email {
+		if strings.EqualFold(u.Email, email) {
 			return u, nil
 		}
 	}
-	return nil, errors.New("user not found")
+	return nil, sql.ErrNoRows
 }
 
 func (m *mockAuthRepository) FindUserByID(ctx context.Context, id string) (*domain.User, error) {
@@ -81,22 +106,343 @@ func (m *mockAuthRepository) FindValidRefreshToken(ctx context.Context, tokenHas
 	return nil, errors.New("token not found")
 }
 
-func (m *mockAuthRepository) RevokeRefreshToken(ctx context.Context, tokenHash string) error {
-	if m.revokeRefreshToken != nil {
-		return m.revokeRefreshToken(tokenHash)
+func (m *mockAuthRepository) FindRefreshTokenByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	if m.findRefreshTokenByHash != nil {
+		return m.findRefreshTokenByHash(tokenHash)
 	}
 	if t, ok := m.refreshTokens[tokenHash]; ok {
-		t.Revoked = true
+		return t, nil
+	}
+	return nil, nil
+}
+
+func (m *mockAuthRepository) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	for _, t := range m.refreshTokens {
+		if t.FamilyID == familyID && !t.Revoked {
+			t.Revoked, t.RevokedReason = true, domain.RefreshTokenEnded
+		}
+	}
+	return nil
+}
+
+func (m *mockAuthRepository) RevokeRefreshToken(ctx context.Context, tokenHash string, reason domain.RefreshTokenRevokeReason) (bool, error) {
+	if m.revokeRefreshToken != nil {
+		return m.revokeRefreshToken(tokenHash, reason)
+	}
+	t, ok := m.refreshTokens[tokenHash]
+	if !ok || t.Revoked {
+		return false, nil
+	}
+	t.Revoked, t.RevokedReason = true, reason
+	return true, nil
+}
+
+func (m *mockAuthRepository) ListActiveSessions(ctx context.Context, userID string) ([]domain.Session, error) {
+	var sessions []domain.Session
+	for _, t := range m.refreshTokens {
+		if t.UserID == userID && !t.Revoked {
+			sessions = append(sessions, domain.Session{
+				ID:         t.FamilyID,
+				UserAgent:  t.UserAgent,
+				IPAddress:  t.IPAddress,
+				LastUsedAt: t.LastUsedAt,
+				ExpiresAt:  t.ExpiresAt,
+			})
+		}
+	}
+	return sessions, nil
+}
+
+func (m *mockAuthRepository) RevokeSession(ctx context.Context, userID, sessionID string) (bool, error) {
+	revoked := false
+	for _, t := range m.refreshTokens {
+		if t.UserID == userID && t.FamilyID == sessionID && !t.Revoked {
+			t.Revoked, t.RevokedReason = true, domain.RefreshTokenEnded
+			revoked = true
+		}
+	}
+	return revoked, nil
+}
+
+func (m *mockAuthRepository) FindUserDevice(ctx context.Context, userID, fingerprint string) (*domain.UserDevice, error) {
+	for _, device := range m.userDevices {
+		if device.UserID == userID && device.Fingerprint == fingerprint {
+			return device, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockAuthRepository) CountUserDevices(ctx context.Context, userID string) (int, error) {
+	count := 0
+	for _, device := range m.userDevices {
+		if device.UserID == userID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *mockAuthRepository) StoreUserDevice(ctx context.Context, device *domain.UserDevice) (bool, error) {
+	if known, _ := m.FindUserDevice(ctx, device.UserID, device.Fingerprint); known != nil {
+		return false, nil
+	}
+	m.userDevices = append(m.userDevices, device)
+	return true, nil
+}
+
+func (m *mockAuthRepository) TouchUserDevice(ctx context.Context, id, ipAddress string, seenAt time.Time) error {
+	for _, device := range m.userDevices {
+		if device.ID == id {
+			device.IPAddress = ipAddress
+			device.LastSeenAt = seenAt
+		}
 	}
 	return nil
 }
 
+func (m *mockAuthRepository) FindUserDeviceByRevokeToken(ctx context.Context, tokenHash string) (*domain.UserDevice, error) {
+	for _, device := range m.userDevices {
+		if device.RevokeTokenHash != "" && device.RevokeTokenHash == tokenHash {
+			return device, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockAuthRepository) DeleteUserDevice(ctx context.Context, id string) (bool, error) {
+	for i, device := range m.userDevices {
+		if device.ID == id {
+			m.userDevices = append(m.userDevices[:i], m.userDevices[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (m *mockAuthRepository) RevokeAllRefreshTokens(ctx context.Context, userID string) error {
 	for _, t := range m.refreshTokens {
-		if t.UserID == userID {
-			t.Revoked = true
+		if t.UserID == userID && !t.Revoked {
+			t.Revoked, t.RevokedReason = true, domain.RefreshTokenEnded
+		}
+	}
+	return nil
+}
+
+func (m *mockAuthRepository) StoreRevokedToken(ctx context.Context, token *domain.RevokedToken) error {
+	if m.revokedTokens == nil {
+		m.revokedTokens = make(map[string]*domain.RevokedToken)
+	}
+	m.revokedTokens[token.JTI] = token
+	return nil
+}
+
+func (m *mockAuthRepository) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	_, ok := m.revokedTokens[jti]
+	return ok, nil
+}
+
+func (m *mockAuthRepository) DeleteExpiredRevokedTokens(ctx context.Context) error {
+	return nil
+}
+
+func (m *mockAuthRepository) StoreRevokedSession(ctx context.Context, session *domain.RevokedSession) error {
+	if m.revokedSessions == nil {
+		m.revokedSessions = make(map[string]*domain.RevokedSession)
+	}
+	m.revokedSessions[session.SessionID] = session
+	return nil
+}
+
+func (m *mockAuthRepository) IsSessionRevoked(ctx context.Context, sessionID string) (bool, error) {
+	_, ok := m.revokedSessions[sessionID]
+	return ok, nil
+}
+
+func (m *mockAuthRepository) DeleteExpiredRevokedSessions(ctx context.Context) error {
+	return nil
+}
+
+func (m *mockAuthRepository) SetTokensValidAfter(ctx context.Context, userID string, validAfter time.Time) error {
+	if m.tokensValidAfter == nil {
+		m.tokensValidAfter = make(map[string]time.Time)
+	}
+	m.tokensValidAfter[userID] = validAfter
+	return nil
+}
+
+func (m *mockAuthRepository) FindTokensValidAfter(ctx context.Context, userID string) (time.Time, bool, error) {
+	_, exists := m.users[userID]
+	return m.tokensValidAfter[userID], exists, nil
+}
+
+func (m *mockAuthRepository) SetOrganizationTokensValidAfter(ctx context.Context, userID, organizationID string, validAfter time.Time) error {
+	return nil
+}
+
+func (m *mockAuthRepository) FindOrganizationTokensValidAfter(ctx context.Context, userID, organizationID string) (time.Time, error) {
+	return time.Time{}, nil
+}
+
+func (m *mockAuthRepository) StoreApiKey(ctx context.Context, key *domain.ApiKey) error {
+	if m.apiKeys == nil {
+		m.apiKeys = make(map[string]*domain.ApiKey)
+	}
+	m.apiKeys[key.ID] = key
+	return nil
+}
+
+func (m *mockAuthRepository) FindApiKeyByHash(ctx context.Context, keyHash string) (*domain.ApiKey, error) {
+	for _, key := range m.apiKeys {
+		if key.KeyHash == keyHash {
+			return key, nil
 		}
 	}
+	return nil, nil
+}
+
+func (m *mockAuthRepository) ListApiKeys(ctx context.Context, userID string) ([]domain.ApiKey, error) {
+	var result []domain.ApiKey
+	for _, key := range m.apiKeys {
+		if key.UserID == userID && key.RevokedAt == nil {
+			result = append(result, *key)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockAuthRepository) RevokeApiKey(ctx context.Context, userID, id string) (bool, error) {
+	key, ok := m.apiKeys[id]
+	if !ok || key.UserID != userID || key.RevokedAt != nil {
+		return false, nil
+	}
+	revokedAt := time.Now()
+	key.RevokedAt = &revokedAt
+	return true, nil
+}
+
+func (m *mockAuthRepository) FindServiceClient(ctx context.Context, clientID string) (*domain.ServiceClient, error) {
+	return m.serviceClients[clientID], nil
+}
+
+func (m *mockAuthRepository) TouchApiKey(ctx context.Context, id string, usedAt time.Time) error {
+	if key, ok := m.apiKeys[id]; ok {
+		key.LastUsedAt = &usedAt
+	}
+	return nil
+}
+
+func (m *mockAuthRepository) ListPasswordHistory(ctx context.Context, userID string, limit int) ([]string, error) {
+	var hashes []string
+	entries := m.passwordHistory[userID]
+	for i := len(entries) - 1; i >= 0 && len(hashes) < limit; i-- {
+		hashes = append(hashes, entries[i].PasswordHash)
+	}
+	return hashes, nil
+}
+
+func (m *mockAuthRepository) StorePasswordHistory(ctx context.Context, entry *domain.PasswordHistory) error {
+	if m.passwordHistory == nil {
+		m.passwordHistory = make(map[string][]*domain.PasswordHistory)
+	}
+	m.passwordHistory[entry.UserID] = append(m.passwordHistory[entry.UserID], entry)
+	return nil
+}
+
+func (m *mockAuthRepository) PrunePasswordHistory(ctx context.Context, userID string, keep int) error {
+	if entries := m.passwordHistory[userID]; len(entries) > keep {
+		m.passwordHistory[userID] = entries[len(entries)-keep:]
+	}
+	return nil
+}
+
+type mockIPFailures struct {
+	attempts        int
+	windowStartedAt time.Time
+}
+
+func (m *mockAuthRepository) FindLoginLockout(ctx context.Context, userID string) (*domain.LoginLockout, error) {
+	return m.loginLockouts[userID], nil
+}
+
+func (m *mockAuthRepository) IncrementFailedLogins(ctx context.Context, userID string, at time.Time) (int, int, error) {
+	if m.loginLockouts == nil {
+		m.loginLockouts = make(map[string]*domain.LoginLockout)
+	}
+	lockout, ok := m.loginLockouts[userID]
+	if !ok {
+		lockout = &domain.LoginLockout{UserID: userID}
+		m.loginLockouts[userID] = lockout
+	}
+	lockout.FailedAttempts++
+	lockout.UpdatedAt = at
+	return lockout.FailedAttempts, lockout.LockoutCount, nil
+}
+
+func (m *mockAuthRepository) LockAccount(ctx context.Context, userID string, until time.Time, at time.Time) error {
+	if lockout, ok := m.loginLockouts[userID]; ok {
+		lockout.LockedUntil = &until
+		lockout.LockoutCount++
+		lockout.FailedAttempts = 0
+		lockout.UpdatedAt = at
+	}
+	return nil
+}
+
+func (m *mockAuthRepository) DeleteLoginLockout(ctx context.Context, userID string) error {
+	delete(m.loginLockouts, userID)
+	return nil
+}
+
+func (m *mockAuthRepository) IncrementLoginIPFailures(ctx context.Context, ipAddress string, at time.Time, windowStart time.Time) (int, time.Time, error) {
+	if m.loginIPFailures == nil {
+		m.loginIPFailures = make(map[string]*mockIPFailures)
+	}
+	failures, ok := m.loginIPFailures[ipAddress]
+	if !ok || !failures.windowStartedAt.After(windowStart) {
+		failures = &mockIPFailures{windowStartedAt: at}
+		m.loginIPFailures[ipAddress] = failures
+	}
+	failures.attempts++
+	return failures.attempts, failures.windowStartedAt, nil
+}
+
+func (m *mockAuthRepository) FindLoginIPFailures(ctx context.Context, ipAddress string, windowStart time.Time) (int, time.Time, error) {
+	failures, ok := m.loginIPFailures[ipAddress]
+	if !ok || !failures.windowStartedAt.After(windowStart) {
+		return 0, time.Time{}, nil
+	}
+	return failures.attempts, failures.windowStartedAt, nil
+}
+
+func (m *mockAuthRepository) StoreEmailVerification(ctx context.Context, verification *domain.EmailVerification) error {
+	if m.emailVerifications == nil {
+		m.emailVerifications = make(map[string]*domain.EmailVerification)
+	}
+	m.emailVerifications[verification.TokenHash] = verification
+	return nil
+}
+
+func (m *mockAuthRepository) FindValidEmailVerification(ctx context.Context, tokenHash string) (*domain.EmailVerification, error) {
+	if v, ok := m.emailVerifications[tokenHash]; ok && !v.Used {
+		return v, nil
+	}
+	return nil, nil
+}
+
+func (m *mockAuthRepository) MarkEmailVerificationUsed(ctx context.Context, id string) error {
+	for _, v := range m.emailVerifications {
+		if v.ID == id {
+			v.Used = true
+		}
+	}
+	return nil
+}
+
+func (m *mockAuthRepository) MarkUserEmailVerified(ctx context.Context, userID string, verifiedAt time.Time) error {
+	if u, ok := m.users[userID]; ok {
+		u.EmailVerifiedAt = &verifiedAt
+	}
 	return nil
 }
 
@@ -126,7 +472,7 @@ func (m *mockAuthRepository) MarkPasswordResetUsed(ctx context.Context, id strin
 		return m.markPasswordResetUsed(id)
 	}
 	for _, pr := range m.passwordResets {
-		if pr.UserID == id {
+		if pr.ID == id {
 			pr.Used = true
 		}
 	}
@@ -143,16 +489,379 @@ func (m *mockAuthRepository) UpdateUserPassword(ctx context.Context, userID, new
 	return nil
 }
 
+func (m *mockAuthRepository) UpgradeUserPasswordHash(ctx context.Context, userID, oldHash, newHash string) (bool, error) {
+	u, ok := m.users[userID]
+	if !ok || u.PasswordHash != oldHash {
+		return false, nil
+	}
+	u.PasswordHash = newHash
+	return true, nil
+}
+
+func (m *mockAuthRepository) FindValidInvitation(ctx context.Context, tokenHash string) (*domain.UserInvitation, error) {
+	if invitation, ok := m.invitations[tokenHash]; ok && !invitation.Used && invitation.ExpiresAt.After(time.Now()) {
+		return invitation, nil
+	}
+	return nil, nil
+}
+
+func (m *mockAuthRepository) AcceptInvitation(ctx context.Context, invitation *domain.UserInvitation, name, passwordHash string, acceptedAt time.Time) error {
+	u, ok := m.users[invitation.UserID]
+	if !ok || u.Status != domain.UserStatusPending {
+		return domain.ErrInvalidToken
+	}
+	u.Name = name
+	u.PasswordHash = passwordHash
+	u.Status = domain.UserStatusActive
+	u.EmailVerifiedAt = &acceptedAt
+	invitation.Used = true
+	return nil
+}
+
+func (m *mockAuthRepository) FindUserByVerifiedPhone(ctx context.Context, phone string) (*domain.User, error) {
+	for _, u := range m.users {
+		if u.Phone == phone && u.PhoneVerifiedAt != nil {
+			return u, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockAuthRepository) StorePhoneOtp(ctx context.Context, otp *domain.PhoneOtp) error {
+	for _, earlier := range m.phoneOtps {
+		if earlier.UserID == otp.UserID && earlier.Purpose == otp.Purpose {
+			earlier.Used = true
+		}
+	}
+	m.phoneOtps = append(m.phoneOtps, otp)
+	return nil
+}
+
+func (m *mockAuthRepository) FindLatestPhoneOtp(ctx context.Context, userID string, purpose domain.PhoneOtpPurpose) (*domain.PhoneOtp, error) {
+	for i := len(m.phoneOtps) - 1; i >= 0; i-- {
+		if otp := m.phoneOtps[i]; otp.UserID == userID && otp.Purpose == purpose && !otp.Used {
+			return otp, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockAuthRepository) IncrementPhoneOtpAttempts(ctx context.Context, id string) (int, error) {
+	for _, otp := range m.phoneOtps {
+		if otp.ID == id && !otp.Used {
+			otp.Attempts++
+			return otp.Attempts, nil
+		}
+	}
+	return 0, nil
+}
+
+func (m *mockAuthRepository) MarkPhoneOtpUsed(ctx context.Context, id string) (bool, error) {
+	for _, otp := range m.phoneOtps {
+		if otp.ID == id && !otp.Used {
+			otp.Used = true
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *mockAuthRepository) MarkUserPhoneVerified(ctx context.Context, userID, phone string, verifiedAt time.Time) error {
+	if u, ok := m.users[userID]; ok {
+		u.Phone = phone
+		u.PhoneVerifiedAt = &verifiedAt
+	}
+	return nil
+}
+
+func (m *mockAuthRepository) StoreMagicLink(ctx context.Context, link *domain.MagicLink) error {
+	if m.magicLinks == nil {
+		m.magicLinks = make(map[string]*domain.MagicLink)
+	}
+	m.magicLinks[link.TokenHash] = link
+	return nil
+}
+
+func (m *mockAuthRepository) FindValidMagicLink(ctx context.Context, tokenHash string) (*domain.MagicLink, error) {
+	if link, ok := m.magicLinks[tokenHash]; ok && !link.Used {
+		return link, nil
+	}
+	return nil, nil
+}
+
+func (m *mockAuthRepository) FindLatestMagicLink(ctx context.Context, userID string) (*domain.MagicLink, error) {
+	var latest *domain.MagicLink
+	for _, link := range m.magicLinks {
+		if link.UserID == userID && (latest == nil || link.CreatedAt.After(latest.CreatedAt)) {
+			latest = link
+		}
+	}
+	return latest, nil
+}
+
+func (m *mockAuthRepository) MarkMagicLinkUsed(ctx context.Context, id string) (bool, error) {
+	for _, link := range m.magicLinks {
+		if link.ID == id && !link.Used {
+			link.Used = true
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *mockAuthRepository) StoreOidcState(ctx context.Context, state *domain.OidcState) error {
+	if m.oidcStates == nil {
+		m.oidcStates = make(map[string]*domain.OidcState)
+	}
+	m.oidcStates[state.StateHash] = state
+	return nil
+}
+
+func (m *mockAuthRepository) FindValidOidcState(ctx context.Context, stateHash string) (*domain.OidcState, error) {
+	if state, ok := m.oidcStates[stateHash]; ok && !state.Used {
+		return state, nil
+	}
+	return nil, nil
+}
+
+func (m *mockAuthRepository) MarkOidcStateUsed(ctx context.Context, id string) (bool, error) {
+	for _, state := range m.oidcStates {
+		if state.ID == id && !state.Used {
+			state.Used = true
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *mockAuthRepository) StoreWebauthnChallenge(ctx context.Context, challenge *domain.WebauthnChallenge) error {
+	if m.webauthnChallenges == nil {
+		m.webauthnChallenges = make(map[string]*domain.WebauthnChallenge)
+	}
+	m.webauthnChallenges[challenge.ChallengeHash] = challenge
+	return nil
+}
+
+func (m *mockAuthRepository) FindValidWebauthnChallenge(ctx context.Context, challengeHash string) (*domain.WebauthnChallenge, error) {
+	if challenge, ok := m.webauthnChallenges[challengeHash]; ok && !challenge.Used {
+		return challenge, nil
+	}
+	return nil, nil
+}
+
+func (m *mockAuthRepository) MarkWebauthnChallengeUsed(ctx context.Context, id string) (bool, error) {
+	for _, challenge := range m.webauthnChallenges {
+		if challenge.ID == id && !challenge.Used {
+			challenge.Used = true
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *mockAuthRepository) StoreWebauthnCredential(ctx context.Context, credential *domain.WebauthnCredential) error {
+	for _, existing := range m.webauthnCredentials {
+		if string(existing.CredentialID) == string(credential.CredentialID) {
+			return domain.ErrWebauthnRegistered
+		}
+	}
+	m.webauthnCredentials = append(m.webauthnCredentials, credential)
+	return nil
+}
+
+func (m *mockAuthRepository) FindWebauthnCredential(ctx context.Context, credentialID []byte) (*domain.WebauthnCredential, error) {
+	for _, credential := range m.webauthnCredentials {
+		if string(credential.CredentialID) == string(credentialID) {
+			return credential, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockAuthRepository) ListWebauthnCredentials(ctx context.Context, userID string) ([]domain.WebauthnCredential, error) {
+	var result []domain.WebauthnCredential
+	for _, credential := range m.webauthnCredentials {
+		if credential.UserID == userID {
+			result = append(result, *credential)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockAuthRepository) UpdateWebauthnCredentialUsage(ctx context.Context, id string, signCount uint32, usedAt time.Time) error {
+	for _, credential := range m.webauthnCredentials {
+		if credential.ID == id {
+			credential.SignCount = signCount
+			credential.LastUsedAt = &usedAt
+		}
+	}
+	return nil
+}
+
+func (m *mockAuthRepository) DeleteWebauthnCredential(ctx context.Context, userID, id string) (bool, error) {
+	for i, credential := range m.webauthnCredentials {
+		if credential.UserID == userID && credential.ID == id {
+			m.webauthnCredentials = append(m.webauthnCredentials[:i], m.webauthnCredentials[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *mockAuthRepository) FindUserIdentity(ctx context.Context, provider, subject string) (*domain.UserIdentity, error) {
+	for _, identity := range m.identities {
+		if identity.Provider == provider && identity.Subject == subject {
+			return identity, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockAuthRepository) StoreUserIdentity(ctx context.Context, identity *domain.UserIdentity) error {
+	m.identities = append(m.identities, identity)
+	return nil
+}
+
+func (m *mockAuthRepository) CreateUserWithIdentity(ctx context.Context, user *domain.User, identity *domain.UserIdentity) error {
+	if err := m.CreateUser(ctx, user); err != nil {
+		return err
+	}
+	return m.StoreUserIdentity(ctx, identity)
+}
+
+func (m *mockAuthRepository) StoreAuditLog(ctx context.Context, log *domain.AuditLog) error {
+	m.auditLogs = append(m.auditLogs, log)
+	return nil
+}
+
+func (m *mockAuthRepository) FindOrganizationMember(ctx context.Context, organizationID, userID string) (*domain.OrganizationMember, error) {
+	for _, member := range m.organizationMembers {
+		if member.OrganizationID == organizationID && member.UserID == userID {
+			return member, nil
+		}
+	}
+	return nil, nil
+}
+
+// FindDefaultOrganizationMember treats organizationMembers as ordered by join date
+func (m *mockAuthRepository) FindDefaultOrganizationMember(ctx context.Context, userID string) (*domain.OrganizationMember, error) {
+	for _, member := range m.organizationMembers {
+		if member.UserID == userID {
+			return member, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockAuthRepository) UpsertUserMfa(ctx context.Context, mfa *domain.UserMfa) error {
+	if m.userMfa == nil {
+		m.userMfa = make(map[string]*domain.UserMfa)
+	}
+	m.userMfa[mfa.UserID] = mfa
+	return nil
+}
+
+func (m *mockAuthRepository) FindUserMfa(ctx context.Context, userID string) (*domain.UserMfa, error) {
+	if mfa, ok := m.userMfa[userID]; ok {
+		return mfa, nil
+	}
+	return nil, nil
+}
+
+func (m *mockAuthRepository) EnableUserMfa(ctx context.Context, userID string, step int64) error {
+	if mfa, ok := m.userMfa[userID]; ok {
+		mfa.Enabled = true
+		mfa.LastUsedStep = step
+	}
+	return nil
+}
+
+func (m *mockAuthRepository) UpdateMfaLastUsedStep(ctx context.Context, userID string, step int64) (bool, error) {
+	if mfa, ok := m.userMfa[userID]; ok && mfa.LastUsedStep < step {
+		mfa.LastUsedStep = step
+		return true, nil
+	}
+	return false, nil
+}
+
+func (m *mockAuthRepository) DeleteUserMfa(ctx context.Context, userID string) error {
+	delete(m.userMfa, userID)
+	for hash, code := range m.recoveryCodes {
+		if code.UserID == userID {
+			delete(m.recoveryCodes, hash)
+		}
+	}
+	return nil
+}
+
+func (m *mockAuthRepository) ReplaceMfaRecoveryCodes(ctx context.Context, userID string, codes []*domain.MfaRecoveryCode) error {
+	if m.recoveryCodes == nil {
+		m.recoveryCodes = make(map[string]*domain.MfaRecoveryCode)
+	}
+	for hash, code := range m.recoveryCodes {
+		if code.UserID == userID {
+			delete(m.recoveryCodes, hash)
+		}
+	}
+	for _, code := range codes {
+		m.recoveryCodes[code.CodeHash] = code
+	}
+	return nil
+}
+
+func (m *mockAuthRepository) UseMfaRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error) {
+	if code, ok := m.recoveryCodes[codeHash]; ok && code.UserID == userID && !code.Used {
+		code.Used = true
+		return true, nil
+	}
+	return false, nil
+}
+
+func (m *mockAuthRepository) StoreMfaChallenge(ctx context.Context, challenge *domain.MfaChallenge) error {
+	if m.mfaChallenges == nil {
+		m.mfaChallenges = make(map[string]*domain.MfaChallenge)
+	}
+	m.mfaChallenges[challenge.TokenHash] = challenge
+	return nil
+}
+
+func (m *mockAuthRepository) FindValidMfaChallenge(ctx context.Context, tokenHash string) (*domain.MfaChallenge, error) {
+	if c, ok := m.mfaChallenges[tokenHash]; ok && !c.Used {
+		return c, nil
+	}
+	return nil, nil
+}
+
+func (m *mockAuthRepository) IncrementMfaChallengeAttempts(ctx context.Context, id string, maxAttempts int) (int, error) {
+	for _, c := range m.mfaChallenges {
+		if c.ID == id && !c.Used && c.Attempts < maxAttempts {
+			c.Attempts++
+			return c.Attempts, nil
+		}
+	}
+	return 0, nil
+}
+
+func (m *mockAuthRepository) MarkMfaChallengeUsed(ctx context.Context, id string) (bool, error) {
+	for _, c := range m.mfaChallenges {
+		if c.ID == id && !c.Used {
+			c.Used = true
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 type mockTokenService struct {
-	generateAccessToken  func(userID, role string) (string, error)
+	generateAccessToken  func(claims domain.AccessTokenClaims) (string, error)
 	generateRefreshToken func() (plain string, hash string, err error)
 }
 
-func (m *mockTokenService) GenerateAccessToken(userID, role string) (string, error) {
+func (m *mockTokenService) GenerateAccessToken(claims domain.AccessTokenClaims) (string, error) {
 	if m.generateAccessToken != nil {
-		return m.generateAccessToken(userID, role)
+		return m.generateAccessToken(claims)
 	}
-	return "access-token-" + userID, nil
+	return "access-token-" + claims.UserID, nil
 }
 
 func (m *mockTokenService) GenerateRefreshToken() (plain string, hash string, err error) {
@@ -162,9 +871,18 @@ func (m *mockTokenService) GenerateRefreshToken() (plain string, hash string, er
 	return "refresh-token-plain", "refresh-token-hash", nil
 }
 
+func (m *mockTokenService) GenerateServiceToken(claims domain.ServiceTokenClaims) (string, time.Duration, error) {
+	return "service-token-" + claims.ClientID, 15 * time.Minute, nil
+}
+
+func (m *mockTokenService) PublicKeys() []domain.JSONWebKey {
+	return nil
+}
+
 type mockPasswordHasher struct {
 	hashPassword        func(password string) (string, error)
 	verifyPassword      func(password, hash string) bool
+	needsRehash         func(hash string) bool
 	generateRandomToken func() (string, error)
 	hashToken           func(token string) string
 }
@@ -183,6 +901,13 @@ func (m *mockPasswordHasher) VerifyPassword(password, hash string) bool {
 	return hash == "hashed-"+password
 }
 
+func (m *mockPasswordHasher) NeedsRehash(hash string) bool {
+	if m.needsRehash != nil {
+		return m.needsRehash(hash)
+	}
+	return false
+}
+
 func (m *mockPasswordHasher) GenerateRandomToken() (string, error) {
 	if m.generateRandomToken != nil {
 		return m.generateRandomToken()
@@ -197,6 +922,45 @@ func (m *mockPasswordHasher) HashToken(token string) string {
 	return "sha256-" + token
 }
 
+// mockTokenRevoker records revocations the way the denylist would persist them
+type mockTokenRevoker struct {
+	repo *mockAuthRepository
+}
+
+func (m *mockTokenRevoker) RevokeAccessToken(ctx context.Context, jti, userID string, expiresAt time.Time) error {
+	return m.repo.StoreRevokedToken(ctx, &domain.RevokedToken{JTI: jti, UserID: userID, ExpiresAt: expiresAt})
+}
+
+func (m *mockTokenRevoker) RevokeSessionAccessTokens(ctx context.Context, sessionID, userID string, expiresAt time.Time) error {
+	return m.repo.StoreRevokedSession(ctx, &domain.RevokedSession{SessionID: sessionID, UserID: userID, ExpiresAt: expiresAt})
+}
+
+func (m *mockTokenRevoker) RevokeUserAccessTokens(ctx context.Context, userID string, before time.Time) error {
+	return m.repo.SetTokensValidAfter(ctx, userID, before)
+}
+
+// mockOTPService accepts "123456" for step 100 and "654321" for step 101
+type mockOTPService struct{}
+
+func (m *mockOTPService) GenerateSecret() (string, error) {
+	return "MOCKSECRET", nil
+}
+
+func (m *mockOTPService) ProvisioningURI(secret, account string) string {
+	return "otpauth://totp/Test:" + account + "?secret=" + secret
+}
+
+func (m *mockOTPService) Validate(secret, code string, t time.Time) (int64, bool) {
+	switch code {
+	case "123456":
+		return 100, true
+	case "654321":
+		return 101, true
+	default:
+		return 0, false
+	}
+}
+
 type mockUUIDGenerator struct {
 	id string
 }
@@ -233,7 +997,10 @@ func setupTestUsecase() (*AuthUsecase, *mockAuthRepository, *mockTokenService, *
 	uc.now = func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) }
 	uc.passwordHasher = hasher
 	uc.uuid = uuid
-	// eventPub will be set in individual tests that need it
+	uc.otp = &mockOTPService{}
+	uc.tokenRevoker = &mockTokenRevoker{repo: repo}
+	uc.eventPub = event.NewAuthPublisher(&mockEventBus{})
+	// tests asserting on published events replace eventPub with their own bus
 
 	return uc, repo, tokenSvc, hasher, uuid, eventPub
 }
@@ -368,6 +1135,48 @@ func TestAuthUsecase_Login(t *testing.T) {
 			},
 			wantErr: domain.ErrInvalidCredentials,
 		},
+		{
+			name: "failure - suspended user with valid credentials",
+			input: domain.LoginInput{
+				Email:    "test@example.com",
+				Password: "password123",
+			},
+			setup: func(repo *mockAuthRepository, hasher *mockPasswordHasher, tokenSvc *mockTokenService) {
+				repo.findUserByEmail = func(email string) (*domain.User, error) {
+					return &domain.User{
+						ID:           "user-123",
+						Email:        email,
+						PasswordHash: "hashed-password123",
+						Status:       domain.UserStatusSuspended,
+					}, nil
+				}
+				hasher.verifyPassword = func(password, hash string) bool {
+					return true
+				}
+			},
+			wantErr: domain.ErrAccountSuspended,
+		},
+		{
+			name: "failure - deactivated user with valid credentials",
+			input: domain.LoginInput{
+				Email:    "test@example.com",
+				Password: "password123",
+			},
+			setup: func(repo *mockAuthRepository, hasher *mockPasswordHasher, tokenSvc *mockTokenService) {
+				repo.findUserByEmail = func(email string) (*domain.User, error) {
+					return &domain.User{
+						ID:           "user-123",
+						Email:        email,
+						PasswordHash: "hashed-password123",
+						Status:       domain.UserStatusDeactivated,
+					}, nil
+				}
+				hasher.verifyPassword = func(password, hash string) bool {
+					return true
+				}
+			},
+			wantErr: domain.ErrAccountDeactivated,
+		},
 	}
 
 	for _, tt := range tests {
@@ -456,8 +1265,8 @@ func TestAuthUsecase_Logout(t *testing.T) {
 			name:         "success - valid token",
 			refreshToken: "valid-refresh-token",
 			setup: func(repo *mockAuthRepository, hasher *mockPasswordHasher) {
-				hasher.hashPassword = func(password string) (string, error) {
-					return "hashed-" + password, nil
+				hasher.hashToken = func(token string) string {
+					return "hashed-" + token
 				}
 				repo.findValidRefreshToken = func(tokenHash string) (*domain.RefreshToken, error) {
 					if tokenHash == "hashed-valid-refresh-token" {
@@ -468,15 +1277,31 @@ func TestAuthUsecase_Logout(t *testing.T) {
 					}
 					return nil, errors.New("token not found")
 				}
+				repo.revokeRefreshToken = func(tokenHash string, reason domain.RefreshTokenRevokeReason) (bool, error) {
+					return true, nil
+				}
 			},
 			wantErr: nil,
 		},
+		{
+			name:         "failure - already logged out concurrently",
+			refreshToken: "valid-refresh-token",
+			setup: func(repo *mockAuthRepository, hasher *mockPasswordHasher) {
+				repo.findValidRefreshToken = func(tokenHash string) (*domain.RefreshToken, error) {
+					return &domain.RefreshToken{TokenHash: tokenHash}, nil
+				}
+				repo.revokeRefreshToken = func(tokenHash string, reason domain.RefreshTokenRevokeReason) (bool, error) {
+					return false, nil
+				}
+			},
+			wantErr: domain.ErrInvalidToken,
+		},
 		{
 			name:         "failure - invalid token",
 			refreshToken: "invalid-token",
 			setup: func(repo *mockAuthRepository, hasher *mockPasswordHasher) {
-				hasher.hashPassword = func(password string) (string, error) {
-					return "hashed-" + password, nil
+				hasher.hashToken = func(token string) string {
+					return "hashed-" + token
 				}
 				repo.findValidRefreshToken = func(tokenHash string) (*domain.RefreshToken, error) {
 					return nil, errors.New("token not found")
@@ -491,10 +1316,17 @@ func TestAuthUsecase_Logout(t *testing.T) {
 			uc, repo, _, hasher, _, _ := setupTestUsecase()
 			tt.setup(repo, hasher)
 
-			err := uc.Logout(context.Background(), tt.refreshToken)
+			err := uc.Logout(context.Background(), domain.LogoutInput{
+				RefreshToken:         tt.refreshToken,
+				AccessTokenID:        "jti-123",
+				AccessTokenExpiresAt: time.Date(2024, 1, 1, 0, 15, 0, 0, time.UTC),
+			})
 			if !errors.Is(err, tt.wantErr) {
 				t.Errorf("Logout() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if _, revoked := repo.revokedTokens["jti-123"]; revoked != (tt.wantErr == nil) {
+				t.Errorf("Logout() access token revoked = %v", revoked)
+			}
 		})
 	}
 }
@@ -577,42 +1409,49 @@ func TestAuthUsecase_ResetPassword(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			uc, repo, _, hasher, _, _ := setupTestUsecase()
+			repo.refreshTokens["session-hash"] = &domain.RefreshToken{UserID: "user-123", TokenHash: "session-hash"}
 			tt.setup(repo, hasher)
 
 			err := uc.ResetPassword(context.Background(), tt.input)
 			if !errors.Is(err, tt.wantErr) {
 				t.Errorf("ResetPassword() error = %v, wantErr %v", err, tt.wantErr)
 			}
+
+			_, watermarked := repo.tokensValidAfter["user-123"]
+			if watermarked != (tt.wantErr == nil) || repo.refreshTokens["session-hash"].Revoked != (tt.wantErr == nil) {
+				t.Errorf("ResetPassword() revoked access tokens = %v, sessions = %v", watermarked, repo.refreshTokens["session-hash"].Revoked)
+			}
 		})
 	}
 }
 
 // Test RefreshToken
 func TestAuthUsecase_RefreshToken(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
 	tests := []struct {
-		name    string
-		token   string
-		setup   func(*mockAuthRepository, *mockPasswordHasher, *mockTokenService)
-		wantErr error
+		name          string
+		token         string
+		setup         func(*mockAuthRepository, *mockPasswordHasher, *mockTokenService)
+		wantErr       error
+		wantPublished string
 	}{
 		{
 			name:  "success - valid refresh token",
 			token: "valid-refresh-token",
 			setup: func(repo *mockAuthRepository, hasher *mockPasswordHasher, tokenSvc *mockTokenService) {
-				hasher.hashPassword = func(password string) (string, error) {
-					return "hashed-" + password, nil
+				hasher.hashToken = func(token string) string {
+					return "hashed-" + token
 				}
-				repo.findValidRefreshToken = func(tokenHash string) (*domain.RefreshToken, error) {
-					if tokenHash == "hashed-valid-refresh-token" {
-						return &domain.RefreshToken{
-							ID:        "token-123",
-							UserID:    "user-123",
-							TokenHash: tokenHash,
-							Revoked:   false,
-							ExpiresAt: time.Now().Add(24 * time.Hour),
-						}, nil
-					}
-					return nil, errors.New("token not found")
+				repo.refreshTokens["hashed-valid-refresh-token"] = &domain.RefreshToken{
+					ID:        "token-123",
+					UserID:    "user-123",
+					FamilyID:  "family-123",
+					TokenHash: "hashed-valid-refresh-token",
+					Revoked:   false,
+					UserAgent: "Mozilla/5.0",
+					IPAddress: "203.0.113.7",
+					ExpiresAt: fixedTime.Add(24 * time.Hour),
 				}
 			},
 			wantErr: nil,
@@ -621,11 +1460,155 @@ func TestAuthUsecase_RefreshToken(t *testing.T) {
 			name:  "failure - invalid refresh token",
 			token: "invalid-token",
 			setup: func(repo *mockAuthRepository, hasher *mockPasswordHasher, tokenSvc *mockTokenService) {
-				hasher.hashPassword = func(password string) (string, error) {
-					return "hashed-" + password, nil
+				hasher.hashToken = func(token string) string {
+					return "hashed-" + token
 				}
-				repo.findValidRefreshToken = func(tokenHash string) (*domain.RefreshToken, error) {
-					return nil, errors.New("token not found")
+			},
+			wantErr: domain.ErrInvalidToken,
+		},
+		{
+			name:  "failure - expired refresh token",
+			token: "expired-token",
+			setup: func(repo *mockAuthRepository, hasher *mockPasswordHasher, tokenSvc *mockTokenService) {
+				repo.refreshTokens["sha256-expired-token"] = &domain.RefreshToken{
+					ID:        "token-123",
+					UserID:    "user-123",
+					FamilyID:  "family-123",
+					TokenHash: "sha256-expired-token",
+					ExpiresAt: fixedTime.Add(-time.Hour),
+				}
+			},
+			wantErr: domain.ErrTokenExpired,
+		},
+		{
+			name:  "failure - rotated token replayed revokes family",
+			token: "rotated-token",
+			setup: func(repo *mockAuthRepository, hasher *mockPasswordHasher, tokenSvc *mockTokenService) {
+				repo.refreshTokens["sha256-rotated-token"] = &domain.RefreshToken{
+					ID:            "token-1",
+					UserID:        "user-123",
+					FamilyID:      "family-123",
+					TokenHash:     "sha256-rotated-token",
+					Revoked:       true,
+					RevokedReason: domain.RefreshTokenRotated,
+					ExpiresAt:     fixedTime.Add(24 * time.Hour),
+				}
+				repo.refreshTokens["sha256-live-token"] = &domain.RefreshToken{
+					ID:        "token-2",
+					UserID:    "user-123",
+					FamilyID:  "family-123",
+					ParentID:  "token-1",
+					TokenHash: "sha256-live-token",
+					ExpiresAt: fixedTime.Add(24 * time.Hour),
+				}
+				repo.refreshTokens["sha256-other-device"] = &domain.RefreshToken{
+					ID:        "token-3",
+					UserID:    "user-123",
+					FamilyID:  "family-456",
+					TokenHash: "sha256-other-device",
+					ExpiresAt: fixedTime.Add(24 * time.Hour),
+				}
+			},
+			wantErr:       domain.ErrRefreshTokenReused,
+			wantPublished: event.RefreshTokenReusedSubject,
+		},
+		{
+			// Another refresh rotated the token after it was read
+			name:  "failure - concurrent refresh loses the race",
+			token: "raced-token",
+			setup: func(repo *mockAuthRepository, hasher *mockPasswordHasher, tokenSvc *mockTokenService) {
+				repo.refreshTokens["sha256-raced-token"] = &domain.RefreshToken{
+					ID:        "token-1",
+					UserID:    "user-123",
+					FamilyID:  "family-123",
+					TokenHash: "sha256-raced-token",
+					ExpiresAt: fixedTime.Add(24 * time.Hour),
+				}
+				repo.refreshTokens["sha256-live-token"] = &domain.RefreshToken{
+					ID:        "token-2",
+					UserID:    "user-123",
+					FamilyID:  "family-123",
+					ParentID:  "token-1",
+					TokenHash: "sha256-live-token",
+					ExpiresAt: fixedTime.Add(24 * time.Hour),
+				}
+				repo.refreshTokens["sha256-other-device"] = &domain.RefreshToken{
+					ID:        "token-3",
+					UserID:    "user-123",
+					FamilyID:  "family-456",
+					TokenHash: "sha256-other-device",
+					ExpiresAt: fixedTime.Add(24 * time.Hour),
+				}
+				repo.revokeRefreshToken = func(tokenHash string, reason domain.RefreshTokenRevokeReason) (bool, error) {
+					return false, nil
+				}
+			},
+			wantErr:       domain.ErrRefreshTokenReused,
+			wantPublished: event.RefreshTokenReusedSubject,
+		},
+		{
+			name:  "failure - suspended user",
+			token: "valid-refresh-token",
+			setup: func(repo *mockAuthRepository, hasher *mockPasswordHasher, tokenSvc *mockTokenService) {
+				repo.users["user-123"].Status = domain.UserStatusSuspended
+				repo.refreshTokens["sha256-valid-refresh-token"] = &domain.RefreshToken{
+					ID:        "token-123",
+					UserID:    "user-123",
+					FamilyID:  "family-123",
+					TokenHash: "sha256-valid-refresh-token",
+					ExpiresAt: fixedTime.Add(24 * time.Hour),
+				}
+			},
+			wantErr: domain.ErrAccountSuspended,
+		},
+		{
+			// A rotated token of a suspended user: suspension ended the session, not a thief
+			name:  "failure - rotated token of suspended user is not reuse",
+			token: "revoked-token",
+			setup: func(repo *mockAuthRepository, hasher *mockPasswordHasher, tokenSvc *mockTokenService) {
+				repo.users["user-123"].Status = domain.UserStatusSuspended
+				repo.refreshTokens["sha256-revoked-token"] = &domain.RefreshToken{
+					ID:            "token-123",
+					UserID:        "user-123",
+					FamilyID:      "family-123",
+					TokenHash:     "sha256-revoked-token",
+					Revoked:       true,
+					RevokedReason: domain.RefreshTokenRotated,
+					ExpiresAt:     fixedTime.Add(24 * time.Hour),
+				}
+			},
+			wantErr: domain.ErrAccountSuspended,
+		},
+		{
+			// Suspension, a password reset or logging out everywhere ended the
+			// session; presenting its token again must not look like theft
+			name:  "failure - ended session is not reuse",
+			token: "ended-token",
+			setup: func(repo *mockAuthRepository, hasher *mockPasswordHasher, tokenSvc *mockTokenService) {
+				repo.refreshTokens["sha256-ended-token"] = &domain.RefreshToken{
+					ID:            "token-123",
+					UserID:        "user-123",
+					FamilyID:      "family-123",
+					TokenHash:     "sha256-ended-token",
+					Revoked:       true,
+					RevokedReason: domain.RefreshTokenEnded,
+					ExpiresAt:     fixedTime.Add(24 * time.Hour),
+				}
+			},
+			wantErr: domain.ErrInvalidToken,
+		},
+		{
+			name:  "failure - logged out token is not reuse",
+			token: "logged-out-token",
+			setup: func(repo *mockAuthRepository, hasher *mockPasswordHasher, tokenSvc *mockTokenService) {
+				repo.refreshTokens["sha256-logged-out-token"] = &domain.RefreshToken{
+					ID:            "token-123",
+					UserID:        "user-123",
+					FamilyID:      "family-123",
+					TokenHash:     "sha256-logged-out-token",
+					Revoked:       true,
+					RevokedReason: domain.RefreshTokenLoggedOut,
+					ExpiresAt:     fixedTime.Add(24 * time.Hour),
 				}
 			},
 			wantErr: domain.ErrInvalidToken,
@@ -635,12 +1618,39 @@ func TestAuthUsecase_RefreshToken(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			uc, repo, tokenSvc, hasher, _, _ := setupTestUsecase()
+			repo.users["user-123"] = &domain.User{ID: "user-123", RoleID: string(domain.RoleIDUser)}
+			mockBus := &mockEventBus{}
+			uc.eventPub = event.NewAuthPublisher(mockBus)
 			tt.setup(repo, hasher, tokenSvc)
 
-			_, err := uc.RefreshToken(context.Background(), tt.token)
+			_, err := uc.RefreshToken(context.Background(), tt.token, domain.ClientInfo{IPAddress: "198.51.100.2"})
 			if !errors.Is(err, tt.wantErr) {
 				t.Errorf("RefreshToken() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if mockBus.publishedSubject != tt.wantPublished {
+				t.Errorf("RefreshToken() published %q, want %q", mockBus.publishedSubject, tt.wantPublished)
+			}
+
+			switch tt.wantErr {
+			case nil:
+				rotated := repo.refreshTokens["refresh-token-hash"]
+				if rotated == nil || rotated.FamilyID != "family-123" || rotated.ParentID != "token-123" {
+					t.Errorf("RefreshToken() new token = %+v, want same family with parent token-123", rotated)
+				}
+				if rotated != nil && (rotated.UserAgent != "Mozilla/5.0" || rotated.IPAddress != "198.51.100.2") {
+					t.Errorf("RefreshToken() new token device = %q %q, want kept user agent and latest IP", rotated.UserAgent, rotated.IPAddress)
+				}
+				if !repo.refreshTokens["hashed-valid-refresh-token"].Revoked {
+					t.Errorf("RefreshToken() did not revoke presented token")
+				}
+			case domain.ErrRefreshTokenReused:
+				if !repo.refreshTokens["sha256-live-token"].Revoked {
+					t.Errorf("RefreshToken() did not revoke live token of reused family")
+				}
+				if repo.refreshTokens["sha256-other-device"].Revoked {
+					t.Errorf("RefreshToken() revoked token from another family")
+				}
+			}
 		})
 	}
 }
@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/auth/event"
+)
+
+// deviceRevokeTTL matches the lifetime of the session's refresh tokens
+const deviceRevokeTTL = 7 * 24 * time.Hour
+
+// recordDevice remembers the device a new session was opened from. A device the
+// user has not logged in from before raises an alert with a link that ends the
+// session, unless the user has no known device yet to compare it with.
+func (usecase *AuthUsecase) recordDevice(ctx context.Context, user *domain.User, client domain.ClientInfo, sessionID string) error {
+	fingerprint := usecase.passwordHasher.HashToken(deviceFingerprint(client))
+
+	device, err := usecase.repository.FindUserDevice(ctx, user.ID, fingerprint)
+
+	if err != nil {
+		return err
+	}
+
+	if device != nil {
+		return usecase.repository.TouchUserDevice(ctx, device.ID, client.IPAddress, usecase.now())
+	}
+
+	known, err := usecase.repository.CountUserDevices(ctx, user.ID)
+
+	if err != nil {
+		return err
+	}
+
+	device = &domain.UserDevice{
+		ID:          usecase.uuid.GenerateID(),
+		UserID:      user.ID,
+		Fingerprint: fingerprint,
+		UserAgent:   client.UserAgent,
+		IPAddress:   client.IPAddress,
+		SessionID:   sessionID,
+		FirstSeenAt: usecase.now(),
+		LastSeenAt:  usecase.now(),
+	}
+
+	var revokeToken string
+	expiredAt := usecase.now().Add(deviceRevokeTTL)
+
+	if known > 0 {
+		revokeToken, err = usecase.passwordHasher.GenerateRandomToken()
+
+		if err != nil {
+			return err
+		}
+
+		device.RevokeTokenHash = usecase.passwordHasher.HashToken(revokeToken)
+		device.RevokeTokenExpiresAt = &expiredAt
+	}
+
+	stored, err := usecase.repository.StoreUserDevice(ctx, device)
+
+	if err != nil {
+		return err
+	}
+
+	// A concurrent login from the same device already stored it and raised the alert
+	if !stored || revokeToken == "" {
+		return nil
+	}
+
+	_ = usecase.eventPub.NewDeviceLogin(event.NewDeviceLoginEvent{
+		UserID:      user.ID,
+		Name:        user.Name,
+		Email:       user.Email,
+		UserAgent:   client.UserAgent,
+		IPAddress:   client.IPAddress,
+		LoginAt:     usecase.now(),
+		RevokeToken: revokeToken,
+		ExpiredAt:   expiredAt,
+	})
+
+	return nil
+}
+
+// RevokeDeviceSession answers a new device alert with "this was not me": it ends
+// the session the device opened and forgets the device, so logging in from it
+// raises the alert again. Access tokens already issued to the user are revoked
+// too; their other sessions get new ones on the next refresh.
+func (usecase *AuthUsecase) RevokeDeviceSession(ctx context.Context, token string) error {
+	device, err := usecase.repository.FindUserDeviceByRevokeToken(ctx, usecase.passwordHasher.HashToken(token))
+
+	if err != nil {
+		return err
+	}
+
+	if device == nil || device.RevokeTokenExpiresAt == nil || !device.RevokeTokenExpiresAt.After(usecase.now()) {
+		return domain.ErrInvalidDeviceToken
+	}
+
+	deleted, err := usecase.repository.DeleteUserDevice(ctx, device.ID)
+
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return domain.ErrInvalidDeviceToken
+	}
+
+	// The session may have ended already, the access tokens still have to go
+	if _, err := usecase.repository.RevokeSession(ctx, device.UserID, device.SessionID); err != nil {
+		return err
+	}
+
+	return usecase.tokenRevoker.RevokeUserAccessTokens(ctx, device.UserID, usecase.now())
+}
+
+// deviceFingerprint identifies a device by its user agent, the network it
+// connects from and the id the client keeps for it
+func deviceFingerprint(client domain.ClientInfo) string {
+	return strings.Join([]string{client.UserAgent, networkPrefix(client.IPAddress), client.DeviceID}, "\n")
+}
+
+// networkPrefix cuts an IP address to its /24, or /48 for IPv6, so moving to
+// another address of the same network is not a new device
+func networkPrefix(ipAddress string) string {
+	addr, err := netip.ParseAddr(ipAddress)
+
+	if err != nil {
+		return ipAddress
+	}
+
+	addr = addr.Unmap()
+	bits := 48
+	if addr.Is4() {
+		bits = 24
+	}
+
+	prefix, err := addr.Prefix(bits)
+
+	if err != nil {
+		return ipAddress
+	}
+
+	return prefix.String()
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/auth/event"
+)
+
+// Test Login remembers the user's devices and alerts on an unknown one
+func TestAuthUsecase_Login_RecordsDevice(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	repo.users["user-123"] = &domain.User{ID: "user-123", Name: "Test", Email: "test@example.com", PasswordHash: "hashed-password123", RoleID: string(domain.RoleIDUser)}
+	bus := &mockEventBus{}
+	uc.eventPub = event.NewAuthPublisher(bus)
+
+	login := func(client domain.ClientInfo) {
+		t.Helper()
+		if _, err := uc.Login(context.Background(), domain.LoginInput{Email: "test@example.com", Password: "password123", Client: client}); err != nil {
+			t.Fatalf("Login() error = %v", err)
+		}
+	}
+
+	laptop := domain.ClientInfo{UserAgent: "Mozilla/5.0", IPAddress: "203.0.113.7", DeviceID: "device-laptop"}
+	login(laptop)
+	if len(repo.userDevices) != 1 || repo.userDevices[0].RevokeTokenHash != "" {
+		t.Fatalf("first login devices = %+v, want one without revoke token", repo.userDevices)
+	}
+	if bus.publishedSubject != "" {
+		t.Errorf("first login published %q, want nothing", bus.publishedSubject)
+	}
+
+	// another address of the same network is the same device
+	laptop.IPAddress = "203.0.113.99"
+	login(laptop)
+	if len(repo.userDevices) != 1 || repo.userDevices[0].IPAddress != "203.0.113.99" {
+		t.Fatalf("known device login devices = %+v", repo.userDevices)
+	}
+	if bus.publishedSubject != "" {
+		t.Errorf("known device login published %q, want nothing", bus.publishedSubject)
+	}
+
+	login(domain.ClientInfo{UserAgent: "okhttp/4.12", IPAddress: "198.51.100.20", DeviceID: "device-phone"})
+	if len(repo.userDevices) != 2 {
+		t.Fatalf("new device login stored %d devices, want 2", len(repo.userDevices))
+	}
+	device := repo.userDevices[1]
+	if device.RevokeTokenHash != "sha256-random-token" || device.SessionID != "test-uuid-123" || device.RevokeTokenExpiresAt == nil {
+		t.Errorf("new device = %+v", device)
+	}
+	if bus.publishedSubject != event.NewDeviceLoginSubject {
+		t.Fatalf("published subject = %q, want %q", bus.publishedSubject, event.NewDeviceLoginSubject)
+	}
+
+	var payload event.NewDeviceLoginEvent
+	if err := json.Unmarshal(bus.publishedData, &payload); err != nil {
+		t.Fatalf("invalid event payload: %v", err)
+	}
+	if payload.Email != "test@example.com" || payload.RevokeToken != "random-token" || payload.IPAddress != "198.51.100.20" || payload.UserAgent != "okhttp/4.12" {
+		t.Errorf("event payload = %+v", payload)
+	}
+}
+
+// Test RevokeDeviceSession
+func TestAuthUsecase_RevokeDeviceSession(t *testing.T) {
+	expiresAt := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	expiredAt := time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		token   string
+		device  *domain.UserDevice
+		wantErr error
+	}{
+		{
+			name:   "success - ends the session the device opened",
+			token:  "revoke-token",
+			device: &domain.UserDevice{ID: "device-1", UserID: "user-123", SessionID: "session-phone", RevokeTokenHash: "sha256-revoke-token", RevokeTokenExpiresAt: &expiresAt},
+		},
+		{
+			name:    "error - unknown token",
+			token:   "other-token",
+			device:  &domain.UserDevice{ID: "device-1", UserID: "user-123", SessionID: "session-phone", RevokeTokenHash: "sha256-revoke-token", RevokeTokenExpiresAt: &expiresAt},
+			wantErr: domain.ErrInvalidDeviceToken,
+		},
+		{
+			name:    "error - expired token",
+			token:   "revoke-token",
+			device:  &domain.UserDevice{ID: "device-1", UserID: "user-123", SessionID: "session-phone", RevokeTokenHash: "sha256-revoke-token", RevokeTokenExpiresAt: &expiredAt},
+			wantErr: domain.ErrInvalidDeviceToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, _, _, _, _ := setupTestUsecase()
+			setupSessions(repo)
+			repo.userDevices = []*domain.UserDevice{tt.device}
+
+			err := uc.RevokeDeviceSession(context.Background(), tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RevokeDeviceSession() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if repo.refreshTokens["hash-phone"].Revoked || len(repo.userDevices) != 1 {
+					t.Errorf("RevokeDeviceSession() changed state on error")
+				}
+				return
+			}
+
+			if !repo.refreshTokens["hash-phone"].Revoked {
+				t.Error("RevokeDeviceSession() did not revoke the device's session")
+			}
+			if repo.refreshTokens["hash-laptop"].Revoked {
+				t.Error("RevokeDeviceSession() revoked another session")
+			}
+			if len(repo.userDevices) != 0 {
+				t.Error("RevokeDeviceSession() kept the device")
+			}
+			if !repo.tokensValidAfter["user-123"].Equal(uc.now()) {
+				t.Errorf("RevokeDeviceSession() tokens valid after = %v, want %v", repo.tokensValidAfter["user-123"], uc.now())
+			}
+
+			// the link is single use
+			if err := uc.RevokeDeviceSession(context.Background(), tt.token); !errors.Is(err, domain.ErrInvalidDeviceToken) {
+				t.Errorf("RevokeDeviceSession() reused error = %v, want %v", err, domain.ErrInvalidDeviceToken)
+			}
+		})
+	}
+}
+
+func TestNetworkPrefix(t *testing.T) {
+	tests := []struct {
+		ipAddress string
+		want      string
+	}{
+		{"203.0.113.7", "203.0.113.0/24"},
+		{"203.0.113.200", "203.0.113.0/24"},
+		{"::ffff:203.0.113.7", "203.0.113.0/24"},
+		{"2001:db8:1:2::1", "2001:db8:1::/48"},
+		{"", ""},
+		{"not-an-ip", "not-an-ip"},
+	}
+
+	for _, tt := range tests {
+		if got := networkPrefix(tt.ipAddress); got != tt.want {
+			t.Errorf("networkPrefix(%q) = %q, want %q", tt.ipAddress, got, tt.want)
+		}
+	}
+}
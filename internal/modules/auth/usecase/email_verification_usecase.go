@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/auth/event"
+)
+
+const emailVerificationTTL = 24 * time.Hour
+
+// VerifyEmail marks the user's email address as verified using the token from the verification mail
+func (usecase *AuthUsecase) VerifyEmail(ctx context.Context, token string) error {
+	verification, err := usecase.repository.FindValidEmailVerification(ctx, usecase.passwordHasher.HashToken(token))
+
+	if err != nil || verification == nil {
+		return domain.ErrInvalidToken
+	}
+
+	if verification.Used || !verification.ExpiresAt.After(usecase.now()) {
+		return domain.ErrInvalidToken
+	}
+
+	if err := usecase.repository.MarkEmailVerificationUsed(ctx, verification.ID); err != nil {
+		return err
+	}
+
+	return usecase.repository.MarkUserEmailVerified(ctx, verification.UserID, usecase.now())
+}
+
+// ResendVerification issues a new verification token for an unverified account
+func (usecase *AuthUsecase) ResendVerification(ctx context.Context, email string) error {
+	user, err := usecase.repository.FindUserByEmail(ctx, email)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrUserNotFound
+		}
+		return err
+	}
+
+	if user.EmailVerifiedAt != nil {
+		return domain.ErrEmailAlreadyVerified
+	}
+
+	token, expiredAt, err := usecase.createEmailVerification(ctx, user)
+
+	if err != nil {
+		return err
+	}
+
+	_ = usecase.eventPub.EmailVerificationRequested(event.EmailVerificationRequestedEvent{
+		UserID:    user.ID,
+		Name:      user.Name,
+		Email:     user.Email,
+		Token:     token,
+		ExpiredAt: expiredAt,
+	})
+
+	return nil
+}
+
+// createEmailVerification stores a new verification token and returns its plain value for the mail
+func (usecase *AuthUsecase) createEmailVerification(ctx context.Context, user *domain.User) (string, time.Time, error) {
+	token, err := usecase.passwordHasher.GenerateRandomToken()
+
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	expiredAt := usecase.now().Add(emailVerificationTTL)
+
+	if err := usecase.repository.StoreEmailVerification(ctx, &domain.EmailVerification{
+		ID:        usecase.uuid.GenerateID(),
+		UserID:    user.ID,
+		TokenHash: usecase.passwordHasher.HashToken(token),
+		ExpiresAt: expiredAt,
+		CreatedAt: usecase.now(),
+		UpdatedAt: usecase.now(),
+	}); err != nil {
+		return "", time.Time{}, err
+	}
+
+	return token, expiredAt, nil
+}
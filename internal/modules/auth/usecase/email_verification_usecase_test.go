@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/auth/event"
+)
+
+// Test Register stores a verification token and publishes auth.user_registered
+func TestAuthUsecase_Register_SendsVerification(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	repo.findUserByEmail = func(email string) (*domain.User, error) {
+		return nil, nil
+	}
+	bus := &mockEventBus{}
+	uc.eventPub = event.NewAuthPublisher(bus)
+
+	err := uc.Register(context.Background(), domain.RegisterInput{
+		Name:                 "Test User",
+		Email:                "test@example.com",
+		Password:             "password123",
+		PasswordConfirmation: "password123",
+	})
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	verification := repo.emailVerifications["sha256-random-token"]
+	if verification == nil || verification.UserID != "test-uuid-123" || !verification.ExpiresAt.Equal(uc.now().Add(24*time.Hour)) {
+		t.Fatalf("Register() stored verification = %+v", verification)
+	}
+
+	if bus.publishedSubject != event.UserRegisteredSubject {
+		t.Fatalf("Register() published %q, want %q", bus.publishedSubject, event.UserRegisteredSubject)
+	}
+	var payload event.UserRegisteredEvent
+	if err := json.Unmarshal(bus.publishedData, &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.Email != "test@example.com" || payload.Token != "random-token" {
+		t.Errorf("Register() event = %+v", payload)
+	}
+}
+
+// Test VerifyEmail
+func TestAuthUsecase_VerifyEmail(t *testing.T) {
+	tests := []struct {
+		name      string
+		token     string
+		expiresAt time.Time
+		wantErr   error
+	}{
+		{name: "success - valid token", token: "verify-token", expiresAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{name: "failure - unknown token", token: "other-token", expiresAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), wantErr: domain.ErrInvalidToken},
+		{name: "failure - expired token", token: "verify-token", expiresAt: time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC), wantErr: domain.ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, _, _, _, _ := setupTestUsecase()
+			repo.users["user-123"] = &domain.User{ID: "user-123", Email: "test@example.com"}
+			repo.emailVerifications = map[string]*domain.EmailVerification{
+				"sha256-verify-token": {ID: "verification-1", UserID: "user-123", TokenHash: "sha256-verify-token", ExpiresAt: tt.expiresAt},
+			}
+
+			err := uc.VerifyEmail(context.Background(), tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("VerifyEmail() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			verified := repo.users["user-123"].EmailVerifiedAt != nil
+			if verified != (tt.wantErr == nil) {
+				t.Errorf("VerifyEmail() email verified = %v", verified)
+			}
+			if tt.wantErr == nil && !repo.emailVerifications["sha256-verify-token"].Used {
+				t.Errorf("VerifyEmail() did not mark the token used")
+			}
+		})
+	}
+}
+
+// Test ResendVerification
+func TestAuthUsecase_ResendVerification(t *testing.T) {
+	verifiedAt := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		user        *domain.User
+		wantErr     error
+		wantSubject string
+	}{
+		{
+			name:        "success - unverified user",
+			user:        &domain.User{ID: "user-123", Email: "test@example.com"},
+			wantSubject: event.EmailVerificationRequestedSubject,
+		},
+		{
+			name:    "failure - already verified",
+			user:    &domain.User{ID: "user-123", Email: "test@example.com", EmailVerifiedAt: &verifiedAt},
+			wantErr: domain.ErrEmailAlreadyVerified,
+		},
+		{
+			name:    "failure - unknown email",
+			wantErr: domain.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, _, _, _, _ := setupTestUsecase()
+			repo.findUserByEmail = func(email string) (*domain.User, error) {
+				if tt.user == nil {
+					return nil, sql.ErrNoRows
+				}
+				return tt.user, nil
+			}
+			bus := &mockEventBus{}
+			uc.eventPub = event.NewAuthPublisher(bus)
+
+			err := uc.ResendVerification(context.Background(), "test@example.com")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ResendVerification() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if bus.publishedSubject != tt.wantSubject {
+				t.Errorf("ResendVerification() published %q, want %q", bus.publishedSubject, tt.wantSubject)
+			}
+		})
+	}
+}
+
+// Test Login refuses unverified accounts only when verification is required
+func TestAuthUsecase_Login_RequireEmailVerification(t *testing.T) {
+	verifiedAt := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		required bool
+		verified *time.Time
+		wantErr  error
+	}{
+		{name: "not required - unverified allowed", required: false},
+		{name: "required - verified allowed", required: true, verified: &verifiedAt},
+		{name: "required - unverified refused", required: true, wantErr: domain.ErrEmailNotVerified},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, _, _, _, _ := setupTestUsecase()
+			uc.SetRequireEmailVerification(tt.required)
+			repo.findUserByEmail = func(email string) (*domain.User, error) {
+				return &domain.User{ID: "user-123", Email: email, PasswordHash: "hashed-password123", EmailVerifiedAt: tt.verified}, nil
+			}
+
+			_, err := uc.Login(context.Background(), domain.LoginInput{Email: "test@example.com", Password: "password123"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Login() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+// Test Impersonate issues a short-lived actor token and starts the audit trail
+func TestAuthUsecase_Impersonate(t *testing.T) {
+	uc, repo, tokenSvc, _, _, _ := setupTestUsecase()
+	repo.users["user-123"] = &domain.User{ID: "user-123", RoleID: string(domain.RoleIDUser), Status: domain.UserStatusActive}
+
+	var issued domain.AccessTokenClaims
+	tokenSvc.generateAccessToken = func(claims domain.AccessTokenClaims) (string, error) {
+		issued = claims
+		return "impersonation-token", nil
+	}
+
+	result, err := uc.Impersonate(context.Background(), domain.ImpersonateInput{
+		ActorID: "admin-123",
+		UserID:  "user-123",
+		Client:  domain.ClientInfo{IPAddress: "10.0.0.1"},
+	})
+	if err != nil {
+		t.Fatalf("Impersonate() error = %v", err)
+	}
+	if result.AccessToken != "impersonation-token" || result.ExpiresIn != impersonationTokenTTL {
+		t.Errorf("Impersonate() = %+v", result)
+	}
+	if issued.UserID != "user-123" || issued.ActorID != "admin-123" || issued.TTL != impersonationTokenTTL || issued.SessionID != "" {
+		t.Errorf("Impersonate() token claims = %+v", issued)
+	}
+	if len(repo.refreshTokens) != 0 {
+		t.Errorf("Impersonate() stored a refresh token")
+	}
+	if len(repo.auditLogs) != 1 || repo.auditLogs[0].Action != auditActionImpersonationStarted || repo.auditLogs[0].ActorID != "admin-123" || repo.auditLogs[0].IPAddress != "10.0.0.1" {
+		t.Errorf("Impersonate() audit logs = %+v", repo.auditLogs)
+	}
+}
+
+// Test users that cannot be impersonated
+func TestAuthUsecase_Impersonate_Refused(t *testing.T) {
+	tests := []struct {
+		name    string
+		userID  string
+		user    *domain.User
+		wantErr error
+	}{
+		{
+			name:    "self",
+			userID:  "admin-123",
+			user:    &domain.User{ID: "admin-123", RoleID: string(domain.RoleIDSuperAdmin), Status: domain.UserStatusActive},
+			wantErr: domain.ErrCannotImpersonate,
+		},
+		{
+			name:    "another super admin",
+			userID:  "admin-456",
+			user:    &domain.User{ID: "admin-456", RoleID: string(domain.RoleIDSuperAdmin), Status: domain.UserStatusActive},
+			wantErr: domain.ErrCannotImpersonate,
+		},
+		{
+			name:    "pending invited user",
+			userID:  "user-123",
+			user:    &domain.User{ID: "user-123", RoleID: string(domain.RoleIDUser), Status: domain.UserStatusPending},
+			wantErr: domain.ErrCannotImpersonate,
+		},
+		{
+			name:    "unknown user",
+			userID:  "user-404",
+			wantErr: domain.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, _, _, _, _ := setupTestUsecase()
+			if tt.user != nil {
+				repo.users[tt.user.ID] = tt.user
+			}
+
+			_, err := uc.Impersonate(context.Background(), domain.ImpersonateInput{ActorID: "admin-123", UserID: tt.userID})
+			if err != tt.wantErr {
+				t.Errorf("Impersonate() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(repo.auditLogs) != 0 {
+				t.Errorf("Impersonate() audited a refused impersonation")
+			}
+		})
+	}
+}
+
+// Test RecordImpersonatedCall adds the method to the audit trail
+func TestAuthUsecase_RecordImpersonatedCall(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+
+	if err := uc.RecordImpersonatedCall(context.Background(), "admin-123", "user-123", "/user.v1.UserService/GetMe", domain.ClientInfo{}); err != nil {
+		t.Fatalf("RecordImpersonatedCall() error = %v", err)
+	}
+
+	if len(repo.auditLogs) != 1 || repo.auditLogs[0].Action != auditActionImpersonatedCall || repo.auditLogs[0].Details != "/user.v1.UserService/GetMe" {
+		t.Errorf("RecordImpersonatedCall() audit logs = %+v", repo.auditLogs)
+	}
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+// AcceptInvitation activates an invited user with the name and password they chose.
+// Receiving the invitation mail proves the email address, so it counts as verified.
+func (usecase *AuthUsecase) AcceptInvitation(ctx context.Context, req domain.AcceptInvitationInput) error {
+	invitation, err := usecase.repository.FindValidInvitation(ctx, usecase.passwordHasher.HashToken(req.Token))
+
+	if err != nil || invitation == nil {
+		return domain.ErrInvalidToken
+	}
+
+	if invitation.Used || !invitation.ExpiresAt.After(usecase.now()) {
+		return domain.ErrInvalidToken
+	}
+
+	user, err := usecase.repository.FindUserByID(ctx, invitation.UserID)
+
+	if err != nil || user == nil || user.Status != domain.UserStatusPending {
+		return domain.ErrInvalidToken
+	}
+
+	if req.Password != req.PasswordConfirmation {
+		return domain.ErrPasswordNotMatch
+	}
+
+	user.Name = req.Name
+
+	if err := usecase.checkPassword(ctx, req.Password, user); err != nil {
+		return err
+	}
+
+	hash, err := usecase.passwordHasher.HashPassword(req.Password)
+
+	if err != nil {
+		return err
+	}
+
+	return usecase.repository.AcceptInvitation(ctx, invitation, req.Name, hash, usecase.now())
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+func setupInvitation(repo *mockAuthRepository, expiresAt time.Time) {
+	repo.users["user-invited"] = &domain.User{ID: "user-invited", Email: "invited@app.com", RoleID: string(domain.RoleIDUser), Status: domain.UserStatusPending}
+	repo.invitations = map[string]*domain.UserInvitation{
+		"sha256-invite-token": {ID: "invitation-1", UserID: "user-invited", TokenHash: "sha256-invite-token", ExpiresAt: expiresAt},
+	}
+}
+
+// Test AcceptInvitation activates the pending user with the chosen name and password
+func TestAuthUsecase_AcceptInvitation(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     domain.AcceptInvitationInput
+		expiresAt time.Time
+		wantErr   error
+	}{
+		{
+			name:      "success",
+			input:     domain.AcceptInvitationInput{Token: "invite-token", Name: "Invited User", Password: "password123", PasswordConfirmation: "password123"},
+			expiresAt: time.Now().Add(time.Hour),
+		},
+		{
+			name:      "unknown token",
+			input:     domain.AcceptInvitationInput{Token: "other-token", Name: "Invited User", Password: "password123", PasswordConfirmation: "password123"},
+			expiresAt: time.Now().Add(time.Hour),
+			wantErr:   domain.ErrInvalidToken,
+		},
+		{
+			name:      "expired invitation",
+			input:     domain.AcceptInvitationInput{Token: "invite-token", Name: "Invited User", Password: "password123", PasswordConfirmation: "password123"},
+			expiresAt: time.Now().Add(-time.Hour),
+			wantErr:   domain.ErrInvalidToken,
+		},
+		{
+			name:      "password confirmation mismatch",
+			input:     domain.AcceptInvitationInput{Token: "invite-token", Name: "Invited User", Password: "password123", PasswordConfirmation: "password124"},
+			expiresAt: time.Now().Add(time.Hour),
+			wantErr:   domain.ErrPasswordNotMatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, _, _, _, _ := setupTestUsecase()
+			uc.now = time.Now
+			setupInvitation(repo, tt.expiresAt)
+
+			err := uc.AcceptInvitation(context.Background(), tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AcceptInvitation() error = %v, want %v", err, tt.wantErr)
+			}
+
+			user := repo.users["user-invited"]
+			if tt.wantErr != nil {
+				if user.Status != domain.UserStatusPending {
+					t.Errorf("user status = %s, want pending", user.Status)
+				}
+				return
+			}
+
+			if user.Status != domain.UserStatusActive || user.Name != "Invited User" || user.PasswordHash != "hashed-password123" || user.EmailVerifiedAt == nil {
+				t.Errorf("AcceptInvitation() user = %+v", user)
+			}
+
+			if err := uc.AcceptInvitation(context.Background(), tt.input); !errors.Is(err, domain.ErrInvalidToken) {
+				t.Errorf("AcceptInvitation() reused token error = %v, want %v", err, domain.ErrInvalidToken)
+			}
+		})
+	}
+}
+
+// Test pending users can neither log in nor reset a password they never set
+func TestAuthUsecase_PendingUser(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	setupInvitation(repo, time.Now().Add(time.Hour))
+	repo.users["user-invited"].PasswordHash = "hashed-password123"
+	repo.findUserByEmail = func(email string) (*domain.User, error) {
+		return repo.users["user-invited"], nil
+	}
+
+	if _, err := uc.Login(context.Background(), domain.LoginInput{Email: "invited@app.com", Password: "password123"}); !errors.Is(err, domain.ErrInvalidCredentials) {
+		t.Errorf("Login() error = %v, want %v", err, domain.ErrInvalidCredentials)
+	}
+
+	if err := uc.ForgotPassword(context.Background(), "invited@app.com"); !errors.Is(err, domain.ErrUserNotFound) {
+		t.Errorf("ForgotPassword() error = %v, want %v", err, domain.ErrUserNotFound)
+	}
+}
@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/auth/event"
+)
+
+// UnlockAccount lifts a lockout and resets the failed attempt counter, used by admins
+func (usecase *AuthUsecase) UnlockAccount(ctx context.Context, userID string) error {
+	user, err := usecase.repository.FindUserByID(ctx, userID)
+
+	if err != nil || user == nil {
+		return domain.ErrUserNotFound
+	}
+
+	return usecase.repository.DeleteLoginLockout(ctx, user.ID)
+}
+
+// checkIPThrottle refuses any login from a client IP with too many recent failures
+func (usecase *AuthUsecase) checkIPThrottle(ctx context.Context, ipAddress string) error {
+	policy := usecase.loginThrottle
+	if policy.MaxIPFailures <= 0 || ipAddress == "" {
+		return nil
+	}
+
+	failures, windowStartedAt, err := usecase.repository.FindLoginIPFailures(ctx, ipThrottleKey(ipAddress), usecase.now().Add(-policy.IPWindow))
+
+	if err != nil {
+		return err
+	}
+
+	if failures >= policy.MaxIPFailures {
+		return &domain.LoginThrottledError{RetryAfter: windowStartedAt.Add(policy.IPWindow).Sub(usecase.now())}
+	}
+
+	return nil
+}
+
+// ipThrottleKey is what failures of a client address are counted against. The
+// address is the one metadata.ClientInfo trusts, never a forwarded header the
+// client wrote itself. An IPv6 client usually owns a whole /64 and could pick a
+// fresh address for every attempt, so the /64 is counted as one client.
+func ipThrottleKey(ipAddress string) string {
+	addr, err := netip.ParseAddr(ipAddress)
+	if err != nil {
+		return ipAddress
+	}
+
+	addr = addr.Unmap()
+	if addr.Is4() {
+		return addr.String()
+	}
+
+	return netip.PrefixFrom(addr, 64).Masked().String()
+}
+
+// checkAccountLockout refuses the attempt while the account is locked, before the password is checked
+func (usecase *AuthUsecase) checkAccountLockout(ctx context.Context, userID string) (*domain.LoginLockout, error) {
+	if usecase.loginThrottle.MaxFailedAttempts <= 0 {
+		return nil, nil
+	}
+
+	lockout, err := usecase.repository.FindLoginLockout(ctx, userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if lockout != nil && lockout.LockedUntil != nil && lockout.LockedUntil.After(usecase.now()) {
+		return nil, &domain.LoginThrottledError{RetryAfter: lockout.LockedUntil.Sub(usecase.now())}
+	}
+
+	return lockout, nil
+}
+
+// recordFailedLogin counts a failed attempt against the client IP and, for known
+// users, the account. It returns the error Login should report: the lockout once
+// the account reaches the limit, ErrInvalidCredentials otherwise.
+func (usecase *AuthUsecase) recordFailedLogin(ctx context.Context, user *domain.User, client domain.ClientInfo) error {
+	policy := usecase.loginThrottle
+
+	if policy.MaxIPFailures > 0 && client.IPAddress != "" {
+		if _, _, err := usecase.repository.IncrementLoginIPFailures(ctx, ipThrottleKey(client.IPAddress), usecase.now(), usecase.now().Add(-policy.IPWindow)); err != nil {
+			return err
+		}
+	}
+
+	if user == nil || policy.MaxFailedAttempts <= 0 {
+		return domain.ErrInvalidCredentials
+	}
+
+	attempts, lockouts, err := usecase.repository.IncrementFailedLogins(ctx, user.ID, usecase.now())
+
+	if err != nil {
+		return err
+	}
+
+	if attempts < policy.MaxFailedAttempts {
+		return domain.ErrInvalidCredentials
+	}
+
+	duration := usecase.lockoutDuration(lockouts)
+	lockedUntil := usecase.now().Add(duration)
+
+	if err := usecase.repository.LockAccount(ctx, user.ID, lockedUntil, usecase.now()); err != nil {
+		return err
+	}
+
+	_ = usecase.eventPub.AccountLocked(event.AccountLockedEvent{
+		UserID:         user.ID,
+		Name:           user.Name,
+		Email:          user.Email,
+		IPAddress:      client.IPAddress,
+		FailedAttempts: attempts,
+		LockedUntil:    lockedUntil,
+	})
+
+	return &domain.LoginThrottledError{RetryAfter: duration}
+}
+
+// lockoutDuration doubles the lockout for every previous consecutive lockout
+func (usecase *AuthUsecase) lockoutDuration(previousLockouts int) time.Duration {
+	policy := usecase.loginThrottle
+	duration := policy.LockoutDuration
+
+	for i := 0; i < previousLockouts; i++ {
+		if policy.MaxLockoutDuration > 0 && duration >= policy.MaxLockoutDuration {
+			break
+		}
+		duration *= 2
+	}
+
+	if policy.MaxLockoutDuration > 0 && duration > policy.MaxLockoutDuration {
+		duration = policy.MaxLockoutDuration
+	}
+
+	return duration
+}
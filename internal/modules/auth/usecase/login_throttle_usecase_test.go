@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/auth/event"
+)
+
+func setupThrottledUsecase() (*AuthUsecase, *mockAuthRepository) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	uc.SetLoginThrottlePolicy(domain.LoginThrottlePolicy{
+		MaxFailedAttempts:  3,
+		LockoutDuration:    time.Minute,
+		MaxLockoutDuration: 5 * time.Minute,
+		MaxIPFailures:      10,
+		IPWindow:           15 * time.Minute,
+	})
+	repo.users["user-123"] = &domain.User{ID: "user-123", Name: "Test User", Email: "test@example.com", PasswordHash: "hashed-password123"}
+
+	return uc, repo
+}
+
+func login(uc *AuthUsecase, password, ip string) error {
+	_, err := uc.Login(context.Background(), domain.LoginInput{
+		Email:    "test@example.com",
+		Password: password,
+		Client:   domain.ClientInfo{IPAddress: ip},
+	})
+	return err
+}
+
+// Test the account is locked after MaxFailedAttempts and auth.account_locked is published
+func TestAuthUsecase_Login_LocksAccount(t *testing.T) {
+	uc, repo := setupThrottledUsecase()
+	bus := &mockEventBus{}
+	uc.eventPub = event.NewAuthPublisher(bus)
+
+	for i := 0; i < 2; i++ {
+		if err := login(uc, "wrong", "10.0.0.1"); err != domain.ErrInvalidCredentials {
+			t.Fatalf("Login() attempt %d error = %v, want %v", i+1, err, domain.ErrInvalidCredentials)
+		}
+	}
+
+	err := login(uc, "wrong", "10.0.0.1")
+	var throttled *domain.LoginThrottledError
+	if !errors.As(err, &throttled) || throttled.RetryAfter != time.Minute {
+		t.Fatalf("Login() error = %v, want lockout of 1m", err)
+	}
+	if !errors.Is(err, domain.ErrTooManyAttempts) {
+		t.Errorf("Login() error does not wrap ErrTooManyAttempts")
+	}
+
+	if bus.publishedSubject != event.AccountLockedSubject {
+		t.Fatalf("Login() published %q, want %q", bus.publishedSubject, event.AccountLockedSubject)
+	}
+	var payload event.AccountLockedEvent
+	if err := json.Unmarshal(bus.publishedData, &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.Email != "test@example.com" || payload.IPAddress != "10.0.0.1" || payload.FailedAttempts != 3 {
+		t.Errorf("Login() event = %+v", payload)
+	}
+
+	// the correct password is refused while locked
+	if err := login(uc, "password123", "10.0.0.1"); !errors.As(err, &throttled) {
+		t.Fatalf("Login() while locked error = %v, want lockout", err)
+	}
+
+	// once the lockout expires the password works and the counters are reset
+	uc.now = func() time.Time { return time.Date(2024, 1, 1, 0, 2, 0, 0, time.UTC) }
+	if err := login(uc, "password123", "10.0.0.1"); err != nil {
+		t.Fatalf("Login() after lockout error = %v", err)
+	}
+	if _, ok := repo.loginLockouts["user-123"]; ok {
+		t.Errorf("Login() kept the lockout after a successful login")
+	}
+}
+
+// Test consecutive lockouts double the duration up to MaxLockoutDuration
+func TestAuthUsecase_Login_LockoutBackoff(t *testing.T) {
+	uc, repo := setupThrottledUsecase()
+
+	want := []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute, 5 * time.Minute}
+	for i, expected := range want {
+		var err error
+		for attempt := 0; attempt < 3; attempt++ {
+			err = login(uc, "wrong", "")
+		}
+
+		var throttled *domain.LoginThrottledError
+		if !errors.As(err, &throttled) || throttled.RetryAfter != expected {
+			t.Fatalf("lockout %d: Login() error = %v, want lockout of %v", i+1, err, expected)
+		}
+
+		// let the lockout expire before the next round
+		locked := *repo.loginLockouts["user-123"].LockedUntil
+		uc.now = func() time.Time { return locked }
+	}
+}
+
+// Test a client IP is throttled after MaxIPFailures, across accounts
+func TestAuthUsecase_Login_ThrottlesIP(t *testing.T) {
+	uc, repo := setupThrottledUsecase()
+	repo.users["user-456"] = &domain.User{ID: "user-456", Email: "other@example.com", PasswordHash: "hashed-password456"}
+
+	for i := 0; i < 10; i++ {
+		_, err := uc.Login(context.Background(), domain.LoginInput{
+			Email:    "unknown@example.com",
+			Password: "wrong",
+			Client:   domain.ClientInfo{IPAddress: "10.0.0.1"},
+		})
+		if err != domain.ErrInvalidCredentials {
+			t.Fatalf("Login() attempt %d error = %v, want %v", i+1, err, domain.ErrInvalidCredentials)
+		}
+	}
+
+	err := login(uc, "password123", "10.0.0.1")
+	var throttled *domain.LoginThrottledError
+	if !errors.As(err, &throttled) || throttled.RetryAfter != 15*time.Minute {
+		t.Fatalf("Login() error = %v, want IP throttle of 15m", err)
+	}
+
+	// other clients are not affected
+	if err := login(uc, "password123", "10.0.0.2"); err != nil {
+		t.Errorf("Login() from another IP error = %v", err)
+	}
+
+	// the window slides
+	uc.now = func() time.Time { return time.Date(2024, 1, 1, 0, 15, 0, 0, time.UTC) }
+	if err := login(uc, "password123", "10.0.0.1"); err != nil {
+		t.Errorf("Login() after the window error = %v", err)
+	}
+}
+
+// Test the addresses of one IPv6 /64 count as one client
+func TestAuthUsecase_Login_ThrottlesIPv6Prefix(t *testing.T) {
+	uc, _ := setupThrottledUsecase()
+
+	for i := 0; i < 10; i++ {
+		_, err := uc.Login(context.Background(), domain.LoginInput{
+			Email:    "unknown@example.com",
+			Password: "wrong",
+			Client:   domain.ClientInfo{IPAddress: fmt.Sprintf("2001:db8:1:2::%x", i+1)},
+		})
+		if err != domain.ErrInvalidCredentials {
+			t.Fatalf("Login() attempt %d error = %v, want %v", i+1, err, domain.ErrInvalidCredentials)
+		}
+	}
+
+	var throttled *domain.LoginThrottledError
+	if err := login(uc, "password123", "2001:db8:1:2:ffff::1"); !errors.As(err, &throttled) {
+		t.Errorf("Login() from the same /64 error = %v, want throttled", err)
+	}
+	if err := login(uc, "password123", "2001:db8:1:3::1"); err != nil {
+		t.Errorf("Login() from another /64 error = %v", err)
+	}
+}
+
+// Test a zero policy leaves Login unthrottled
+func TestAuthUsecase_Login_ThrottleDisabled(t *testing.T) {
+	uc, repo := setupThrottledUsecase()
+	uc.SetLoginThrottlePolicy(domain.LoginThrottlePolicy{})
+
+	for i := 0; i < 20; i++ {
+		if err := login(uc, "wrong", "10.0.0.1"); err != domain.ErrInvalidCredentials {
+			t.Fatalf("Login() attempt %d error = %v, want %v", i+1, err, domain.ErrInvalidCredentials)
+		}
+	}
+	if len(repo.loginLockouts) != 0 || len(repo.loginIPFailures) != 0 {
+		t.Errorf("Login() recorded failures with throttling disabled")
+	}
+}
+
+// Test UnlockAccount
+func TestAuthUsecase_UnlockAccount(t *testing.T) {
+	uc, repo := setupThrottledUsecase()
+
+	for i := 0; i < 3; i++ {
+		_ = login(uc, "wrong", "")
+	}
+	if repo.loginLockouts["user-123"].LockedUntil == nil {
+		t.Fatalf("account was not locked")
+	}
+
+	if err := uc.UnlockAccount(context.Background(), "user-123"); err != nil {
+		t.Fatalf("UnlockAccount() error = %v", err)
+	}
+	if err := login(uc, "password123", ""); err != nil {
+		t.Errorf("Login() after unlock error = %v", err)
+	}
+
+	if err := uc.UnlockAccount(context.Background(), "user-unknown"); err != domain.ErrUserNotFound {
+		t.Errorf("UnlockAccount() error = %v, want %v", err, domain.ErrUserNotFound)
+	}
+}
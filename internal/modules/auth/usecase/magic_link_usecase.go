@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/auth/event"
+)
+
+const (
+	magicLinkTTL            = 15 * time.Minute
+	magicLinkResendInterval = time.Minute
+)
+
+// RequestMagicLink mails a sign-in link and returns the browser code the link
+// only works with. Unknown emails, and repeated requests within
+// magicLinkResendInterval, get a code too, so the response cannot be used to
+// probe which addresses are registered.
+func (usecase *AuthUsecase) RequestMagicLink(ctx context.Context, email string) (string, error) {
+	browserCode, err := generateBrowserCode()
+
+	if err != nil {
+		return "", err
+	}
+
+	user, err := usecase.repository.FindUserByEmail(ctx, email)
+
+	if err != nil || user == nil || user.Status != domain.UserStatusActive {
+		return browserCode, nil
+	}
+
+	latest, err := usecase.repository.FindLatestMagicLink(ctx, user.ID)
+
+	if err != nil {
+		return "", err
+	}
+
+	// Do not let a client flood the user's inbox; the earlier link still works
+	if latest != nil && latest.CreatedAt.Add(magicLinkResendInterval).After(usecase.now()) {
+		return browserCode, nil
+	}
+
+	token, err := usecase.passwordHasher.GenerateRandomToken()
+
+	if err != nil {
+		return "", err
+	}
+
+	expiredAt := usecase.now().Add(magicLinkTTL)
+
+	if err := usecase.repository.StoreMagicLink(ctx, &domain.MagicLink{
+		ID:              usecase.uuid.GenerateID(),
+		UserID:          user.ID,
+		TokenHash:       usecase.passwordHasher.HashToken(token),
+		BrowserCodeHash: usecase.passwordHasher.HashToken(normalizeBrowserCode(browserCode)),
+		ExpiresAt:       expiredAt,
+		CreatedAt:       usecase.now(),
+		UpdatedAt:       usecase.now(),
+	}); err != nil {
+		return "", err
+	}
+
+	_ = usecase.eventPub.MagicLinkRequested(event.MagicLinkRequestedEvent{
+		UserID:    user.ID,
+		Name:      user.Name,
+		Email:     user.Email,
+		Token:     token,
+		ExpiredAt: expiredAt,
+	})
+
+	return browserCode, nil
+}
+
+// ConsumeMagicLink signs the user in like Login does. The link is spent before
+// the browser code is compared, so a forwarded link gets exactly one guess.
+func (usecase *AuthUsecase) ConsumeMagicLink(ctx context.Context, req domain.ConsumeMagicLinkInput) (*domain.AuthOutput, error) {
+	link, err := usecase.repository.FindValidMagicLink(ctx, usecase.passwordHasher.HashToken(req.Token))
+
+	if err != nil || link == nil || link.Used || !link.ExpiresAt.After(usecase.now()) {
+		return nil, domain.ErrInvalidToken
+	}
+
+	claimed, err := usecase.repository.MarkMagicLinkUsed(ctx, link.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !claimed {
+		return nil, domain.ErrInvalidToken
+	}
+
+	codeHash := usecase.passwordHasher.HashToken(normalizeBrowserCode(req.BrowserCode))
+
+	if subtle.ConstantTimeCompare([]byte(codeHash), []byte(link.BrowserCodeHash)) != 1 {
+		return nil, domain.ErrInvalidToken
+	}
+
+	user, err := usecase.repository.FindUserByID(ctx, link.UserID)
+
+	if err != nil || user == nil || user.Status == domain.UserStatusPending {
+		return nil, domain.ErrInvalidToken
+	}
+
+	// Opening the mailed link proves the address
+	if user.EmailVerifiedAt == nil {
+		if err := usecase.repository.MarkUserEmailVerified(ctx, user.ID, usecase.now()); err != nil {
+			return nil, err
+		}
+	}
+
+	methods, err := usecase.mfaMethods(ctx, user.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(methods) > 0 {
+		return usecase.createMfaChallenge(ctx, user, methods)
+	}
+
+	return usecase.issueTokens(ctx, user, req.Client)
+}
+
+// generateBrowserCode returns a short code a user can also type in by hand
+// when the link opens in another browser
+func generateBrowserCode() (string, error) {
+	bytes := make([]byte, 5)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return recoveryCodeEncoding.EncodeToString(bytes), nil
+}
+
+func normalizeBrowserCode(code string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.ReplaceAll(code, "-", ""), " ", ""))
+}
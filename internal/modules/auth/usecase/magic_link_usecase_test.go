@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/auth/event"
+)
+
+func setupMagicLinkUser(repo *mockAuthRepository) *domain.User {
+	user := &domain.User{
+		ID:     "user-123",
+		Email:  "test@example.com",
+		RoleID: string(domain.RoleIDUser),
+		Status: domain.UserStatusActive,
+	}
+	repo.users[user.ID] = user
+	return user
+}
+
+// Test RequestMagicLink stores a hashed link and mails the plain token
+func TestAuthUsecase_RequestMagicLink(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	bus := &mockEventBus{}
+	uc.eventPub = event.NewAuthPublisher(bus)
+	setupMagicLinkUser(repo)
+
+	code, err := uc.RequestMagicLink(context.Background(), "test@example.com")
+	if err != nil {
+		t.Fatalf("RequestMagicLink() error = %v", err)
+	}
+	if len(code) != 8 {
+		t.Errorf("RequestMagicLink() browser code = %q, want 8 characters", code)
+	}
+
+	link, ok := repo.magicLinks["sha256-random-token"]
+	if !ok {
+		t.Fatalf("RequestMagicLink() did not store hashed token")
+	}
+	if link.BrowserCodeHash != "sha256-"+code {
+		t.Errorf("RequestMagicLink() browser code hash = %q", link.BrowserCodeHash)
+	}
+	if bus.publishedSubject != event.MagicLinkRequestedSubject {
+		t.Errorf("RequestMagicLink() published %q, want %q", bus.publishedSubject, event.MagicLinkRequestedSubject)
+	}
+}
+
+// Test RequestMagicLink answers unknown emails the same way
+func TestAuthUsecase_RequestMagicLink_UnknownEmail(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	bus := &mockEventBus{}
+	uc.eventPub = event.NewAuthPublisher(bus)
+
+	code, err := uc.RequestMagicLink(context.Background(), "nobody@example.com")
+	if err != nil || code == "" {
+		t.Fatalf("RequestMagicLink() = %q, %v, want a browser code", code, err)
+	}
+	if len(repo.magicLinks) != 0 || bus.publishedSubject != "" {
+		t.Errorf("RequestMagicLink() must not store or send a link for unknown emails")
+	}
+}
+
+// Test RequestMagicLink sends at most one link per resend interval and answers
+// the throttled request like any other
+func TestAuthUsecase_RequestMagicLink_ResendInterval(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	bus := &mockEventBus{}
+	uc.eventPub = event.NewAuthPublisher(bus)
+	setupMagicLinkUser(repo)
+
+	if _, err := uc.RequestMagicLink(context.Background(), "test@example.com"); err != nil {
+		t.Fatalf("RequestMagicLink() error = %v", err)
+	}
+
+	bus.publishedSubject = ""
+	uc.now = func() time.Time { return time.Date(2024, 1, 1, 0, 0, 30, 0, time.UTC) }
+
+	code, err := uc.RequestMagicLink(context.Background(), "test@example.com")
+	if err != nil || len(code) != 8 {
+		t.Fatalf("RequestMagicLink() = %q, %v, want a browser code", code, err)
+	}
+	if bus.publishedSubject != "" {
+		t.Errorf("RequestMagicLink() sent another link within the resend interval")
+	}
+
+	uc.now = func() time.Time { return time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC) }
+
+	if _, err := uc.RequestMagicLink(context.Background(), "test@example.com"); err != nil {
+		t.Fatalf("RequestMagicLink() error = %v", err)
+	}
+	if bus.publishedSubject != event.MagicLinkRequestedSubject {
+		t.Errorf("RequestMagicLink() did not send a link after the resend interval")
+	}
+}
+
+// Test ConsumeMagicLink
+func TestAuthUsecase_ConsumeMagicLink(t *testing.T) {
+	tests := []struct {
+		name        string
+		token       string
+		browserCode func(code string) string
+		wantErr     error
+	}{
+		{
+			name:        "success",
+			token:       "random-token",
+			browserCode: func(code string) string { return code },
+		},
+		{
+			name:        "success - code typed with separators",
+			token:       "random-token",
+			browserCode: func(code string) string { return "  " + code[:4] + "-" + code[4:] },
+		},
+		{
+			name:        "unknown token",
+			token:       "other-token",
+			browserCode: func(code string) string { return code },
+			wantErr:     domain.ErrInvalidToken,
+		},
+		{
+			name:        "forwarded link - wrong browser code",
+			token:       "random-token",
+			browserCode: func(code string) string { return "AAAAAAAA" },
+			wantErr:     domain.ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, _, _, _, _ := setupTestUsecase()
+			setupMagicLinkUser(repo)
+
+			code, err := uc.RequestMagicLink(context.Background(), "test@example.com")
+			if err != nil {
+				t.Fatalf("RequestMagicLink() error = %v", err)
+			}
+
+			out, err := uc.ConsumeMagicLink(context.Background(), domain.ConsumeMagicLinkInput{
+				Token:       tt.token,
+				BrowserCode: tt.browserCode(code),
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ConsumeMagicLink() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if out.AccessToken == "" || out.RefreshToken == "" {
+				t.Errorf("ConsumeMagicLink() expected token pair, got %+v", out)
+			}
+			if repo.users["user-123"].EmailVerifiedAt == nil {
+				t.Errorf("ConsumeMagicLink() did not mark email verified")
+			}
+		})
+	}
+}
+
+// Test ConsumeMagicLink is single-use, even after a wrong browser code
+func TestAuthUsecase_ConsumeMagicLink_SingleUse(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	setupMagicLinkUser(repo)
+
+	code, _ := uc.RequestMagicLink(context.Background(), "test@example.com")
+
+	if _, err := uc.ConsumeMagicLink(context.Background(), domain.ConsumeMagicLinkInput{Token: "random-token", BrowserCode: "AAAAAAAA"}); !errors.Is(err, domain.ErrInvalidToken) {
+		t.Fatalf("ConsumeMagicLink() error = %v, want %v", err, domain.ErrInvalidToken)
+	}
+	if _, err := uc.ConsumeMagicLink(context.Background(), domain.ConsumeMagicLinkInput{Token: "random-token", BrowserCode: code}); !errors.Is(err, domain.ErrInvalidToken) {
+		t.Errorf("ConsumeMagicLink() reused link error = %v, want %v", err, domain.ErrInvalidToken)
+	}
+}
+
+// Test ConsumeMagicLink returns an MFA challenge when MFA is enabled
+func TestAuthUsecase_ConsumeMagicLink_MfaChallenge(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	setupMfaUser(repo, true)
+	repo.users["user-123"].Status = domain.UserStatusActive
+
+	code, _ := uc.RequestMagicLink(context.Background(), "admin@app.com")
+
+	out, err := uc.ConsumeMagicLink(context.Background(), domain.ConsumeMagicLinkInput{Token: "random-token", BrowserCode: code})
+	if err != nil {
+		t.Fatalf("ConsumeMagicLink() error = %v", err)
+	}
+	if !out.MfaRequired || out.AccessToken != "" {
+		t.Errorf("ConsumeMagicLink() expected MFA challenge, got %+v", out)
+	}
+}
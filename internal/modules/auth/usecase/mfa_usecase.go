@@ -0,0 +1,326 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+const (
+	mfaChallengeTTL      = 5 * time.Minute
+	mfaMaxAttempts       = 5
+	mfaRecoveryCodeCount = 10
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// EnrollMfa generates a new TOTP secret for the user. MFA stays disabled until ConfirmMfa succeeds.
+func (usecase *AuthUsecase) EnrollMfa(ctx context.Context, userID string) (*domain.MfaEnrollment, error) {
+	user, err := usecase.repository.FindUserByID(ctx, userID)
+
+	if err != nil || user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	existing, err := usecase.repository.FindUserMfa(ctx, userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil && existing.Enabled {
+		return nil, domain.ErrMfaAlreadyEnabled
+	}
+
+	secret, err := usecase.otp.GenerateSecret()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := usecase.repository.UpsertUserMfa(ctx, &domain.UserMfa{
+		UserID:    userID,
+		Secret:    secret,
+		CreatedAt: usecase.now(),
+		UpdatedAt: usecase.now(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &domain.MfaEnrollment{
+		Secret:          secret,
+		ProvisioningURI: usecase.otp.ProvisioningURI(secret, user.Email),
+	}, nil
+}
+
+// ConfirmMfa activates MFA once the user proves possession of the secret and returns one-time recovery codes
+func (usecase *AuthUsecase) ConfirmMfa(ctx context.Context, userID, code string) ([]string, error) {
+	mfa, err := usecase.repository.FindUserMfa(ctx, userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if mfa == nil {
+		return nil, domain.ErrMfaNotEnrolled
+	}
+
+	if mfa.Enabled {
+		return nil, domain.ErrMfaAlreadyEnabled
+	}
+
+	step, ok := usecase.otp.Validate(mfa.Secret, code, usecase.now())
+
+	if !ok {
+		return nil, domain.ErrInvalidMfaCode
+	}
+
+	if err := usecase.repository.EnableUserMfa(ctx, userID, step); err != nil {
+		return nil, err
+	}
+
+	return usecase.generateRecoveryCodes(ctx, userID)
+}
+
+// DisableMfa turns MFA off after verifying a TOTP or recovery code
+func (usecase *AuthUsecase) DisableMfa(ctx context.Context, userID, code string) error {
+	mfa, err := usecase.repository.FindUserMfa(ctx, userID)
+
+	if err != nil {
+		return err
+	}
+
+	if mfa == nil || !mfa.Enabled {
+		return domain.ErrMfaNotEnabled
+	}
+
+	if err := usecase.verifyMfaCode(ctx, mfa, code); err != nil {
+		return err
+	}
+
+	return usecase.repository.DeleteUserMfa(ctx, userID)
+}
+
+// VerifyMfa completes a login that returned an MFA challenge and issues the token pair.
+// Wrong codes count toward the account lockout like wrong passwords, so a
+// password holder cannot keep starting new challenges to guess the code.
+func (usecase *AuthUsecase) VerifyMfa(ctx context.Context, req domain.VerifyMfaInput) (*domain.AuthOutput, error) {
+	challenge, err := usecase.findMfaChallenge(ctx, req.MfaToken)
+
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := usecase.repository.FindUserByID(ctx, challenge.UserID)
+
+	if err != nil || user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	lockout, err := usecase.checkAccountLockout(ctx, user.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The attempt is counted before the code is checked so parallel guesses
+	// cannot share the challenge's last remaining attempt
+	attempts, err := usecase.repository.IncrementMfaChallengeAttempts(ctx, challenge.ID, mfaMaxAttempts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if attempts == 0 {
+		_, _ = usecase.repository.MarkMfaChallengeUsed(ctx, challenge.ID)
+		return nil, domain.ErrInvalidMfaChallenge
+	}
+
+	mfa, err := usecase.repository.FindUserMfa(ctx, challenge.UserID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if mfa == nil || !mfa.Enabled {
+		return nil, domain.ErrMfaNotEnabled
+	}
+
+	if err := usecase.verifyMfaCode(ctx, mfa, req.Code); err != nil {
+		if errors.Is(err, domain.ErrInvalidMfaCode) {
+			if failed := usecase.recordFailedLogin(ctx, user, req.Client); !errors.Is(failed, domain.ErrInvalidCredentials) {
+				return nil, failed
+			}
+		}
+		return nil, err
+	}
+
+	// A concurrent verification of the same challenge already won
+	claimed, err := usecase.repository.MarkMfaChallengeUsed(ctx, challenge.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !claimed {
+		return nil, domain.ErrInvalidMfaChallenge
+	}
+
+	if lockout != nil {
+		if err := usecase.repository.DeleteLoginLockout(ctx, user.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return usecase.issueTokens(ctx, user, req.Client)
+}
+
+// findMfaChallenge returns the pending challenge for token, retiring it once its attempts are used up
+func (usecase *AuthUsecase) findMfaChallenge(ctx context.Context, token string) (*domain.MfaChallenge, error) {
+	challenge, err := usecase.repository.FindValidMfaChallenge(ctx, usecase.passwordHasher.HashToken(token))
+
+	if err != nil || challenge == nil || challenge.Used || challenge.ExpiresAt.Before(usecase.now()) {
+		return nil, domain.ErrInvalidMfaChallenge
+	}
+
+	if challenge.Attempts >= mfaMaxAttempts {
+		_, _ = usecase.repository.MarkMfaChallengeUsed(ctx, challenge.ID)
+		return nil, domain.ErrInvalidMfaChallenge
+	}
+
+	return challenge, nil
+}
+
+// mfaMethods lists the second factors the user set up, any of which completes
+// the login's MFA challenge. Passkeys only count while WebAuthn is configured.
+func (usecase *AuthUsecase) mfaMethods(ctx context.Context, userID string) ([]string, error) {
+	var methods []string
+
+	mfa, err := usecase.repository.FindUserMfa(ctx, userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if mfa != nil && mfa.Enabled {
+		methods = append(methods, domain.MfaMethodTotp)
+	}
+
+	if usecase.webauthn != nil {
+		credentials, err := usecase.repository.ListWebauthnCredentials(ctx, userID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(credentials) > 0 {
+			methods = append(methods, domain.MfaMethodWebauthn)
+		}
+	}
+
+	return methods, nil
+}
+
+// createMfaChallenge stores a short-lived, single-use challenge in place of the token pair
+func (usecase *AuthUsecase) createMfaChallenge(ctx context.Context, user *domain.User, methods []string) (*domain.AuthOutput, error) {
+	if err := requireActiveUser(user); err != nil {
+		return nil, err
+	}
+
+	token, err := usecase.passwordHasher.GenerateRandomToken()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := usecase.repository.StoreMfaChallenge(ctx, &domain.MfaChallenge{
+		ID:        usecase.uuid.GenerateID(),
+		UserID:    user.ID,
+		TokenHash: usecase.passwordHasher.HashToken(token),
+		ExpiresAt: usecase.now().Add(mfaChallengeTTL),
+		CreatedAt: usecase.now(),
+		UpdatedAt: usecase.now(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &domain.AuthOutput{
+		MfaRequired: true,
+		MfaToken:    token,
+		MfaMethods:  methods,
+	}, nil
+}
+
+// verifyMfaCode accepts either a TOTP code (rejecting replays of an already used step) or an unused recovery code
+func (usecase *AuthUsecase) verifyMfaCode(ctx context.Context, mfa *domain.UserMfa, code string) error {
+	code = strings.TrimSpace(code)
+
+	if step, ok := usecase.otp.Validate(mfa.Secret, code, usecase.now()); ok {
+		if step <= mfa.LastUsedStep {
+			return domain.ErrInvalidMfaCode
+		}
+
+		// The step is claimed in the database so two requests racing with the same code cannot both pass
+		claimed, err := usecase.repository.UpdateMfaLastUsedStep(ctx, mfa.UserID, step)
+
+		if err != nil {
+			return err
+		}
+
+		if !claimed {
+			return domain.ErrInvalidMfaCode
+		}
+
+		return nil
+	}
+
+	used, err := usecase.repository.UseMfaRecoveryCode(ctx, mfa.UserID, usecase.passwordHasher.HashToken(normalizeRecoveryCode(code)))
+
+	if err != nil {
+		return err
+	}
+
+	if !used {
+		return domain.ErrInvalidMfaCode
+	}
+
+	return nil
+}
+
+func (usecase *AuthUsecase) generateRecoveryCodes(ctx context.Context, userID string) ([]string, error) {
+	plain := make([]string, 0, mfaRecoveryCodeCount)
+	codes := make([]*domain.MfaRecoveryCode, 0, mfaRecoveryCodeCount)
+
+	for i := 0; i < mfaRecoveryCodeCount; i++ {
+		bytes := make([]byte, 5)
+		if _, err := rand.Read(bytes); err != nil {
+			return nil, err
+		}
+
+		code := strings.ToLower(recoveryCodeEncoding.EncodeToString(bytes))
+		plain = append(plain, code[:4]+"-"+code[4:])
+
+		codes = append(codes, &domain.MfaRecoveryCode{
+			ID:        usecase.uuid.GenerateID(),
+			UserID:    userID,
+			CodeHash:  usecase.passwordHasher.HashToken(code),
+			CreatedAt: usecase.now(),
+			UpdatedAt: usecase.now(),
+		})
+	}
+
+	if err := usecase.repository.ReplaceMfaRecoveryCodes(ctx, userID, codes); err != nil {
+		return nil, err
+	}
+
+	return plain, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.ReplaceAll(code, "-", ""), " ", ""))
+}
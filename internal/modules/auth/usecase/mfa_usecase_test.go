@@ -0,0 +1,287 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+func setupMfaUser(repo *mockAuthRepository, enabled bool) *domain.User {
+	user := &domain.User{
+		ID:           "user-123",
+		Email:        "admin@app.com",
+		PasswordHash: "hashed-password123",
+		RoleID:       string(domain.RoleIDSuperAdmin),
+	}
+	repo.users[user.ID] = user
+	repo.userMfa = map[string]*domain.UserMfa{
+		user.ID: {UserID: user.ID, Secret: "MOCKSECRET", Enabled: enabled, LastUsedStep: 50},
+	}
+	return user
+}
+
+// Test Login with MFA enabled
+func TestAuthUsecase_Login_MfaChallenge(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	setupMfaUser(repo, true)
+
+	out, err := uc.Login(context.Background(), domain.LoginInput{Email: "admin@app.com", Password: "password123"})
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if !out.MfaRequired || out.MfaToken == "" {
+		t.Errorf("Login() expected MFA challenge, got %+v", out)
+	}
+	if len(out.MfaMethods) != 1 || out.MfaMethods[0] != domain.MfaMethodTotp {
+		t.Errorf("Login() mfa methods = %v, want [%s]", out.MfaMethods, domain.MfaMethodTotp)
+	}
+	if out.AccessToken != "" || out.RefreshToken != "" {
+		t.Errorf("Login() must not issue tokens before MFA, got %+v", out)
+	}
+	if len(repo.refreshTokens) != 0 {
+		t.Errorf("Login() stored refresh token before MFA")
+	}
+	if _, ok := repo.mfaChallenges["sha256-"+out.MfaToken]; !ok {
+		t.Errorf("Login() did not store hashed challenge")
+	}
+}
+
+// Test EnrollMfa and ConfirmMfa
+func TestAuthUsecase_EnrollAndConfirmMfa(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	user := setupMfaUser(repo, false)
+	delete(repo.userMfa, user.ID)
+
+	enrollment, err := uc.EnrollMfa(context.Background(), user.ID)
+	if err != nil {
+		t.Fatalf("EnrollMfa() error = %v", err)
+	}
+	if enrollment.Secret != "MOCKSECRET" || enrollment.ProvisioningURI == "" {
+		t.Errorf("EnrollMfa() = %+v", enrollment)
+	}
+	if repo.userMfa[user.ID].Enabled {
+		t.Errorf("EnrollMfa() must not enable MFA before confirmation")
+	}
+
+	if _, err := uc.ConfirmMfa(context.Background(), user.ID, "000000"); !errors.Is(err, domain.ErrInvalidMfaCode) {
+		t.Errorf("ConfirmMfa() error = %v, want %v", err, domain.ErrInvalidMfaCode)
+	}
+
+	codes, err := uc.ConfirmMfa(context.Background(), user.ID, "123456")
+	if err != nil {
+		t.Fatalf("ConfirmMfa() error = %v", err)
+	}
+	if len(codes) != mfaRecoveryCodeCount {
+		t.Errorf("ConfirmMfa() returned %d recovery codes, want %d", len(codes), mfaRecoveryCodeCount)
+	}
+	if !repo.userMfa[user.ID].Enabled {
+		t.Errorf("ConfirmMfa() did not enable MFA")
+	}
+	for _, code := range codes {
+		if _, ok := repo.recoveryCodes[code]; ok {
+			t.Errorf("ConfirmMfa() stored recovery code in plain text")
+		}
+	}
+
+	if _, err := uc.EnrollMfa(context.Background(), user.ID); !errors.Is(err, domain.ErrMfaAlreadyEnabled) {
+		t.Errorf("EnrollMfa() error = %v, want %v", err, domain.ErrMfaAlreadyEnabled)
+	}
+}
+
+// Test VerifyMfa
+func TestAuthUsecase_VerifyMfa(t *testing.T) {
+	fixedTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		code         string
+		setup        func(*mockAuthRepository)
+		wantErr      error
+		wantAttempts int
+	}{
+		{
+			name:         "success - valid totp code",
+			code:         "123456",
+			wantErr:      nil,
+			wantAttempts: 1,
+		},
+		{
+			name: "success - valid recovery code",
+			code: "ABCD-EFGH",
+			setup: func(repo *mockAuthRepository) {
+				repo.recoveryCodes = map[string]*domain.MfaRecoveryCode{
+					"sha256-abcdefgh": {ID: "rc-1", UserID: "user-123", CodeHash: "sha256-abcdefgh"},
+				}
+			},
+			wantErr:      nil,
+			wantAttempts: 1,
+		},
+		{
+			name: "failure - replayed totp step",
+			code: "123456",
+			setup: func(repo *mockAuthRepository) {
+				repo.userMfa["user-123"].LastUsedStep = 100
+			},
+			wantErr:      domain.ErrInvalidMfaCode,
+			wantAttempts: 1,
+		},
+		{
+			name:         "failure - wrong code",
+			code:         "000000",
+			wantErr:      domain.ErrInvalidMfaCode,
+			wantAttempts: 1,
+		},
+		{
+			name: "failure - too many attempts",
+			code: "123456",
+			setup: func(repo *mockAuthRepository) {
+				repo.mfaChallenges["sha256-mfa-token"].Attempts = mfaMaxAttempts
+			},
+			wantErr:      domain.ErrInvalidMfaChallenge,
+			wantAttempts: mfaMaxAttempts,
+		},
+		{
+			name: "failure - expired challenge",
+			code: "123456",
+			setup: func(repo *mockAuthRepository) {
+				repo.mfaChallenges["sha256-mfa-token"].ExpiresAt = fixedTime.Add(-time.Minute)
+			},
+			wantErr: domain.ErrInvalidMfaChallenge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, _, _, _, _ := setupTestUsecase()
+			setupMfaUser(repo, true)
+			repo.mfaChallenges = map[string]*domain.MfaChallenge{
+				"sha256-mfa-token": {
+					ID:        "challenge-1",
+					UserID:    "user-123",
+					TokenHash: "sha256-mfa-token",
+					ExpiresAt: fixedTime.Add(mfaChallengeTTL),
+				},
+			}
+			if tt.setup != nil {
+				tt.setup(repo)
+			}
+
+			out, err := uc.VerifyMfa(context.Background(), domain.VerifyMfaInput{MfaToken: "mfa-token", Code: tt.code})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("VerifyMfa() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			challenge := repo.mfaChallenges["sha256-mfa-token"]
+			if challenge.Attempts != tt.wantAttempts {
+				t.Errorf("VerifyMfa() attempts = %d, want %d", challenge.Attempts, tt.wantAttempts)
+			}
+
+			if tt.wantErr == nil {
+				if out.AccessToken == "" || out.RefreshToken == "" {
+					t.Errorf("VerifyMfa() expected tokens, got %+v", out)
+				}
+				if !challenge.Used {
+					t.Errorf("VerifyMfa() did not consume the challenge")
+				}
+			}
+		})
+	}
+}
+
+// Test wrong MFA codes lock the account and a fresh password login does not reset the count
+func TestAuthUsecase_VerifyMfa_LocksAccount(t *testing.T) {
+	uc, repo := setupThrottledUsecase()
+	setupMfaUser(repo, true)
+
+	verify := func() error {
+		out, err := uc.Login(context.Background(), domain.LoginInput{Email: "admin@app.com", Password: "password123"})
+		if err != nil {
+			return err
+		}
+		_, err = uc.VerifyMfa(context.Background(), domain.VerifyMfaInput{MfaToken: out.MfaToken, Code: "000000"})
+		return err
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := verify(); !errors.Is(err, domain.ErrInvalidMfaCode) {
+			t.Fatalf("VerifyMfa() attempt %d error = %v, want %v", i+1, err, domain.ErrInvalidMfaCode)
+		}
+	}
+
+	var throttled *domain.LoginThrottledError
+	if err := verify(); !errors.As(err, &throttled) {
+		t.Fatalf("VerifyMfa() error = %v, want lockout", err)
+	}
+
+	// the password alone is refused while locked
+	if _, err := uc.Login(context.Background(), domain.LoginInput{Email: "admin@app.com", Password: "password123"}); !errors.As(err, &throttled) {
+		t.Fatalf("Login() while locked error = %v, want lockout", err)
+	}
+}
+
+// Test a correct code resets the failed attempts once the login completes
+func TestAuthUsecase_VerifyMfa_ResetsLockout(t *testing.T) {
+	uc, repo := setupThrottledUsecase()
+	setupMfaUser(repo, true)
+
+	out, err := uc.Login(context.Background(), domain.LoginInput{Email: "admin@app.com", Password: "password123"})
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if _, err := uc.VerifyMfa(context.Background(), domain.VerifyMfaInput{MfaToken: out.MfaToken, Code: "000000"}); !errors.Is(err, domain.ErrInvalidMfaCode) {
+		t.Fatalf("VerifyMfa() error = %v, want %v", err, domain.ErrInvalidMfaCode)
+	}
+	if _, ok := repo.loginLockouts["user-123"]; !ok {
+		t.Fatalf("VerifyMfa() did not record the failed code")
+	}
+
+	// a new password login keeps the count while MFA is pending
+	out, err = uc.Login(context.Background(), domain.LoginInput{Email: "admin@app.com", Password: "password123"})
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if _, ok := repo.loginLockouts["user-123"]; !ok {
+		t.Fatalf("Login() reset the failed attempts before MFA passed")
+	}
+
+	if _, err := uc.VerifyMfa(context.Background(), domain.VerifyMfaInput{MfaToken: out.MfaToken, Code: "123456"}); err != nil {
+		t.Fatalf("VerifyMfa() error = %v", err)
+	}
+	if _, ok := repo.loginLockouts["user-123"]; ok {
+		t.Errorf("VerifyMfa() kept the failed attempts after a successful login")
+	}
+}
+
+// Test DisableMfa
+func TestAuthUsecase_DisableMfa(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled bool
+		code    string
+		wantErr error
+	}{
+		{name: "success - valid code", enabled: true, code: "654321", wantErr: nil},
+		{name: "failure - invalid code", enabled: true, code: "000000", wantErr: domain.ErrInvalidMfaCode},
+		{name: "failure - mfa not enabled", enabled: false, code: "654321", wantErr: domain.ErrMfaNotEnabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, _, _, _, _ := setupTestUsecase()
+			user := setupMfaUser(repo, tt.enabled)
+
+			err := uc.DisableMfa(context.Background(), user.ID, tt.code)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("DisableMfa() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			_, stillEnrolled := repo.userMfa[user.ID]
+			if (tt.wantErr == nil) == stillEnrolled {
+				t.Errorf("DisableMfa() enrolled = %v after error %v", stillEnrolled, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+const oidcStateTTL = 10 * time.Minute
+
+// OidcProvider is an OpenID Connect provider users sign in with
+type OidcProvider interface {
+	AuthorizationURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	// Exchange redeems the authorization code and returns the claims of the validated ID token
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*domain.OidcClaims, error)
+}
+
+// SetOidcProviders sets the providers users can sign in with, by name
+func (usecase *AuthUsecase) SetOidcProviders(providers map[string]OidcProvider) {
+	usecase.oidcProviders = providers
+}
+
+// StartOidcLogin returns the provider's authorization URL. The state, nonce and
+// PKCE verifier stay on the server; the returned browser code must come back
+// with the callback, so a callback URL planted in another browser is useless.
+func (usecase *AuthUsecase) StartOidcLogin(ctx context.Context, providerName string) (*domain.OidcStart, error) {
+	provider, ok := usecase.oidcProviders[providerName]
+
+	if !ok {
+		return nil, domain.ErrUnknownOidcProvider
+	}
+
+	secrets := make([]string, 4)
+	for i := range secrets {
+		secret, err := usecase.passwordHasher.GenerateRandomToken()
+
+		if err != nil {
+			return nil, err
+		}
+
+		secrets[i] = secret
+	}
+
+	state, nonce, codeVerifier, browserCode := secrets[0], secrets[1], secrets[2], secrets[3]
+	challenge := sha256.Sum256([]byte(codeVerifier))
+
+	authorizationURL, err := provider.AuthorizationURL(ctx, state, nonce, base64.RawURLEncoding.EncodeToString(challenge[:]))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := usecase.repository.StoreOidcState(ctx, &domain.OidcState{
+		ID:              usecase.uuid.GenerateID(),
+		Provider:        providerName,
+		StateHash:       usecase.passwordHasher.HashToken(state),
+		BrowserCodeHash: usecase.passwordHasher.HashToken(browserCode),
+		Nonce:           nonce,
+		CodeVerifier:    codeVerifier,
+		ExpiresAt:       usecase.now().Add(oidcStateTTL),
+		CreatedAt:       usecase.now(),
+		UpdatedAt:       usecase.now(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &domain.OidcStart{AuthorizationURL: authorizationURL, BrowserCode: browserCode}, nil
+}
+
+// CompleteOidcLogin handles the provider's callback and signs the user in like
+// Login does. Unknown identities are linked to the user with the same verified
+// email address, or sign up a new user.
+func (usecase *AuthUsecase) CompleteOidcLogin(ctx context.Context, req domain.OidcCallbackInput) (*domain.AuthOutput, error) {
+	provider, ok := usecase.oidcProviders[req.Provider]
+
+	if !ok {
+		return nil, domain.ErrUnknownOidcProvider
+	}
+
+	state, err := usecase.repository.FindValidOidcState(ctx, usecase.passwordHasher.HashToken(req.State))
+
+	if err != nil || state == nil || state.Used || state.Provider != req.Provider || !state.ExpiresAt.After(usecase.now()) {
+		return nil, domain.ErrInvalidOidcState
+	}
+
+	claimed, err := usecase.repository.MarkOidcStateUsed(ctx, state.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !claimed {
+		return nil, domain.ErrInvalidOidcState
+	}
+
+	codeHash := usecase.passwordHasher.HashToken(req.BrowserCode)
+
+	if subtle.ConstantTimeCompare([]byte(codeHash), []byte(state.BrowserCodeHash)) != 1 {
+		return nil, domain.ErrInvalidOidcState
+	}
+
+	claims, err := provider.Exchange(ctx, req.Code, state.CodeVerifier, state.Nonce)
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrOidcLoginFailed, err)
+	}
+
+	user, err := usecase.findOrCreateOidcUser(ctx, req.Provider, claims)
+
+	if err != nil {
+		return nil, err
+	}
+
+	methods, err := usecase.mfaMethods(ctx, user.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(methods) > 0 {
+		return usecase.createMfaChallenge(ctx, user, methods)
+	}
+
+	return usecase.issueTokens(ctx, user, req.Client)
+}
+
+func (usecase *AuthUsecase) findOrCreateOidcUser(ctx context.Context, provider string, claims *domain.OidcClaims) (*domain.User, error) {
+	identity, err := usecase.repository.FindUserIdentity(ctx, provider, claims.Subject)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if identity != nil {
+		user, err := usecase.repository.FindUserByID(ctx, identity.UserID)
+
+		if err != nil || user == nil {
+			return nil, domain.ErrUserNotFound
+		}
+
+		return user, nil
+	}
+
+	// Linking by email is only safe when the provider vouches for the address
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, domain.ErrOidcEmailNotVerified
+	}
+
+	identity = &domain.UserIdentity{
+		ID:        usecase.uuid.GenerateID(),
+		Provider:  provider,
+		Subject:   claims.Subject,
+		Email:     claims.Email,
+		CreatedAt: usecase.now(),
+		UpdatedAt: usecase.now(),
+	}
+
+	user, err := usecase.repository.FindUserByEmail(ctx, claims.Email)
+
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	if user != nil {
+		// Invited users join by accepting the invitation
+		if user.Status == domain.UserStatusPending {
+			return nil, domain.ErrInvalidCredentials
+		}
+
+		identity.UserID = user.ID
+
+		if err := usecase.repository.StoreUserIdentity(ctx, identity); err != nil {
+			return nil, err
+		}
+
+		if user.EmailVerifiedAt == nil {
+			if err := usecase.repository.MarkUserEmailVerified(ctx, user.ID, usecase.now()); err != nil {
+				return nil, err
+			}
+		}
+
+		return user, nil
+	}
+
+	name := claims.Name
+	if name == "" {
+		name, _, _ = strings.Cut(claims.Email, "@")
+	}
+
+	verifiedAt := usecase.now()
+	user = &domain.User{
+		ID:              usecase.uuid.GenerateID(),
+		Name:            name,
+		Email:           claims.Email,
+		RoleID:          string(domain.RoleIDUser),
+		EmailVerifiedAt: &verifiedAt,
+		Status:          domain.UserStatusActive,
+		CreatedAt:       usecase.now(),
+		UpdatedAt:       usecase.now(),
+	}
+	identity.UserID = user.ID
+
+	// No password: the user signs in through the provider until they reset one
+	if err := usecase.repository.CreateUserWithIdentity(ctx, user, identity); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
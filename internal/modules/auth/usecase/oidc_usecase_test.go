@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/nassabiq/golang-template/internal/infrastructure/oidc"
+	"github.com/nassabiq/golang-template/internal/infrastructure/oidc/oidctest"
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+// setupOidcUsecase signs in against an in-process fake provider named "fake"
+func setupOidcUsecase(t *testing.T) (*AuthUsecase, *mockAuthRepository, *oidctest.Server) {
+	t.Helper()
+
+	uc, repo, _, hasher, _, _ := setupTestUsecase()
+	fake := oidctest.NewServer("client-123", "secret-123")
+	t.Cleanup(fake.Close)
+
+	uc.SetOidcProviders(map[string]OidcProvider{
+		"fake": oidc.NewProvider(oidc.Config{
+			Name:         "fake",
+			Issuer:       fake.URL,
+			ClientID:     fake.ClientID,
+			ClientSecret: fake.ClientSecret,
+			RedirectURL:  "http://localhost:8080/auth/oidc/fake/callback",
+		}, nil),
+	})
+
+	// state, nonce, verifier and browser code must differ for the flow to be meaningful
+	var n int
+	hasher.generateRandomToken = func() (string, error) {
+		n++
+		return fmt.Sprintf("random-token-%d", n), nil
+	}
+
+	return uc, repo, fake
+}
+
+// startOidc runs StartOidcLogin and the fake provider's consent, returning the callback input
+func startOidc(t *testing.T, uc *AuthUsecase, fake *oidctest.Server) domain.OidcCallbackInput {
+	t.Helper()
+
+	start, err := uc.StartOidcLogin(context.Background(), "fake")
+	if err != nil {
+		t.Fatalf("StartOidcLogin() error = %v", err)
+	}
+
+	callback, err := fake.Authorize(start.AuthorizationURL)
+	if err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+
+	return domain.OidcCallbackInput{
+		Provider:    "fake",
+		Code:        callback.Query().Get("code"),
+		State:       callback.Query().Get("state"),
+		BrowserCode: start.BrowserCode,
+	}
+}
+
+// Test the first OIDC login signs up a verified user linked to the identity
+func TestAuthUsecase_CompleteOidcLogin_SignsUp(t *testing.T) {
+	uc, repo, fake := setupOidcUsecase(t)
+
+	result, err := uc.CompleteOidcLogin(context.Background(), startOidc(t, uc, fake))
+	if err != nil {
+		t.Fatalf("CompleteOidcLogin() error = %v", err)
+	}
+	if result.AccessToken == "" {
+		t.Errorf("CompleteOidcLogin() returned no access token")
+	}
+
+	user, _ := repo.FindUserByEmail(context.Background(), "user@example.com")
+	if user == nil || user.Name != "Fake User" || user.EmailVerifiedAt == nil || user.Status != domain.UserStatusActive {
+		t.Fatalf("CompleteOidcLogin() created user = %+v", user)
+	}
+	if len(repo.identities) != 1 || repo.identities[0].UserID != user.ID || repo.identities[0].Subject != "fake-subject" {
+		t.Errorf("CompleteOidcLogin() identities = %+v", repo.identities)
+	}
+
+	// Signing in again reuses the linked identity
+	if _, err := uc.CompleteOidcLogin(context.Background(), startOidc(t, uc, fake)); err != nil {
+		t.Fatalf("CompleteOidcLogin() second login error = %v", err)
+	}
+	if len(repo.users) != 1 || len(repo.identities) != 1 {
+		t.Errorf("CompleteOidcLogin() second login created %d users, %d identities", len(repo.users), len(repo.identities))
+	}
+}
+
+// Test an OIDC login links to the existing user with the same verified email,
+// however the address was capitalized at registration
+func TestAuthUsecase_CompleteOidcLogin_LinksExistingUser(t *testing.T) {
+	for _, email := range []string{"user@example.com", "User@Example.COM"} {
+		t.Run(email, func(t *testing.T) {
+			uc, repo, fake := setupOidcUsecase(t)
+			repo.users["user-123"] = &domain.User{
+				ID:     "user-123",
+				Email:  email,
+				RoleID: string(domain.RoleIDUser),
+				Status: domain.UserStatusActive,
+			}
+
+			if _, err := uc.CompleteOidcLogin(context.Background(), startOidc(t, uc, fake)); err != nil {
+				t.Fatalf("CompleteOidcLogin() error = %v", err)
+			}
+
+			if len(repo.users) != 1 {
+				t.Errorf("CompleteOidcLogin() created a user instead of linking")
+			}
+			if len(repo.identities) != 1 || repo.identities[0].UserID != "user-123" {
+				t.Errorf("CompleteOidcLogin() identities = %+v", repo.identities)
+			}
+			if repo.users["user-123"].EmailVerifiedAt == nil {
+				t.Errorf("CompleteOidcLogin() did not mark the email verified")
+			}
+		})
+	}
+}
+
+// Test rejected OIDC callbacks
+func TestAuthUsecase_CompleteOidcLogin_Rejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(*mockAuthRepository, *oidctest.Server)
+		modify  func(*domain.OidcCallbackInput)
+		wantErr error
+	}{
+		{
+			name:    "unknown provider",
+			modify:  func(in *domain.OidcCallbackInput) { in.Provider = "other" },
+			wantErr: domain.ErrUnknownOidcProvider,
+		},
+		{
+			name:    "forged state",
+			modify:  func(in *domain.OidcCallbackInput) { in.State = "forged" },
+			wantErr: domain.ErrInvalidOidcState,
+		},
+		{
+			name:    "callback from another browser",
+			modify:  func(in *domain.OidcCallbackInput) { in.BrowserCode = "other-browser" },
+			wantErr: domain.ErrInvalidOidcState,
+		},
+		{
+			name:    "invalid code",
+			modify:  func(in *domain.OidcCallbackInput) { in.Code = "invalid" },
+			wantErr: domain.ErrOidcLoginFailed,
+		},
+		{
+			name: "unverified email",
+			setup: func(repo *mockAuthRepository, fake *oidctest.Server) {
+				fake.SetUser(oidctest.User{Subject: "unverified", Email: "user@example.com"})
+			},
+			wantErr: domain.ErrOidcEmailNotVerified,
+		},
+		{
+			name: "pending invited user",
+			setup: func(repo *mockAuthRepository, fake *oidctest.Server) {
+				repo.users["user-123"] = &domain.User{ID: "user-123", Email: "user@example.com", Status: domain.UserStatusPending}
+			},
+			wantErr: domain.ErrInvalidCredentials,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, fake := setupOidcUsecase(t)
+			if tt.setup != nil {
+				tt.setup(repo, fake)
+			}
+
+			input := startOidc(t, uc, fake)
+			if tt.modify != nil {
+				tt.modify(&input)
+			}
+
+			_, err := uc.CompleteOidcLogin(context.Background(), input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CompleteOidcLogin() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(repo.identities) != 0 {
+				t.Errorf("CompleteOidcLogin() linked an identity on failure")
+			}
+		})
+	}
+}
+
+// Test the state cannot be replayed
+func TestAuthUsecase_CompleteOidcLogin_Replay(t *testing.T) {
+	uc, _, fake := setupOidcUsecase(t)
+	input := startOidc(t, uc, fake)
+
+	if _, err := uc.CompleteOidcLogin(context.Background(), input); err != nil {
+		t.Fatalf("CompleteOidcLogin() error = %v", err)
+	}
+	if _, err := uc.CompleteOidcLogin(context.Background(), input); err != domain.ErrInvalidOidcState {
+		t.Errorf("CompleteOidcLogin() replay error = %v, want %v", err, domain.ErrInvalidOidcState)
+	}
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+// SwitchOrganization ends the caller's session and starts a new one acting in
+// another organization the user belongs to
+func (usecase *AuthUsecase) SwitchOrganization(ctx context.Context, req domain.SwitchOrganizationInput) (*domain.AuthOutput, error) {
+	member, err := usecase.repository.FindOrganizationMember(ctx, req.OrganizationID, req.UserID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if member == nil {
+		return nil, domain.ErrNotOrganizationMember
+	}
+
+	user, err := usecase.repository.FindUserByID(ctx, req.UserID)
+
+	if err != nil || user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	// Refreshing the old session would bring its organization back, so it ends here
+	if req.SessionID != "" {
+		if err := usecase.repository.RevokeRefreshTokenFamily(ctx, req.SessionID); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.AccessTokenID != "" {
+		if err := usecase.tokenRevoker.RevokeAccessToken(ctx, req.AccessTokenID, user.ID, req.AccessTokenExpiresAt); err != nil {
+			return nil, err
+		}
+	}
+
+	// Switching is not a login: the new session keeps the caller's auth_time
+	return usecase.issueTokensInFamily(ctx, user, member, req.Client, "", "", req.AuthTime)
+}
+
+// sessionMember keeps a refreshed session in its organization, falling back to
+// the default one once the user has been removed from it
+func (usecase *AuthUsecase) sessionMember(ctx context.Context, userID, organizationID string) (*domain.OrganizationMember, error) {
+	if organizationID != "" {
+		member, err := usecase.repository.FindOrganizationMember(ctx, organizationID, userID)
+
+		if err != nil || member != nil {
+			return member, err
+		}
+	}
+
+	return usecase.repository.FindDefaultOrganizationMember(ctx, userID)
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+func setupOrganizations(repo *mockAuthRepository) {
+	repo.users["user-123"] = &domain.User{ID: "user-123", Email: "admin@app.com", PasswordHash: "hashed-password123", RoleID: string(domain.RoleIDUser)}
+	repo.organizationMembers = []*domain.OrganizationMember{
+		{OrganizationID: "org-acme", UserID: "user-123", RoleID: string(domain.RoleIDAdmin)},
+		{OrganizationID: "org-globex", UserID: "user-123", RoleID: string(domain.RoleIDUser)},
+	}
+}
+
+// Test Login acts in the user's first organization with their role there
+func TestAuthUsecase_Login_DefaultOrganization(t *testing.T) {
+	tests := []struct {
+		name     string
+		members  bool
+		wantOrg  string
+		wantRole string
+	}{
+		{name: "member of organizations", members: true, wantOrg: "org-acme", wantRole: string(domain.RoleIDAdmin)},
+		{name: "no organization", members: false, wantOrg: "", wantRole: string(domain.RoleIDUser)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, tokenSvc, _, _, _ := setupTestUsecase()
+			setupOrganizations(repo)
+			if !tt.members {
+				repo.organizationMembers = nil
+			}
+
+			var claims domain.AccessTokenClaims
+			tokenSvc.generateAccessToken = func(c domain.AccessTokenClaims) (string, error) {
+				claims = c
+				return "access-token", nil
+			}
+
+			result, err := uc.Login(context.Background(), domain.LoginInput{Email: "admin@app.com", Password: "password123"})
+			if err != nil {
+				t.Fatalf("Login() error = %v", err)
+			}
+
+			if claims.OrganizationID != tt.wantOrg || claims.Role != tt.wantRole {
+				t.Errorf("Login() claims org = %q role = %q, want %q %q", claims.OrganizationID, claims.Role, tt.wantOrg, tt.wantRole)
+			}
+			if result.OrganizationID != tt.wantOrg || repo.refreshTokens["refresh-token-hash"].OrganizationID != tt.wantOrg {
+				t.Errorf("Login() organization = %q, stored %q, want %q", result.OrganizationID, repo.refreshTokens["refresh-token-hash"].OrganizationID, tt.wantOrg)
+			}
+		})
+	}
+}
+
+// Test RefreshToken keeps the session's organization while the user is still a member
+func TestAuthUsecase_RefreshToken_Organization(t *testing.T) {
+	tests := []struct {
+		name    string
+		removed bool
+		wantOrg string
+	}{
+		{name: "stays in switched organization", wantOrg: "org-globex"},
+		{name: "falls back after removal", removed: true, wantOrg: "org-acme"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, tokenSvc, _, _, _ := setupTestUsecase()
+			setupOrganizations(repo)
+			if tt.removed {
+				repo.organizationMembers = repo.organizationMembers[:1]
+			}
+			repo.refreshTokens["sha256-old-refresh"] = &domain.RefreshToken{
+				ID: "token-1", UserID: "user-123", FamilyID: "session-1", TokenHash: "sha256-old-refresh",
+				OrganizationID: "org-globex", ExpiresAt: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+			}
+
+			var claims domain.AccessTokenClaims
+			tokenSvc.generateAccessToken = func(c domain.AccessTokenClaims) (string, error) {
+				claims = c
+				return "access-token", nil
+			}
+
+			if _, err := uc.RefreshToken(context.Background(), "old-refresh", domain.ClientInfo{}); err != nil {
+				t.Fatalf("RefreshToken() error = %v", err)
+			}
+
+			if claims.OrganizationID != tt.wantOrg {
+				t.Errorf("RefreshToken() organization = %q, want %q", claims.OrganizationID, tt.wantOrg)
+			}
+		})
+	}
+}
+
+// Test SwitchOrganization
+func TestAuthUsecase_SwitchOrganization(t *testing.T) {
+	tests := []struct {
+		name           string
+		organizationID string
+		wantErr        error
+		wantRole       string
+	}{
+		{name: "success - member", organizationID: "org-globex", wantRole: string(domain.RoleIDUser)},
+		{name: "failure - not a member", organizationID: "org-initech", wantErr: domain.ErrNotOrganizationMember},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, tokenSvc, _, _, _ := setupTestUsecase()
+			setupOrganizations(repo)
+			repo.refreshTokens["sha256-old-refresh"] = &domain.RefreshToken{ID: "token-1", UserID: "user-123", FamilyID: "session-1", TokenHash: "sha256-old-refresh"}
+
+			var claims domain.AccessTokenClaims
+			tokenSvc.generateAccessToken = func(c domain.AccessTokenClaims) (string, error) {
+				claims = c
+				return "access-token", nil
+			}
+
+			result, err := uc.SwitchOrganization(context.Background(), domain.SwitchOrganizationInput{
+				UserID:               "user-123",
+				SessionID:            "session-1",
+				OrganizationID:       tt.organizationID,
+				AccessTokenID:        "jti-1",
+				AccessTokenExpiresAt: time.Date(2024, 1, 1, 0, 15, 0, 0, time.UTC),
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SwitchOrganization() error = %v, want %v", err, tt.wantErr)
+			}
+
+			oldSessionRevoked := repo.refreshTokens["sha256-old-refresh"].Revoked
+			_, accessTokenRevoked := repo.revokedTokens["jti-1"]
+			if tt.wantErr != nil {
+				if oldSessionRevoked || accessTokenRevoked {
+					t.Errorf("SwitchOrganization() ended the session of a refused switch")
+				}
+				return
+			}
+
+			if result.OrganizationID != tt.organizationID || claims.OrganizationID != tt.organizationID || claims.Role != tt.wantRole {
+				t.Errorf("SwitchOrganization() claims = %+v, want org %q role %q", claims, tt.organizationID, tt.wantRole)
+			}
+			if claims.SessionID == "session-1" {
+				t.Errorf("SwitchOrganization() kept the old session")
+			}
+			if !oldSessionRevoked || !accessTokenRevoked {
+				t.Errorf("SwitchOrganization() old session revoked = %v, access token revoked = %v", oldSessionRevoked, accessTokenRevoked)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+// checkPassword applies the password policy and, for existing users, reuse
+// prevention. All violations are returned together in a WeakPasswordError.
+func (usecase *AuthUsecase) checkPassword(ctx context.Context, password string, user *domain.User) error {
+	var violations []domain.PasswordViolation
+
+	if usecase.passwordPolicy != nil {
+		violations = usecase.passwordPolicy.Validate(password, domain.PasswordOwner{Name: user.Name, Email: user.Email})
+	}
+
+	if user.PasswordHash != "" && usecase.passwordHistorySize > 0 {
+		reused, err := usecase.isPasswordReused(ctx, password, user)
+
+		if err != nil {
+			return err
+		}
+
+		if reused {
+			violations = append(violations, domain.PasswordViolation{
+				Rule:    domain.PasswordRuleReused,
+				Message: fmt.Sprintf("password must differ from your last %d passwords", usecase.passwordHistorySize),
+			})
+		}
+	}
+
+	if len(violations) > 0 {
+		return &domain.WeakPasswordError{Violations: violations}
+	}
+
+	return nil
+}
+
+// isPasswordReused compares against the current hash and the previous ones kept in the history
+func (usecase *AuthUsecase) isPasswordReused(ctx context.Context, password string, user *domain.User) (bool, error) {
+	if usecase.passwordHasher.VerifyPassword(password, user.PasswordHash) {
+		return true, nil
+	}
+
+	if usecase.passwordHistorySize <= 1 {
+		return false, nil
+	}
+
+	hashes, err := usecase.repository.ListPasswordHistory(ctx, user.ID, usecase.passwordHistorySize-1)
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, hash := range hashes {
+		if usecase.passwordHasher.VerifyPassword(password, hash) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// upgradePasswordHash rehashes with the current algorithm and parameters while
+// the plain password is at hand. Best effort: a failure must not fail the login.
+func (usecase *AuthUsecase) upgradePasswordHash(ctx context.Context, user *domain.User, password string) {
+	if !usecase.passwordHasher.NeedsRehash(user.PasswordHash) {
+		return
+	}
+
+	hash, err := usecase.passwordHasher.HashPassword(password)
+
+	if err != nil {
+		return
+	}
+
+	if upgraded, err := usecase.repository.UpgradeUserPasswordHash(ctx, user.ID, user.PasswordHash, hash); err == nil && upgraded {
+		user.PasswordHash = hash
+	}
+}
+
+// rememberPassword keeps a replaced hash so it cannot be chosen again, trimming older entries
+func (usecase *AuthUsecase) rememberPassword(ctx context.Context, userID, previousHash string) error {
+	if usecase.passwordHistorySize <= 1 || previousHash == "" {
+		return nil
+	}
+
+	if err := usecase.repository.StorePasswordHistory(ctx, &domain.PasswordHistory{
+		ID:           usecase.uuid.GenerateID(),
+		UserID:       userID,
+		PasswordHash: previousHash,
+		CreatedAt:    usecase.now(),
+	}); err != nil {
+		return err
+	}
+
+	return usecase.repository.PrunePasswordHistory(ctx, userID, usecase.passwordHistorySize-1)
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+// mockPasswordPolicy rejects passwords shorter than 10 characters
+type mockPasswordPolicy struct {
+	owner domain.PasswordOwner
+}
+
+func (m *mockPasswordPolicy) Validate(password string, owner domain.PasswordOwner) []domain.PasswordViolation {
+	m.owner = owner
+	if len(password) < 10 {
+		return []domain.PasswordViolation{{Rule: domain.PasswordRuleTooShort, Message: "password must be at least 10 characters"}}
+	}
+	return nil
+}
+
+func violatedRules(err error) []string {
+	var weak *domain.WeakPasswordError
+	if !errors.As(err, &weak) {
+		return nil
+	}
+
+	var names []string
+	for _, violation := range weak.Violations {
+		names = append(names, violation.Rule)
+	}
+	return names
+}
+
+// Test Register applies the password policy with the new user as owner
+func TestAuthUsecase_Register_PasswordPolicy(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	repo.findUserByEmail = func(email string) (*domain.User, error) {
+		return nil, nil
+	}
+	policy := &mockPasswordPolicy{}
+	uc.SetPasswordPolicy(policy, 5)
+
+	err := uc.Register(context.Background(), domain.RegisterInput{
+		Name:                 "Test User",
+		Email:                "test@example.com",
+		Password:             "short",
+		PasswordConfirmation: "short",
+	})
+	if !errors.Is(err, domain.ErrWeakPassword) {
+		t.Fatalf("Register() error = %v, want %v", err, domain.ErrWeakPassword)
+	}
+	if got := violatedRules(err); len(got) != 1 || got[0] != domain.PasswordRuleTooShort {
+		t.Errorf("Register() violations = %v", got)
+	}
+	if policy.owner.Email != "test@example.com" || policy.owner.Name != "Test User" {
+		t.Errorf("Register() checked owner = %+v", policy.owner)
+	}
+	if len(repo.users) != 0 {
+		t.Errorf("Register() created a user with a weak password")
+	}
+}
+
+// Test ResetPassword applies the policy and refuses the last N passwords
+func TestAuthUsecase_ResetPassword_PasswordPolicy(t *testing.T) {
+	reset := func(uc *AuthUsecase, repo *mockAuthRepository, password string) error {
+		repo.passwordResets = map[string]*domain.PasswordReset{
+			"sha256-reset-token": {ID: "reset-1", UserID: "user-123", TokenHash: "sha256-reset-token", ExpiresAt: time.Now().Add(time.Hour)},
+		}
+		return uc.ResetPassword(context.Background(), domain.ResetPasswordInput{Token: "reset-token", NewPassword: password})
+	}
+
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	uc.SetPasswordPolicy(&mockPasswordPolicy{}, 3)
+	repo.users["user-123"] = &domain.User{ID: "user-123", Email: "test@example.com", PasswordHash: "hashed-first-password"}
+
+	if got := violatedRules(reset(uc, repo, "short")); strings.Join(got, ",") != domain.PasswordRuleTooShort {
+		t.Fatalf("ResetPassword() violations = %v, want too short", got)
+	}
+	if got := violatedRules(reset(uc, repo, "first-password")); strings.Join(got, ",") != domain.PasswordRuleReused {
+		t.Fatalf("ResetPassword() current password violations = %v, want reused", got)
+	}
+
+	for _, password := range []string{"second-password", "third-password", "fourth-password"} {
+		if err := reset(uc, repo, password); err != nil {
+			t.Fatalf("ResetPassword(%s) error = %v", password, err)
+		}
+	}
+
+	// history holds the last two replaced hashes, the current one makes three
+	if got := violatedRules(reset(uc, repo, "third-password")); strings.Join(got, ",") != domain.PasswordRuleReused {
+		t.Errorf("ResetPassword() recent password violations = %v, want reused", got)
+	}
+	if err := reset(uc, repo, "first-password"); err != nil {
+		t.Errorf("ResetPassword() of a password older than the history error = %v", err)
+	}
+	if n := len(repo.passwordHistory["user-123"]); n != 2 {
+		t.Errorf("password history has %d entries, want 2", n)
+	}
+}
+
+// Test Login upgrades an outdated hash, leaves current ones alone and never
+// overwrites a password changed while the rehash was computed
+func TestAuthUsecase_Login_RehashesOutdatedHash(t *testing.T) {
+	tests := []struct {
+		name      string
+		stored    string
+		changedTo string
+		wantHash  string
+	}{
+		{name: "outdated hash is upgraded", stored: "$2a$04$legacy", wantHash: "hashed-password123"},
+		{name: "current hash is kept", stored: "hashed-password123", wantHash: "hashed-password123"},
+		{name: "concurrent password change is kept", stored: "$2a$04$legacy", changedTo: "hashed-changed", wantHash: "hashed-changed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, _, hasher, _, _ := setupTestUsecase()
+			repo.users["user-123"] = &domain.User{ID: "user-123", Email: "test@example.com", PasswordHash: tt.stored}
+			hasher.verifyPassword = func(password, hash string) bool {
+				return password == "password123"
+			}
+			hasher.needsRehash = func(hash string) bool {
+				return strings.HasPrefix(hash, "$2a$04$")
+			}
+			hasher.hashPassword = func(password string) (string, error) {
+				// the password is reset while the new hash is computed
+				if tt.changedTo != "" {
+					repo.users["user-123"] = &domain.User{ID: "user-123", Email: "test@example.com", PasswordHash: tt.changedTo}
+				}
+				return "hashed-" + password, nil
+			}
+			repo.updateUserPassword = func(userID, newHash string) error {
+				t.Errorf("Login() used the unconditional password update")
+				return nil
+			}
+
+			if _, err := uc.Login(context.Background(), domain.LoginInput{Email: "test@example.com", Password: "password123"}); err != nil {
+				t.Fatalf("Login() error = %v", err)
+			}
+			if got := repo.users["user-123"].PasswordHash; got != tt.wantHash {
+				t.Errorf("Login() stored hash = %q, want %q", got, tt.wantHash)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/auth/event"
+	"github.com/nassabiq/golang-template/internal/shared/helper"
+)
+
+const (
+	phoneOtpTTL            = 5 * time.Minute
+	phoneOtpMaxAttempts    = 5
+	phoneOtpResendInterval = time.Minute
+	phoneOtpDigits         = 6
+)
+
+// SetPhoneDefaultCountryCode sets the calling code, without the plus, for
+// phone numbers entered in national format
+func (usecase *AuthUsecase) SetPhoneDefaultCountryCode(code string) {
+	usecase.phoneDefaultCountryCode = code
+}
+
+func (usecase *AuthUsecase) normalizePhone(raw string) (string, error) {
+	phone, ok := helper.NormalizePhone(raw, usecase.phoneDefaultCountryCode)
+
+	if !ok {
+		return "", domain.ErrInvalidPhone
+	}
+
+	return phone, nil
+}
+
+// RequestPhoneVerification sends a code to the user's phone. A new phone number
+// only replaces the current one once its code is verified.
+func (usecase *AuthUsecase) RequestPhoneVerification(ctx context.Context, userID, phone string) error {
+	user, err := usecase.repository.FindUserByID(ctx, userID)
+
+	if err != nil || user == nil {
+		return domain.ErrUserNotFound
+	}
+
+	target := user.Phone
+
+	if phone != "" {
+		if target, err = usecase.normalizePhone(phone); err != nil {
+			return err
+		}
+	}
+
+	if target == "" {
+		return domain.ErrPhoneNotSet
+	}
+
+	if target == user.Phone && user.PhoneVerifiedAt != nil {
+		return domain.ErrPhoneAlreadyVerified
+	}
+
+	owner, err := usecase.repository.FindUserByVerifiedPhone(ctx, target)
+
+	if err != nil {
+		return err
+	}
+
+	if owner != nil && owner.ID != user.ID {
+		return domain.ErrPhoneAlreadyUsed
+	}
+
+	return usecase.sendPhoneOtp(ctx, user.ID, target, domain.PhoneOtpPurposeVerify)
+}
+
+// VerifyPhone marks the phone number the code was sent to as the user's verified number
+func (usecase *AuthUsecase) VerifyPhone(ctx context.Context, userID, code string) error {
+	otp, err := usecase.checkPhoneOtp(ctx, userID, domain.PhoneOtpPurposeVerify, code)
+
+	if err != nil {
+		return err
+	}
+
+	return usecase.repository.MarkUserPhoneVerified(ctx, userID, otp.Phone, usecase.now())
+}
+
+// RequestPhoneLogin sends a login code to a verified phone number. Unknown
+// numbers succeed silently so the response does not reveal registered numbers.
+func (usecase *AuthUsecase) RequestPhoneLogin(ctx context.Context, phone string) error {
+	target, err := usecase.normalizePhone(phone)
+
+	if err != nil {
+		return err
+	}
+
+	user, err := usecase.repository.FindUserByVerifiedPhone(ctx, target)
+
+	if err != nil || user == nil || user.Status != domain.UserStatusActive {
+		return nil
+	}
+
+	if err := usecase.sendPhoneOtp(ctx, user.ID, target, domain.PhoneOtpPurposeLogin); err != nil && !errors.Is(err, domain.ErrOtpRecentlySent) {
+		return err
+	}
+
+	return nil
+}
+
+// LoginWithPhone signs a user in with a code sent to their verified phone number.
+// Wrong codes count as failed logins, the same as wrong passwords.
+func (usecase *AuthUsecase) LoginWithPhone(ctx context.Context, req domain.PhoneLoginInput) (*domain.AuthOutput, error) {
+	if err := usecase.checkIPThrottle(ctx, req.Client.IPAddress); err != nil {
+		return nil, err
+	}
+
+	phone, err := usecase.normalizePhone(req.Phone)
+
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := usecase.repository.FindUserByVerifiedPhone(ctx, phone)
+
+	if err != nil || user == nil || user.Status == domain.UserStatusPending {
+		return nil, usecase.recordFailedLogin(ctx, nil, req.Client)
+	}
+
+	lockout, err := usecase.checkAccountLockout(ctx, user.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := usecase.checkPhoneOtp(ctx, user.ID, domain.PhoneOtpPurposeLogin, req.Code); err != nil {
+		if errors.Is(err, domain.ErrInvalidOtp) {
+			return nil, usecase.recordFailedLogin(ctx, user, req.Client)
+		}
+		return nil, err
+	}
+
+	if usecase.requireEmailVerification && user.EmailVerifiedAt == nil {
+		return nil, domain.ErrEmailNotVerified
+	}
+
+	methods, err := usecase.mfaMethods(ctx, user.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Failed attempts stand until the second factor passes too
+	if len(methods) > 0 {
+		return usecase.createMfaChallenge(ctx, user, methods)
+	}
+
+	if lockout != nil {
+		if err := usecase.repository.DeleteLoginLockout(ctx, user.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return usecase.issueTokens(ctx, user, req.Client)
+}
+
+// sendPhoneOtp stores a new code and publishes it for the notification worker to send by SMS
+func (usecase *AuthUsecase) sendPhoneOtp(ctx context.Context, userID, phone string, purpose domain.PhoneOtpPurpose) error {
+	latest, err := usecase.repository.FindLatestPhoneOtp(ctx, userID, purpose)
+
+	if err != nil {
+		return err
+	}
+
+	// Every SMS costs money, do not let a client send them back to back
+	if latest != nil && latest.CreatedAt.Add(phoneOtpResendInterval).After(usecase.now()) {
+		return domain.ErrOtpRecentlySent
+	}
+
+	code, err := generatePhoneOtpCode()
+
+	if err != nil {
+		return err
+	}
+
+	expiredAt := usecase.now().Add(phoneOtpTTL)
+
+	if err := usecase.repository.StorePhoneOtp(ctx, &domain.PhoneOtp{
+		ID:        usecase.uuid.GenerateID(),
+		UserID:    userID,
+		Phone:     phone,
+		Purpose:   purpose,
+		CodeHash:  usecase.passwordHasher.HashToken(code),
+		ExpiresAt: expiredAt,
+		CreatedAt: usecase.now(),
+		UpdatedAt: usecase.now(),
+	}); err != nil {
+		return err
+	}
+
+	_ = usecase.eventPub.SMSOtpRequested(event.SMSOtpRequestedEvent{
+		UserID:    userID,
+		Phone:     phone,
+		Code:      code,
+		Purpose:   string(purpose),
+		ExpiredAt: expiredAt,
+	})
+
+	return nil
+}
+
+// checkPhoneOtp spends the user's latest code for purpose when it matches. Only
+// the latest code counts, and it is burned after phoneOtpMaxAttempts guesses. The
+// attempt is counted before the code is compared so concurrent guesses cannot
+// share one remaining attempt.
+func (usecase *AuthUsecase) checkPhoneOtp(ctx context.Context, userID string, purpose domain.PhoneOtpPurpose, code string) (*domain.PhoneOtp, error) {
+	otp, err := usecase.repository.FindLatestPhoneOtp(ctx, userID, purpose)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if otp == nil || otp.Used || !otp.ExpiresAt.After(usecase.now()) {
+		return nil, domain.ErrInvalidOtp
+	}
+
+	attempts, err := usecase.repository.IncrementPhoneOtpAttempts(ctx, otp.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if attempts == 0 {
+		return nil, domain.ErrInvalidOtp
+	}
+
+	if attempts > phoneOtpMaxAttempts {
+		_, _ = usecase.repository.MarkPhoneOtpUsed(ctx, otp.ID)
+		return nil, domain.ErrInvalidOtp
+	}
+
+	codeHash := usecase.passwordHasher.HashToken(code)
+
+	if subtle.ConstantTimeCompare([]byte(codeHash), []byte(otp.CodeHash)) != 1 {
+		return nil, domain.ErrInvalidOtp
+	}
+
+	claimed, err := usecase.repository.MarkPhoneOtpUsed(ctx, otp.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !claimed {
+		return nil, domain.ErrInvalidOtp
+	}
+
+	return otp, nil
+}
+
+func generatePhoneOtpCode() (string, error) {
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(phoneOtpDigits), nil)
+
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", phoneOtpDigits, n), nil
+}
@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/auth/event"
+)
+
+// lastSMSCode returns the code of the last auth.sms_otp_requested event on bus
+func lastSMSCode(t *testing.T, bus *mockEventBus) event.SMSOtpRequestedEvent {
+	t.Helper()
+
+	if bus.publishedSubject != event.SMSOtpRequestedSubject {
+		t.Fatalf("published %q, want %q", bus.publishedSubject, event.SMSOtpRequestedSubject)
+	}
+
+	var published event.SMSOtpRequestedEvent
+	if err := json.Unmarshal(bus.publishedData, &published); err != nil {
+		t.Fatalf("invalid event payload: %v", err)
+	}
+	return published
+}
+
+func setupPhoneUser(repo *mockAuthRepository, verified bool) *domain.User {
+	user := &domain.User{
+		ID:     "user-123",
+		Email:  "test@example.com",
+		Phone:  "+6281234567890",
+		RoleID: string(domain.RoleIDUser),
+		Status: domain.UserStatusActive,
+	}
+	if verified {
+		verifiedAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		user.PhoneVerifiedAt = &verifiedAt
+	}
+	repo.users[user.ID] = user
+	return user
+}
+
+// Test Register stores the phone number in E.164
+func TestAuthUsecase_Register_Phone(t *testing.T) {
+	tests := []struct {
+		name      string
+		phone     string
+		taken     bool
+		wantPhone string
+		wantErr   error
+	}{
+		{name: "national format", phone: "0812-3456-7890", wantPhone: "+6281234567890"},
+		{name: "no phone", phone: ""},
+		{name: "invalid phone", phone: "12345", wantErr: domain.ErrInvalidPhone},
+		{name: "verified by another user", phone: "+62 812 3456 7890", taken: true, wantErr: domain.ErrPhoneAlreadyUsed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, _, _, _, _ := setupTestUsecase()
+			uc.SetPhoneDefaultCountryCode("62")
+			if tt.taken {
+				owner := setupPhoneUser(repo, true)
+				owner.ID, owner.Email = "owner-1", "owner@example.com"
+				repo.users = map[string]*domain.User{owner.ID: owner}
+			}
+
+			err := uc.Register(context.Background(), domain.RegisterInput{
+				Name:                 "Test User",
+				Email:                "new@example.com",
+				Password:             "password123",
+				PasswordConfirmation: "password123",
+				Phone:                tt.phone,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Register() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if user := repo.users["test-uuid-123"]; user.Phone != tt.wantPhone || user.PhoneVerifiedAt != nil {
+				t.Errorf("Register() stored phone %q verified %v, want %q unverified", user.Phone, user.PhoneVerifiedAt, tt.wantPhone)
+			}
+		})
+	}
+}
+
+// Test a new phone number replaces the current one only once verified
+func TestAuthUsecase_VerifyPhone(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	bus := &mockEventBus{}
+	uc.eventPub = event.NewAuthPublisher(bus)
+	user := setupPhoneUser(repo, true)
+
+	if err := uc.RequestPhoneVerification(context.Background(), user.ID, ""); !errors.Is(err, domain.ErrPhoneAlreadyVerified) {
+		t.Fatalf("RequestPhoneVerification() current number error = %v, want %v", err, domain.ErrPhoneAlreadyVerified)
+	}
+
+	if err := uc.RequestPhoneVerification(context.Background(), user.ID, "+14155550100"); err != nil {
+		t.Fatalf("RequestPhoneVerification() error = %v", err)
+	}
+	sent := lastSMSCode(t, bus)
+	if sent.Phone != "+14155550100" || sent.Purpose != "verify" || len(sent.Code) != phoneOtpDigits {
+		t.Fatalf("RequestPhoneVerification() sent %+v", sent)
+	}
+	if user.Phone != "+6281234567890" {
+		t.Errorf("RequestPhoneVerification() changed phone before verification to %q", user.Phone)
+	}
+
+	if err := uc.RequestPhoneVerification(context.Background(), user.ID, "+14155550100"); !errors.Is(err, domain.ErrOtpRecentlySent) {
+		t.Errorf("RequestPhoneVerification() resend error = %v, want %v", err, domain.ErrOtpRecentlySent)
+	}
+
+	if err := uc.VerifyPhone(context.Background(), user.ID, "wrong"); !errors.Is(err, domain.ErrInvalidOtp) {
+		t.Fatalf("VerifyPhone() wrong code error = %v, want %v", err, domain.ErrInvalidOtp)
+	}
+	if err := uc.VerifyPhone(context.Background(), user.ID, sent.Code); err != nil {
+		t.Fatalf("VerifyPhone() error = %v", err)
+	}
+	if user.Phone != "+14155550100" || user.PhoneVerifiedAt == nil || !user.PhoneVerifiedAt.Equal(uc.now()) {
+		t.Errorf("VerifyPhone() phone = %q verified %v", user.Phone, user.PhoneVerifiedAt)
+	}
+	if err := uc.VerifyPhone(context.Background(), user.ID, sent.Code); !errors.Is(err, domain.ErrInvalidOtp) {
+		t.Errorf("VerifyPhone() reused code error = %v, want %v", err, domain.ErrInvalidOtp)
+	}
+}
+
+// Test a code is burned after too many wrong guesses
+func TestAuthUsecase_VerifyPhone_MaxAttempts(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	bus := &mockEventBus{}
+	uc.eventPub = event.NewAuthPublisher(bus)
+	user := setupPhoneUser(repo, false)
+
+	if err := uc.RequestPhoneVerification(context.Background(), user.ID, ""); err != nil {
+		t.Fatalf("RequestPhoneVerification() error = %v", err)
+	}
+	code := lastSMSCode(t, bus).Code
+
+	for i := 0; i < phoneOtpMaxAttempts; i++ {
+		_ = uc.VerifyPhone(context.Background(), user.ID, "wrong")
+	}
+
+	if err := uc.VerifyPhone(context.Background(), user.ID, code); !errors.Is(err, domain.ErrInvalidOtp) {
+		t.Errorf("VerifyPhone() after max attempts error = %v, want %v", err, domain.ErrInvalidOtp)
+	}
+	if user.PhoneVerifiedAt != nil {
+		t.Errorf("VerifyPhone() verified phone after max attempts")
+	}
+}
+
+// Test an earlier code stops working once a new one is sent
+func TestAuthUsecase_VerifyPhone_NewCodeReplacesEarlier(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	bus := &mockEventBus{}
+	uc.eventPub = event.NewAuthPublisher(bus)
+	user := setupPhoneUser(repo, false)
+
+	if err := uc.RequestPhoneVerification(context.Background(), user.ID, ""); err != nil {
+		t.Fatalf("RequestPhoneVerification() error = %v", err)
+	}
+	first := lastSMSCode(t, bus).Code
+
+	uc.now = func() time.Time { return time.Date(2024, 1, 1, 0, 2, 0, 0, time.UTC) }
+	if err := uc.RequestPhoneVerification(context.Background(), user.ID, ""); err != nil {
+		t.Fatalf("RequestPhoneVerification() resend error = %v", err)
+	}
+
+	// burning the newest code must not bring the first one back
+	for i := 0; i < phoneOtpMaxAttempts+1; i++ {
+		_ = uc.VerifyPhone(context.Background(), user.ID, "wrong")
+	}
+	if err := uc.VerifyPhone(context.Background(), user.ID, first); !errors.Is(err, domain.ErrInvalidOtp) {
+		t.Errorf("VerifyPhone() earlier code error = %v, want %v", err, domain.ErrInvalidOtp)
+	}
+	if user.PhoneVerifiedAt != nil {
+		t.Errorf("VerifyPhone() verified phone with an earlier code")
+	}
+}
+
+// Test phone OTP login
+func TestAuthUsecase_LoginWithPhone(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	bus := &mockEventBus{}
+	uc.eventPub = event.NewAuthPublisher(bus)
+	setupPhoneUser(repo, true)
+
+	if err := uc.RequestPhoneLogin(context.Background(), "+14155550100"); err != nil || bus.publishedSubject != "" {
+		t.Fatalf("RequestPhoneLogin() unknown number = %v, published %q", err, bus.publishedSubject)
+	}
+
+	if err := uc.RequestPhoneLogin(context.Background(), "+62 812 3456 7890"); err != nil {
+		t.Fatalf("RequestPhoneLogin() error = %v", err)
+	}
+	sent := lastSMSCode(t, bus)
+	if sent.Purpose != "login" {
+		t.Fatalf("RequestPhoneLogin() sent %+v", sent)
+	}
+
+	if _, err := uc.LoginWithPhone(context.Background(), domain.PhoneLoginInput{Phone: "+6281234567890", Code: "000000"}); !errors.Is(err, domain.ErrInvalidCredentials) {
+		t.Fatalf("LoginWithPhone() wrong code error = %v, want %v", err, domain.ErrInvalidCredentials)
+	}
+
+	out, err := uc.LoginWithPhone(context.Background(), domain.PhoneLoginInput{Phone: "+6281234567890", Code: sent.Code})
+	if err != nil {
+		t.Fatalf("LoginWithPhone() error = %v", err)
+	}
+	if out.AccessToken == "" || out.RefreshToken == "" {
+		t.Errorf("LoginWithPhone() expected token pair, got %+v", out)
+	}
+}
+
+// Test only verified numbers can log in
+func TestAuthUsecase_LoginWithPhone_Unverified(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	bus := &mockEventBus{}
+	uc.eventPub = event.NewAuthPublisher(bus)
+	setupPhoneUser(repo, false)
+
+	if err := uc.RequestPhoneLogin(context.Background(), "+6281234567890"); err != nil || bus.publishedSubject != "" {
+		t.Fatalf("RequestPhoneLogin() unverified number = %v, published %q", err, bus.publishedSubject)
+	}
+	if _, err := uc.LoginWithPhone(context.Background(), domain.PhoneLoginInput{Phone: "+6281234567890", Code: "123456"}); !errors.Is(err, domain.ErrInvalidCredentials) {
+		t.Errorf("LoginWithPhone() error = %v, want %v", err, domain.ErrInvalidCredentials)
+	}
+}
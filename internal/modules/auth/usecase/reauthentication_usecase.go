@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+// Reauthenticate confirms the caller's password, or their MFA code, and issues
+// an access token for the same session with a fresh auth_time. Failures count
+// toward the account lockout like failed logins, so a stolen access token
+// cannot be used to guess either.
+func (usecase *AuthUsecase) Reauthenticate(ctx context.Context, req domain.ReauthenticateInput) (*domain.AuthOutput, error) {
+	user, err := usecase.repository.FindUserByID(ctx, req.UserID)
+
+	if err != nil || user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	if err := requireActiveUser(user); err != nil {
+		return nil, err
+	}
+
+	if err := usecase.checkIPThrottle(ctx, req.Client.IPAddress); err != nil {
+		return nil, err
+	}
+
+	lockout, err := usecase.checkAccountLockout(ctx, user.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	confirmed, err := usecase.confirmCredentials(ctx, user, req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !confirmed {
+		return nil, usecase.recordFailedLogin(ctx, user, req.Client)
+	}
+
+	if lockout != nil {
+		if err := usecase.repository.DeleteLoginLockout(ctx, user.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	member, err := usecase.sessionMember(ctx, user.ID, req.OrganizationID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	role, organizationID := memberRole(user, member)
+
+	accessToken, err := usecase.token.GenerateAccessToken(domain.AccessTokenClaims{
+		UserID:         user.ID,
+		Role:           role,
+		SessionID:      req.SessionID,
+		OrganizationID: organizationID,
+		AuthTime:       usecase.now(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.AuthOutput{AccessToken: accessToken, OrganizationID: organizationID}, nil
+}
+
+// confirmCredentials checks the MFA code when one is given and MFA is enabled,
+// the password otherwise
+func (usecase *AuthUsecase) confirmCredentials(ctx context.Context, user *domain.User, req domain.ReauthenticateInput) (bool, error) {
+	if req.MfaCode != "" {
+		mfa, err := usecase.repository.FindUserMfa(ctx, user.ID)
+
+		if err != nil {
+			return false, err
+		}
+
+		if mfa == nil || !mfa.Enabled {
+			return false, nil
+		}
+
+		if err := usecase.verifyMfaCode(ctx, mfa, req.MfaCode); err != nil {
+			if errors.Is(err, domain.ErrInvalidMfaCode) {
+				return false, nil
+			}
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	return req.Password != "" && usecase.passwordHasher.VerifyPassword(req.Password, user.PasswordHash), nil
+}
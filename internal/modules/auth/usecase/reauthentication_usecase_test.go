@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+func reauthenticate(uc *AuthUsecase, password, mfaCode string) (*domain.AuthOutput, error) {
+	return uc.Reauthenticate(context.Background(), domain.ReauthenticateInput{
+		UserID:    "user-123",
+		SessionID: "family-123",
+		Password:  password,
+		MfaCode:   mfaCode,
+		Client:    domain.ClientInfo{IPAddress: "203.0.113.7"},
+	})
+}
+
+// Test Reauthenticate issues a token for the same session with a fresh auth_time
+func TestAuthUsecase_Reauthenticate(t *testing.T) {
+	uc, repo := setupThrottledUsecase()
+	tokenSvc := uc.token.(*mockTokenService)
+	repo.userMfa = map[string]*domain.UserMfa{"user-123": {UserID: "user-123", Enabled: true}}
+
+	var issued []domain.AccessTokenClaims
+	tokenSvc.generateAccessToken = func(claims domain.AccessTokenClaims) (string, error) {
+		issued = append(issued, claims)
+		return "fresh-token", nil
+	}
+
+	for _, confirm := range []struct{ password, mfaCode string }{
+		{password: "password123"},
+		{mfaCode: "123456"},
+	} {
+		result, err := reauthenticate(uc, confirm.password, confirm.mfaCode)
+		if err != nil {
+			t.Fatalf("Reauthenticate(%+v) error = %v", confirm, err)
+		}
+		if result.AccessToken != "fresh-token" || result.RefreshToken != "" {
+			t.Errorf("Reauthenticate(%+v) = %+v, want an access token only", confirm, result)
+		}
+	}
+
+	for _, claims := range issued {
+		if claims.SessionID != "family-123" || !claims.AuthTime.Equal(uc.now()) {
+			t.Errorf("Reauthenticate() claims = %+v, want session family-123 authenticated now", claims)
+		}
+	}
+	if len(repo.refreshTokens) != 0 {
+		t.Errorf("Reauthenticate() stored a refresh token")
+	}
+}
+
+// Test failed confirmations count toward the account lockout
+func TestAuthUsecase_Reauthenticate_LocksAccount(t *testing.T) {
+	uc, _ := setupThrottledUsecase()
+
+	// Without MFA enabled a code never confirms anything
+	if _, err := reauthenticate(uc, "", "123456"); !errors.Is(err, domain.ErrInvalidCredentials) {
+		t.Fatalf("Reauthenticate(mfa without mfa enabled) error = %v, want %v", err, domain.ErrInvalidCredentials)
+	}
+	if _, err := reauthenticate(uc, "wrong", ""); !errors.Is(err, domain.ErrInvalidCredentials) {
+		t.Fatalf("Reauthenticate(wrong) error = %v, want %v", err, domain.ErrInvalidCredentials)
+	}
+
+	var throttled *domain.LoginThrottledError
+	if _, err := reauthenticate(uc, "wrong", ""); !errors.As(err, &throttled) {
+		t.Fatalf("Reauthenticate() third failure error = %v, want the account locked", err)
+	}
+
+	if _, err := reauthenticate(uc, "password123", ""); !errors.As(err, &throttled) {
+		t.Errorf("Reauthenticate() while locked error = %v, want the account locked", err)
+	}
+}
+
+// Test a refreshed session keeps the time of the login instead of refreshing it
+func TestAuthUsecase_RefreshToken_KeepsAuthTime(t *testing.T) {
+	uc, repo, tokenSvc, _, _, _ := setupTestUsecase()
+	repo.users["user-123"] = &domain.User{ID: "user-123", Email: "test@example.com", PasswordHash: "hashed-password123"}
+
+	var issued domain.AccessTokenClaims
+	tokenSvc.generateAccessToken = func(claims domain.AccessTokenClaims) (string, error) {
+		issued = claims
+		return "access-token", nil
+	}
+
+	if _, err := uc.Login(context.Background(), domain.LoginInput{Email: "test@example.com", Password: "password123"}); err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if !issued.AuthTime.Equal(uc.now()) || !repo.refreshTokens["refresh-token-hash"].AuthenticatedAt.Equal(uc.now()) {
+		t.Fatalf("Login() auth time = %v, stored %v, want %v", issued.AuthTime, repo.refreshTokens["refresh-token-hash"].AuthenticatedAt, uc.now())
+	}
+
+	loggedInAt := uc.now()
+	uc.now = func() time.Time { return loggedInAt.Add(time.Hour) }
+	repo.refreshTokens["sha256-refresh-token-plain"] = repo.refreshTokens["refresh-token-hash"]
+	repo.refreshTokens["sha256-refresh-token-plain"].TokenHash = "sha256-refresh-token-plain"
+	delete(repo.refreshTokens, "refresh-token-hash")
+
+	if _, err := uc.RefreshToken(context.Background(), "refresh-token-plain", domain.ClientInfo{}); err != nil {
+		t.Fatalf("RefreshToken() error = %v", err)
+	}
+	if !issued.AuthTime.Equal(loggedInAt) {
+		t.Errorf("RefreshToken() auth time = %v, want the login time %v", issued.AuthTime, loggedInAt)
+	}
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"slices"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+// IssueServiceToken implements the OAuth2 client credentials grant. The token
+// is bound to the client and carries the granted scopes instead of a user role.
+func (usecase *AuthUsecase) IssueServiceToken(ctx context.Context, req domain.ClientCredentialsInput) (*domain.ServiceTokenOutput, error) {
+	client, err := usecase.repository.FindServiceClient(ctx, req.ClientID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if client == nil || client.RevokedAt != nil {
+		return nil, domain.ErrInvalidClient
+	}
+
+	secretHash := usecase.passwordHasher.HashToken(req.ClientSecret)
+	if subtle.ConstantTimeCompare([]byte(secretHash), []byte(client.SecretHash)) != 1 {
+		return nil, domain.ErrInvalidClient
+	}
+
+	scopes := client.Scopes
+	if len(req.Scopes) > 0 {
+		for _, scope := range req.Scopes {
+			if !slices.Contains(client.Scopes, scope) {
+				return nil, domain.ErrInvalidScope
+			}
+		}
+		scopes = req.Scopes
+	}
+
+	accessToken, expiresIn, err := usecase.token.GenerateServiceToken(domain.ServiceTokenClaims{
+		ClientID: client.ClientID,
+		Scopes:   scopes,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.ServiceTokenOutput{
+		AccessToken: accessToken,
+		ExpiresIn:   expiresIn,
+		Scopes:      scopes,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+// Test IssueServiceToken
+func TestAuthUsecase_IssueServiceToken(t *testing.T) {
+	revokedAt := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		input      domain.ClientCredentialsInput
+		revoked    bool
+		wantScopes []string
+		wantErr    error
+	}{
+		{
+			name:       "success - all client scopes by default",
+			input:      domain.ClientCredentialsInput{ClientID: "worker", ClientSecret: "s3cret"},
+			wantScopes: []string{domain.ScopeUsersRead, domain.ScopeUsersWrite},
+		},
+		{
+			name:       "success - narrowed scopes",
+			input:      domain.ClientCredentialsInput{ClientID: "worker", ClientSecret: "s3cret", Scopes: []string{domain.ScopeUsersRead}},
+			wantScopes: []string{domain.ScopeUsersRead},
+		},
+		{
+			name:    "failure - scope not granted to client",
+			input:   domain.ClientCredentialsInput{ClientID: "worker", ClientSecret: "s3cret", Scopes: []string{domain.ScopeSessionsWrite}},
+			wantErr: domain.ErrInvalidScope,
+		},
+		{
+			name:    "failure - wrong secret",
+			input:   domain.ClientCredentialsInput{ClientID: "worker", ClientSecret: "wrong"},
+			wantErr: domain.ErrInvalidClient,
+		},
+		{
+			name:    "failure - unknown client",
+			input:   domain.ClientCredentialsInput{ClientID: "unknown", ClientSecret: "s3cret"},
+			wantErr: domain.ErrInvalidClient,
+		},
+		{
+			name:    "failure - revoked client",
+			input:   domain.ClientCredentialsInput{ClientID: "worker", ClientSecret: "s3cret"},
+			revoked: true,
+			wantErr: domain.ErrInvalidClient,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, _, _, _, _ := setupTestUsecase()
+			client := &domain.ServiceClient{
+				ID:         "client-1",
+				ClientID:   "worker",
+				SecretHash: "sha256-s3cret",
+				Scopes:     []string{domain.ScopeUsersRead, domain.ScopeUsersWrite},
+			}
+			if tt.revoked {
+				client.RevokedAt = &revokedAt
+			}
+			repo.serviceClients = map[string]*domain.ServiceClient{"worker": client}
+
+			result, err := uc.IssueServiceToken(context.Background(), tt.input)
+			if err != tt.wantErr {
+				t.Fatalf("IssueServiceToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if result.AccessToken != "service-token-worker" || result.ExpiresIn != 15*time.Minute {
+				t.Errorf("IssueServiceToken() = %+v", result)
+			}
+			if len(result.Scopes) != len(tt.wantScopes) {
+				t.Fatalf("IssueServiceToken() scopes = %v, want %v", result.Scopes, tt.wantScopes)
+			}
+			for i := range tt.wantScopes {
+				if result.Scopes[i] != tt.wantScopes[i] {
+					t.Errorf("IssueServiceToken() scopes = %v, want %v", result.Scopes, tt.wantScopes)
+				}
+			}
+		})
+	}
+}
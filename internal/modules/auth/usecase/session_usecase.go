@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+// revokedSessionTTL keeps a revoked session denylisted past the expiry of any
+// access token issued for it
+const revokedSessionTTL = time.Hour
+
+// ListSessions returns the user's active sessions, marking the one the current access token belongs to
+func (usecase *AuthUsecase) ListSessions(ctx context.Context, userID, currentSessionID string) ([]domain.Session, error) {
+	sessions, err := usecase.repository.ListActiveSessions(ctx, userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range sessions {
+		sessions[i].Current = currentSessionID != "" && sessions[i].ID == currentSessionID
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession revokes every refresh token of one of the user's sessions and
+// the access tokens it already handed out
+func (usecase *AuthUsecase) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	revoked, err := usecase.repository.RevokeSession(ctx, userID, sessionID)
+
+	if err != nil {
+		return err
+	}
+
+	if !revoked {
+		return domain.ErrSessionNotFound
+	}
+
+	return usecase.tokenRevoker.RevokeSessionAccessTokens(ctx, sessionID, userID, usecase.now().Add(revokedSessionTTL))
+}
+
+// LogoutAll revokes every refresh token and outstanding access token of the user, ending all sessions
+func (usecase *AuthUsecase) LogoutAll(ctx context.Context, userID string) error {
+	if err := usecase.repository.RevokeAllRefreshTokens(ctx, userID); err != nil {
+		return err
+	}
+
+	return usecase.tokenRevoker.RevokeUserAccessTokens(ctx, userID, usecase.now())
+}
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+func setupSessions(repo *mockAuthRepository) {
+	expiresAt := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	repo.refreshTokens = map[string]*domain.RefreshToken{
+		"hash-laptop": {ID: "token-1", UserID: "user-123", FamilyID: "session-laptop", TokenHash: "hash-laptop", UserAgent: "Mozilla/5.0", ExpiresAt: expiresAt},
+		"hash-phone":  {ID: "token-2", UserID: "user-123", FamilyID: "session-phone", TokenHash: "hash-phone", UserAgent: "okhttp/4.12", ExpiresAt: expiresAt},
+		"hash-other":  {ID: "token-3", UserID: "user-456", FamilyID: "session-other", TokenHash: "hash-other", ExpiresAt: expiresAt},
+	}
+}
+
+// Test Login stores the device of the new session and binds the access token to it
+func TestAuthUsecase_Login_StartsSession(t *testing.T) {
+	uc, repo, tokenSvc, _, _, _ := setupTestUsecase()
+	repo.users["user-123"] = &domain.User{ID: "user-123", Email: "admin@app.com", PasswordHash: "hashed-password123", RoleID: string(domain.RoleIDUser)}
+
+	var claims domain.AccessTokenClaims
+	tokenSvc.generateAccessToken = func(c domain.AccessTokenClaims) (string, error) {
+		claims = c
+		return "access-token", nil
+	}
+
+	_, err := uc.Login(context.Background(), domain.LoginInput{
+		Email:    "admin@app.com",
+		Password: "password123",
+		Client:   domain.ClientInfo{UserAgent: "Mozilla/5.0", IPAddress: "203.0.113.7"},
+	})
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+
+	token := repo.refreshTokens["refresh-token-hash"]
+	if token == nil || token.UserAgent != "Mozilla/5.0" || token.IPAddress != "203.0.113.7" || token.LastUsedAt.IsZero() {
+		t.Errorf("Login() stored refresh token = %+v", token)
+	}
+	if claims.SessionID == "" || claims.SessionID != token.FamilyID {
+		t.Errorf("Login() access token session = %q, want %q", claims.SessionID, token.FamilyID)
+	}
+}
+
+// Test ListSessions
+func TestAuthUsecase_ListSessions(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	setupSessions(repo)
+
+	sessions, err := uc.ListSessions(context.Background(), "user-123", "session-phone")
+	if err != nil {
+		t.Fatalf("ListSessions() error = %v", err)
+	}
+	if len(sessions) != 2 {
+		t.Fatalf("ListSessions() returned %d sessions, want 2", len(sessions))
+	}
+	for _, session := range sessions {
+		if session.Current != (session.ID == "session-phone") {
+			t.Errorf("ListSessions() session %s current = %v", session.ID, session.Current)
+		}
+	}
+}
+
+// Test RevokeSession
+func TestAuthUsecase_RevokeSession(t *testing.T) {
+	tests := []struct {
+		name      string
+		sessionID string
+		wantErr   error
+	}{
+		{name: "success - own session", sessionID: "session-laptop", wantErr: nil},
+		{name: "failure - unknown session", sessionID: "session-unknown", wantErr: domain.ErrSessionNotFound},
+		{name: "failure - session of another user", sessionID: "session-other", wantErr: domain.ErrSessionNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, _, _, _, _ := setupTestUsecase()
+			setupSessions(repo)
+
+			err := uc.RevokeSession(context.Background(), "user-123", tt.sessionID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("RevokeSession() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if repo.refreshTokens["hash-phone"].Revoked || repo.refreshTokens["hash-other"].Revoked {
+				t.Errorf("RevokeSession() revoked an unrelated session")
+			}
+			if (tt.wantErr == nil) != repo.refreshTokens["hash-laptop"].Revoked {
+				t.Errorf("RevokeSession() laptop revoked = %v", repo.refreshTokens["hash-laptop"].Revoked)
+			}
+			if _, ok := repo.revokedSessions[tt.sessionID]; ok != (tt.wantErr == nil) {
+				t.Errorf("RevokeSession() access tokens revoked = %v", ok)
+			}
+		})
+	}
+}
+
+// Test LogoutAll
+func TestAuthUsecase_LogoutAll(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	setupSessions(repo)
+
+	if err := uc.LogoutAll(context.Background(), "user-123"); err != nil {
+		t.Fatalf("LogoutAll() error = %v", err)
+	}
+	if !repo.refreshTokens["hash-laptop"].Revoked || !repo.refreshTokens["hash-phone"].Revoked {
+		t.Errorf("LogoutAll() left a session active")
+	}
+	if repo.refreshTokens["hash-other"].Revoked {
+		t.Errorf("LogoutAll() revoked another user's session")
+	}
+	if _, ok := repo.tokensValidAfter["user-123"]; !ok {
+		t.Errorf("LogoutAll() did not invalidate outstanding access tokens")
+	}
+}
@@ -0,0 +1,319 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+const (
+	webauthnChallengeTTL      = 5 * time.Minute
+	webauthnDefaultCredential = "Passkey"
+)
+
+// WebauthnRelyingParty builds the options for the browser's WebAuthn calls and
+// verifies what the authenticator answers. Options and responses are JSON.
+type WebauthnRelyingParty interface {
+	CreationOptions(user domain.WebauthnUser, challenge []byte, exclude []domain.WebauthnCredential) ([]byte, error)
+	RequestOptions(challenge []byte, allow []domain.WebauthnCredential, requireUserVerification bool) ([]byte, error)
+	// VerifyRegistration checks everything but the challenge, which it returns for the caller to match
+	VerifyRegistration(response []byte) (*domain.WebauthnRegistration, error)
+	ParseAssertion(response []byte) (*domain.WebauthnAssertion, error)
+	// VerifyAssertion checks the signature against publicKey and returns the new signature counter
+	VerifyAssertion(assertion *domain.WebauthnAssertion, publicKey []byte, requireUserVerification bool) (uint32, error)
+}
+
+// SetWebauthn enables passkeys for the relying party
+func (usecase *AuthUsecase) SetWebauthn(relyingParty WebauthnRelyingParty) {
+	usecase.webauthn = relyingParty
+}
+
+// BeginWebauthnRegistration returns the options for navigator.credentials.create,
+// excluding the authenticators the user already registered
+func (usecase *AuthUsecase) BeginWebauthnRegistration(ctx context.Context, userID string) ([]byte, error) {
+	if usecase.webauthn == nil {
+		return nil, domain.ErrWebauthnNotConfigured
+	}
+
+	user, err := usecase.repository.FindUserByID(ctx, userID)
+
+	if err != nil || user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	if err := requireActiveUser(user); err != nil {
+		return nil, err
+	}
+
+	credentials, err := usecase.repository.ListWebauthnCredentials(ctx, user.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	challenge, err := usecase.newWebauthnChallenge(ctx, user.ID, domain.WebauthnPurposeRegistration)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return usecase.webauthn.CreationOptions(domain.WebauthnUser{
+		ID:          user.ID,
+		Name:        user.Email,
+		DisplayName: user.Name,
+	}, challenge, credentials)
+}
+
+// FinishWebauthnRegistration stores the credential created for the user's registration challenge
+func (usecase *AuthUsecase) FinishWebauthnRegistration(ctx context.Context, req domain.FinishWebauthnRegistrationInput) (*domain.WebauthnCredential, error) {
+	if usecase.webauthn == nil {
+		return nil, domain.ErrWebauthnNotConfigured
+	}
+
+	registration, err := usecase.webauthn.VerifyRegistration(req.Credential)
+
+	if err != nil {
+		return nil, domain.ErrInvalidWebauthn
+	}
+
+	challenge, err := usecase.consumeWebauthnChallenge(ctx, registration.Challenge)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if challenge.Purpose != domain.WebauthnPurposeRegistration || challenge.UserID != req.UserID {
+		return nil, domain.ErrWebauthnChallenge
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = webauthnDefaultCredential
+	}
+
+	credential := &domain.WebauthnCredential{
+		ID:           usecase.uuid.GenerateID(),
+		UserID:       req.UserID,
+		CredentialID: registration.CredentialID,
+		PublicKey:    registration.PublicKey,
+		SignCount:    registration.SignCount,
+		Transports:   registration.Transports,
+		Name:         name,
+		CreatedAt:    usecase.now(),
+		UpdatedAt:    usecase.now(),
+	}
+
+	if err := usecase.repository.StoreWebauthnCredential(ctx, credential); err != nil {
+		return nil, err
+	}
+
+	return credential, nil
+}
+
+// BeginWebauthnLogin returns the options for navigator.credentials.get. Without
+// an MFA token it is a passwordless login where the user picks any passkey for
+// this site; with one the user's own passkeys complete that MFA challenge.
+func (usecase *AuthUsecase) BeginWebauthnLogin(ctx context.Context, mfaToken string) ([]byte, error) {
+	if usecase.webauthn == nil {
+		return nil, domain.ErrWebauthnNotConfigured
+	}
+
+	if mfaToken == "" {
+		challenge, err := usecase.newWebauthnChallenge(ctx, "", domain.WebauthnPurposeLogin)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return usecase.webauthn.RequestOptions(challenge, nil, true)
+	}
+
+	mfaChallenge, err := usecase.findMfaChallenge(ctx, mfaToken)
+
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, err := usecase.repository.ListWebauthnCredentials(ctx, mfaChallenge.UserID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(credentials) == 0 {
+		return nil, domain.ErrWebauthnNotFound
+	}
+
+	challenge, err := usecase.newWebauthnChallenge(ctx, mfaChallenge.UserID, domain.WebauthnPurposeMfa)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return usecase.webauthn.RequestOptions(challenge, credentials, false)
+}
+
+// FinishWebauthnLogin verifies the assertion and issues the token pair. A
+// passwordless login requires user verification, as the passkey is then both
+// factors; as a second factor user presence is enough.
+func (usecase *AuthUsecase) FinishWebauthnLogin(ctx context.Context, req domain.FinishWebauthnLoginInput) (*domain.AuthOutput, error) {
+	if usecase.webauthn == nil {
+		return nil, domain.ErrWebauthnNotConfigured
+	}
+
+	assertion, err := usecase.webauthn.ParseAssertion(req.Credential)
+
+	if err != nil {
+		return nil, domain.ErrInvalidWebauthn
+	}
+
+	purpose := domain.WebauthnPurposeLogin
+	var mfaChallenge *domain.MfaChallenge
+	var lockout *domain.LoginLockout
+
+	if req.MfaToken != "" {
+		purpose = domain.WebauthnPurposeMfa
+
+		if mfaChallenge, err = usecase.findMfaChallenge(ctx, req.MfaToken); err != nil {
+			return nil, err
+		}
+
+		// The first factor left its failed attempts for the second one to clear
+		if lockout, err = usecase.checkAccountLockout(ctx, mfaChallenge.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	challenge, err := usecase.consumeWebauthnChallenge(ctx, assertion.Challenge)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if challenge.Purpose != purpose || (mfaChallenge != nil && challenge.UserID != mfaChallenge.UserID) {
+		return nil, domain.ErrWebauthnChallenge
+	}
+
+	credential, err := usecase.repository.FindWebauthnCredential(ctx, assertion.CredentialID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if credential == nil ||
+		(challenge.UserID != "" && credential.UserID != challenge.UserID) ||
+		(len(assertion.UserHandle) > 0 && string(assertion.UserHandle) != credential.UserID) {
+		return nil, domain.ErrInvalidWebauthn
+	}
+
+	signCount, err := usecase.webauthn.VerifyAssertion(assertion, credential.PublicKey, purpose == domain.WebauthnPurposeLogin)
+
+	if err != nil {
+		return nil, domain.ErrInvalidWebauthn
+	}
+
+	// Authenticators without a counter always report zero. Otherwise a counter
+	// that does not move forward means a copy of the key signed in the meantime.
+	if (signCount != 0 || credential.SignCount != 0) && signCount <= credential.SignCount {
+		return nil, domain.ErrWebauthnCloned
+	}
+
+	if err := usecase.repository.UpdateWebauthnCredentialUsage(ctx, credential.ID, signCount, usecase.now()); err != nil {
+		return nil, err
+	}
+
+	if mfaChallenge != nil {
+		claimed, err := usecase.repository.MarkMfaChallengeUsed(ctx, mfaChallenge.ID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !claimed {
+			return nil, domain.ErrInvalidMfaChallenge
+		}
+
+		if lockout != nil {
+			if err := usecase.repository.DeleteLoginLockout(ctx, mfaChallenge.UserID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	user, err := usecase.repository.FindUserByID(ctx, credential.UserID)
+
+	if err != nil || user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	if usecase.requireEmailVerification && user.EmailVerifiedAt == nil {
+		return nil, domain.ErrEmailNotVerified
+	}
+
+	return usecase.issueTokens(ctx, user, req.Client)
+}
+
+func (usecase *AuthUsecase) ListWebauthnCredentials(ctx context.Context, userID string) ([]domain.WebauthnCredential, error) {
+	return usecase.repository.ListWebauthnCredentials(ctx, userID)
+}
+
+func (usecase *AuthUsecase) DeleteWebauthnCredential(ctx context.Context, userID, id string) error {
+	deleted, err := usecase.repository.DeleteWebauthnCredential(ctx, userID, id)
+
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return domain.ErrWebauthnNotFound
+	}
+
+	return nil
+}
+
+// newWebauthnChallenge stores a single-use challenge and returns it. Only its
+// hash is kept, looked up again from the client data the authenticator signs.
+func (usecase *AuthUsecase) newWebauthnChallenge(ctx context.Context, userID string, purpose domain.WebauthnPurpose) ([]byte, error) {
+	token, err := usecase.passwordHasher.GenerateRandomToken()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := usecase.repository.StoreWebauthnChallenge(ctx, &domain.WebauthnChallenge{
+		ID:            usecase.uuid.GenerateID(),
+		UserID:        userID,
+		Purpose:       purpose,
+		ChallengeHash: usecase.passwordHasher.HashToken(token),
+		ExpiresAt:     usecase.now().Add(webauthnChallengeTTL),
+		CreatedAt:     usecase.now(),
+		UpdatedAt:     usecase.now(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return []byte(token), nil
+}
+
+// consumeWebauthnChallenge marks the challenge a response answers as used
+func (usecase *AuthUsecase) consumeWebauthnChallenge(ctx context.Context, raw []byte) (*domain.WebauthnChallenge, error) {
+	challenge, err := usecase.repository.FindValidWebauthnChallenge(ctx, usecase.passwordHasher.HashToken(string(raw)))
+
+	if err != nil || challenge == nil || challenge.Used || challenge.ExpiresAt.Before(usecase.now()) {
+		return nil, domain.ErrWebauthnChallenge
+	}
+
+	used, err := usecase.repository.MarkWebauthnChallengeUsed(ctx, challenge.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !used {
+		return nil, domain.ErrWebauthnChallenge
+	}
+
+	return challenge, nil
+}
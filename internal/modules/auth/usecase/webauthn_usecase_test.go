@@ -0,0 +1,281 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/nassabiq/golang-template/internal/infrastructure/webauthn"
+	"github.com/nassabiq/golang-template/internal/infrastructure/webauthn/webauthntest"
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+)
+
+const webauthnOrigin = "https://app.example.com"
+
+// setupWebauthnUsecase enables passkeys for example.com and returns a software authenticator for it
+func setupWebauthnUsecase() (*AuthUsecase, *mockAuthRepository, *webauthntest.Authenticator) {
+	uc, repo, _, hasher, _, _ := setupTestUsecase()
+	uc.SetWebauthn(webauthn.NewRelyingParty(webauthn.Config{
+		RPID:    "example.com",
+		RPName:  "Example",
+		Origins: []string{webauthnOrigin},
+	}))
+	repo.users["user-123"] = &domain.User{ID: "user-123", Name: "Test User", Email: "test@example.com", PasswordHash: "hashed-password123"}
+
+	// every challenge must differ, or the mock could not tell them apart
+	var n int
+	hasher.generateRandomToken = func() (string, error) {
+		n++
+		return fmt.Sprintf("random-token-%d", n), nil
+	}
+
+	return uc, repo, webauthntest.NewAuthenticator("example.com", webauthnOrigin)
+}
+
+// registerPasskey runs both registration steps for user-123
+func registerPasskey(t *testing.T, uc *AuthUsecase, authenticator *webauthntest.Authenticator) *domain.WebauthnCredential {
+	t.Helper()
+
+	options, err := uc.BeginWebauthnRegistration(context.Background(), "user-123")
+	if err != nil {
+		t.Fatalf("BeginWebauthnRegistration() error = %v", err)
+	}
+	response, err := authenticator.Create(options)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	credential, err := uc.FinishWebauthnRegistration(context.Background(), domain.FinishWebauthnRegistrationInput{
+		UserID:     "user-123",
+		Name:       "Laptop",
+		Credential: response,
+	})
+	if err != nil {
+		t.Fatalf("FinishWebauthnRegistration() error = %v", err)
+	}
+
+	return credential
+}
+
+// assertPasskey answers BeginWebauthnLogin with the authenticator
+func assertPasskey(t *testing.T, uc *AuthUsecase, authenticator *webauthntest.Authenticator, mfaToken string) []byte {
+	t.Helper()
+
+	options, err := uc.BeginWebauthnLogin(context.Background(), mfaToken)
+	if err != nil {
+		t.Fatalf("BeginWebauthnLogin() error = %v", err)
+	}
+	response, err := authenticator.Get(options)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	return response
+}
+
+// Test a registered passkey is stored with its key, and the registration challenge works once
+func TestAuthUsecase_WebauthnRegistration(t *testing.T) {
+	uc, repo, authenticator := setupWebauthnUsecase()
+
+	options, err := uc.BeginWebauthnRegistration(context.Background(), "user-123")
+	if err != nil {
+		t.Fatalf("BeginWebauthnRegistration() error = %v", err)
+	}
+	response, err := authenticator.Create(options)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	input := domain.FinishWebauthnRegistrationInput{UserID: "user-123", Credential: response}
+	credential, err := uc.FinishWebauthnRegistration(context.Background(), input)
+	if err != nil {
+		t.Fatalf("FinishWebauthnRegistration() error = %v", err)
+	}
+	if credential.Name != webauthnDefaultCredential || len(credential.PublicKey) == 0 || credential.Transports[0] != "internal" {
+		t.Errorf("FinishWebauthnRegistration() = %+v", credential)
+	}
+	if len(repo.webauthnCredentials) != 1 {
+		t.Fatalf("FinishWebauthnRegistration() stored %d credentials, want 1", len(repo.webauthnCredentials))
+	}
+
+	if _, err := uc.FinishWebauthnRegistration(context.Background(), input); !errors.Is(err, domain.ErrWebauthnChallenge) {
+		t.Errorf("FinishWebauthnRegistration() replay error = %v, want %v", err, domain.ErrWebauthnChallenge)
+	}
+
+	// the same authenticator is excluded from registering again
+	options, err = uc.BeginWebauthnRegistration(context.Background(), "user-123")
+	if err != nil {
+		t.Fatalf("BeginWebauthnRegistration() error = %v", err)
+	}
+	if _, err := authenticator.Create(options); err == nil {
+		t.Errorf("Create() registered the same authenticator twice")
+	}
+}
+
+// Test a registration challenge issued to one user cannot register a passkey for another
+func TestAuthUsecase_WebauthnRegistration_OtherUser(t *testing.T) {
+	uc, repo, authenticator := setupWebauthnUsecase()
+	repo.users["user-456"] = &domain.User{ID: "user-456", Email: "other@example.com"}
+
+	options, err := uc.BeginWebauthnRegistration(context.Background(), "user-123")
+	if err != nil {
+		t.Fatalf("BeginWebauthnRegistration() error = %v", err)
+	}
+	response, err := authenticator.Create(options)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	_, err = uc.FinishWebauthnRegistration(context.Background(), domain.FinishWebauthnRegistrationInput{UserID: "user-456", Credential: response})
+	if !errors.Is(err, domain.ErrWebauthnChallenge) {
+		t.Errorf("FinishWebauthnRegistration() error = %v, want %v", err, domain.ErrWebauthnChallenge)
+	}
+	if len(repo.webauthnCredentials) != 0 {
+		t.Errorf("FinishWebauthnRegistration() stored a credential")
+	}
+}
+
+// Test a passkey signs the user in without a password, once per challenge
+func TestAuthUsecase_WebauthnLogin_Passwordless(t *testing.T) {
+	uc, repo, authenticator := setupWebauthnUsecase()
+	registerPasskey(t, uc, authenticator)
+
+	response := assertPasskey(t, uc, authenticator, "")
+	input := domain.FinishWebauthnLoginInput{Credential: response, Client: domain.ClientInfo{IPAddress: "203.0.113.7"}}
+
+	out, err := uc.FinishWebauthnLogin(context.Background(), input)
+	if err != nil {
+		t.Fatalf("FinishWebauthnLogin() error = %v", err)
+	}
+	if out.AccessToken == "" || out.RefreshToken == "" || out.MfaRequired {
+		t.Errorf("FinishWebauthnLogin() = %+v, want a token pair", out)
+	}
+	if stored := repo.webauthnCredentials[0]; stored.SignCount != 1 || stored.LastUsedAt == nil {
+		t.Errorf("FinishWebauthnLogin() credential sign count = %d, last used = %v", stored.SignCount, stored.LastUsedAt)
+	}
+
+	if _, err := uc.FinishWebauthnLogin(context.Background(), input); !errors.Is(err, domain.ErrWebauthnChallenge) {
+		t.Errorf("FinishWebauthnLogin() replay error = %v, want %v", err, domain.ErrWebauthnChallenge)
+	}
+}
+
+// Test passkey logins the relying party must refuse
+func TestAuthUsecase_WebauthnLogin_Rejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		prepare func(repo *mockAuthRepository, authenticator *webauthntest.Authenticator)
+		wantErr error
+	}{
+		{
+			name:    "user not verified",
+			prepare: func(_ *mockAuthRepository, a *webauthntest.Authenticator) { a.UserVerified = false },
+			wantErr: domain.ErrInvalidWebauthn,
+		},
+		{
+			name:    "other origin",
+			prepare: func(_ *mockAuthRepository, a *webauthntest.Authenticator) { a.Origin = "https://evil.example.com" },
+			wantErr: domain.ErrInvalidWebauthn,
+		},
+		{
+			name: "sign count went backwards",
+			prepare: func(repo *mockAuthRepository, _ *webauthntest.Authenticator) {
+				repo.webauthnCredentials[0].SignCount = 10
+			},
+			wantErr: domain.ErrWebauthnCloned,
+		},
+		{
+			name:    "credential removed",
+			prepare: func(repo *mockAuthRepository, _ *webauthntest.Authenticator) { repo.webauthnCredentials = nil },
+			wantErr: domain.ErrInvalidWebauthn,
+		},
+		{
+			name: "suspended account",
+			prepare: func(repo *mockAuthRepository, _ *webauthntest.Authenticator) {
+				repo.users["user-123"].Status = domain.UserStatusSuspended
+			},
+			wantErr: domain.ErrAccountSuspended,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo, authenticator := setupWebauthnUsecase()
+			registerPasskey(t, uc, authenticator)
+			tt.prepare(repo, authenticator)
+
+			response := assertPasskey(t, uc, authenticator, "")
+			out, err := uc.FinishWebauthnLogin(context.Background(), domain.FinishWebauthnLoginInput{Credential: response})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("FinishWebauthnLogin() = %+v, error = %v, want %v", out, err, tt.wantErr)
+			}
+			if len(repo.refreshTokens) != 0 {
+				t.Errorf("FinishWebauthnLogin() stored a refresh token")
+			}
+		})
+	}
+}
+
+// Test a password login of a user with a passkey asks for it as the second factor
+func TestAuthUsecase_WebauthnLogin_SecondFactor(t *testing.T) {
+	uc, repo, authenticator := setupWebauthnUsecase()
+	registerPasskey(t, uc, authenticator)
+
+	out, err := uc.Login(context.Background(), domain.LoginInput{Email: "test@example.com", Password: "password123"})
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if !out.MfaRequired || len(out.MfaMethods) != 1 || out.MfaMethods[0] != domain.MfaMethodWebauthn {
+		t.Fatalf("Login() = %+v, want a webauthn MFA challenge", out)
+	}
+
+	// user presence is enough for a second factor
+	authenticator.UserVerified = false
+	response := assertPasskey(t, uc, authenticator, out.MfaToken)
+
+	// the assertion answers an MFA challenge, not a passwordless login
+	if _, err := uc.FinishWebauthnLogin(context.Background(), domain.FinishWebauthnLoginInput{Credential: response}); !errors.Is(err, domain.ErrWebauthnChallenge) {
+		t.Fatalf("FinishWebauthnLogin() without mfa token error = %v, want %v", err, domain.ErrWebauthnChallenge)
+	}
+
+	response = assertPasskey(t, uc, authenticator, out.MfaToken)
+	tokens, err := uc.FinishWebauthnLogin(context.Background(), domain.FinishWebauthnLoginInput{Credential: response, MfaToken: out.MfaToken})
+	if err != nil {
+		t.Fatalf("FinishWebauthnLogin() error = %v", err)
+	}
+	if tokens.AccessToken == "" || tokens.RefreshToken == "" {
+		t.Errorf("FinishWebauthnLogin() = %+v, want a token pair", tokens)
+	}
+	if !repo.mfaChallenges["sha256-"+out.MfaToken].Used {
+		t.Errorf("FinishWebauthnLogin() did not use up the MFA challenge")
+	}
+}
+
+// Test passkeys are unavailable until the relying party is configured
+func TestAuthUsecase_Webauthn_NotConfigured(t *testing.T) {
+	uc, repo, _, _, _, _ := setupTestUsecase()
+	repo.users["user-123"] = &domain.User{ID: "user-123", Email: "test@example.com"}
+
+	if _, err := uc.BeginWebauthnRegistration(context.Background(), "user-123"); !errors.Is(err, domain.ErrWebauthnNotConfigured) {
+		t.Errorf("BeginWebauthnRegistration() error = %v, want %v", err, domain.ErrWebauthnNotConfigured)
+	}
+	if _, err := uc.BeginWebauthnLogin(context.Background(), ""); !errors.Is(err, domain.ErrWebauthnNotConfigured) {
+		t.Errorf("BeginWebauthnLogin() error = %v, want %v", err, domain.ErrWebauthnNotConfigured)
+	}
+}
+
+// Test a user can only delete their own passkeys
+func TestAuthUsecase_DeleteWebauthnCredential(t *testing.T) {
+	uc, repo, authenticator := setupWebauthnUsecase()
+	credential := registerPasskey(t, uc, authenticator)
+
+	if err := uc.DeleteWebauthnCredential(context.Background(), "user-456", credential.ID); !errors.Is(err, domain.ErrWebauthnNotFound) {
+		t.Errorf("DeleteWebauthnCredential(other user) error = %v, want %v", err, domain.ErrWebauthnNotFound)
+	}
+	if err := uc.DeleteWebauthnCredential(context.Background(), "user-123", credential.ID); err != nil {
+		t.Fatalf("DeleteWebauthnCredential() error = %v", err)
+	}
+	if len(repo.webauthnCredentials) != 0 {
+		t.Errorf("DeleteWebauthnCredential() left %d credentials", len(repo.webauthnCredentials))
+	}
+}
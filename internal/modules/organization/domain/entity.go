@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+type Organization struct {
+	ID        string
+	Name      string
+	Slug      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type OrganizationCreate struct {
+	ID   string
+	Name string
+	Slug string
+	// The creator joins the organization with OwnerRoleID
+	OwnerID     string
+	OwnerRoleID string
+}
+
+type OrganizationUpdate struct {
+	ID   string
+	Name *string
+	Slug *string
+}
+
+type Member struct {
+	OrganizationID string
+	UserID         string
+	Name           string
+	Email          string
+	RoleID         string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// MemberInvitation offers UserID a role in an organization. They only become a
+// member by accepting it.
+type MemberInvitation struct {
+	OrganizationID   string
+	OrganizationName string
+	UserID           string
+	RoleID           string
+	InvitedBy        string
+	ExpiresAt        time.Time
+	CreatedAt        time.Time
+}
@@ -0,0 +1,10 @@
+package domain
+
+import "errors"
+
+var (
+	ErrSlugAlreadyExists = errors.New("organization slug already exists")
+	ErrAlreadyMember     = errors.New("user is already a member")
+	ErrUnknownUser       = errors.New("unknown user")
+	ErrUnknownRole       = errors.New("unknown role")
+)
@@ -0,0 +1,27 @@
+package domain
+
+import "context"
+
+type OrganizationRepository interface {
+	ListForUser(ctx context.Context, userID string) ([]Organization, error)
+	FindByID(ctx context.Context, id string) (*Organization, error)
+	FindBySlug(ctx context.Context, slug string) (*Organization, error)
+	Create(ctx context.Context, request *OrganizationCreate) (*Organization, error)
+	Update(ctx context.Context, request *OrganizationUpdate) (*Organization, error)
+	Delete(ctx context.Context, organization *Organization) error
+
+	ListMembers(ctx context.Context, organizationID string) ([]Member, error)
+	FindMember(ctx context.Context, organizationID, userID string) (*Member, error)
+	UpdateMember(ctx context.Context, member *Member) error
+	RemoveMember(ctx context.Context, member *Member) error
+
+	SaveInvitation(ctx context.Context, invitation *MemberInvitation) error
+	ListInvitations(ctx context.Context, userID string) ([]MemberInvitation, error)
+	FindInvitation(ctx context.Context, organizationID, userID string) (*MemberInvitation, error)
+	AcceptInvitation(ctx context.Context, invitation *MemberInvitation) error
+	DeleteInvitation(ctx context.Context, invitation *MemberInvitation) error
+
+	UserExists(ctx context.Context, userID string) (bool, error)
+	RoleExists(ctx context.Context, roleID string) (bool, error)
+	RolePermissions(ctx context.Context, roleID string) ([]string, error)
+}
@@ -0,0 +1,18 @@
+package dto
+
+type CreateOrganizationDto struct {
+	Name string `validate:"required,min=3,max=100"`
+	Slug string `validate:"required,min=3,max=100,lowercase,hostname_rfc1123"`
+}
+
+type UpdateOrganizationDto struct {
+	ID   string  `validate:"required"`
+	Name *string `validate:"omitempty,min=3,max=100"`
+	Slug *string `validate:"omitempty,min=3,max=100,lowercase,hostname_rfc1123"`
+}
+
+type MemberDto struct {
+	OrganizationID string `validate:"required"`
+	UserID         string `validate:"required"`
+	RoleID         string `validate:"required"`
+}
@@ -0,0 +1,406 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/nassabiq/golang-template/internal/modules/organization/domain"
+	"github.com/nassabiq/golang-template/internal/modules/organization/dto"
+	"github.com/nassabiq/golang-template/internal/modules/organization/usecase"
+	"github.com/nassabiq/golang-template/internal/shared/common/response"
+	"github.com/nassabiq/golang-template/internal/shared/helper"
+	middleware "github.com/nassabiq/golang-template/internal/shared/middleware/auth"
+	commonpb "github.com/nassabiq/golang-template/proto/common"
+	proto "github.com/nassabiq/golang-template/proto/organization"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type OrganizationHandler struct {
+	proto.UnimplementedOrganizationServiceServer
+	usecase *usecase.OrganizationUsecase
+}
+
+func NewOrganizationHandler(usecase *usecase.OrganizationUsecase) *OrganizationHandler {
+	return &OrganizationHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *OrganizationHandler) List(ctx context.Context, _ *proto.ListOrganizationRequest) (*proto.ListOrganizationResponse, error) {
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return &proto.ListOrganizationResponse{
+			Metadata: response.Unauthorized(),
+		}, nil
+	}
+
+	organizations, err := handler.usecase.List(ctx, userID)
+	if err != nil {
+		return &proto.ListOrganizationResponse{
+			Metadata:      response.Internal(),
+			Organizations: []*proto.Organization{},
+		}, nil
+	}
+
+	resp := &proto.ListOrganizationResponse{
+		Metadata: response.Success(200, "success"),
+	}
+
+	for i := range organizations {
+		resp.Organizations = append(resp.Organizations, toProtoOrganization(&organizations[i]))
+	}
+
+	return resp, nil
+}
+
+func (handler *OrganizationHandler) GetByID(ctx context.Context, req *proto.GetByIDRequest) (*proto.OrganizationResponse, error) {
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return &proto.OrganizationResponse{
+			Metadata: response.Unauthorized(),
+		}, nil
+	}
+
+	organization, err := handler.usecase.GetByID(ctx, userID, req.GetId())
+	if err != nil {
+		return &proto.OrganizationResponse{
+			Metadata: organizationError(err),
+		}, nil
+	}
+
+	return &proto.OrganizationResponse{
+		Metadata: response.Success(200, "success"),
+		Data:     toProtoOrganization(organization),
+	}, nil
+}
+
+func (handler *OrganizationHandler) Create(ctx context.Context, req *proto.CreateOrganizationRequest) (*proto.OrganizationResponse, error) {
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return &proto.OrganizationResponse{
+			Metadata: response.Unauthorized(),
+		}, nil
+	}
+
+	request := &dto.CreateOrganizationDto{
+		Name: req.GetName(),
+		Slug: req.GetSlug(),
+	}
+
+	if err := helper.Validate.Struct(request); err != nil {
+		return &proto.OrganizationResponse{
+			Metadata: response.Validation(err.Error()),
+		}, nil
+	}
+
+	organization, err := handler.usecase.Create(ctx, userID, request)
+	if err != nil {
+		return &proto.OrganizationResponse{
+			Metadata: organizationError(err),
+		}, nil
+	}
+
+	return &proto.OrganizationResponse{
+		Metadata: response.Created(),
+		Data:     toProtoOrganization(organization),
+	}, nil
+}
+
+func (handler *OrganizationHandler) Update(ctx context.Context, req *proto.UpdateOrganizationRequest) (*proto.OrganizationResponse, error) {
+	request := &dto.UpdateOrganizationDto{
+		ID:   req.GetId(),
+		Name: req.Name,
+		Slug: req.Slug,
+	}
+
+	if err := helper.Validate.Struct(request); err != nil {
+		return &proto.OrganizationResponse{
+			Metadata: response.Validation(err.Error()),
+		}, nil
+	}
+
+	organization, err := handler.usecase.Update(ctx, request)
+	if err != nil {
+		return &proto.OrganizationResponse{
+			Metadata: organizationError(err),
+		}, nil
+	}
+
+	return &proto.OrganizationResponse{
+		Metadata: response.Success(200, "success"),
+		Data:     toProtoOrganization(organization),
+	}, nil
+}
+
+func (handler *OrganizationHandler) Delete(ctx context.Context, req *proto.DeleteOrganizationRequest) (*proto.DeleteOrganizationResponse, error) {
+	if err := handler.usecase.Delete(ctx, req.GetId()); err != nil {
+		return &proto.DeleteOrganizationResponse{
+			Metadata: organizationError(err),
+		}, nil
+	}
+
+	return &proto.DeleteOrganizationResponse{
+		Metadata: response.Deleted(),
+	}, nil
+}
+
+func (handler *OrganizationHandler) ListMembers(ctx context.Context, req *proto.ListMemberRequest) (*proto.ListMemberResponse, error) {
+	members, err := handler.usecase.ListMembers(ctx, req.GetOrganizationId())
+	if err != nil {
+		return &proto.ListMemberResponse{
+			Metadata: organizationError(err),
+			Members:  []*proto.Member{},
+		}, nil
+	}
+
+	resp := &proto.ListMemberResponse{
+		Metadata: response.Success(200, "success"),
+	}
+
+	for i := range members {
+		resp.Members = append(resp.Members, toProtoMember(&members[i]))
+	}
+
+	return resp, nil
+}
+
+func (handler *OrganizationHandler) InviteMember(ctx context.Context, req *proto.InviteMemberRequest) (*proto.MemberInvitationResponse, error) {
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return &proto.MemberInvitationResponse{
+			Metadata: response.Unauthorized(),
+		}, nil
+	}
+
+	request := &dto.MemberDto{
+		OrganizationID: req.GetOrganizationId(),
+		UserID:         req.GetUserId(),
+		RoleID:         req.GetRoleId(),
+	}
+
+	if err := helper.Validate.Struct(request); err != nil {
+		return &proto.MemberInvitationResponse{
+			Metadata: response.Validation(err.Error()),
+		}, nil
+	}
+
+	if metadata := handler.ensureAssignable(ctx, request.RoleID); metadata != nil {
+		return &proto.MemberInvitationResponse{
+			Metadata: metadata,
+		}, nil
+	}
+
+	invitation, err := handler.usecase.InviteMember(ctx, userID, request)
+	if err != nil {
+		return &proto.MemberInvitationResponse{
+			Metadata: organizationError(err),
+		}, nil
+	}
+
+	return &proto.MemberInvitationResponse{
+		Metadata: response.Created(),
+		Data:     toProtoInvitation(invitation),
+	}, nil
+}
+
+func (handler *OrganizationHandler) ListInvitations(ctx context.Context, _ *proto.ListInvitationRequest) (*proto.ListInvitationResponse, error) {
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return &proto.ListInvitationResponse{
+			Metadata: response.Unauthorized(),
+		}, nil
+	}
+
+	invitations, err := handler.usecase.ListInvitations(ctx, userID)
+	if err != nil {
+		return &proto.ListInvitationResponse{
+			Metadata:    response.Internal(),
+			Invitations: []*proto.MemberInvitation{},
+		}, nil
+	}
+
+	resp := &proto.ListInvitationResponse{
+		Metadata: response.Success(200, "success"),
+	}
+
+	for i := range invitations {
+		resp.Invitations = append(resp.Invitations, toProtoInvitation(&invitations[i]))
+	}
+
+	return resp, nil
+}
+
+func (handler *OrganizationHandler) AcceptInvitation(ctx context.Context, req *proto.InvitationRequest) (*proto.MemberResponse, error) {
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return &proto.MemberResponse{
+			Metadata: response.Unauthorized(),
+		}, nil
+	}
+
+	member, err := handler.usecase.AcceptInvitation(ctx, req.GetOrganizationId(), userID)
+	if err != nil {
+		return &proto.MemberResponse{
+			Metadata: invitationError(err),
+		}, nil
+	}
+
+	return &proto.MemberResponse{
+		Metadata: response.Success(200, "success"),
+		Data:     toProtoMember(member),
+	}, nil
+}
+
+func (handler *OrganizationHandler) DeclineInvitation(ctx context.Context, req *proto.InvitationRequest) (*proto.DeleteOrganizationResponse, error) {
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return &proto.DeleteOrganizationResponse{
+			Metadata: response.Unauthorized(),
+		}, nil
+	}
+
+	if err := handler.usecase.DeclineInvitation(ctx, req.GetOrganizationId(), userID); err != nil {
+		return &proto.DeleteOrganizationResponse{
+			Metadata: invitationError(err),
+		}, nil
+	}
+
+	return &proto.DeleteOrganizationResponse{
+		Metadata: response.Deleted(),
+	}, nil
+}
+
+func (handler *OrganizationHandler) UpdateMember(ctx context.Context, req *proto.UpdateMemberRequest) (*proto.MemberResponse, error) {
+	request := &dto.MemberDto{
+		OrganizationID: req.GetOrganizationId(),
+		UserID:         req.GetUserId(),
+		RoleID:         req.GetRoleId(),
+	}
+
+	if err := helper.Validate.Struct(request); err != nil {
+		return &proto.MemberResponse{
+			Metadata: response.Validation(err.Error()),
+		}, nil
+	}
+
+	if metadata := handler.ensureAssignable(ctx, request.RoleID); metadata != nil {
+		return &proto.MemberResponse{
+			Metadata: metadata,
+		}, nil
+	}
+
+	member, err := handler.usecase.UpdateMember(ctx, request)
+	if err != nil {
+		return &proto.MemberResponse{
+			Metadata: organizationError(err),
+		}, nil
+	}
+
+	return &proto.MemberResponse{
+		Metadata: response.Success(200, "success"),
+		Data:     toProtoMember(member),
+	}, nil
+}
+
+func (handler *OrganizationHandler) RemoveMember(ctx context.Context, req *proto.RemoveMemberRequest) (*proto.DeleteOrganizationResponse, error) {
+	if err := handler.usecase.RemoveMember(ctx, req.GetOrganizationId(), req.GetUserId()); err != nil {
+		return &proto.DeleteOrganizationResponse{
+			Metadata: organizationError(err),
+		}, nil
+	}
+
+	return &proto.DeleteOrganizationResponse{
+		Metadata: response.Deleted(),
+	}, nil
+}
+
+// ensureAssignable refuses roles granting a permission the caller does not
+// hold, so managing members never lifts anyone above the caller
+func (handler *OrganizationHandler) ensureAssignable(ctx context.Context, roleID string) *commonpb.MetaData {
+	permissions, err := handler.usecase.RolePermissions(ctx, roleID)
+	if err != nil {
+		return organizationError(err)
+	}
+
+	for _, permission := range permissions {
+		if !middleware.HasPermission(ctx, permission) {
+			return response.Forbidden("cannot assign a role with permissions you do not hold")
+		}
+	}
+
+	return nil
+}
+
+func organizationError(err error) *commonpb.MetaData {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return response.NotFound("organization or member not found")
+	case errors.Is(err, domain.ErrSlugAlreadyExists), errors.Is(err, domain.ErrAlreadyMember):
+		return response.Conflict(err.Error())
+	case errors.Is(err, domain.ErrUnknownUser), errors.Is(err, domain.ErrUnknownRole):
+		return response.BadRequest(err.Error())
+	default:
+		return response.Internal()
+	}
+}
+
+func invitationError(err error) *commonpb.MetaData {
+	if errors.Is(err, sql.ErrNoRows) {
+		return response.NotFound("invitation not found")
+	}
+	return response.Internal()
+}
+
+func toProtoOrganization(organization *domain.Organization) *proto.Organization {
+	data := &proto.Organization{
+		Id:   organization.ID,
+		Name: organization.Name,
+		Slug: organization.Slug,
+	}
+
+	if !organization.CreatedAt.IsZero() {
+		data.CreatedAt = timestamppb.New(organization.CreatedAt)
+	}
+	if !organization.UpdatedAt.IsZero() {
+		data.UpdatedAt = timestamppb.New(organization.UpdatedAt)
+	}
+
+	return data
+}
+
+func toProtoMember(member *domain.Member) *proto.Member {
+	data := &proto.Member{
+		OrganizationId: member.OrganizationID,
+		UserId:         member.UserID,
+		Name:           member.Name,
+		Email:          member.Email,
+		RoleId:         member.RoleID,
+	}
+
+	if !member.CreatedAt.IsZero() {
+		data.CreatedAt = timestamppb.New(member.CreatedAt)
+	}
+	if !member.UpdatedAt.IsZero() {
+		data.UpdatedAt = timestamppb.New(member.UpdatedAt)
+	}
+
+	return data
+}
+
+func toProtoInvitation(invitation *domain.MemberInvitation) *proto.MemberInvitation {
+	data := &proto.MemberInvitation{
+		OrganizationId:   invitation.OrganizationID,
+		OrganizationName: invitation.OrganizationName,
+		UserId:           invitation.UserID,
+		RoleId:           invitation.RoleID,
+		InvitedBy:        invitation.InvitedBy,
+		ExpiresAt:        timestamppb.New(invitation.ExpiresAt),
+	}
+
+	if !invitation.CreatedAt.IsZero() {
+		data.CreatedAt = timestamppb.New(invitation.CreatedAt)
+	}
+
+	return data
+}
@@ -0,0 +1,391 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/organization/domain"
+)
+
+const organizationColumns = "o.id, o.name, o.slug, o.created_at, o.updated_at FROM organizations o"
+
+const memberColumns = `m.organization_id, m.user_id, u.name, u.email, m.role_id, m.created_at, m.updated_at
+	FROM organization_members m
+	JOIN users u ON u.id = m.user_id`
+
+const invitationColumns = `i.organization_id, o.name, i.user_id, i.role_id, i.invited_by, i.expires_at, i.created_at
+	FROM organization_invitations i
+	JOIN organizations o ON o.id = i.organization_id`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+type OrganizationRepository struct {
+	db *sql.DB
+}
+
+func NewOrganizationRepository(db *sql.DB) *OrganizationRepository {
+	return &OrganizationRepository{db: db}
+}
+
+func (r *OrganizationRepository) ListForUser(ctx context.Context, userID string) ([]domain.Organization, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+organizationColumns+" JOIN organization_members m ON m.organization_id = o.id WHERE m.user_id = $1 ORDER BY o.name",
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var organizations []domain.Organization
+	for rows.Next() {
+		organization, err := scanOrganization(rows)
+		if err != nil {
+			return nil, err
+		}
+		organizations = append(organizations, *organization)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return organizations, nil
+}
+
+func (r *OrganizationRepository) FindByID(ctx context.Context, id string) (*domain.Organization, error) {
+	return scanOrganization(r.db.QueryRowContext(ctx, "SELECT "+organizationColumns+" WHERE o.id = $1", id))
+}
+
+func (r *OrganizationRepository) FindBySlug(ctx context.Context, slug string) (*domain.Organization, error) {
+	return scanOrganization(r.db.QueryRowContext(ctx, "SELECT "+organizationColumns+" WHERE o.slug = $1", slug))
+}
+
+func (r *OrganizationRepository) Create(ctx context.Context, request *domain.OrganizationCreate) (*domain.Organization, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO organizations (id, name, slug, created_at, updated_at) VALUES ($1, $2, $3, NOW(), NOW())",
+		request.ID, request.Name, request.Slug,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO organization_members (organization_id, user_id, role_id, created_at, updated_at)
+		VALUES ($1, $2, $3, NOW(), NOW())`,
+		request.ID, request.OwnerID, request.OwnerRoleID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &domain.Organization{
+		ID:        request.ID,
+		Name:      request.Name,
+		Slug:      request.Slug,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+func (r *OrganizationRepository) Update(ctx context.Context, request *domain.OrganizationUpdate) (*domain.Organization, error) {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE organizations SET name = COALESCE($1, name), slug = COALESCE($2, slug), updated_at = NOW() WHERE id = $3",
+		request.Name, request.Slug, request.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return r.FindByID(ctx, request.ID)
+}
+
+// Delete removes the organization; memberships go with it
+func (r *OrganizationRepository) Delete(ctx context.Context, organization *domain.Organization) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM organizations WHERE id = $1", organization.ID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *OrganizationRepository) ListMembers(ctx context.Context, organizationID string) ([]domain.Member, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+memberColumns+" WHERE m.organization_id = $1 ORDER BY u.name",
+		organizationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []domain.Member
+	for rows.Next() {
+		member, err := scanMember(rows)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, *member)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+func (r *OrganizationRepository) FindMember(ctx context.Context, organizationID, userID string) (*domain.Member, error) {
+	return scanMember(r.db.QueryRowContext(ctx,
+		"SELECT "+memberColumns+" WHERE m.organization_id = $1 AND m.user_id = $2",
+		organizationID, userID,
+	))
+}
+
+func (r *OrganizationRepository) UpdateMember(ctx context.Context, member *domain.Member) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE organization_members SET role_id = $1, updated_at = NOW() WHERE organization_id = $2 AND user_id = $3",
+		member.RoleID, member.OrganizationID, member.UserID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *OrganizationRepository) RemoveMember(ctx context.Context, member *domain.Member) error {
+	result, err := r.db.ExecContext(ctx,
+		"DELETE FROM organization_members WHERE organization_id = $1 AND user_id = $2",
+		member.OrganizationID, member.UserID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// SaveInvitation invites a user, replacing an earlier invitation to the same
+// organization
+func (r *OrganizationRepository) SaveInvitation(ctx context.Context, invitation *domain.MemberInvitation) error {
+	_, err := r.db.ExecContext(ctx, `INSERT INTO organization_invitations (organization_id, user_id, role_id, invited_by, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		ON CONFLICT (organization_id, user_id) DO UPDATE
+		SET role_id = EXCLUDED.role_id, invited_by = EXCLUDED.invited_by, expires_at = EXCLUDED.expires_at, created_at = EXCLUDED.created_at`,
+		invitation.OrganizationID, invitation.UserID, invitation.RoleID, invitation.InvitedBy, invitation.ExpiresAt,
+	)
+	return err
+}
+
+// ListInvitations returns the invitations userID can still accept
+func (r *OrganizationRepository) ListInvitations(ctx context.Context, userID string) ([]domain.MemberInvitation, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+invitationColumns+" WHERE i.user_id = $1 AND i.expires_at > NOW() ORDER BY i.created_at DESC",
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var invitations []domain.MemberInvitation
+	for rows.Next() {
+		invitation, err := scanInvitation(rows)
+		if err != nil {
+			return nil, err
+		}
+		invitations = append(invitations, *invitation)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return invitations, nil
+}
+
+func (r *OrganizationRepository) FindInvitation(ctx context.Context, organizationID, userID string) (*domain.MemberInvitation, error) {
+	return scanInvitation(r.db.QueryRowContext(ctx,
+		"SELECT "+invitationColumns+" WHERE i.organization_id = $1 AND i.user_id = $2 AND i.expires_at > NOW()",
+		organizationID, userID,
+	))
+}
+
+// AcceptInvitation turns the invitation into a membership. Only one of two
+// concurrent accepts finds it; the other gets sql.ErrNoRows.
+func (r *OrganizationRepository) AcceptInvitation(ctx context.Context, invitation *domain.MemberInvitation) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var roleID string
+	err = tx.QueryRowContext(ctx,
+		"DELETE FROM organization_invitations WHERE organization_id = $1 AND user_id = $2 AND expires_at > NOW() RETURNING role_id",
+		invitation.OrganizationID, invitation.UserID,
+	).Scan(&roleID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO organization_members (organization_id, user_id, role_id, created_at, updated_at)
+		VALUES ($1, $2, $3, NOW(), NOW())
+		ON CONFLICT (organization_id, user_id) DO NOTHING`,
+		invitation.OrganizationID, invitation.UserID, roleID,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *OrganizationRepository) DeleteInvitation(ctx context.Context, invitation *domain.MemberInvitation) error {
+	result, err := r.db.ExecContext(ctx,
+		"DELETE FROM organization_invitations WHERE organization_id = $1 AND user_id = $2",
+		invitation.OrganizationID, invitation.UserID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *OrganizationRepository) UserExists(ctx context.Context, userID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM users WHERE id = $1)", userID).Scan(&exists)
+	return exists, err
+}
+
+func (r *OrganizationRepository) RoleExists(ctx context.Context, roleID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM roles WHERE id = $1)", roleID).Scan(&exists)
+	return exists, err
+}
+
+func (r *OrganizationRepository) RolePermissions(ctx context.Context, roleID string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT p.name FROM role_permissions rp JOIN permissions p ON p.id = rp.permission_id WHERE rp.role_id = $1",
+		roleID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var permissions []string
+	for rows.Next() {
+		var permission string
+		if err := rows.Scan(&permission); err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, permission)
+	}
+
+	return permissions, rows.Err()
+}
+
+func scanOrganization(row rowScanner) (*domain.Organization, error) {
+	var organization domain.Organization
+	var createdAt, updatedAt sql.NullTime
+
+	err := row.Scan(&organization.ID, &organization.Name, &organization.Slug, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	organization.CreatedAt = createdAt.Time
+	organization.UpdatedAt = updatedAt.Time
+	return &organization, nil
+}
+
+func scanMember(row rowScanner) (*domain.Member, error) {
+	var member domain.Member
+	var createdAt, updatedAt sql.NullTime
+
+	err := row.Scan(&member.OrganizationID, &member.UserID, &member.Name, &member.Email, &member.RoleID, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	member.CreatedAt = createdAt.Time
+	member.UpdatedAt = updatedAt.Time
+	return &member, nil
+}
+
+func scanInvitation(row rowScanner) (*domain.MemberInvitation, error) {
+	var invitation domain.MemberInvitation
+	var invitedBy sql.NullString
+	var createdAt sql.NullTime
+
+	err := row.Scan(&invitation.OrganizationID, &invitation.OrganizationName, &invitation.UserID, &invitation.RoleID,
+		&invitedBy, &invitation.ExpiresAt, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation.InvitedBy = invitedBy.String
+	invitation.CreatedAt = createdAt.Time
+	return &invitation, nil
+}
@@ -0,0 +1,267 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	authDomain "github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/organization/domain"
+	"github.com/nassabiq/golang-template/internal/modules/organization/dto"
+	"github.com/nassabiq/golang-template/internal/shared/tenant"
+)
+
+const memberInvitationTTL = 7 * 24 * time.Hour
+
+// TokenRevoker invalidates the access tokens a user holds in one organization
+type TokenRevoker interface {
+	RevokeOrganizationAccessTokens(ctx context.Context, userID, organizationID string, before time.Time) error
+}
+
+type OrganizationUsecase struct {
+	repository   domain.OrganizationRepository
+	tokenRevoker TokenRevoker
+}
+
+func NewOrganizationUsecase(repository domain.OrganizationRepository) *OrganizationUsecase {
+	return &OrganizationUsecase{
+		repository: repository,
+	}
+}
+
+func (usecase *OrganizationUsecase) SetTokenRevoker(revoker TokenRevoker) {
+	usecase.tokenRevoker = revoker
+}
+
+// List returns the organizations userID belongs to
+func (usecase *OrganizationUsecase) List(ctx context.Context, userID string) ([]domain.Organization, error) {
+	return usecase.repository.ListForUser(ctx, userID)
+}
+
+// GetByID only finds organizations userID is a member of
+func (usecase *OrganizationUsecase) GetByID(ctx context.Context, userID, id string) (*domain.Organization, error) {
+	if _, err := usecase.repository.FindMember(ctx, id, userID); err != nil {
+		return nil, err
+	}
+
+	return usecase.repository.FindByID(ctx, id)
+}
+
+// Create makes userID the first admin of the new organization
+func (usecase *OrganizationUsecase) Create(ctx context.Context, userID string, request *dto.CreateOrganizationDto) (*domain.Organization, error) {
+	if err := usecase.ensureSlugAvailable(ctx, request.Slug, ""); err != nil {
+		return nil, err
+	}
+
+	return usecase.repository.Create(ctx, &domain.OrganizationCreate{
+		ID:          uuid.New().String(),
+		Name:        request.Name,
+		Slug:        request.Slug,
+		OwnerID:     userID,
+		OwnerRoleID: string(authDomain.RoleIDAdmin),
+	})
+}
+
+func (usecase *OrganizationUsecase) Update(ctx context.Context, request *dto.UpdateOrganizationDto) (*domain.Organization, error) {
+	if err := ensureCurrent(ctx, request.ID); err != nil {
+		return nil, err
+	}
+
+	if request.Slug != nil {
+		if err := usecase.ensureSlugAvailable(ctx, *request.Slug, request.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return usecase.repository.Update(ctx, &domain.OrganizationUpdate{
+		ID:   request.ID,
+		Name: request.Name,
+		Slug: request.Slug,
+	})
+}
+
+func (usecase *OrganizationUsecase) Delete(ctx context.Context, id string) error {
+	if err := ensureCurrent(ctx, id); err != nil {
+		return err
+	}
+
+	organization, err := usecase.repository.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return usecase.repository.Delete(ctx, organization)
+}
+
+func (usecase *OrganizationUsecase) ListMembers(ctx context.Context, organizationID string) ([]domain.Member, error) {
+	if err := ensureCurrent(ctx, organizationID); err != nil {
+		return nil, err
+	}
+
+	return usecase.repository.ListMembers(ctx, organizationID)
+}
+
+// InviteMember offers userID a role in the current organization. Nobody is
+// added without their consent: they join once they accept the invitation.
+func (usecase *OrganizationUsecase) InviteMember(ctx context.Context, invitedBy string, request *dto.MemberDto) (*domain.MemberInvitation, error) {
+	if err := ensureCurrent(ctx, request.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	exists, err := usecase.repository.UserExists(ctx, request.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, domain.ErrUnknownUser
+	}
+
+	if err := usecase.ensureRoleExists(ctx, request.RoleID); err != nil {
+		return nil, err
+	}
+
+	_, err = usecase.repository.FindMember(ctx, request.OrganizationID, request.UserID)
+	if err == nil {
+		return nil, domain.ErrAlreadyMember
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	err = usecase.repository.SaveInvitation(ctx, &domain.MemberInvitation{
+		OrganizationID: request.OrganizationID,
+		UserID:         request.UserID,
+		RoleID:         request.RoleID,
+		InvitedBy:      invitedBy,
+		ExpiresAt:      time.Now().Add(memberInvitationTTL),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return usecase.repository.FindInvitation(ctx, request.OrganizationID, request.UserID)
+}
+
+// ListInvitations returns the invitations userID has not answered yet
+func (usecase *OrganizationUsecase) ListInvitations(ctx context.Context, userID string) ([]domain.MemberInvitation, error) {
+	return usecase.repository.ListInvitations(ctx, userID)
+}
+
+// AcceptInvitation makes userID a member of organizationID with the role they
+// were invited with. It takes effect on their next token.
+func (usecase *OrganizationUsecase) AcceptInvitation(ctx context.Context, organizationID, userID string) (*domain.Member, error) {
+	invitation, err := usecase.repository.FindInvitation(ctx, organizationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := usecase.repository.AcceptInvitation(ctx, invitation); err != nil {
+		return nil, err
+	}
+
+	return usecase.repository.FindMember(ctx, organizationID, userID)
+}
+
+func (usecase *OrganizationUsecase) DeclineInvitation(ctx context.Context, organizationID, userID string) error {
+	invitation, err := usecase.repository.FindInvitation(ctx, organizationID, userID)
+	if err != nil {
+		return err
+	}
+
+	return usecase.repository.DeleteInvitation(ctx, invitation)
+}
+
+// UpdateMember changes a member's role. It takes effect on their next token.
+func (usecase *OrganizationUsecase) UpdateMember(ctx context.Context, request *dto.MemberDto) (*domain.Member, error) {
+	if err := ensureCurrent(ctx, request.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	if err := usecase.ensureRoleExists(ctx, request.RoleID); err != nil {
+		return nil, err
+	}
+
+	err := usecase.repository.UpdateMember(ctx, &domain.Member{
+		OrganizationID: request.OrganizationID,
+		UserID:         request.UserID,
+		RoleID:         request.RoleID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return usecase.repository.FindMember(ctx, request.OrganizationID, request.UserID)
+}
+
+func (usecase *OrganizationUsecase) RemoveMember(ctx context.Context, organizationID, userID string) error {
+	if err := ensureCurrent(ctx, organizationID); err != nil {
+		return err
+	}
+
+	member, err := usecase.repository.FindMember(ctx, organizationID, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := usecase.repository.RemoveMember(ctx, member); err != nil {
+		return err
+	}
+
+	// A removed member's tokens for this organization stop working right away
+	return usecase.tokenRevoker.RevokeOrganizationAccessTokens(ctx, userID, organizationID, time.Now())
+}
+
+// RolePermissions returns what a member given roleID could do, so the caller
+// can be kept from assigning more than they hold themselves
+func (usecase *OrganizationUsecase) RolePermissions(ctx context.Context, roleID string) ([]string, error) {
+	if err := usecase.ensureRoleExists(ctx, roleID); err != nil {
+		return nil, err
+	}
+
+	return usecase.repository.RolePermissions(ctx, roleID)
+}
+
+// ensureCurrent rejects changes to any organization but the one the caller's
+// token acts in: their permissions come from their role there. Others look
+// like they do not exist.
+func ensureCurrent(ctx context.Context, organizationID string) error {
+	current, ok := tenant.OrganizationFromContext(ctx)
+	if !ok || current != organizationID {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (usecase *OrganizationUsecase) ensureSlugAvailable(ctx context.Context, slug, organizationID string) error {
+	existing, err := usecase.repository.FindBySlug(ctx, slug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	if existing.ID != organizationID {
+		return domain.ErrSlugAlreadyExists
+	}
+
+	return nil
+}
+
+func (usecase *OrganizationUsecase) ensureRoleExists(ctx context.Context, roleID string) error {
+	exists, err := usecase.repository.RoleExists(ctx, roleID)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return domain.ErrUnknownRole
+	}
+
+	return nil
+}
@@ -0,0 +1,369 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	authDomain "github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/organization/domain"
+	"github.com/nassabiq/golang-template/internal/modules/organization/dto"
+	"github.com/nassabiq/golang-template/internal/shared/tenant"
+)
+
+type mockOrganizationRepository struct {
+	organizations map[string]*domain.Organization
+	members       map[string]*domain.Member
+	users         map[string]bool
+	roles         map[string]bool
+	invitations   map[string]*domain.MemberInvitation
+}
+
+func newMockOrganizationRepository() *mockOrganizationRepository {
+	return &mockOrganizationRepository{
+		organizations: map[string]*domain.Organization{
+			"org-acme":   {ID: "org-acme", Name: "Acme", Slug: "acme"},
+			"org-globex": {ID: "org-globex", Name: "Globex", Slug: "globex"},
+		},
+		members: map[string]*domain.Member{
+			"org-acme/user-alice": {OrganizationID: "org-acme", UserID: "user-alice", RoleID: string(authDomain.RoleIDAdmin)},
+			"org-globex/user-bob": {OrganizationID: "org-globex", UserID: "user-bob", RoleID: string(authDomain.RoleIDAdmin)},
+		},
+		users:       map[string]bool{"user-alice": true, "user-bob": true, "user-carol": true},
+		roles:       map[string]bool{string(authDomain.RoleIDAdmin): true, string(authDomain.RoleIDUser): true},
+		invitations: map[string]*domain.MemberInvitation{},
+	}
+}
+
+func (m *mockOrganizationRepository) ListForUser(ctx context.Context, userID string) ([]domain.Organization, error) {
+	var organizations []domain.Organization
+	for _, member := range m.members {
+		if member.UserID == userID {
+			organizations = append(organizations, *m.organizations[member.OrganizationID])
+		}
+	}
+	return organizations, nil
+}
+
+func (m *mockOrganizationRepository) FindByID(ctx context.Context, id string) (*domain.Organization, error) {
+	organization, ok := m.organizations[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return organization, nil
+}
+
+func (m *mockOrganizationRepository) FindBySlug(ctx context.Context, slug string) (*domain.Organization, error) {
+	for _, organization := range m.organizations {
+		if organization.Slug == slug {
+			return organization, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (m *mockOrganizationRepository) Create(ctx context.Context, request *domain.OrganizationCreate) (*domain.Organization, error) {
+	organization := &domain.Organization{ID: request.ID, Name: request.Name, Slug: request.Slug}
+	m.organizations[organization.ID] = organization
+	m.members[request.ID+"/"+request.OwnerID] = &domain.Member{OrganizationID: request.ID, UserID: request.OwnerID, RoleID: request.OwnerRoleID}
+	return organization, nil
+}
+
+func (m *mockOrganizationRepository) Update(ctx context.Context, request *domain.OrganizationUpdate) (*domain.Organization, error) {
+	organization, ok := m.organizations[request.ID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	if request.Name != nil {
+		organization.Name = *request.Name
+	}
+	if request.Slug != nil {
+		organization.Slug = *request.Slug
+	}
+	return organization, nil
+}
+
+func (m *mockOrganizationRepository) Delete(ctx context.Context, organization *domain.Organization) error {
+	delete(m.organizations, organization.ID)
+	return nil
+}
+
+func (m *mockOrganizationRepository) ListMembers(ctx context.Context, organizationID string) ([]domain.Member, error) {
+	var members []domain.Member
+	for _, member := range m.members {
+		if member.OrganizationID == organizationID {
+			members = append(members, *member)
+		}
+	}
+	return members, nil
+}
+
+func (m *mockOrganizationRepository) FindMember(ctx context.Context, organizationID, userID string) (*domain.Member, error) {
+	member, ok := m.members[organizationID+"/"+userID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	copied := *member
+	return &copied, nil
+}
+
+func (m *mockOrganizationRepository) UpdateMember(ctx context.Context, member *domain.Member) error {
+	existing, ok := m.members[member.OrganizationID+"/"+member.UserID]
+	if !ok {
+		return sql.ErrNoRows
+	}
+	existing.RoleID = member.RoleID
+	return nil
+}
+
+func (m *mockOrganizationRepository) RemoveMember(ctx context.Context, member *domain.Member) error {
+	delete(m.members, member.OrganizationID+"/"+member.UserID)
+	return nil
+}
+
+func (m *mockOrganizationRepository) SaveInvitation(ctx context.Context, invitation *domain.MemberInvitation) error {
+	m.invitations[invitation.OrganizationID+"/"+invitation.UserID] = invitation
+	return nil
+}
+
+func (m *mockOrganizationRepository) ListInvitations(ctx context.Context, userID string) ([]domain.MemberInvitation, error) {
+	var invitations []domain.MemberInvitation
+	for _, invitation := range m.invitations {
+		if invitation.UserID == userID {
+			invitations = append(invitations, *invitation)
+		}
+	}
+	return invitations, nil
+}
+
+func (m *mockOrganizationRepository) FindInvitation(ctx context.Context, organizationID, userID string) (*domain.MemberInvitation, error) {
+	invitation, ok := m.invitations[organizationID+"/"+userID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	copied := *invitation
+	return &copied, nil
+}
+
+func (m *mockOrganizationRepository) AcceptInvitation(ctx context.Context, invitation *domain.MemberInvitation) error {
+	key := invitation.OrganizationID + "/" + invitation.UserID
+	if _, ok := m.invitations[key]; !ok {
+		return sql.ErrNoRows
+	}
+	delete(m.invitations, key)
+	m.members[key] = &domain.Member{OrganizationID: invitation.OrganizationID, UserID: invitation.UserID, RoleID: invitation.RoleID}
+	return nil
+}
+
+func (m *mockOrganizationRepository) DeleteInvitation(ctx context.Context, invitation *domain.MemberInvitation) error {
+	delete(m.invitations, invitation.OrganizationID+"/"+invitation.UserID)
+	return nil
+}
+
+func (m *mockOrganizationRepository) UserExists(ctx context.Context, userID string) (bool, error) {
+	return m.users[userID], nil
+}
+
+func (m *mockOrganizationRepository) RoleExists(ctx context.Context, roleID string) (bool, error) {
+	return m.roles[roleID], nil
+}
+
+func (m *mockOrganizationRepository) RolePermissions(ctx context.Context, roleID string) ([]string, error) {
+	return nil, nil
+}
+
+func TestOrganizationUsecase_Create(t *testing.T) {
+	repo := newMockOrganizationRepository()
+	usecase := NewOrganizationUsecase(repo)
+	ctx := context.Background()
+
+	organization, err := usecase.Create(ctx, "user-carol", &dto.CreateOrganizationDto{Name: "Initech", Slug: "initech"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	member, err := repo.FindMember(ctx, organization.ID, "user-carol")
+	if err != nil {
+		t.Fatalf("creator is not a member: %v", err)
+	}
+	if member.RoleID != string(authDomain.RoleIDAdmin) {
+		t.Errorf("creator role = %s, want admin", member.RoleID)
+	}
+
+	if _, err := usecase.Create(ctx, "user-carol", &dto.CreateOrganizationDto{Name: "Acme 2", Slug: "acme"}); !errors.Is(err, domain.ErrSlugAlreadyExists) {
+		t.Errorf("Create() duplicate slug error = %v, want %v", err, domain.ErrSlugAlreadyExists)
+	}
+}
+
+func TestOrganizationUsecase_TenantIsolation(t *testing.T) {
+	usecase := NewOrganizationUsecase(newMockOrganizationRepository())
+	acme := tenant.WithOrganization(context.Background(), "org-acme")
+	name := "Renamed"
+
+	if _, err := usecase.GetByID(acme, "user-alice", "org-globex"); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("GetByID() other organization error = %v, want %v", err, sql.ErrNoRows)
+	}
+
+	if _, err := usecase.ListMembers(acme, "org-globex"); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("ListMembers() other organization error = %v, want %v", err, sql.ErrNoRows)
+	}
+
+	if _, err := usecase.Update(acme, &dto.UpdateOrganizationDto{ID: "org-globex", Name: &name}); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("Update() other organization error = %v, want %v", err, sql.ErrNoRows)
+	}
+
+	if err := usecase.Delete(context.Background(), "org-acme"); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("Delete() outside any organization error = %v, want %v", err, sql.ErrNoRows)
+	}
+
+	members, err := usecase.ListMembers(acme, "org-acme")
+	if err != nil {
+		t.Fatalf("ListMembers() error = %v", err)
+	}
+	if len(members) != 1 || members[0].UserID != "user-alice" {
+		t.Errorf("ListMembers() = %+v, want only user-alice", members)
+	}
+}
+
+func TestOrganizationUsecase_InviteMember(t *testing.T) {
+	acme := tenant.WithOrganization(context.Background(), "org-acme")
+	userRole := string(authDomain.RoleIDUser)
+
+	tests := []struct {
+		name    string
+		request *dto.MemberDto
+		wantErr error
+	}{
+		{
+			name:    "invites user",
+			request: &dto.MemberDto{OrganizationID: "org-acme", UserID: "user-carol", RoleID: userRole},
+		},
+		{
+			name:    "already a member",
+			request: &dto.MemberDto{OrganizationID: "org-acme", UserID: "user-alice", RoleID: userRole},
+			wantErr: domain.ErrAlreadyMember,
+		},
+		{
+			name:    "unknown user",
+			request: &dto.MemberDto{OrganizationID: "org-acme", UserID: "user-missing", RoleID: userRole},
+			wantErr: domain.ErrUnknownUser,
+		},
+		{
+			name:    "unknown role",
+			request: &dto.MemberDto{OrganizationID: "org-acme", UserID: "user-carol", RoleID: "role-missing"},
+			wantErr: domain.ErrUnknownRole,
+		},
+		{
+			name:    "not the current organization",
+			request: &dto.MemberDto{OrganizationID: "org-globex", UserID: "user-carol", RoleID: userRole},
+			wantErr: sql.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockOrganizationRepository()
+			usecase := NewOrganizationUsecase(repo)
+
+			invitation, err := usecase.InviteMember(acme, "user-alice", tt.request)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("InviteMember() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if invitation.RoleID != tt.request.RoleID || invitation.InvitedBy != "user-alice" {
+				t.Errorf("InviteMember() = %+v, want role %s invited by user-alice", invitation, tt.request.RoleID)
+			}
+			if _, err := repo.FindMember(acme, tt.request.OrganizationID, tt.request.UserID); !errors.Is(err, sql.ErrNoRows) {
+				t.Errorf("invited user is a member before accepting: %v", err)
+			}
+		})
+	}
+}
+
+func TestOrganizationUsecase_AnswerInvitation(t *testing.T) {
+	acme := tenant.WithOrganization(context.Background(), "org-acme")
+	userRole := string(authDomain.RoleIDUser)
+	request := &dto.MemberDto{OrganizationID: "org-acme", UserID: "user-carol", RoleID: userRole}
+
+	t.Run("accept", func(t *testing.T) {
+		repo := newMockOrganizationRepository()
+		usecase := NewOrganizationUsecase(repo)
+
+		if _, err := usecase.InviteMember(acme, "user-alice", request); err != nil {
+			t.Fatalf("InviteMember() error = %v", err)
+		}
+
+		if _, err := usecase.AcceptInvitation(context.Background(), "org-acme", "user-bob"); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("AcceptInvitation() by someone else error = %v, want %v", err, sql.ErrNoRows)
+		}
+
+		member, err := usecase.AcceptInvitation(context.Background(), "org-acme", "user-carol")
+		if err != nil {
+			t.Fatalf("AcceptInvitation() error = %v", err)
+		}
+		if member.RoleID != userRole {
+			t.Errorf("AcceptInvitation() role = %s, want %s", member.RoleID, userRole)
+		}
+
+		if _, err := usecase.AcceptInvitation(context.Background(), "org-acme", "user-carol"); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("AcceptInvitation() twice error = %v, want %v", err, sql.ErrNoRows)
+		}
+	})
+
+	t.Run("decline", func(t *testing.T) {
+		repo := newMockOrganizationRepository()
+		usecase := NewOrganizationUsecase(repo)
+
+		if _, err := usecase.InviteMember(acme, "user-alice", request); err != nil {
+			t.Fatalf("InviteMember() error = %v", err)
+		}
+
+		if err := usecase.DeclineInvitation(context.Background(), "org-acme", "user-carol"); err != nil {
+			t.Fatalf("DeclineInvitation() error = %v", err)
+		}
+
+		if _, err := repo.FindMember(acme, "org-acme", "user-carol"); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("declined user is a member: %v", err)
+		}
+
+		invitations, err := usecase.ListInvitations(context.Background(), "user-carol")
+		if err != nil || len(invitations) != 0 {
+			t.Errorf("ListInvitations() = %v, %v, want none", invitations, err)
+		}
+	})
+}
+
+type mockTokenRevoker struct {
+	revoked map[string]time.Time
+}
+
+func (m *mockTokenRevoker) RevokeOrganizationAccessTokens(ctx context.Context, userID, organizationID string, before time.Time) error {
+	m.revoked[organizationID+"/"+userID] = before
+	return nil
+}
+
+func TestOrganizationUsecase_RemoveMember(t *testing.T) {
+	acme := tenant.WithOrganization(context.Background(), "org-acme")
+	repo := newMockOrganizationRepository()
+	revoker := &mockTokenRevoker{revoked: make(map[string]time.Time)}
+	usecase := NewOrganizationUsecase(repo)
+	usecase.SetTokenRevoker(revoker)
+
+	if err := usecase.RemoveMember(acme, "org-globex", "user-bob"); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("RemoveMember() other organization error = %v, want %v", err, sql.ErrNoRows)
+	}
+
+	if err := usecase.RemoveMember(acme, "org-acme", "user-alice"); err != nil {
+		t.Fatalf("RemoveMember() error = %v", err)
+	}
+	if _, err := repo.FindMember(acme, "org-acme", "user-alice"); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("removed user is still a member: %v", err)
+	}
+	if _, ok := revoker.revoked["org-acme/user-alice"]; !ok || len(revoker.revoked) != 1 {
+		t.Errorf("RemoveMember() revoked %v, want only org-acme/user-alice", revoker.revoked)
+	}
+}
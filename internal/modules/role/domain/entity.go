@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+type Role struct {
+	ID          string
+	Name        string
+	Permissions []string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type RoleCreate struct {
+	ID          string
+	Name        string
+	Permissions []string
+}
+
+type RoleUpdate struct {
+	ID   string
+	Name *string
+}
+
+type Permission struct {
+	ID          string
+	Name        string
+	Description string
+}
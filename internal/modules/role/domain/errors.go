@@ -0,0 +1,10 @@
+package domain
+
+import "errors"
+
+var (
+	ErrRoleAlreadyExists = errors.New("role already exists")
+	ErrRoleInUse         = errors.New("role is still assigned to users")
+	ErrBuiltInRole       = errors.New("built-in roles cannot be deleted")
+	ErrUnknownPermission = errors.New("unknown permission")
+)
@@ -0,0 +1,17 @@
+package domain
+
+import "context"
+
+type RoleRepository interface {
+	List(ctx context.Context) ([]Role, error)
+	FindByID(ctx context.Context, id string) (*Role, error)
+	FindByName(ctx context.Context, name string) (*Role, error)
+	Create(ctx context.Context, request *RoleCreate) (*Role, error)
+	Update(ctx context.Context, request *RoleUpdate) (*Role, error)
+	Delete(ctx context.Context, role *Role) error
+	CountUsers(ctx context.Context, roleID string) (int64, error)
+
+	ListPermissions(ctx context.Context) ([]Permission, error)
+	GrantPermission(ctx context.Context, roleID string, permission string) error
+	RevokePermission(ctx context.Context, roleID string, permission string) error
+}
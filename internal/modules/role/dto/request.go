@@ -0,0 +1,16 @@
+package dto
+
+type CreateRoleDto struct {
+	Name        string   `validate:"required,min=3,max=50"`
+	Permissions []string `validate:"dive,required"`
+}
+
+type UpdateRoleDto struct {
+	ID   string  `validate:"required"`
+	Name *string `validate:"omitempty,min=3,max=50"`
+}
+
+type RolePermissionDto struct {
+	RoleID     string `validate:"required"`
+	Permission string `validate:"required"`
+}
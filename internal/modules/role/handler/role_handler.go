@@ -0,0 +1,250 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/nassabiq/golang-template/internal/modules/role/domain"
+	"github.com/nassabiq/golang-template/internal/modules/role/dto"
+	"github.com/nassabiq/golang-template/internal/modules/role/usecase"
+	"github.com/nassabiq/golang-template/internal/shared/common/response"
+	"github.com/nassabiq/golang-template/internal/shared/helper"
+	middleware "github.com/nassabiq/golang-template/internal/shared/middleware/auth"
+	commonpb "github.com/nassabiq/golang-template/proto/common"
+	proto "github.com/nassabiq/golang-template/proto/role"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type RoleHandler struct {
+	proto.UnimplementedRoleServiceServer
+	usecase *usecase.RoleUsecase
+}
+
+func NewRoleHandler(usecase *usecase.RoleUsecase) *RoleHandler {
+	return &RoleHandler{
+		usecase: usecase,
+	}
+}
+
+func (handler *RoleHandler) List(ctx context.Context, _ *proto.ListRoleRequest) (*proto.ListRoleResponse, error) {
+	roles, err := handler.usecase.List(ctx)
+	if err != nil {
+		return &proto.ListRoleResponse{
+			Metadata: response.Internal(),
+			Roles:    []*proto.Role{},
+		}, nil
+	}
+
+	resp := &proto.ListRoleResponse{
+		Metadata: response.Success(200, "success"),
+	}
+
+	for i := range roles {
+		resp.Roles = append(resp.Roles, toProtoRole(&roles[i]))
+	}
+
+	return resp, nil
+}
+
+func (handler *RoleHandler) GetByID(ctx context.Context, req *proto.GetByIDRequest) (*proto.RoleResponse, error) {
+	role, err := handler.usecase.GetByID(ctx, req.GetId())
+	if err != nil {
+		return &proto.RoleResponse{
+			Metadata: roleError(err),
+		}, nil
+	}
+
+	return &proto.RoleResponse{
+		Metadata: response.Success(200, "success"),
+		Data:     toProtoRole(role),
+	}, nil
+}
+
+func (handler *RoleHandler) Create(ctx context.Context, req *proto.CreateRoleRequest) (*proto.RoleResponse, error) {
+	request := &dto.CreateRoleDto{
+		Name:        req.GetName(),
+		Permissions: req.GetPermissions(),
+	}
+
+	if err := helper.Validate.Struct(request); err != nil {
+		return &proto.RoleResponse{
+			Metadata: response.Validation(err.Error()),
+		}, nil
+	}
+
+	for _, permission := range request.Permissions {
+		if !middleware.HasPermission(ctx, permission) {
+			return &proto.RoleResponse{
+				Metadata: response.Forbidden("cannot grant a permission you do not hold"),
+			}, nil
+		}
+	}
+
+	role, err := handler.usecase.Create(ctx, request)
+	if err != nil {
+		return &proto.RoleResponse{
+			Metadata: roleError(err),
+		}, nil
+	}
+
+	return &proto.RoleResponse{
+		Metadata: response.Created(),
+		Data:     toProtoRole(role),
+	}, nil
+}
+
+func (handler *RoleHandler) Update(ctx context.Context, req *proto.UpdateRoleRequest) (*proto.RoleResponse, error) {
+	request := &dto.UpdateRoleDto{
+		ID:   req.GetId(),
+		Name: req.Name,
+	}
+
+	if err := helper.Validate.Struct(request); err != nil {
+		return &proto.RoleResponse{
+			Metadata: response.Validation(err.Error()),
+		}, nil
+	}
+
+	role, err := handler.usecase.Update(ctx, request)
+	if err != nil {
+		return &proto.RoleResponse{
+			Metadata: roleError(err),
+		}, nil
+	}
+
+	return &proto.RoleResponse{
+		Metadata: response.Success(200, "success"),
+		Data:     toProtoRole(role),
+	}, nil
+}
+
+func (handler *RoleHandler) Delete(ctx context.Context, req *proto.DeleteRoleRequest) (*proto.DeleteRoleResponse, error) {
+	role, err := handler.usecase.GetByID(ctx, req.GetId())
+	if err != nil {
+		return &proto.DeleteRoleResponse{
+			Metadata: roleError(err),
+		}, nil
+	}
+
+	if err := handler.usecase.Delete(ctx, role); err != nil {
+		return &proto.DeleteRoleResponse{
+			Metadata: roleError(err),
+		}, nil
+	}
+
+	return &proto.DeleteRoleResponse{
+		Metadata: response.Deleted(),
+	}, nil
+}
+
+func (handler *RoleHandler) ListPermissions(ctx context.Context, _ *proto.Empty) (*proto.ListPermissionResponse, error) {
+	permissions, err := handler.usecase.ListPermissions(ctx)
+	if err != nil {
+		return &proto.ListPermissionResponse{
+			Metadata:    response.Internal(),
+			Permissions: []*proto.Permission{},
+		}, nil
+	}
+
+	resp := &proto.ListPermissionResponse{
+		Metadata: response.Success(200, "success"),
+	}
+
+	for _, p := range permissions {
+		resp.Permissions = append(resp.Permissions, &proto.Permission{
+			Id:          p.ID,
+			Name:        p.Name,
+			Description: p.Description,
+		})
+	}
+
+	return resp, nil
+}
+
+func (handler *RoleHandler) GrantPermission(ctx context.Context, req *proto.GrantPermissionRequest) (*proto.RoleResponse, error) {
+	request := &dto.RolePermissionDto{
+		RoleID:     req.GetRoleId(),
+		Permission: req.GetPermission(),
+	}
+
+	if err := helper.Validate.Struct(request); err != nil {
+		return &proto.RoleResponse{
+			Metadata: response.Validation(err.Error()),
+		}, nil
+	}
+
+	// Managing roles must not become a way to escalate one's own access
+	if !middleware.HasPermission(ctx, request.Permission) {
+		return &proto.RoleResponse{
+			Metadata: response.Forbidden("cannot grant a permission you do not hold"),
+		}, nil
+	}
+
+	role, err := handler.usecase.GrantPermission(ctx, request)
+	if err != nil {
+		return &proto.RoleResponse{
+			Metadata: roleError(err),
+		}, nil
+	}
+
+	return &proto.RoleResponse{
+		Metadata: response.Success(200, "success"),
+		Data:     toProtoRole(role),
+	}, nil
+}
+
+func (handler *RoleHandler) RevokePermission(ctx context.Context, req *proto.RevokePermissionRequest) (*proto.RoleResponse, error) {
+	request := &dto.RolePermissionDto{
+		RoleID:     req.GetRoleId(),
+		Permission: req.GetPermission(),
+	}
+
+	if err := helper.Validate.Struct(request); err != nil {
+		return &proto.RoleResponse{
+			Metadata: response.Validation(err.Error()),
+		}, nil
+	}
+
+	role, err := handler.usecase.RevokePermission(ctx, request)
+	if err != nil {
+		return &proto.RoleResponse{
+			Metadata: roleError(err),
+		}, nil
+	}
+
+	return &proto.RoleResponse{
+		Metadata: response.Success(200, "success"),
+		Data:     toProtoRole(role),
+	}, nil
+}
+
+func roleError(err error) *commonpb.MetaData {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return response.NotFound("role not found")
+	case errors.Is(err, domain.ErrRoleAlreadyExists), errors.Is(err, domain.ErrRoleInUse), errors.Is(err, domain.ErrBuiltInRole):
+		return response.Conflict(err.Error())
+	case errors.Is(err, domain.ErrUnknownPermission):
+		return response.BadRequest(err.Error())
+	default:
+		return response.Internal()
+	}
+}
+
+func toProtoRole(role *domain.Role) *proto.Role {
+	data := &proto.Role{
+		Id:          role.ID,
+		Name:        role.Name,
+		Permissions: role.Permissions,
+	}
+
+	if !role.CreatedAt.IsZero() {
+		data.CreatedAt = timestamppb.New(role.CreatedAt)
+	}
+	if !role.UpdatedAt.IsZero() {
+		data.UpdatedAt = timestamppb.New(role.UpdatedAt)
+	}
+
+	return data
+}
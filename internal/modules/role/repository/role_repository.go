@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/nassabiq/golang-template/internal/modules/role/domain"
+)
+
+// roleColumns selects a role with its granted permission names, sorted
+const roleColumns = `r.id, r.name,
+	COALESCE(array_agg(p.name ORDER BY p.name) FILTER (WHERE p.name IS NOT NULL), '{}'),
+	r.created_at, r.updated_at
+	FROM roles r
+	LEFT JOIN role_permissions rp ON rp.role_id = r.id
+	LEFT JOIN permissions p ON p.id = rp.permission_id`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+type RoleRepository struct {
+	db *sql.DB
+}
+
+func NewRoleRepository(db *sql.DB) *RoleRepository {
+	return &RoleRepository{db: db}
+}
+
+func (r *RoleRepository) List(ctx context.Context) ([]domain.Role, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+roleColumns+" GROUP BY r.id ORDER BY r.name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var roles []domain.Role
+	for rows.Next() {
+		role, err := scanRole(rows)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, *role)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func (r *RoleRepository) FindByID(ctx context.Context, id string) (*domain.Role, error) {
+	return scanRole(r.db.QueryRowContext(ctx, "SELECT "+roleColumns+" WHERE r.id = $1 GROUP BY r.id", id))
+}
+
+func (r *RoleRepository) FindByName(ctx context.Context, name string) (*domain.Role, error) {
+	return scanRole(r.db.QueryRowContext(ctx, "SELECT "+roleColumns+" WHERE r.name = $1 GROUP BY r.id", name))
+}
+
+func (r *RoleRepository) Create(ctx context.Context, request *domain.RoleCreate) (*domain.Role, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx,
+		"INSERT INTO roles (id, name, created_at, updated_at) VALUES ($1, $2, NOW(), NOW())",
+		request.ID, request.Name,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, permission := range request.Permissions {
+		if err := grantPermission(ctx, tx, request.ID, permission); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &domain.Role{
+		ID:          request.ID,
+		Name:        request.Name,
+		Permissions: request.Permissions,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}, nil
+}
+
+func (r *RoleRepository) Update(ctx context.Context, request *domain.RoleUpdate) (*domain.Role, error) {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE roles SET name = COALESCE($1, name), updated_at = NOW() WHERE id = $2",
+		request.Name, request.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return r.FindByID(ctx, request.ID)
+}
+
+func (r *RoleRepository) Delete(ctx context.Context, role *domain.Role) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM roles WHERE id = $1", role.ID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// CountUsers counts the users holding roleID, on their account or as a member
+// of an organization
+func (r *RoleRepository) CountUsers(ctx context.Context, roleID string) (int64, error) {
+	var total int64
+	err := r.db.QueryRowContext(ctx,
+		"SELECT (SELECT COUNT(*) FROM users WHERE role_id = $1) + (SELECT COUNT(*) FROM organization_members WHERE role_id = $1)",
+		roleID,
+	).Scan(&total)
+	return total, err
+}
+
+func (r *RoleRepository) ListPermissions(ctx context.Context) ([]domain.Permission, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT id, name, description FROM permissions ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var permissions []domain.Permission
+	for rows.Next() {
+		var permission domain.Permission
+		if err := rows.Scan(&permission.ID, &permission.Name, &permission.Description); err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, permission)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
+func (r *RoleRepository) GrantPermission(ctx context.Context, roleID string, permission string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := grantPermission(ctx, tx, roleID, permission); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE roles SET updated_at = NOW() WHERE id = $1", roleID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *RoleRepository) RevokePermission(ctx context.Context, roleID string, permission string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM role_permissions
+		WHERE role_id = $1 AND permission_id = (SELECT id FROM permissions WHERE name = $2)`,
+		roleID, permission,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE roles SET updated_at = NOW() WHERE id = $1", roleID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// grantPermission is a no-op when the role already holds permission
+func grantPermission(ctx context.Context, tx *sql.Tx, roleID, permission string) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO role_permissions (role_id, permission_id, created_at)
+		SELECT $1, id, NOW() FROM permissions WHERE name = $2
+		ON CONFLICT DO NOTHING`,
+		roleID, permission,
+	)
+	return err
+}
+
+func scanRole(row rowScanner) (*domain.Role, error) {
+	var role domain.Role
+	var createdAt, updatedAt sql.NullTime
+
+	err := row.Scan(&role.ID, &role.Name, pq.Array(&role.Permissions), &createdAt, &updatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, err
+	}
+
+	// Seeded roles were inserted without timestamps
+	role.CreatedAt = createdAt.Time
+	role.UpdatedAt = updatedAt.Time
+
+	return &role, nil
+}
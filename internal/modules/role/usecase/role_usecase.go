@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	authDomain "github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/role/domain"
+	"github.com/nassabiq/golang-template/internal/modules/role/dto"
+)
+
+// PermissionCache holds the role → permission map the auth middleware checks
+// against. It is reloaded after every change so edits apply right away.
+type PermissionCache interface {
+	Reload(ctx context.Context) error
+}
+
+type RoleUsecase struct {
+	repository domain.RoleRepository
+	cache      PermissionCache
+}
+
+func NewRoleUsecase(repository domain.RoleRepository) *RoleUsecase {
+	return &RoleUsecase{
+		repository: repository,
+	}
+}
+
+func (usecase *RoleUsecase) SetPermissionCache(cache PermissionCache) {
+	usecase.cache = cache
+}
+
+func (usecase *RoleUsecase) List(ctx context.Context) ([]domain.Role, error) {
+	return usecase.repository.List(ctx)
+}
+
+func (usecase *RoleUsecase) GetByID(ctx context.Context, id string) (*domain.Role, error) {
+	return usecase.repository.FindByID(ctx, id)
+}
+
+func (usecase *RoleUsecase) ListPermissions(ctx context.Context) ([]domain.Permission, error) {
+	return usecase.repository.ListPermissions(ctx)
+}
+
+func (usecase *RoleUsecase) Create(ctx context.Context, request *dto.CreateRoleDto) (*domain.Role, error) {
+	if err := usecase.ensureNameAvailable(ctx, request.Name, ""); err != nil {
+		return nil, err
+	}
+
+	if err := usecase.ensurePermissionsExist(ctx, request.Permissions...); err != nil {
+		return nil, err
+	}
+
+	role, err := usecase.repository.Create(ctx, &domain.RoleCreate{
+		ID:          uuid.New().String(),
+		Name:        request.Name,
+		Permissions: request.Permissions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	usecase.reloadCache(ctx)
+	return role, nil
+}
+
+func (usecase *RoleUsecase) Update(ctx context.Context, request *dto.UpdateRoleDto) (*domain.Role, error) {
+	if request.Name != nil {
+		if err := usecase.ensureNameAvailable(ctx, *request.Name, request.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return usecase.repository.Update(ctx, &domain.RoleUpdate{
+		ID:   request.ID,
+		Name: request.Name,
+	})
+}
+
+func (usecase *RoleUsecase) Delete(ctx context.Context, role *domain.Role) error {
+	// Registration and the first admin depend on the seeded roles
+	if _, ok := authDomain.RoleIDToName[authDomain.RoleID(role.ID)]; ok {
+		return domain.ErrBuiltInRole
+	}
+
+	users, err := usecase.repository.CountUsers(ctx, role.ID)
+	if err != nil {
+		return err
+	}
+
+	if users > 0 {
+		return domain.ErrRoleInUse
+	}
+
+	if err := usecase.repository.Delete(ctx, role); err != nil {
+		return err
+	}
+
+	usecase.reloadCache(ctx)
+	return nil
+}
+
+func (usecase *RoleUsecase) GrantPermission(ctx context.Context, request *dto.RolePermissionDto) (*domain.Role, error) {
+	if _, err := usecase.repository.FindByID(ctx, request.RoleID); err != nil {
+		return nil, err
+	}
+
+	if err := usecase.ensurePermissionsExist(ctx, request.Permission); err != nil {
+		return nil, err
+	}
+
+	if err := usecase.repository.GrantPermission(ctx, request.RoleID, request.Permission); err != nil {
+		return nil, err
+	}
+
+	usecase.reloadCache(ctx)
+	return usecase.repository.FindByID(ctx, request.RoleID)
+}
+
+func (usecase *RoleUsecase) RevokePermission(ctx context.Context, request *dto.RolePermissionDto) (*domain.Role, error) {
+	if _, err := usecase.repository.FindByID(ctx, request.RoleID); err != nil {
+		return nil, err
+	}
+
+	if err := usecase.repository.RevokePermission(ctx, request.RoleID, request.Permission); err != nil {
+		return nil, err
+	}
+
+	usecase.reloadCache(ctx)
+	return usecase.repository.FindByID(ctx, request.RoleID)
+}
+
+func (usecase *RoleUsecase) ensureNameAvailable(ctx context.Context, name, roleID string) error {
+	existing, err := usecase.repository.FindByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	if existing.ID != roleID {
+		return domain.ErrRoleAlreadyExists
+	}
+
+	return nil
+}
+
+func (usecase *RoleUsecase) ensurePermissionsExist(ctx context.Context, names ...string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	permissions, err := usecase.repository.ListPermissions(ctx)
+	if err != nil {
+		return err
+	}
+
+	known := make(map[string]bool, len(permissions))
+	for _, permission := range permissions {
+		known[permission.Name] = true
+	}
+
+	for _, name := range names {
+		if !known[name] {
+			return domain.ErrUnknownPermission
+		}
+	}
+
+	return nil
+}
+
+// reloadCache is best effort: the cache also refreshes on its own interval
+func (usecase *RoleUsecase) reloadCache(ctx context.Context) {
+	if usecase.cache == nil {
+		return
+	}
+
+	_ = usecase.cache.Reload(ctx)
+}
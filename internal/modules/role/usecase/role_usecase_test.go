@@ -0,0 +1,223 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"testing"
+
+	authDomain "github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/role/domain"
+	"github.com/nassabiq/golang-template/internal/modules/role/dto"
+)
+
+type mockRoleRepository struct {
+	roles       map[string]*domain.Role
+	permissions []domain.Permission
+	users       map[string]int64
+}
+
+func newMockRoleRepository() *mockRoleRepository {
+	return &mockRoleRepository{
+		roles: map[string]*domain.Role{
+			string(authDomain.RoleIDAdmin): {ID: string(authDomain.RoleIDAdmin), Name: "admin"},
+			"role-support":                 {ID: "role-support", Name: "support"},
+		},
+		permissions: []domain.Permission{
+			{ID: "perm-1", Name: authDomain.PermissionUsersRead},
+			{ID: "perm-2", Name: authDomain.PermissionUsersUnlock},
+		},
+		users: make(map[string]int64),
+	}
+}
+
+func (m *mockRoleRepository) List(ctx context.Context) ([]domain.Role, error) {
+	var roles []domain.Role
+	for _, role := range m.roles {
+		roles = append(roles, *role)
+	}
+	return roles, nil
+}
+
+func (m *mockRoleRepository) FindByID(ctx context.Context, id string) (*domain.Role, error) {
+	role, ok := m.roles[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	copied := *role
+	return &copied, nil
+}
+
+func (m *mockRoleRepository) FindByName(ctx context.Context, name string) (*domain.Role, error) {
+	for _, role := range m.roles {
+		if role.Name == name {
+			return role, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (m *mockRoleRepository) Create(ctx context.Context, request *domain.RoleCreate) (*domain.Role, error) {
+	role := &domain.Role{ID: request.ID, Name: request.Name, Permissions: request.Permissions}
+	m.roles[role.ID] = role
+	return role, nil
+}
+
+func (m *mockRoleRepository) Update(ctx context.Context, request *domain.RoleUpdate) (*domain.Role, error) {
+	role, ok := m.roles[request.ID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	if request.Name != nil {
+		role.Name = *request.Name
+	}
+	return role, nil
+}
+
+func (m *mockRoleRepository) Delete(ctx context.Context, role *domain.Role) error {
+	delete(m.roles, role.ID)
+	return nil
+}
+
+func (m *mockRoleRepository) CountUsers(ctx context.Context, roleID string) (int64, error) {
+	return m.users[roleID], nil
+}
+
+func (m *mockRoleRepository) ListPermissions(ctx context.Context) ([]domain.Permission, error) {
+	return m.permissions, nil
+}
+
+func (m *mockRoleRepository) GrantPermission(ctx context.Context, roleID string, permission string) error {
+	role := m.roles[roleID]
+	if !slices.Contains(role.Permissions, permission) {
+		role.Permissions = append(role.Permissions, permission)
+	}
+	return nil
+}
+
+func (m *mockRoleRepository) RevokePermission(ctx context.Context, roleID string, permission string) error {
+	role := m.roles[roleID]
+	role.Permissions = slices.DeleteFunc(role.Permissions, func(p string) bool { return p == permission })
+	return nil
+}
+
+type mockPermissionCache struct {
+	reloads int
+}
+
+func (m *mockPermissionCache) Reload(ctx context.Context) error {
+	m.reloads++
+	return nil
+}
+
+func TestRoleUsecase_Create(t *testing.T) {
+	tests := []struct {
+		name        string
+		request     *dto.CreateRoleDto
+		wantErr     error
+		wantReloads int
+	}{
+		{
+			name:        "creates role with permissions",
+			request:     &dto.CreateRoleDto{Name: "auditor", Permissions: []string{authDomain.PermissionUsersRead}},
+			wantReloads: 1,
+		},
+		{
+			name:    "duplicate name",
+			request: &dto.CreateRoleDto{Name: "support"},
+			wantErr: domain.ErrRoleAlreadyExists,
+		},
+		{
+			name:    "unknown permission",
+			request: &dto.CreateRoleDto{Name: "auditor", Permissions: []string{"users.everything"}},
+			wantErr: domain.ErrUnknownPermission,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := &mockPermissionCache{}
+			usecase := NewRoleUsecase(newMockRoleRepository())
+			usecase.SetPermissionCache(cache)
+
+			role, err := usecase.Create(context.Background(), tt.request)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Create() error = %v, want %v", err, tt.wantErr)
+			}
+			if cache.reloads != tt.wantReloads {
+				t.Errorf("cache reloads = %d, want %d", cache.reloads, tt.wantReloads)
+			}
+			if tt.wantErr == nil && !slices.Equal(role.Permissions, tt.request.Permissions) {
+				t.Errorf("Create() permissions = %v, want %v", role.Permissions, tt.request.Permissions)
+			}
+		})
+	}
+}
+
+func TestRoleUsecase_Delete(t *testing.T) {
+	tests := []struct {
+		name    string
+		roleID  string
+		users   int64
+		wantErr error
+	}{
+		{name: "deletes unused role", roleID: "role-support"},
+		{name: "role still assigned", roleID: "role-support", users: 2, wantErr: domain.ErrRoleInUse},
+		{name: "built-in role", roleID: string(authDomain.RoleIDAdmin), wantErr: domain.ErrBuiltInRole},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockRoleRepository()
+			repo.users[tt.roleID] = tt.users
+			usecase := NewRoleUsecase(repo)
+
+			role, _ := repo.FindByID(context.Background(), tt.roleID)
+			err := usecase.Delete(context.Background(), role)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Delete() error = %v, want %v", err, tt.wantErr)
+			}
+
+			_, stillThere := repo.roles[tt.roleID]
+			if stillThere != (tt.wantErr != nil) {
+				t.Errorf("role kept = %v, want %v", stillThere, tt.wantErr != nil)
+			}
+		})
+	}
+}
+
+func TestRoleUsecase_GrantAndRevokePermission(t *testing.T) {
+	cache := &mockPermissionCache{}
+	usecase := NewRoleUsecase(newMockRoleRepository())
+	usecase.SetPermissionCache(cache)
+	ctx := context.Background()
+
+	role, err := usecase.GrantPermission(ctx, &dto.RolePermissionDto{RoleID: "role-support", Permission: authDomain.PermissionUsersUnlock})
+	if err != nil {
+		t.Fatalf("GrantPermission() error = %v", err)
+	}
+	if !slices.Contains(role.Permissions, authDomain.PermissionUsersUnlock) {
+		t.Errorf("GrantPermission() permissions = %v", role.Permissions)
+	}
+
+	if _, err := usecase.GrantPermission(ctx, &dto.RolePermissionDto{RoleID: "role-support", Permission: "users.everything"}); !errors.Is(err, domain.ErrUnknownPermission) {
+		t.Errorf("GrantPermission() unknown permission error = %v", err)
+	}
+
+	if _, err := usecase.GrantPermission(ctx, &dto.RolePermissionDto{RoleID: "role-missing", Permission: authDomain.PermissionUsersRead}); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("GrantPermission() missing role error = %v", err)
+	}
+
+	role, err = usecase.RevokePermission(ctx, &dto.RolePermissionDto{RoleID: "role-support", Permission: authDomain.PermissionUsersUnlock})
+	if err != nil {
+		t.Fatalf("RevokePermission() error = %v", err)
+	}
+	if slices.Contains(role.Permissions, authDomain.PermissionUsersUnlock) {
+		t.Errorf("RevokePermission() permissions = %v", role.Permissions)
+	}
+
+	if cache.reloads != 2 {
+		t.Errorf("cache reloads = %d, want 2", cache.reloads)
+	}
+}
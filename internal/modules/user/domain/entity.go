@@ -3,12 +3,39 @@ package domain
 import "time"
 
 type User struct {
-	ID        string
-	Name      string
-	Email     string
-	RoleID    string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID     string
+	Name   string
+	Email  string
+	RoleID string
+	Status string
+	// StatusReason explains the last suspension, deactivation or reactivation
+	StatusReason string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// UserFilter narrows the user list. Empty fields match every user.
+type UserFilter struct {
+	// Search matches part of the name or email, ignoring case
+	Search string
+	// Role matches the role ID or name; inside an organization, the member's role there
+	Role   string
+	Status string
+	// IsActive keeps only active users when true, only the others when false
+	IsActive *bool
+}
+
+// Fields the user list can be sorted by
+const (
+	UserSortCreatedAt = "created_at"
+	UserSortUpdatedAt = "updated_at"
+	UserSortName      = "name"
+	UserSortEmail     = "email"
+)
+
+type UserSort struct {
+	Field      string
+	Descending bool
 }
 
 type UserCreate struct {
@@ -17,6 +44,8 @@ type UserCreate struct {
 	Email    string
 	Password string
 	RoleID   string
+	// Role of the new user in the organization they are created in, if any
+	MemberRoleID string
 }
 
 type UserUpdate struct {
@@ -25,3 +54,45 @@ type UserUpdate struct {
 	Email  *string
 	RoleID *string
 }
+
+// UserStatusChange moves a user from one status to another. The change only
+// applies while the user is still in From.
+type UserStatusChange struct {
+	// ID of the audit log entry recording the change
+	ID      string
+	UserID  string
+	ActorID string
+	From    string
+	To      string
+	Reason  string
+	// RevokeSessions ends every refresh token of the user
+	RevokeSessions bool
+	// IPAddress and UserAgent of the admin's client, for the audit trail
+	IPAddress string
+	UserAgent string
+	ChangedAt time.Time
+}
+
+// Invitation is a pending user who has not chosen a password yet
+type Invitation struct {
+	ID        string
+	UserID    string
+	Email     string
+	RoleID    string
+	InvitedBy string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type InvitationCreate struct {
+	ID     string
+	UserID string
+	Email  string
+	RoleID string
+	// Role of the invitee in the organization they are invited to, if any
+	MemberRoleID string
+	InvitedBy    string
+	TokenHash    string
+	ExpiresAt    time.Time
+}
@@ -1,11 +1,30 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/shared/pagination"
+)
 
 type UserRepository interface {
-	List(ctx context.Context, limit int, offset int) ([]User, int64, error)
+	// List returns a page of the users matching filter, with the cursors around
+	// it. It returns pagination.ErrInvalidCursor for a cursor of another sort.
+	List(ctx context.Context, filter UserFilter, sort UserSort, page pagination.Page) ([]User, *pagination.Result, error)
 	FindByID(ctx context.Context, id string) (*User, error)
 	Create(ctx context.Context, request *UserCreate) (*User, error)
 	Update(ctx context.Context, request *UserUpdate) (*User, error)
 	Delete(ctx context.Context, user *User) error
+	EmailExists(ctx context.Context, email string) (bool, error)
+	RolePermissions(ctx context.Context, roleID string) ([]string, error)
+	// UpdateStatus applies the change and records it in the audit log. It
+	// returns sql.ErrNoRows when the user is no longer in change.From.
+	UpdateStatus(ctx context.Context, change *UserStatusChange) error
+
+	CreateInvitation(ctx context.Context, request *InvitationCreate) (*Invitation, error)
+	ListInvitations(ctx context.Context) ([]Invitation, error)
+	FindInvitation(ctx context.Context, id string) (*Invitation, error)
+	RenewInvitation(ctx context.Context, id, tokenHash string, expiresAt time.Time) error
+	// DeleteInvitation removes the invitation together with its pending user
+	DeleteInvitation(ctx context.Context, invitation *Invitation) error
 }
@@ -1,15 +1,42 @@
 package dto
 
+import "github.com/nassabiq/golang-template/internal/shared/pagination"
+
 type CreateUserDto struct {
 	Name     string `validate:"required,min=3,max=100"`
 	Email    string `validate:"required,email"`
-	Password string `validate:"required,min=8,max=100"`
+	Password string `validate:"required"` // length and strength are checked by the password policy
 	RoleID   string `validate:"required"`
 }
 
+type ListUserDto struct {
+	Limit    int
+	Offset   int    `validate:"min=0"`
+	Search   string `validate:"max=100"`
+	Role     string `validate:"max=50"`
+	Status   string `validate:"omitempty,oneof=pending active suspended deactivated"`
+	IsActive *bool
+	// SortOrder defaults to asc for name and email, desc for the timestamps
+	SortBy    string `validate:"omitempty,oneof=created_at updated_at name email"`
+	SortOrder string `validate:"omitempty,oneof=asc desc"`
+	// Cursor continues from a previous page, Offset is ignored with it
+	Cursor       *pagination.Cursor
+	IncludeTotal bool
+}
+
 type UpdateUserDto struct {
 	ID     string  `validate:"required"`
 	Name   *string `validate:"omitempty,min=3,max=100"`
 	Email  *string `validate:"omitempty,email"`
 	RoleID *string `validate:"omitempty"`
 }
+
+type InviteUserDto struct {
+	Email  string `validate:"required,email"`
+	RoleID string `validate:"required"`
+}
+
+type ChangeUserStatusDto struct {
+	ID     string `validate:"required"`
+	Reason string `validate:"required,max=500"`
+}
@@ -0,0 +1,25 @@
+package event
+
+import (
+	"encoding/json"
+
+	"github.com/nassabiq/golang-template/internal/infrastructure/messaging"
+)
+
+type Publisher struct {
+	bus messaging.EventBus
+}
+
+func NewUserPublisher(bus messaging.EventBus) *Publisher {
+	return &Publisher{bus: bus}
+}
+
+func (publisher *Publisher) UserInvited(payload UserInvitedEvent) error {
+	data, err := json.Marshal(payload)
+
+	if err != nil {
+		return err
+	}
+
+	return publisher.bus.Publish(UserInvitedSubject, data)
+}
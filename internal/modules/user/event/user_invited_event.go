@@ -0,0 +1,13 @@
+package event
+
+import "time"
+
+const UserInvitedSubject = "user.invited"
+
+// UserInvitedEvent carries the token the invitee accepts the invitation with
+type UserInvitedEvent struct {
+	UserID    string    `json:"user_id"`
+	Email     string    `json:"email"`
+	Token     string    `json:"token"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
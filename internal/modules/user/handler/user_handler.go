@@ -4,24 +4,32 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
 
+	authDomain "github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/user/domain"
 	"github.com/nassabiq/golang-template/internal/modules/user/dto"
 	"github.com/nassabiq/golang-template/internal/modules/user/usecase"
+	"github.com/nassabiq/golang-template/internal/shared/common/metadata"
 	"github.com/nassabiq/golang-template/internal/shared/common/response"
 	"github.com/nassabiq/golang-template/internal/shared/helper"
 	middleware "github.com/nassabiq/golang-template/internal/shared/middleware/auth"
+	"github.com/nassabiq/golang-template/internal/shared/pagination"
 	commonpb "github.com/nassabiq/golang-template/proto/common"
 	proto "github.com/nassabiq/golang-template/proto/user"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type UserHandler struct {
 	proto.UnimplementedUserServiceServer
 	usecase usecase.UserUsecase
+	cursors *pagination.Codec
 }
 
-func NewUserHandler(usecase usecase.UserUsecase) *UserHandler {
+func NewUserHandler(usecase usecase.UserUsecase, cursors *pagination.Codec) *UserHandler {
 	return &UserHandler{
 		usecase: usecase,
+		cursors: cursors,
 	}
 }
 
@@ -47,22 +55,11 @@ func (handler *UserHandler) GetMe(ctx context.Context, _ *proto.Empty) (*proto.U
 
 	return &proto.UserResponse{
 		Metadata: response.Success(200, "success"),
-		Data: &proto.User{
-			Id:    user.ID,
-			Name:  user.Name,
-			Email: user.Email,
-			Role:  user.RoleID,
-		},
+		Data:     toProtoUser(user),
 	}, nil
 }
 
 func (handler *UserHandler) GetByID(ctx context.Context, req *proto.GetByIDRequest) (*proto.UserResponse, error) {
-	if err := middleware.RequireRole("admin", "super_admin")(ctx); err != nil {
-		return &proto.UserResponse{
-			Metadata: response.Forbidden(),
-		}, nil
-	}
-
 	user, err := handler.usecase.GetByID(ctx, req.GetId())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -78,24 +75,52 @@ func (handler *UserHandler) GetByID(ctx context.Context, req *proto.GetByIDReque
 
 	return &proto.UserResponse{
 		Metadata: response.Success(200, "success"),
-		Data: &proto.User{
-			Id:    user.ID,
-			Name:  user.Name,
-			Email: user.Email,
-			Role:  user.RoleID,
-		},
+		Data:     toProtoUser(user),
 	}, nil
 }
 
 func (handler *UserHandler) List(ctx context.Context, req *proto.ListUserRequest) (*proto.ListUserResponse, error) {
-	if err := middleware.RequireRole("admin", "super_admin")(ctx); err != nil {
+	cursor, err := handler.cursors.Decode(req.GetCursor())
+	if err != nil {
 		return &proto.ListUserResponse{
-			Metadata: response.Forbidden(),
+			Metadata: response.Validation(err.Error()),
 			Users:    []*proto.User{},
 		}, nil
 	}
 
-	users, total, err := handler.usecase.List(ctx, int(req.Limit), int(req.Offset))
+	filter := req.GetFilter()
+	request := &dto.ListUserDto{
+		Limit:        int(req.GetLimit()),
+		Offset:       int(req.GetOffset()),
+		Search:       filter.GetSearch(),
+		Role:         filter.GetRole(),
+		Status:       filter.GetStatus(),
+		SortBy:       req.GetSortBy(),
+		SortOrder:    strings.ToLower(req.GetSortOrder()),
+		Cursor:       cursor,
+		IncludeTotal: req.GetIncludeTotal(),
+	}
+
+	if filter != nil && filter.IsActive != nil {
+		isActive := filter.GetIsActive()
+		request.IsActive = &isActive
+	}
+
+	if err := helper.Validate.Struct(request); err != nil {
+		return &proto.ListUserResponse{
+			Metadata: response.Validation(err.Error()),
+			Users:    []*proto.User{},
+		}, nil
+	}
+
+	users, page, err := handler.usecase.List(ctx, request)
+
+	if errors.Is(err, pagination.ErrInvalidCursor) {
+		return &proto.ListUserResponse{
+			Metadata: response.Validation("cursor does not match the sort order"),
+			Users:    []*proto.User{},
+		}, nil
+	}
 
 	if err != nil {
 		return &proto.ListUserResponse{
@@ -112,31 +137,22 @@ func (handler *UserHandler) List(ctx context.Context, req *proto.ListUserRequest
 	resp := &proto.ListUserResponse{
 		Metadata: response.Success(200, "success"),
 		Pagination: &commonpb.Pagination{
-			Limit:  limit,
-			Offset: req.Offset,
-			Total:  total,
+			Limit:      limit,
+			Offset:     req.Offset,
+			Total:      page.Total,
+			NextCursor: handler.cursors.Encode(page.Next),
+			PrevCursor: handler.cursors.Encode(page.Prev),
 		},
 	}
 
 	for _, u := range users {
-		resp.Users = append(resp.Users, &proto.User{
-			Id:    u.ID,
-			Name:  u.Name,
-			Email: u.Email,
-			Role:  u.RoleID,
-		})
+		resp.Users = append(resp.Users, toProtoUser(&u))
 	}
 
 	return resp, nil
 }
 
 func (handler *UserHandler) Create(ctx context.Context, req *proto.CreateUserRequest) (*proto.UserResponse, error) {
-	if err := middleware.RequireRole("admin", "super_admin")(ctx); err != nil {
-		return &proto.UserResponse{
-			Metadata: response.Forbidden(),
-		}, nil
-	}
-
 	request := &dto.CreateUserDto{
 		Name:     req.Name,
 		Email:    req.Email,
@@ -150,8 +166,20 @@ func (handler *UserHandler) Create(ctx context.Context, req *proto.CreateUserReq
 		}, nil
 	}
 
+	if metadata := handler.ensureAssignable(ctx, request.RoleID); metadata != nil {
+		return &proto.UserResponse{
+			Metadata: metadata,
+		}, nil
+	}
+
 	user, err := handler.usecase.Create(ctx, request)
 	if err != nil {
+		var weak *authDomain.WeakPasswordError
+		if errors.As(err, &weak) {
+			return &proto.UserResponse{
+				Metadata: response.ValidationErrors(weak.Error(), toFieldErrors(weak)...),
+			}, nil
+		}
 		return &proto.UserResponse{
 			Metadata: response.Internal(),
 		}, nil
@@ -159,22 +187,11 @@ func (handler *UserHandler) Create(ctx context.Context, req *proto.CreateUserReq
 
 	return &proto.UserResponse{
 		Metadata: response.Success(200, "success"),
-		Data: &proto.User{
-			Id:    user.ID,
-			Name:  user.Name,
-			Email: user.Email,
-			Role:  user.RoleID,
-		},
+		Data:     toProtoUser(user),
 	}, nil
 }
 
 func (handler *UserHandler) Update(ctx context.Context, req *proto.UpdateUserRequest) (*proto.UserResponse, error) {
-	if err := middleware.RequireRole("admin", "super_admin")(ctx); err != nil {
-		return &proto.UserResponse{
-			Metadata: response.Forbidden(),
-		}, nil
-	}
-
 	updateDto := &dto.UpdateUserDto{
 		ID: req.GetId(),
 	}
@@ -199,6 +216,15 @@ func (handler *UserHandler) Update(ctx context.Context, req *proto.UpdateUserReq
 			Metadata: response.Validation(err.Error()),
 		}, nil
 	}
+
+	if updateDto.RoleID != nil {
+		if metadata := handler.ensureAssignable(ctx, *updateDto.RoleID); metadata != nil {
+			return &proto.UserResponse{
+				Metadata: metadata,
+			}, nil
+		}
+	}
+
 	user, err := handler.usecase.Update(ctx, updateDto)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -206,6 +232,11 @@ func (handler *UserHandler) Update(ctx context.Context, req *proto.UpdateUserReq
 				Metadata: response.NotFound("user not found"),
 			}, nil
 		}
+		if errors.Is(err, authDomain.ErrProfileInOrganization) || errors.Is(err, authDomain.ErrUserOutranksCaller) {
+			return &proto.UserResponse{
+				Metadata: response.Forbidden(err.Error()),
+			}, nil
+		}
 		return &proto.UserResponse{
 			Metadata: response.Internal(),
 		}, nil
@@ -213,22 +244,11 @@ func (handler *UserHandler) Update(ctx context.Context, req *proto.UpdateUserReq
 
 	return &proto.UserResponse{
 		Metadata: response.Success(200, "success"),
-		Data: &proto.User{
-			Id:    user.ID,
-			Name:  user.Name,
-			Email: user.Email,
-			Role:  user.RoleID,
-		},
+		Data:     toProtoUser(user),
 	}, nil
 }
 
 func (handler *UserHandler) Delete(ctx context.Context, req *proto.DeleteUserRequest) (*proto.DeleteUserResponse, error) {
-	if err := middleware.RequireRole("admin", "super_admin")(ctx); err != nil {
-		return &proto.DeleteUserResponse{
-			Metadata: response.Forbidden(),
-		}, nil
-	}
-
 	user, err := handler.usecase.GetByID(ctx, req.GetId())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -248,6 +268,11 @@ func (handler *UserHandler) Delete(ctx context.Context, req *proto.DeleteUserReq
 				Metadata: response.NotFound("user not found"),
 			}, nil
 		}
+		if errors.Is(err, authDomain.ErrUserOutranksCaller) {
+			return &proto.DeleteUserResponse{
+				Metadata: response.Forbidden(err.Error()),
+			}, nil
+		}
 		return &proto.DeleteUserResponse{
 			Metadata: response.Internal(),
 		}, nil
@@ -257,3 +282,216 @@ func (handler *UserHandler) Delete(ctx context.Context, req *proto.DeleteUserReq
 		Metadata: response.Success(200, "success"),
 	}, nil
 }
+
+func (handler *UserHandler) InviteUser(ctx context.Context, req *proto.InviteUserRequest) (*proto.InvitationResponse, error) {
+	userID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return &proto.InvitationResponse{
+			Metadata: response.Unauthorized(),
+		}, nil
+	}
+
+	request := &dto.InviteUserDto{
+		Email:  req.GetEmail(),
+		RoleID: req.GetRoleId(),
+	}
+
+	if err := helper.Validate.Struct(request); err != nil {
+		return &proto.InvitationResponse{
+			Metadata: response.Validation(err.Error()),
+		}, nil
+	}
+
+	if metadata := handler.ensureAssignable(ctx, request.RoleID); metadata != nil {
+		return &proto.InvitationResponse{
+			Metadata: metadata,
+		}, nil
+	}
+
+	invitation, err := handler.usecase.InviteUser(ctx, userID, request)
+	if err != nil {
+		return &proto.InvitationResponse{
+			Metadata: invitationError(err),
+		}, nil
+	}
+
+	return &proto.InvitationResponse{
+		Metadata: response.Created(),
+		Data:     toProtoInvitation(invitation),
+	}, nil
+}
+
+func (handler *UserHandler) ListInvitations(ctx context.Context, _ *proto.ListInvitationRequest) (*proto.ListInvitationResponse, error) {
+	invitations, err := handler.usecase.ListInvitations(ctx)
+	if err != nil {
+		return &proto.ListInvitationResponse{
+			Metadata:    response.Internal(),
+			Invitations: []*proto.Invitation{},
+		}, nil
+	}
+
+	resp := &proto.ListInvitationResponse{
+		Metadata: response.Success(200, "success"),
+	}
+
+	for i := range invitations {
+		resp.Invitations = append(resp.Invitations, toProtoInvitation(&invitations[i]))
+	}
+
+	return resp, nil
+}
+
+func (handler *UserHandler) ResendInvitation(ctx context.Context, req *proto.ResendInvitationRequest) (*proto.InvitationResponse, error) {
+	invitation, err := handler.usecase.ResendInvitation(ctx, req.GetId())
+	if err != nil {
+		return &proto.InvitationResponse{
+			Metadata: invitationError(err),
+		}, nil
+	}
+
+	return &proto.InvitationResponse{
+		Metadata: response.Success(200, "success"),
+		Data:     toProtoInvitation(invitation),
+	}, nil
+}
+
+func (handler *UserHandler) RevokeInvitation(ctx context.Context, req *proto.RevokeInvitationRequest) (*proto.DeleteUserResponse, error) {
+	if err := handler.usecase.RevokeInvitation(ctx, req.GetId()); err != nil {
+		return &proto.DeleteUserResponse{
+			Metadata: invitationError(err),
+		}, nil
+	}
+
+	return &proto.DeleteUserResponse{
+		Metadata: response.Deleted(),
+	}, nil
+}
+
+func (handler *UserHandler) Suspend(ctx context.Context, req *proto.ChangeUserStatusRequest) (*proto.UserResponse, error) {
+	return handler.changeStatus(ctx, req, handler.usecase.Suspend)
+}
+
+func (handler *UserHandler) Reactivate(ctx context.Context, req *proto.ChangeUserStatusRequest) (*proto.UserResponse, error) {
+	return handler.changeStatus(ctx, req, handler.usecase.Reactivate)
+}
+
+func (handler *UserHandler) Deactivate(ctx context.Context, req *proto.ChangeUserStatusRequest) (*proto.UserResponse, error) {
+	return handler.changeStatus(ctx, req, handler.usecase.Deactivate)
+}
+
+type statusChange func(ctx context.Context, actorID string, request *dto.ChangeUserStatusDto, client authDomain.ClientInfo) (*domain.User, error)
+
+func (handler *UserHandler) changeStatus(ctx context.Context, req *proto.ChangeUserStatusRequest, change statusChange) (*proto.UserResponse, error) {
+	actorID, _, ok := middleware.FromContext(ctx)
+	if !ok {
+		return &proto.UserResponse{
+			Metadata: response.Unauthorized(),
+		}, nil
+	}
+
+	request := &dto.ChangeUserStatusDto{
+		ID:     req.GetId(),
+		Reason: strings.TrimSpace(req.GetReason()),
+	}
+
+	if err := helper.Validate.Struct(request); err != nil {
+		return &proto.UserResponse{
+			Metadata: response.Validation(err.Error()),
+		}, nil
+	}
+
+	userAgent, ipAddress := metadata.ClientInfo(ctx)
+
+	user, err := change(ctx, actorID, request, authDomain.ClientInfo{UserAgent: userAgent, IPAddress: ipAddress})
+	if err != nil {
+		return &proto.UserResponse{
+			Metadata: statusError(err),
+		}, nil
+	}
+
+	return &proto.UserResponse{
+		Metadata: response.Success(200, "success"),
+		Data:     toProtoUser(user),
+	}, nil
+}
+
+// ensureAssignable refuses roles granting a permission the caller does not
+// hold, so managing users never lifts anyone above the caller
+func (handler *UserHandler) ensureAssignable(ctx context.Context, roleID string) *commonpb.MetaData {
+	permissions, err := handler.usecase.RolePermissions(ctx, roleID)
+	if err != nil {
+		return response.Internal()
+	}
+
+	for _, permission := range permissions {
+		if !middleware.HasPermission(ctx, permission) {
+			return response.Forbidden("cannot assign a role with permissions you do not hold")
+		}
+	}
+
+	return nil
+}
+
+func statusError(err error) *commonpb.MetaData {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return response.NotFound("user not found")
+	case errors.Is(err, authDomain.ErrInvalidStatusChange):
+		return response.Conflict(err.Error())
+	case errors.Is(err, authDomain.ErrCannotChangeOwnStatus), errors.Is(err, authDomain.ErrStatusInOrganization), errors.Is(err, authDomain.ErrUserOutranksCaller):
+		return response.Forbidden(err.Error())
+	default:
+		return response.Internal()
+	}
+}
+
+func toProtoUser(user *domain.User) *proto.User {
+	return &proto.User{
+		Id:           user.ID,
+		Name:         user.Name,
+		Email:        user.Email,
+		Role:         user.RoleID,
+		Status:       user.Status,
+		StatusReason: user.StatusReason,
+	}
+}
+
+func invitationError(err error) *commonpb.MetaData {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return response.NotFound("invitation not found")
+	case errors.Is(err, authDomain.ErrEmailAlreadyUsed):
+		return response.Conflict(err.Error())
+	default:
+		return response.Internal()
+	}
+}
+
+func toProtoInvitation(invitation *domain.Invitation) *proto.Invitation {
+	data := &proto.Invitation{
+		Id:        invitation.ID,
+		UserId:    invitation.UserID,
+		Email:     invitation.Email,
+		Role:      invitation.RoleID,
+		InvitedBy: invitation.InvitedBy,
+		ExpiresAt: timestamppb.New(invitation.ExpiresAt),
+	}
+
+	if !invitation.CreatedAt.IsZero() {
+		data.CreatedAt = timestamppb.New(invitation.CreatedAt)
+	}
+
+	return data
+}
+
+func toFieldErrors(weak *authDomain.WeakPasswordError) []*commonpb.FieldError {
+	errs := make([]*commonpb.FieldError, len(weak.Violations))
+	for i, violation := range weak.Violations {
+		errs[i] = &commonpb.FieldError{
+			Field:   "password",
+			Reason:  violation.Rule,
+			Message: violation.Message,
+		}
+	}
+	return errs
+}
@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/nassabiq/golang-template/internal/modules/user/domain"
+	"github.com/nassabiq/golang-template/internal/modules/user/usecase"
+	middleware "github.com/nassabiq/golang-template/internal/shared/middleware/auth"
+	proto "github.com/nassabiq/golang-template/proto/user"
+)
+
+type mockUserRepository struct {
+	domain.UserRepository
+	users       map[string]*domain.User
+	permissions map[string][]string
+	updated     *domain.UserUpdate
+	deleted     *domain.User
+}
+
+func (m *mockUserRepository) FindByID(ctx context.Context, id string) (*domain.User, error) {
+	user, ok := m.users[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	copied := *user
+	return &copied, nil
+}
+
+func (m *mockUserRepository) RolePermissions(ctx context.Context, roleID string) ([]string, error) {
+	return m.permissions[roleID], nil
+}
+
+func (m *mockUserRepository) Update(ctx context.Context, request *domain.UserUpdate) (*domain.User, error) {
+	m.updated = request
+	user := *m.users[request.ID]
+	if request.RoleID != nil {
+		user.RoleID = *request.RoleID
+	}
+	return &user, nil
+}
+
+func (m *mockUserRepository) Delete(ctx context.Context, user *domain.User) error {
+	m.deleted = user
+	return nil
+}
+
+type mockTokenRevoker struct{}
+
+func (mockTokenRevoker) RevokeUserAccessTokens(ctx context.Context, userID string, before time.Time) error {
+	return nil
+}
+
+func (mockTokenRevoker) RevokeOrganizationAccessTokens(ctx context.Context, userID, organizationID string, before time.Time) error {
+	return nil
+}
+
+// setupUserHandler returns a handler and the context of an admin, who holds
+// fewer permissions than the super admin
+func setupUserHandler() (*UserHandler, *mockUserRepository, context.Context) {
+	repo := &mockUserRepository{
+		users: map[string]*domain.User{
+			"user-root":   {ID: "user-root", Email: "root@example.com", RoleID: "role-super"},
+			"user-member": {ID: "user-member", Email: "member@example.com", RoleID: "role-user"},
+		},
+		permissions: map[string][]string{
+			"role-super": {"users.read", "users.update", "users.delete", "roles.manage"},
+			"role-admin": {"users.read", "users.update", "users.delete"},
+			"role-user":  {"users.read"},
+		},
+	}
+
+	uc := usecase.NewUserUsecase(repo, nil)
+	uc.SetTokenRevoker(mockTokenRevoker{})
+
+	ctx := middleware.WithUser(context.Background(), "user-admin", "role-admin")
+	ctx = middleware.WithPermissions(ctx, repo.permissions["role-admin"])
+
+	return NewUserHandler(*uc, nil), repo, ctx
+}
+
+// Test an admin cannot edit, demote or delete the super admin
+func TestUserHandler_AdminEditsSuperAdmin(t *testing.T) {
+	handler, repo, ctx := setupUserHandler()
+	email := "mallory@example.com"
+	roleUser := "role-user"
+
+	res, err := handler.Update(ctx, &proto.UpdateUserRequest{Id: "user-root", Email: &email})
+	if err != nil || res.GetMetadata().GetCode() != 403 {
+		t.Errorf("Update(email) = %v, %v, want 403", res.GetMetadata(), err)
+	}
+
+	res, err = handler.Update(ctx, &proto.UpdateUserRequest{Id: "user-root", RoleId: &roleUser})
+	if err != nil || res.GetMetadata().GetCode() != 403 {
+		t.Errorf("Update(role) = %v, %v, want 403", res.GetMetadata(), err)
+	}
+	if repo.updated != nil {
+		t.Errorf("Update() reached the repository: %+v", repo.updated)
+	}
+
+	deleted, err := handler.Delete(ctx, &proto.DeleteUserRequest{Id: "user-root"})
+	if err != nil || deleted.GetMetadata().GetCode() != 403 {
+		t.Errorf("Delete() = %v, %v, want 403", deleted.GetMetadata(), err)
+	}
+	if repo.deleted != nil {
+		t.Errorf("Delete() reached the repository: %+v", repo.deleted)
+	}
+}
+
+// Test an admin still manages users below them, but cannot lift them above themselves
+func TestUserHandler_AdminEditsMember(t *testing.T) {
+	handler, repo, ctx := setupUserHandler()
+	roleAdmin := "role-admin"
+	roleSuper := "role-super"
+
+	res, err := handler.Update(ctx, &proto.UpdateUserRequest{Id: "user-member", RoleId: &roleSuper})
+	if err != nil || res.GetMetadata().GetCode() != 403 {
+		t.Errorf("Update(role-super) = %v, %v, want 403", res.GetMetadata(), err)
+	}
+
+	res, err = handler.Update(ctx, &proto.UpdateUserRequest{Id: "user-member", RoleId: &roleAdmin})
+	if err != nil || res.GetMetadata().GetCode() != 200 || res.GetData().GetRole() != roleAdmin {
+		t.Errorf("Update(role-admin) = %v, %v, want 200", res.GetMetadata(), err)
+	}
+
+	deleted, err := handler.Delete(ctx, &proto.DeleteUserRequest{Id: "user-member"})
+	if err != nil || deleted.GetMetadata().GetCode() != 200 || repo.deleted == nil {
+		t.Errorf("Delete() = %v, %v, want 200", deleted.GetMetadata(), err)
+	}
+}
@@ -4,11 +4,20 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
+	authDomain "github.com/nassabiq/golang-template/internal/modules/auth/domain"
 	"github.com/nassabiq/golang-template/internal/modules/user/domain"
+	"github.com/nassabiq/golang-template/internal/shared/pagination"
+	"github.com/nassabiq/golang-template/internal/shared/tenant"
 )
 
+// Inside an organization (see tenant.OrganizationFromContext) every query only
+// sees that organization's members, and a user's role is their role there.
+const memberColumns = "u.id, u.name, u.email, m.role_id, u.status, u.status_reason, u.created_at, u.updated_at"
+const memberJoin = "users u JOIN organization_members m ON m.user_id = u.id AND m.organization_id = "
+
 type UserRepository struct {
 	db *sql.DB
 }
@@ -18,10 +27,17 @@ func NewUserRepository(db *sql.DB) *UserRepository {
 }
 
 func (r *UserRepository) FindByID(ctx context.Context, id string) (*domain.User, error) {
+	query := "SELECT id, name, email, role_id, status, status_reason, created_at, updated_at FROM users WHERE id = $1"
+	args := []interface{}{id}
+
+	if organizationID, ok := tenant.OrganizationFromContext(ctx); ok {
+		query = "SELECT " + memberColumns + " FROM " + memberJoin + "$2 WHERE u.id = $1"
+		args = append(args, organizationID)
+	}
+
 	var user domain.User
-	err := r.db.QueryRowContext(ctx,
-		"SELECT id, name, email, role_id, created_at, updated_at FROM users WHERE id = $1",
-		id).Scan(&user.ID, &user.Name, &user.Email, &user.RoleID, &user.CreatedAt, &user.UpdatedAt)
+	err := r.db.QueryRowContext(ctx, query, args...).
+		Scan(&user.ID, &user.Name, &user.Email, &user.RoleID, &user.Status, &user.StatusReason, &user.CreatedAt, &user.UpdatedAt)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -34,7 +50,13 @@ func (r *UserRepository) FindByID(ctx context.Context, id string) (*domain.User,
 }
 
 func (r *UserRepository) Create(ctx context.Context, request *domain.UserCreate) (*domain.User, error) {
-	_, err := r.db.ExecContext(ctx, `INSERT INTO users (id, name, email, password_hash, role_id, created_at, updated_at) 
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO users (id, name, email, password_hash, role_id, created_at, updated_at) 
 		VALUES ($1, $2, $3, $4, $5, NOW(), NOW())`,
 		request.ID,
 		request.Name,
@@ -47,53 +69,181 @@ func (r *UserRepository) Create(ctx context.Context, request *domain.UserCreate)
 		return nil, err
 	}
 
+	roleID := request.RoleID
+
+	// A user created inside an organization joins it
+	if organizationID, ok := tenant.OrganizationFromContext(ctx); ok {
+		_, err = tx.ExecContext(ctx, `INSERT INTO organization_members (organization_id, user_id, role_id, created_at, updated_at)
+			VALUES ($1, $2, $3, NOW(), NOW())`,
+			organizationID, request.ID, request.MemberRoleID,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		roleID = request.MemberRoleID
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
 	return &domain.User{
 		ID:        request.ID,
 		Name:      request.Name,
 		Email:     request.Email,
-		RoleID:    request.RoleID,
+		RoleID:    roleID,
+		Status:    "active",
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}, nil
 }
 
-func (r *UserRepository) List(ctx context.Context, limit int, offset int) ([]domain.User, int64, error) {
-	// Count total
-	var total int64
-	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM users").Scan(&total)
+// userSortColumns maps the sort fields to their columns; anything else is never
+// written into the query
+var userSortColumns = map[string]string{
+	domain.UserSortCreatedAt: "u.created_at",
+	domain.UserSortUpdatedAt: "u.updated_at",
+	domain.UserSortName:      "u.name",
+	domain.UserSortEmail:     "u.email",
+}
+
+// List pages by keyset on the sort column and id, so a deep page costs as much
+// as the first one. The total is only counted when the page asks for it.
+func (r *UserRepository) List(ctx context.Context, filter domain.UserFilter, sort domain.UserSort, page pagination.Page) ([]domain.User, *pagination.Result, error) {
+	columns := "u.id, u.name, u.email, u.role_id, u.status, u.status_reason, u.created_at, u.updated_at"
+	from := "users u"
+	roleColumn := "u.role_id"
+	args := []interface{}{}
+
+	if organizationID, ok := tenant.OrganizationFromContext(ctx); ok {
+		columns = memberColumns
+		from = memberJoin + "$1"
+		roleColumn = "m.role_id"
+		args = append(args, organizationID)
+	}
+
+	conditions, args := userFilterConditions(filter, roleColumn, args)
+
+	var total *int64
+	if page.WithTotal {
+		var count int64
+		err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM "+from+whereClause(conditions), args...).Scan(&count)
+		if err != nil {
+			return nil, nil, err
+		}
+		total = &count
+	}
+
+	sortField := sort.Field
+	if _, ok := userSortColumns[sortField]; !ok {
+		sortField = domain.UserSortCreatedAt
+	}
+	order := pagination.Order{Column: userSortColumns[sortField], IDColumn: "u.id", Descending: sort.Descending}
+
+	keyset, tail, listArgs, err := page.Clauses(order, args)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, err
+	}
+	if keyset != "" {
+		conditions = append(conditions, keyset)
 	}
 
-	rows, err := r.db.QueryContext(
-		ctx,
-		"SELECT id, name, email, role_id, created_at, updated_at FROM users ORDER BY created_at DESC LIMIT $1 OFFSET $2",
-		limit, offset,
-	)
+	listQuery := fmt.Sprintf("SELECT %s FROM %s%s %s", columns, from, whereClause(conditions), tail)
+
+	rows, err := r.db.QueryContext(ctx, listQuery, listArgs...)
 
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, err
 	}
 	defer rows.Close()
 
 	var users []domain.User
 	for rows.Next() {
 		var user domain.User
-		err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.RoleID, &user.CreatedAt, &user.UpdatedAt)
+		err := rows.Scan(&user.ID, &user.Name, &user.Email, &user.RoleID, &user.Status, &user.StatusReason, &user.CreatedAt, &user.UpdatedAt)
 		if err != nil {
-			return nil, 0, err
+			return nil, nil, err
 		}
 		users = append(users, user)
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, 0, err
+		return nil, nil, err
 	}
 
-	return users, total, nil
+	users, result := pagination.Trim(page, order, users, func(user domain.User) (string, string) {
+		return userSortValue(user, sortField), user.ID
+	})
+	result.Total = total
+
+	return users, result, nil
+}
+
+// userSortValue is the value of the sort column a cursor continues from
+func userSortValue(user domain.User, field string) string {
+	switch field {
+	case domain.UserSortUpdatedAt:
+		return user.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	case domain.UserSortName:
+		return user.Name
+	case domain.UserSortEmail:
+		return user.Email
+	default:
+		return user.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+}
+
+// userFilterConditions returns the conditions for filter, numbering their
+// placeholders after args, and args with the filter's values appended
+func userFilterConditions(filter domain.UserFilter, roleColumn string, args []interface{}) ([]string, []interface{}) {
+	var conditions []string
+
+	if filter.Search != "" {
+		args = append(args, "%"+escapeLike(filter.Search)+"%")
+		conditions = append(conditions, fmt.Sprintf("(u.name ILIKE $%d OR u.email ILIKE $%d)", len(args), len(args)))
+	}
+
+	if filter.Role != "" {
+		args = append(args, filter.Role)
+		conditions = append(conditions, fmt.Sprintf("(%s = $%d OR %s IN (SELECT id FROM roles WHERE LOWER(name) = LOWER($%d)))", roleColumn, len(args), roleColumn, len(args)))
+	}
+
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("u.status = $%d", len(args)))
+	}
+
+	if filter.IsActive != nil {
+		if *filter.IsActive {
+			conditions = append(conditions, "u.status = 'active'")
+		} else {
+			conditions = append(conditions, "u.status <> 'active'")
+		}
+	}
+
+	return conditions, args
+}
+
+func whereClause(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+
+	return " WHERE " + strings.Join(conditions, " AND ")
+}
+
+// escapeLike makes the wildcards of a search term match literally
+func escapeLike(term string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(term)
 }
 
 func (r *UserRepository) Update(ctx context.Context, request *domain.UserUpdate) (*domain.User, error) {
+	if organizationID, ok := tenant.OrganizationFromContext(ctx); ok {
+		return r.updateMember(ctx, organizationID, request)
+	}
+
 	query := "UPDATE users SET updated_at = NOW()"
 	args := []interface{}{}
 	argIndex := 1
@@ -104,8 +254,9 @@ func (r *UserRepository) Update(ctx context.Context, request *domain.UserUpdate)
 		argIndex++
 	}
 
+	// A new address is unverified until its owner proves it
 	if request.Email != nil {
-		query += fmt.Sprintf(", email = $%d", argIndex)
+		query += fmt.Sprintf(", email_verified_at = CASE WHEN email = $%d THEN email_verified_at END, email = $%d", argIndex, argIndex)
 		args = append(args, *request.Email)
 		argIndex++
 	}
@@ -116,12 +267,12 @@ func (r *UserRepository) Update(ctx context.Context, request *domain.UserUpdate)
 		argIndex++
 	}
 
-	query += fmt.Sprintf(" WHERE id = $%d RETURNING id, name, email, role_id, created_at, updated_at", argIndex)
+	query += fmt.Sprintf(" WHERE id = $%d RETURNING id, name, email, role_id, status, status_reason, created_at, updated_at", argIndex)
 	args = append(args, request.ID)
 
 	var user domain.User
 	err := r.db.QueryRowContext(ctx, query, args...).Scan(
-		&user.ID, &user.Name, &user.Email, &user.RoleID, &user.CreatedAt, &user.UpdatedAt,
+		&user.ID, &user.Name, &user.Email, &user.RoleID, &user.Status, &user.StatusReason, &user.CreatedAt, &user.UpdatedAt,
 	)
 
 	if err != nil {
@@ -134,8 +285,249 @@ func (r *UserRepository) Update(ctx context.Context, request *domain.UserUpdate)
 	return &user, nil
 }
 
+// Delete removes the user, or inside an organization only their membership
 func (r *UserRepository) Delete(ctx context.Context, user *domain.User) error {
-	result, err := r.db.ExecContext(ctx, "DELETE FROM users WHERE id = $1", user.ID)
+	query := "DELETE FROM users WHERE id = $1"
+	args := []interface{}{user.ID}
+
+	if organizationID, ok := tenant.OrganizationFromContext(ctx); ok {
+		query = "DELETE FROM organization_members WHERE user_id = $1 AND organization_id = $2"
+		args = append(args, organizationID)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// updateMember changes the role of a member of organizationID. It applies to
+// their membership only: the account itself is shared with other organizations.
+func (r *UserRepository) updateMember(ctx context.Context, organizationID string, request *domain.UserUpdate) (*domain.User, error) {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE organization_members SET role_id = COALESCE($1, role_id), updated_at = NOW() WHERE user_id = $2 AND organization_id = $3",
+		request.RoleID, request.ID, organizationID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return r.FindByID(ctx, request.ID)
+}
+
+func (r *UserRepository) EmailExists(ctx context.Context, email string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM users WHERE lower(email) = lower($1))", email).Scan(&exists)
+	return exists, err
+}
+
+func (r *UserRepository) RolePermissions(ctx context.Context, roleID string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT p.name FROM role_permissions rp JOIN permissions p ON p.id = rp.permission_id WHERE rp.role_id = $1",
+		roleID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var permissions []string
+	for rows.Next() {
+		var permission string
+		if err := rows.Scan(&permission); err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, permission)
+	}
+
+	return permissions, rows.Err()
+}
+
+// UpdateStatus moves the user to change.To, ends their sessions when asked and
+// records the change in audit_logs, all in one transaction
+func (r *UserRepository) UpdateStatus(ctx context.Context, change *domain.UserStatusChange) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Conditional on the current status so two admins racing cannot skip a transition
+	result, err := tx.ExecContext(ctx,
+		"UPDATE users SET status = $1, status_reason = $2, status_changed_at = $3, updated_at = NOW() WHERE id = $4 AND status = $5",
+		change.To, change.Reason, change.ChangedAt, change.UserID, change.From,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	if change.RevokeSessions {
+		_, err = tx.ExecContext(ctx,
+			"UPDATE refresh_tokens SET revoked = true, revoked_reason = $2, updated_at = NOW() WHERE user_id = $1 AND revoked = false",
+			change.UserID, authDomain.RefreshTokenEnded,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO audit_logs (id, actor_id, user_id, action, details, ip_address, user_agent, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		change.ID, change.ActorID, change.UserID, "user."+change.To, change.Reason, change.IPAddress, change.UserAgent, change.ChangedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// invitationColumns lists pending invitations; inside an organization the role
+// is the invitee's role there
+const invitationColumns = `i.id, i.user_id, u.email, %s, COALESCE(i.invited_by, ''), i.expires_at, i.created_at, i.updated_at
+	FROM user_invitations i
+	JOIN users u ON u.id = i.user_id`
+
+// CreateInvitation adds the pending user and their invitation. Inside an
+// organization the invitee also joins it.
+func (r *UserRepository) CreateInvitation(ctx context.Context, request *domain.InvitationCreate) (*domain.Invitation, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// The name is a placeholder until the invitee picks one
+	_, err = tx.ExecContext(ctx, `INSERT INTO users (id, name, email, password, role_id, status, created_at, updated_at)
+		VALUES ($1, $2, $2, '', $3, 'pending', NOW(), NOW())`,
+		request.UserID, request.Email, request.RoleID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	roleID := request.RoleID
+	var organizationID sql.NullString
+
+	if id, ok := tenant.OrganizationFromContext(ctx); ok {
+		_, err = tx.ExecContext(ctx, `INSERT INTO organization_members (organization_id, user_id, role_id, created_at, updated_at)
+			VALUES ($1, $2, $3, NOW(), NOW())`,
+			id, request.UserID, request.MemberRoleID,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		roleID = request.MemberRoleID
+		organizationID = sql.NullString{String: id, Valid: true}
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO user_invitations (id, user_id, invited_by, organization_id, token_hash, used, expires_at, created_at, updated_at)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, false, $6, NOW(), NOW())`,
+		request.ID, request.UserID, request.InvitedBy, organizationID, request.TokenHash, request.ExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &domain.Invitation{
+		ID:        request.ID,
+		UserID:    request.UserID,
+		Email:     request.Email,
+		RoleID:    roleID,
+		InvitedBy: request.InvitedBy,
+		ExpiresAt: request.ExpiresAt,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+// ListInvitations returns invitations not accepted yet, expired ones included
+func (r *UserRepository) ListInvitations(ctx context.Context) ([]domain.Invitation, error) {
+	query := "SELECT " + fmt.Sprintf(invitationColumns, "u.role_id") + " WHERE i.used = false ORDER BY i.created_at DESC"
+	args := []interface{}{}
+
+	if organizationID, ok := tenant.OrganizationFromContext(ctx); ok {
+		query = "SELECT " + fmt.Sprintf(invitationColumns, "m.role_id") +
+			" JOIN organization_members m ON m.user_id = i.user_id AND m.organization_id = i.organization_id" +
+			" WHERE i.used = false AND i.organization_id = $1 ORDER BY i.created_at DESC"
+		args = append(args, organizationID)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var invitations []domain.Invitation
+	for rows.Next() {
+		invitation, err := scanInvitation(rows)
+		if err != nil {
+			return nil, err
+		}
+		invitations = append(invitations, *invitation)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return invitations, nil
+}
+
+func (r *UserRepository) FindInvitation(ctx context.Context, id string) (*domain.Invitation, error) {
+	query := "SELECT " + fmt.Sprintf(invitationColumns, "u.role_id") + " WHERE i.id = $1 AND i.used = false"
+	args := []interface{}{id}
+
+	if organizationID, ok := tenant.OrganizationFromContext(ctx); ok {
+		query = "SELECT " + fmt.Sprintf(invitationColumns, "m.role_id") +
+			" JOIN organization_members m ON m.user_id = i.user_id AND m.organization_id = i.organization_id" +
+			" WHERE i.id = $1 AND i.used = false AND i.organization_id = $2"
+		args = append(args, organizationID)
+	}
+
+	return scanInvitation(r.db.QueryRowContext(ctx, query, args...))
+}
+
+func (r *UserRepository) RenewInvitation(ctx context.Context, id, tokenHash string, expiresAt time.Time) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE user_invitations SET token_hash = $1, expires_at = $2, updated_at = NOW() WHERE id = $3 AND used = false",
+		tokenHash, expiresAt, id,
+	)
 	if err != nil {
 		return err
 	}
@@ -151,3 +543,48 @@ func (r *UserRepository) Delete(ctx context.Context, user *domain.User) error {
 
 	return nil
 }
+
+func (r *UserRepository) DeleteInvitation(ctx context.Context, invitation *domain.Invitation) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM users WHERE id = $1 AND status = 'pending'", invitation.UserID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanInvitation(row rowScanner) (*domain.Invitation, error) {
+	var invitation domain.Invitation
+	var createdAt, updatedAt sql.NullTime
+
+	err := row.Scan(
+		&invitation.ID,
+		&invitation.UserID,
+		&invitation.Email,
+		&invitation.RoleID,
+		&invitation.InvitedBy,
+		&invitation.ExpiresAt,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation.CreatedAt = createdAt.Time
+	invitation.UpdatedAt = updatedAt.Time
+	return &invitation, nil
+}
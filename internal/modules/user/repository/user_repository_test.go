@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/nassabiq/golang-template/internal/modules/user/domain"
+	"github.com/nassabiq/golang-template/internal/shared/pagination"
+	"github.com/nassabiq/golang-template/internal/shared/tenant"
+)
+
+var userListColumns = []string{"id", "name", "email", "role_id", "status", "status_reason", "created_at", "updated_at"}
+
+// Test List applies the same filters to the page and the total it was asked for
+func TestUserRepository_List_Filters(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create mock db: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewUserRepository(db)
+	fixedTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	isActive := false
+	where := " WHERE (u.name ILIKE $1 OR u.email ILIKE $1)" +
+		" AND (u.role_id = $2 OR u.role_id IN (SELECT id FROM roles WHERE LOWER(name) = LOWER($2)))" +
+		" AND u.status = $3 AND u.status <> 'active'"
+
+	mock.ExpectQuery("SELECT COUNT(*) FROM users u"+where).
+		WithArgs(`%50\%\_off%`, "admin", "suspended").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery("SELECT u.id, u.name, u.email, u.role_id, u.status, u.status_reason, u.created_at, u.updated_at FROM users u"+where+
+		" ORDER BY u.name ASC, u.id ASC LIMIT $4 OFFSET $5").
+		WithArgs(`%50\%\_off%`, "admin", "suspended", 11, 20).
+		WillReturnRows(sqlmock.NewRows(userListColumns).
+			AddRow("user-1", "Budi", "budi@app.com", "1", "suspended", "spam", fixedTime, fixedTime))
+
+	// LIKE wildcards in the term match literally
+	filter := domain.UserFilter{Search: "50%_off", Role: "admin", Status: "suspended", IsActive: &isActive}
+	page := pagination.Page{Limit: 10, Offset: 20, WithTotal: true}
+	users, result, err := repo.List(context.Background(), filter, domain.UserSort{Field: domain.UserSortName}, page)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if result.Total == nil || *result.Total != 1 || len(users) != 1 || users[0].ID != "user-1" {
+		t.Errorf("List() = %+v, %+v", users, result)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+// Test List inside an organization filters on the member's role, without counting unless asked
+func TestUserRepository_List_Organization(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create mock db: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewUserRepository(db)
+	ctx := tenant.WithOrganization(context.Background(), "org-1")
+	from := " FROM users u JOIN organization_members m ON m.user_id = u.id AND m.organization_id = $1" +
+		" WHERE (m.role_id = $2 OR m.role_id IN (SELECT id FROM roles WHERE LOWER(name) = LOWER($2)))"
+
+	mock.ExpectQuery("SELECT "+memberColumns+from+" ORDER BY u.created_at DESC, u.id DESC LIMIT $3").
+		WithArgs("org-1", "viewer", 11).
+		WillReturnRows(sqlmock.NewRows(userListColumns))
+
+	sort := domain.UserSort{Field: domain.UserSortCreatedAt, Descending: true}
+	_, result, err := repo.List(ctx, domain.UserFilter{Role: "viewer"}, sort, pagination.Page{Limit: 10})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if result.Total != nil {
+		t.Errorf("List() total = %d, want none", *result.Total)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+// Test List continues from a cursor and returns the cursors around the page
+func TestUserRepository_List_Cursor(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create mock db: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewUserRepository(db)
+	fixedTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT u.id, u.name, u.email, u.role_id, u.status, u.status_reason, u.created_at, u.updated_at FROM users u"+
+		" WHERE u.status = $1 AND (u.created_at, u.id) < ($2, $3) ORDER BY u.created_at DESC, u.id DESC LIMIT $4").
+		WithArgs("active", "2024-01-03T00:00:00Z", "user-3", 3).
+		WillReturnRows(sqlmock.NewRows(userListColumns).
+			AddRow("user-2", "Budi", "budi@app.com", "1", "active", "", fixedTime.Add(24*time.Hour), fixedTime).
+			AddRow("user-1", "Ani", "ani@app.com", "1", "active", "", fixedTime, fixedTime).
+			AddRow("user-0", "Eko", "eko@app.com", "1", "active", "", fixedTime, fixedTime))
+
+	sort := domain.UserSort{Field: domain.UserSortCreatedAt, Descending: true}
+	page := pagination.Page{Limit: 2, Offset: 20, Cursor: &pagination.Cursor{Order: "u.created_at desc", Value: "2024-01-03T00:00:00Z", ID: "user-3"}}
+	users, result, err := repo.List(context.Background(), domain.UserFilter{Status: "active"}, sort, page)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	if len(users) != 2 || users[0].ID != "user-2" || users[1].ID != "user-1" {
+		t.Errorf("List() users = %+v", users)
+	}
+	if want := (pagination.Cursor{Order: "u.created_at desc", Value: "2024-01-01T00:00:00Z", ID: "user-1"}); result.Next == nil || *result.Next != want {
+		t.Errorf("List() next = %+v, want %+v", result.Next, want)
+	}
+	if want := (pagination.Cursor{Order: "u.created_at desc", Value: "2024-01-02T00:00:00Z", ID: "user-2", Backward: true}); result.Prev == nil || *result.Prev != want {
+		t.Errorf("List() prev = %+v, want %+v", result.Prev, want)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
+
+// Test a cursor issued for another sort is rejected before querying
+func TestUserRepository_List_CursorForOtherSort(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("failed to create mock db: %v", err)
+	}
+	defer db.Close()
+
+	repo := NewUserRepository(db)
+	page := pagination.Page{Limit: 10, Cursor: &pagination.Cursor{Order: "u.created_at desc", Value: "2024-01-03T00:00:00Z", ID: "user-3"}}
+
+	_, _, err = repo.List(context.Background(), domain.UserFilter{}, domain.UserSort{Field: domain.UserSortName}, page)
+	if err != pagination.ErrInvalidCursor {
+		t.Errorf("List() error = %v, want ErrInvalidCursor", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unfulfilled expectations: %v", err)
+	}
+}
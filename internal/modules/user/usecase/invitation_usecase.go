@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	authDomain "github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/user/domain"
+	"github.com/nassabiq/golang-template/internal/modules/user/dto"
+	"github.com/nassabiq/golang-template/internal/modules/user/event"
+	"github.com/nassabiq/golang-template/internal/shared/tenant"
+)
+
+const invitationTTL = 72 * time.Hour
+
+// InviteUser creates a pending user and mails them a link to choose their name
+// and password. Inside an organization the invitee joins it with the given role.
+func (usecase *UserUsecase) InviteUser(ctx context.Context, inviterID string, request *dto.InviteUserDto) (*domain.Invitation, error) {
+	exists, err := usecase.repository.EmailExists(ctx, request.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		return nil, authDomain.ErrEmailAlreadyUsed
+	}
+
+	token, err := usecase.tokens.GenerateRandomToken()
+	if err != nil {
+		return nil, err
+	}
+
+	params := &domain.InvitationCreate{
+		ID:        uuid.New().String(),
+		UserID:    uuid.New().String(),
+		Email:     request.Email,
+		RoleID:    request.RoleID,
+		InvitedBy: inviterID,
+		TokenHash: usecase.tokens.HashToken(token),
+		ExpiresAt: usecase.now().Add(invitationTTL),
+	}
+
+	if _, ok := tenant.OrganizationFromContext(ctx); ok {
+		params.MemberRoleID = request.RoleID
+		params.RoleID = string(authDomain.RoleIDUser)
+	}
+
+	invitation, err := usecase.repository.CreateInvitation(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	usecase.publishInvitation(invitation, token)
+	return invitation, nil
+}
+
+func (usecase *UserUsecase) ListInvitations(ctx context.Context) ([]domain.Invitation, error) {
+	return usecase.repository.ListInvitations(ctx)
+}
+
+// ResendInvitation mails a new token; the previous one stops working
+func (usecase *UserUsecase) ResendInvitation(ctx context.Context, id string) (*domain.Invitation, error) {
+	invitation, err := usecase.repository.FindInvitation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := usecase.tokens.GenerateRandomToken()
+	if err != nil {
+		return nil, err
+	}
+
+	invitation.ExpiresAt = usecase.now().Add(invitationTTL)
+
+	if err := usecase.repository.RenewInvitation(ctx, invitation.ID, usecase.tokens.HashToken(token), invitation.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	usecase.publishInvitation(invitation, token)
+	return invitation, nil
+}
+
+func (usecase *UserUsecase) RevokeInvitation(ctx context.Context, id string) error {
+	invitation, err := usecase.repository.FindInvitation(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return usecase.repository.DeleteInvitation(ctx, invitation)
+}
+
+func (usecase *UserUsecase) publishInvitation(invitation *domain.Invitation, token string) {
+	if usecase.eventPub == nil {
+		return
+	}
+
+	_ = usecase.eventPub.UserInvited(event.UserInvitedEvent{
+		UserID:    invitation.UserID,
+		Email:     invitation.Email,
+		Token:     token,
+		ExpiredAt: invitation.ExpiresAt,
+	})
+}
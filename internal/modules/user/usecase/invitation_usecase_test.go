@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	authDomain "github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/user/domain"
+	"github.com/nassabiq/golang-template/internal/modules/user/dto"
+	"github.com/nassabiq/golang-template/internal/modules/user/event"
+	"github.com/nassabiq/golang-template/internal/shared/tenant"
+)
+
+type mockUserRepository struct {
+	domain.UserRepository
+	emails      map[string]bool
+	invitations map[string]*domain.InvitationCreate
+	tokenHashes map[string]string
+}
+
+func newMockUserRepository() *mockUserRepository {
+	return &mockUserRepository{
+		emails:      map[string]bool{"taken@app.com": true},
+		invitations: make(map[string]*domain.InvitationCreate),
+		tokenHashes: make(map[string]string),
+	}
+}
+
+func (m *mockUserRepository) EmailExists(ctx context.Context, email string) (bool, error) {
+	return m.emails[email], nil
+}
+
+func (m *mockUserRepository) CreateInvitation(ctx context.Context, request *domain.InvitationCreate) (*domain.Invitation, error) {
+	m.invitations[request.ID] = request
+	m.tokenHashes[request.ID] = request.TokenHash
+	return &domain.Invitation{ID: request.ID, UserID: request.UserID, Email: request.Email, RoleID: request.RoleID, ExpiresAt: request.ExpiresAt}, nil
+}
+
+func (m *mockUserRepository) FindInvitation(ctx context.Context, id string) (*domain.Invitation, error) {
+	request, ok := m.invitations[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &domain.Invitation{ID: request.ID, UserID: request.UserID, Email: request.Email, ExpiresAt: request.ExpiresAt}, nil
+}
+
+func (m *mockUserRepository) RenewInvitation(ctx context.Context, id, tokenHash string, expiresAt time.Time) error {
+	m.tokenHashes[id] = tokenHash
+	return nil
+}
+
+func (m *mockUserRepository) DeleteInvitation(ctx context.Context, invitation *domain.Invitation) error {
+	delete(m.invitations, invitation.ID)
+	return nil
+}
+
+type mockTokenGenerator struct {
+	count int
+}
+
+func (m *mockTokenGenerator) GenerateRandomToken() (string, error) {
+	m.count++
+	return "token-" + strconv.Itoa(m.count), nil
+}
+
+func (m *mockTokenGenerator) HashToken(token string) string {
+	return "sha256-" + token
+}
+
+type mockEventBus struct {
+	subjects []string
+	payloads [][]byte
+}
+
+func (m *mockEventBus) Publish(subject string, payload []byte) error {
+	m.subjects = append(m.subjects, subject)
+	m.payloads = append(m.payloads, payload)
+	return nil
+}
+
+func setupInvitations() (*UserUsecase, *mockUserRepository, *mockEventBus) {
+	repo := newMockUserRepository()
+	bus := &mockEventBus{}
+
+	usecase := NewUserUsecase(repo, nil)
+	usecase.SetTokenGenerator(&mockTokenGenerator{})
+	usecase.SetEventPublisher(event.NewUserPublisher(bus))
+	usecase.now = func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	return usecase, repo, bus
+}
+
+// Test InviteUser stores only the token hash and mails the plain token
+func TestUserUsecase_InviteUser(t *testing.T) {
+	usecase, repo, bus := setupInvitations()
+
+	invitation, err := usecase.InviteUser(context.Background(), "admin-1", &dto.InviteUserDto{Email: "new@app.com", RoleID: "role-1"})
+	if err != nil {
+		t.Fatalf("InviteUser() error = %v", err)
+	}
+
+	stored := repo.invitations[invitation.ID]
+	if stored.TokenHash != "sha256-token-1" || stored.InvitedBy != "admin-1" || stored.RoleID != "role-1" {
+		t.Errorf("stored invitation = %+v", stored)
+	}
+	if want := time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC); !stored.ExpiresAt.Equal(want) {
+		t.Errorf("invitation expires at %v, want %v", stored.ExpiresAt, want)
+	}
+
+	if len(bus.subjects) != 1 || bus.subjects[0] != event.UserInvitedSubject {
+		t.Fatalf("published subjects = %v", bus.subjects)
+	}
+	var published event.UserInvitedEvent
+	if err := json.Unmarshal(bus.payloads[0], &published); err != nil || published.Token != "token-1" || published.Email != "new@app.com" {
+		t.Errorf("published event = %+v, %v", published, err)
+	}
+
+	if _, err := usecase.InviteUser(context.Background(), "admin-1", &dto.InviteUserDto{Email: "taken@app.com", RoleID: "role-1"}); !errors.Is(err, authDomain.ErrEmailAlreadyUsed) {
+		t.Errorf("InviteUser() taken email error = %v, want %v", err, authDomain.ErrEmailAlreadyUsed)
+	}
+}
+
+// Test an invitation inside an organization grants the role there, not platform-wide
+func TestUserUsecase_InviteUser_Organization(t *testing.T) {
+	usecase, repo, _ := setupInvitations()
+	ctx := tenant.WithOrganization(context.Background(), "org-acme")
+
+	invitation, err := usecase.InviteUser(ctx, "admin-1", &dto.InviteUserDto{Email: "new@app.com", RoleID: string(authDomain.RoleIDAdmin)})
+	if err != nil {
+		t.Fatalf("InviteUser() error = %v", err)
+	}
+
+	stored := repo.invitations[invitation.ID]
+	if stored.RoleID != string(authDomain.RoleIDUser) || stored.MemberRoleID != string(authDomain.RoleIDAdmin) {
+		t.Errorf("stored invitation role = %s, member role = %s", stored.RoleID, stored.MemberRoleID)
+	}
+}
+
+// Test ResendInvitation replaces the token and RevokeInvitation removes the invitation
+func TestUserUsecase_ResendAndRevokeInvitation(t *testing.T) {
+	usecase, repo, bus := setupInvitations()
+	ctx := context.Background()
+
+	invitation, _ := usecase.InviteUser(ctx, "admin-1", &dto.InviteUserDto{Email: "new@app.com", RoleID: "role-1"})
+
+	if _, err := usecase.ResendInvitation(ctx, invitation.ID); err != nil {
+		t.Fatalf("ResendInvitation() error = %v", err)
+	}
+	if repo.tokenHashes[invitation.ID] != "sha256-token-2" || len(bus.subjects) != 2 {
+		t.Errorf("ResendInvitation() token hash = %s, published %d", repo.tokenHashes[invitation.ID], len(bus.subjects))
+	}
+
+	if _, err := usecase.ResendInvitation(ctx, "missing"); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("ResendInvitation() missing error = %v, want %v", err, sql.ErrNoRows)
+	}
+
+	if err := usecase.RevokeInvitation(ctx, invitation.ID); err != nil {
+		t.Fatalf("RevokeInvitation() error = %v", err)
+	}
+	if _, ok := repo.invitations[invitation.ID]; ok {
+		t.Error("RevokeInvitation() kept the invitation")
+	}
+}
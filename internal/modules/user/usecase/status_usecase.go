@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	authDomain "github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/user/domain"
+	"github.com/nassabiq/golang-template/internal/modules/user/dto"
+	"github.com/nassabiq/golang-template/internal/shared/tenant"
+)
+
+// Suspend locks the user out until an admin reactivates them. Their sessions
+// end right away.
+func (usecase *UserUsecase) Suspend(ctx context.Context, actorID string, request *dto.ChangeUserStatusDto, client authDomain.ClientInfo) (*domain.User, error) {
+	return usecase.changeStatus(ctx, actorID, request, authDomain.UserStatusSuspended, client)
+}
+
+// Deactivate closes the account but keeps the user and their history, unlike
+// Delete. Their sessions end right away.
+func (usecase *UserUsecase) Deactivate(ctx context.Context, actorID string, request *dto.ChangeUserStatusDto, client authDomain.ClientInfo) (*domain.User, error) {
+	return usecase.changeStatus(ctx, actorID, request, authDomain.UserStatusDeactivated, client)
+}
+
+// Reactivate lets a suspended or deactivated user sign in again
+func (usecase *UserUsecase) Reactivate(ctx context.Context, actorID string, request *dto.ChangeUserStatusDto, client authDomain.ClientInfo) (*domain.User, error) {
+	return usecase.changeStatus(ctx, actorID, request, authDomain.UserStatusActive, client)
+}
+
+func (usecase *UserUsecase) changeStatus(ctx context.Context, actorID string, request *dto.ChangeUserStatusDto, next authDomain.UserStatus, client authDomain.ClientInfo) (*domain.User, error) {
+	// The status belongs to the account, not to a membership: an organization
+	// admin must not lock a user out of every other organization
+	if _, ok := tenant.OrganizationFromContext(ctx); ok {
+		return nil, authDomain.ErrStatusInOrganization
+	}
+
+	if request.ID == actorID {
+		return nil, authDomain.ErrCannotChangeOwnStatus
+	}
+
+	user, err := usecase.repository.FindByID(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Holding users.suspend must not be enough to lock out a more privileged admin
+	if err := usecase.ensureManageable(ctx, user); err != nil {
+		return nil, err
+	}
+
+	// Pending users become active by accepting their invitation, never by an admin
+	current := authDomain.UserStatus(user.Status)
+	if current == authDomain.UserStatusPending || !current.CanTransitionTo(next) {
+		return nil, authDomain.ErrInvalidStatusChange
+	}
+
+	now := usecase.now()
+
+	err = usecase.repository.UpdateStatus(ctx, &domain.UserStatusChange{
+		ID:             uuid.New().String(),
+		UserID:         user.ID,
+		ActorID:        actorID,
+		From:           user.Status,
+		To:             string(next),
+		Reason:         request.Reason,
+		RevokeSessions: next != authDomain.UserStatusActive,
+		IPAddress:      client.IPAddress,
+		UserAgent:      client.UserAgent,
+		ChangedAt:      now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Access tokens issued so far die with every change: a suspension ends them
+	// before they expire, and a reactivation must not bring back tokens issued
+	// before the user was suspended
+	if err := usecase.tokenRevoker.RevokeUserAccessTokens(ctx, user.ID, now); err != nil {
+		return nil, err
+	}
+
+	user.Status = string(next)
+	user.StatusReason = request.Reason
+	return user, nil
+}
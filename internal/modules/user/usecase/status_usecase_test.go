@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	authDomain "github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/user/domain"
+	"github.com/nassabiq/golang-template/internal/modules/user/dto"
+	middleware "github.com/nassabiq/golang-template/internal/shared/middleware/auth"
+	"github.com/nassabiq/golang-template/internal/shared/tenant"
+)
+
+type mockStatusRepository struct {
+	domain.UserRepository
+	users       map[string]*domain.User
+	permissions map[string][]string
+	changes     []*domain.UserStatusChange
+}
+
+func (m *mockStatusRepository) RolePermissions(ctx context.Context, roleID string) ([]string, error) {
+	return m.permissions[roleID], nil
+}
+
+func (m *mockStatusRepository) FindByID(ctx context.Context, id string) (*domain.User, error) {
+	user, ok := m.users[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	copied := *user
+	return &copied, nil
+}
+
+func (m *mockStatusRepository) UpdateStatus(ctx context.Context, change *domain.UserStatusChange) error {
+	user := m.users[change.UserID]
+	if user.Status != change.From {
+		return sql.ErrNoRows
+	}
+	user.Status = change.To
+	m.changes = append(m.changes, change)
+	return nil
+}
+
+type mockTokenRevoker struct {
+	revoked map[string]time.Time
+}
+
+func (m *mockTokenRevoker) RevokeUserAccessTokens(ctx context.Context, userID string, before time.Time) error {
+	m.revoked[userID] = before
+	return nil
+}
+
+func (m *mockTokenRevoker) RevokeOrganizationAccessTokens(ctx context.Context, userID, organizationID string, before time.Time) error {
+	m.revoked[organizationID+"/"+userID] = before
+	return nil
+}
+
+func setupStatusChanges(status authDomain.UserStatus) (*UserUsecase, *mockStatusRepository, *mockTokenRevoker) {
+	repo := &mockStatusRepository{users: map[string]*domain.User{
+		"user-1": {ID: "user-1", Status: string(status)},
+	}}
+	revoker := &mockTokenRevoker{revoked: make(map[string]time.Time)}
+
+	usecase := NewUserUsecase(repo, nil)
+	usecase.SetTokenRevoker(revoker)
+	usecase.now = func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	return usecase, repo, revoker
+}
+
+// Test Suspend, Deactivate and Reactivate follow the status state machine
+func TestUserUsecase_ChangeStatus(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		from       authDomain.UserStatus
+		apply      func(*UserUsecase, context.Context, string, *dto.ChangeUserStatusDto, authDomain.ClientInfo) (*domain.User, error)
+		wantStatus authDomain.UserStatus
+		wantRevoke bool
+		wantErr    error
+	}{
+		{
+			name:       "suspend active user ends sessions",
+			from:       authDomain.UserStatusActive,
+			apply:      (*UserUsecase).Suspend,
+			wantStatus: authDomain.UserStatusSuspended,
+			wantRevoke: true,
+		},
+		{
+			name:       "deactivate suspended user",
+			from:       authDomain.UserStatusSuspended,
+			apply:      (*UserUsecase).Deactivate,
+			wantStatus: authDomain.UserStatusDeactivated,
+			wantRevoke: true,
+		},
+		{
+			name:       "reactivate suspended user keeps refresh tokens revoked",
+			from:       authDomain.UserStatusSuspended,
+			apply:      (*UserUsecase).Reactivate,
+			wantStatus: authDomain.UserStatusActive,
+		},
+		{
+			name:    "suspend deactivated user",
+			from:    authDomain.UserStatusDeactivated,
+			apply:   (*UserUsecase).Suspend,
+			wantErr: authDomain.ErrInvalidStatusChange,
+		},
+		{
+			name:    "reactivate active user",
+			from:    authDomain.UserStatusActive,
+			apply:   (*UserUsecase).Reactivate,
+			wantErr: authDomain.ErrInvalidStatusChange,
+		},
+		{
+			name:    "reactivate pending invitee",
+			from:    authDomain.UserStatusPending,
+			apply:   (*UserUsecase).Reactivate,
+			wantErr: authDomain.ErrInvalidStatusChange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, repo, revoker := setupStatusChanges(tt.from)
+			request := &dto.ChangeUserStatusDto{ID: "user-1", Reason: "chargeback"}
+			client := authDomain.ClientInfo{IPAddress: "203.0.113.7"}
+
+			user, err := tt.apply(usecase, context.Background(), "admin-1", request, client)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				if len(repo.changes) != 0 || len(revoker.revoked) != 0 {
+					t.Errorf("refused change was applied: changes %d, revoked %v", len(repo.changes), revoker.revoked)
+				}
+				return
+			}
+
+			if user.Status != string(tt.wantStatus) || user.StatusReason != "chargeback" {
+				t.Errorf("user = %q %q, want %q chargeback", user.Status, user.StatusReason, tt.wantStatus)
+			}
+
+			change := repo.changes[0]
+			if change.ActorID != "admin-1" || change.From != string(tt.from) || change.IPAddress != "203.0.113.7" || !change.ChangedAt.Equal(now) {
+				t.Errorf("change = %+v, want audited change by admin-1 from %q", change, tt.from)
+			}
+			if change.RevokeSessions != tt.wantRevoke {
+				t.Errorf("RevokeSessions = %v, want %v", change.RevokeSessions, tt.wantRevoke)
+			}
+
+			// Access tokens issued before the change stop working either way
+			if before, ok := revoker.revoked["user-1"]; !ok || !before.Equal(now) {
+				t.Errorf("access tokens revoked before %v, want %v", before, now)
+			}
+		})
+	}
+}
+
+// Test admins cannot lock themselves out, and organization admins cannot change account status
+func TestUserUsecase_ChangeStatus_Refused(t *testing.T) {
+	usecase, repo, _ := setupStatusChanges(authDomain.UserStatusActive)
+	request := &dto.ChangeUserStatusDto{ID: "user-1", Reason: "testing"}
+
+	if _, err := usecase.Suspend(context.Background(), "user-1", request, authDomain.ClientInfo{}); !errors.Is(err, authDomain.ErrCannotChangeOwnStatus) {
+		t.Errorf("Suspend(self) error = %v, want %v", err, authDomain.ErrCannotChangeOwnStatus)
+	}
+
+	ctx := tenant.WithOrganization(context.Background(), "org-1")
+	if _, err := usecase.Suspend(ctx, "admin-1", request, authDomain.ClientInfo{}); !errors.Is(err, authDomain.ErrStatusInOrganization) {
+		t.Errorf("Suspend() in organization error = %v, want %v", err, authDomain.ErrStatusInOrganization)
+	}
+
+	missing := &dto.ChangeUserStatusDto{ID: "user-404", Reason: "testing"}
+	if _, err := usecase.Suspend(context.Background(), "admin-1", missing, authDomain.ClientInfo{}); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("Suspend(missing) error = %v, want %v", err, sql.ErrNoRows)
+	}
+
+	if len(repo.changes) != 0 {
+		t.Errorf("refused changes were applied: %d", len(repo.changes))
+	}
+}
+
+// Test an admin cannot suspend or deactivate a user holding permissions they lack
+func TestUserUsecase_ChangeStatus_MorePrivilegedTarget(t *testing.T) {
+	usecase, repo, revoker := setupStatusChanges(authDomain.UserStatusActive)
+	repo.users["user-1"].RoleID = "role-super"
+	repo.permissions = map[string][]string{
+		"role-super": {"users.suspend", "roles.manage"},
+		"role-admin": {"users.suspend"},
+	}
+	request := &dto.ChangeUserStatusDto{ID: "user-1", Reason: "testing"}
+
+	admin := middleware.WithPermissions(context.Background(), repo.permissions["role-admin"])
+	if _, err := usecase.Suspend(admin, "admin-1", request, authDomain.ClientInfo{}); !errors.Is(err, authDomain.ErrUserOutranksCaller) {
+		t.Errorf("Suspend() error = %v, want %v", err, authDomain.ErrUserOutranksCaller)
+	}
+	if _, err := usecase.Deactivate(admin, "admin-1", request, authDomain.ClientInfo{}); !errors.Is(err, authDomain.ErrUserOutranksCaller) {
+		t.Errorf("Deactivate() error = %v, want %v", err, authDomain.ErrUserOutranksCaller)
+	}
+	if len(repo.changes) != 0 || len(revoker.revoked) != 0 {
+		t.Errorf("refused changes were applied: %d, revoked %v", len(repo.changes), revoker.revoked)
+	}
+
+	super := middleware.WithPermissions(context.Background(), repo.permissions["role-super"])
+	if _, err := usecase.Suspend(super, "root-1", request, authDomain.ClientInfo{}); err != nil {
+		t.Errorf("Suspend() by an equal role error = %v", err)
+	}
+}
@@ -2,34 +2,116 @@ package usecase
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	authDomain "github.com/nassabiq/golang-template/internal/modules/auth/domain"
 	"github.com/nassabiq/golang-template/internal/modules/user/domain"
 	"github.com/nassabiq/golang-template/internal/modules/user/dto"
+	"github.com/nassabiq/golang-template/internal/modules/user/event"
+	middleware "github.com/nassabiq/golang-template/internal/shared/middleware/auth"
+	"github.com/nassabiq/golang-template/internal/shared/pagination"
+	"github.com/nassabiq/golang-template/internal/shared/tenant"
 )
 
+// PasswordPolicy reports every rule a new password breaks
+type PasswordPolicy interface {
+	Validate(password string, owner authDomain.PasswordOwner) []authDomain.PasswordViolation
+}
+
 type PasswordHasher interface {
 	HashPassword(password string) (string, error)
 }
 
+// TokenGenerator creates the single-use tokens mailed with invitations; only their hash is stored
+type TokenGenerator interface {
+	GenerateRandomToken() (string, error)
+	HashToken(token string) string
+}
+
+// TokenRevoker invalidates the outstanding access tokens of a user, everywhere
+// or only those acting in one organization
+type TokenRevoker interface {
+	RevokeUserAccessTokens(ctx context.Context, userID string, before time.Time) error
+	RevokeOrganizationAccessTokens(ctx context.Context, userID, organizationID string, before time.Time) error
+}
+
 type UserUsecase struct {
-	repository domain.UserRepository
-	hasher     PasswordHasher
+	repository   domain.UserRepository
+	hasher       PasswordHasher
+	tokenRevoker TokenRevoker
+	policy       PasswordPolicy
+	tokens       TokenGenerator
+	eventPub     *event.Publisher
+	now          func() time.Time
 }
 
 func NewUserUsecase(repository domain.UserRepository, hasher PasswordHasher) *UserUsecase {
 	return &UserUsecase{
 		repository: repository,
 		hasher:     hasher,
+		now:        time.Now,
 	}
 }
 
-func (usecase *UserUsecase) List(ctx context.Context, limit int, offset int) ([]domain.User, int64, error) {
+func (usecase *UserUsecase) SetTokenRevoker(revoker TokenRevoker) {
+	usecase.tokenRevoker = revoker
+}
+
+func (usecase *UserUsecase) SetPasswordPolicy(policy PasswordPolicy) {
+	usecase.policy = policy
+}
+
+func (usecase *UserUsecase) SetTokenGenerator(tokens TokenGenerator) {
+	usecase.tokens = tokens
+}
+
+func (usecase *UserUsecase) SetEventPublisher(pub *event.Publisher) {
+	usecase.eventPub = pub
+}
+
+// List returns a page of users matching the request's filters, newest first
+// unless another sort is asked for
+func (usecase *UserUsecase) List(ctx context.Context, request *dto.ListUserDto) ([]domain.User, *pagination.Result, error) {
+	limit := request.Limit
 	if limit <= 0 {
 		limit = 10
 	}
 
-	return usecase.repository.List(ctx, limit, offset)
+	filter := domain.UserFilter{
+		Search:   strings.TrimSpace(request.Search),
+		Role:     strings.TrimSpace(request.Role),
+		Status:   request.Status,
+		IsActive: request.IsActive,
+	}
+
+	sort := domain.UserSort{Field: request.SortBy}
+	if sort.Field == "" {
+		sort.Field = domain.UserSortCreatedAt
+	}
+
+	switch sort.Field {
+	case domain.UserSortCreatedAt, domain.UserSortUpdatedAt:
+		sort.Descending = request.SortOrder != "asc"
+	default:
+		sort.Descending = request.SortOrder == "desc"
+	}
+
+	page := pagination.Page{
+		Limit:     limit,
+		Offset:    request.Offset,
+		Cursor:    request.Cursor,
+		WithTotal: request.IncludeTotal,
+	}
+
+	return usecase.repository.List(ctx, filter, sort, page)
+}
+
+// RolePermissions returns what a user given roleID could do, so the caller can
+// be kept from assigning more than they hold themselves
+func (usecase *UserUsecase) RolePermissions(ctx context.Context, roleID string) ([]string, error) {
+	return usecase.repository.RolePermissions(ctx, roleID)
 }
 
 func (usecase *UserUsecase) GetByID(ctx context.Context, id string) (*domain.User, error) {
@@ -37,6 +119,13 @@ func (usecase *UserUsecase) GetByID(ctx context.Context, id string) (*domain.Use
 }
 
 func (usecase *UserUsecase) Create(ctx context.Context, request *dto.CreateUserDto) (*domain.User, error) {
+	if usecase.policy != nil {
+		violations := usecase.policy.Validate(request.Password, authDomain.PasswordOwner{Name: request.Name, Email: request.Email})
+
+		if len(violations) > 0 {
+			return nil, &authDomain.WeakPasswordError{Violations: violations}
+		}
+	}
 
 	hash, err := usecase.hasher.HashPassword(request.Password)
 
@@ -52,10 +141,31 @@ func (usecase *UserUsecase) Create(ctx context.Context, request *dto.CreateUserD
 		RoleID:   request.RoleID,
 	}
 
+	// Inside an organization the requested role is the member's role there; it
+	// must not grant anything outside of it
+	if _, ok := tenant.OrganizationFromContext(ctx); ok {
+		params.MemberRoleID = request.RoleID
+		params.RoleID = string(authDomain.RoleIDUser)
+	}
+
 	return usecase.repository.Create(ctx, params)
 }
 
 func (usecase *UserUsecase) Update(ctx context.Context, request *dto.UpdateUserDto) (*domain.User, error) {
+	// Name and email belong to the account, which other organizations share.
+	// An organization may only change the member's role in it.
+	if _, ok := tenant.OrganizationFromContext(ctx); ok && (request.Name != nil || request.Email != nil) {
+		return nil, authDomain.ErrProfileInOrganization
+	}
+
+	target, err := usecase.repository.FindByID(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := usecase.ensureManageable(ctx, target); err != nil {
+		return nil, err
+	}
 
 	params := &domain.UserUpdate{
 		ID:     request.ID,
@@ -68,5 +178,38 @@ func (usecase *UserUsecase) Update(ctx context.Context, request *dto.UpdateUserD
 }
 
 func (usecase *UserUsecase) Delete(ctx context.Context, user *domain.User) error {
-	return usecase.repository.Delete(ctx, user)
+	if err := usecase.ensureManageable(ctx, user); err != nil {
+		return err
+	}
+
+	if err := usecase.repository.Delete(ctx, user); err != nil {
+		return err
+	}
+
+	// Inside an organization only the membership is gone: the user keeps their
+	// sessions everywhere else
+	if organizationID, ok := tenant.OrganizationFromContext(ctx); ok {
+		return usecase.tokenRevoker.RevokeOrganizationAccessTokens(ctx, user.ID, organizationID, time.Now())
+	}
+
+	// Access tokens of a deleted user must stop working right away, not when they expire
+	return usecase.tokenRevoker.RevokeUserAccessTokens(ctx, user.ID, time.Now())
+}
+
+// ensureManageable refuses a target whose current role grants a permission the
+// caller does not hold, so no one edits, demotes, locks out or deletes a user
+// more privileged than themselves
+func (usecase *UserUsecase) ensureManageable(ctx context.Context, target *domain.User) error {
+	permissions, err := usecase.repository.RolePermissions(ctx, target.RoleID)
+	if err != nil {
+		return err
+	}
+
+	for _, permission := range permissions {
+		if !middleware.HasPermission(ctx, permission) {
+			return authDomain.ErrUserOutranksCaller
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	authDomain "github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/modules/user/domain"
+	"github.com/nassabiq/golang-template/internal/modules/user/dto"
+	"github.com/nassabiq/golang-template/internal/shared/pagination"
+	"github.com/nassabiq/golang-template/internal/shared/tenant"
+)
+
+type mockListRepository struct {
+	domain.UserRepository
+	filter domain.UserFilter
+	sort   domain.UserSort
+	page   pagination.Page
+}
+
+func (m *mockListRepository) List(ctx context.Context, filter domain.UserFilter, sort domain.UserSort, page pagination.Page) ([]domain.User, *pagination.Result, error) {
+	m.filter, m.sort, m.page = filter, sort, page
+	return nil, &pagination.Result{}, nil
+}
+
+// Test List passes the filters on and fills in the default page and sort
+func TestUserUsecase_List(t *testing.T) {
+	isActive := true
+	cursor := &pagination.Cursor{Order: "u.created_at desc", Value: "2024-01-01T00:00:00Z", ID: "user-1"}
+
+	tests := []struct {
+		name       string
+		request    dto.ListUserDto
+		wantFilter domain.UserFilter
+		wantSort   domain.UserSort
+		wantPage   pagination.Page
+	}{
+		{
+			name:     "defaults - newest first",
+			request:  dto.ListUserDto{},
+			wantSort: domain.UserSort{Field: domain.UserSortCreatedAt, Descending: true},
+			wantPage: pagination.Page{Limit: 10},
+		},
+		{
+			name:       "filters are trimmed",
+			request:    dto.ListUserDto{Limit: 25, Search: "  budi ", Role: " admin ", Status: "suspended", IsActive: &isActive},
+			wantFilter: domain.UserFilter{Search: "budi", Role: "admin", Status: "suspended", IsActive: &isActive},
+			wantSort:   domain.UserSort{Field: domain.UserSortCreatedAt, Descending: true},
+			wantPage:   pagination.Page{Limit: 25},
+		},
+		{
+			name:     "name sorts ascending by default",
+			request:  dto.ListUserDto{SortBy: domain.UserSortName},
+			wantSort: domain.UserSort{Field: domain.UserSortName},
+			wantPage: pagination.Page{Limit: 10},
+		},
+		{
+			name:     "explicit direction",
+			request:  dto.ListUserDto{SortBy: domain.UserSortEmail, SortOrder: "desc"},
+			wantSort: domain.UserSort{Field: domain.UserSortEmail, Descending: true},
+			wantPage: pagination.Page{Limit: 10},
+		},
+		{
+			name:     "oldest first",
+			request:  dto.ListUserDto{SortOrder: "asc"},
+			wantSort: domain.UserSort{Field: domain.UserSortCreatedAt},
+			wantPage: pagination.Page{Limit: 10},
+		},
+		{
+			name:     "cursor and total",
+			request:  dto.ListUserDto{Offset: 20, Cursor: cursor, IncludeTotal: true},
+			wantSort: domain.UserSort{Field: domain.UserSortCreatedAt, Descending: true},
+			wantPage: pagination.Page{Limit: 10, Offset: 20, Cursor: cursor, WithTotal: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockListRepository{}
+			uc := NewUserUsecase(repo, nil)
+
+			if _, _, err := uc.List(context.Background(), &tt.request); err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+			if repo.filter != tt.wantFilter {
+				t.Errorf("List() filter = %+v, want %+v", repo.filter, tt.wantFilter)
+			}
+			if repo.sort != tt.wantSort {
+				t.Errorf("List() sort = %+v, want %+v", repo.sort, tt.wantSort)
+			}
+			if repo.page != tt.wantPage {
+				t.Errorf("List() page = %+v, want %+v", repo.page, tt.wantPage)
+			}
+		})
+	}
+}
+
+type mockUpdateRepository struct {
+	domain.UserRepository
+	updated *domain.UserUpdate
+}
+
+func (m *mockUpdateRepository) FindByID(ctx context.Context, id string) (*domain.User, error) {
+	return &domain.User{ID: id}, nil
+}
+
+func (m *mockUpdateRepository) RolePermissions(ctx context.Context, roleID string) ([]string, error) {
+	return nil, nil
+}
+
+func (m *mockUpdateRepository) Update(ctx context.Context, request *domain.UserUpdate) (*domain.User, error) {
+	m.updated = request
+	return &domain.User{ID: request.ID}, nil
+}
+
+// Test Update keeps an organization from changing the shared account
+func TestUserUsecase_Update_Organization(t *testing.T) {
+	acme := tenant.WithOrganization(context.Background(), "org-acme")
+	name := "Mallory"
+	email := "mallory@example.com"
+	roleID := "role-1"
+
+	repo := &mockUpdateRepository{}
+	uc := NewUserUsecase(repo, nil)
+
+	if _, err := uc.Update(acme, &dto.UpdateUserDto{ID: "user-1", Name: &name}); !errors.Is(err, authDomain.ErrProfileInOrganization) {
+		t.Errorf("Update() name error = %v, want %v", err, authDomain.ErrProfileInOrganization)
+	}
+	if _, err := uc.Update(acme, &dto.UpdateUserDto{ID: "user-1", Email: &email}); !errors.Is(err, authDomain.ErrProfileInOrganization) {
+		t.Errorf("Update() email error = %v, want %v", err, authDomain.ErrProfileInOrganization)
+	}
+	if repo.updated != nil {
+		t.Fatalf("Update() reached the repository: %+v", repo.updated)
+	}
+
+	if _, err := uc.Update(acme, &dto.UpdateUserDto{ID: "user-1", RoleID: &roleID}); err != nil {
+		t.Fatalf("Update() role error = %v", err)
+	}
+	if repo.updated == nil || *repo.updated.RoleID != roleID {
+		t.Errorf("Update() role = %+v, want %s", repo.updated, roleID)
+	}
+}
+
+type mockDeleteRepository struct {
+	domain.UserRepository
+}
+
+func (m *mockDeleteRepository) Delete(ctx context.Context, user *domain.User) error {
+	return nil
+}
+
+func (m *mockDeleteRepository) RolePermissions(ctx context.Context, roleID string) ([]string, error) {
+	return nil, nil
+}
+
+// Test Delete only logs the user out where they were removed
+func TestUserUsecase_Delete(t *testing.T) {
+	user := &domain.User{ID: "user-1"}
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		wantRevoked string
+	}{
+		{name: "account", ctx: context.Background(), wantRevoked: "user-1"},
+		{name: "membership", ctx: tenant.WithOrganization(context.Background(), "org-acme"), wantRevoked: "org-acme/user-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revoker := &mockTokenRevoker{revoked: make(map[string]time.Time)}
+			uc := NewUserUsecase(&mockDeleteRepository{}, nil)
+			uc.SetTokenRevoker(revoker)
+
+			if err := uc.Delete(tt.ctx, user); err != nil {
+				t.Fatalf("Delete() error = %v", err)
+			}
+			if _, ok := revoker.revoked[tt.wantRevoked]; !ok || len(revoker.revoked) != 1 {
+				t.Errorf("Delete() revoked %v, want only %s", revoker.revoked, tt.wantRevoked)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package metadata
+
+import (
+	"context"
+	"encoding/base64"
+	"net/url"
+	"strings"
+
+	grpcmd "google.golang.org/grpc/metadata"
+)
+
+// BasicAuth returns the credentials of an "Authorization: Basic" header.
+// Both parts are form-url-decoded as OAuth2 clients encode them (RFC 6749 section 2.3.1).
+func BasicAuth(ctx context.Context) (username, password string, ok bool) {
+	md, found := grpcmd.FromIncomingContext(ctx)
+	if !found {
+		return "", "", false
+	}
+
+	encoded, found := strings.CutPrefix(first(md, "authorization"), "Basic ")
+	if !found {
+		return "", "", false
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return "", "", false
+	}
+
+	username, password, found = strings.Cut(string(decoded), ":")
+	if !found {
+		return "", "", false
+	}
+
+	username, err = url.QueryUnescape(username)
+	if err != nil {
+		return "", "", false
+	}
+	password, err = url.QueryUnescape(password)
+	if err != nil {
+		return "", "", false
+	}
+
+	return username, password, true
+}
@@ -0,0 +1,119 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+
+	grpcmd "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// trustedProxies are the hops whose x-forwarded-for is believed. By default
+// only loopback: the gateway dials the gRPC server on localhost.
+var trustedProxies = []netip.Prefix{
+	netip.MustParsePrefix("127.0.0.0/8"),
+	netip.MustParsePrefix("::1/128"),
+}
+
+// SetTrustedProxies replaces the proxies allowed to report the client address,
+// given as CIDRs or single addresses. It must be called before serving.
+func SetTrustedProxies(proxies []string) error {
+	prefixes := make([]netip.Prefix, 0, len(proxies))
+
+	for _, proxy := range proxies {
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(proxy)
+			if addrErr != nil {
+				return fmt.Errorf("invalid trusted proxy %q", proxy)
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	trustedProxies = prefixes
+	return nil
+}
+
+// ClientInfo returns the caller's user agent and IP address.
+// Requests coming through grpc-gateway carry the original user agent in
+// grpcgateway-user-agent; direct gRPC calls fall back to the user-agent header.
+// The IP address is the peer's, unless the peer is a trusted proxy: every hop
+// appends the address it got the request from to x-forwarded-for, so the client
+// is the right-most entry not added by a trusted proxy. Entries left of it are
+// whatever the client sent and are never believed.
+func ClientInfo(ctx context.Context) (userAgent string, ipAddress string) {
+	var client netip.Addr
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ipAddress = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ipAddress); err == nil {
+			ipAddress = host
+		}
+		client, _ = netip.ParseAddr(ipAddress)
+	}
+
+	md, ok := grpcmd.FromIncomingContext(ctx)
+	if !ok {
+		return userAgent, ipAddress
+	}
+
+	userAgent = first(md, "grpcgateway-user-agent", "user-agent")
+
+	hops := strings.Split(strings.Join(md.Get("x-forwarded-for"), ","), ",")
+	for i := len(hops) - 1; i >= 0 && isTrustedProxy(client); i-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+		client = hop
+	}
+
+	if client.IsValid() {
+		ipAddress = client.Unmap().String()
+	}
+
+	return userAgent, ipAddress
+}
+
+func isTrustedProxy(addr netip.Addr) bool {
+	if !addr.IsValid() {
+		return false
+	}
+
+	addr = addr.Unmap()
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// DeviceCookie holds the device id of a browser client
+const DeviceCookie = "device_id"
+
+// DeviceID returns the opaque id the client keeps for its device, sent as the
+// X-Device-Id header or, by browsers, the device_id cookie
+func DeviceID(ctx context.Context) string {
+	if md, ok := grpcmd.FromIncomingContext(ctx); ok {
+		if deviceID := first(md, "x-device-id"); deviceID != "" {
+			return deviceID
+		}
+	}
+
+	return Cookie(ctx, DeviceCookie)
+}
+
+func first(md grpcmd.MD, keys ...string) string {
+	for _, key := range keys {
+		if values := md.Get(key); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+package metadata
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	grpcmd "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// Test ClientInfo only believes x-forwarded-for entries added by trusted proxies
+func TestClientInfo_IPAddress(t *testing.T) {
+	if err := SetTrustedProxies([]string{"127.0.0.0/8", "10.0.0.0/8", "::1"}); err != nil {
+		t.Fatalf("SetTrustedProxies() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		peer      net.IP
+		forwarded []string
+		want      string
+	}{
+		{name: "direct call", peer: net.ParseIP("198.51.100.2"), want: "198.51.100.2"},
+		{
+			name:      "direct call claiming another address",
+			peer:      net.ParseIP("198.51.100.2"),
+			forwarded: []string{"203.0.113.7"},
+			want:      "198.51.100.2",
+		},
+		{
+			name:      "through the gateway",
+			peer:      net.ParseIP("127.0.0.1"),
+			forwarded: []string{"203.0.113.7"},
+			want:      "203.0.113.7",
+		},
+		{
+			name:      "client spoofing through the gateway",
+			peer:      net.ParseIP("127.0.0.1"),
+			forwarded: []string{"192.0.2.1, 203.0.113.7"},
+			want:      "203.0.113.7",
+		},
+		{
+			name:      "behind a trusted load balancer",
+			peer:      net.ParseIP("::1"),
+			forwarded: []string{"192.0.2.1, 203.0.113.7", "10.1.2.3"},
+			want:      "203.0.113.7",
+		},
+		{
+			name:      "garbage hop",
+			peer:      net.ParseIP("127.0.0.1"),
+			forwarded: []string{"not-an-ip"},
+			want:      "127.0.0.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: tt.peer, Port: 50312}})
+			md := grpcmd.MD{}
+			for _, forwarded := range tt.forwarded {
+				md.Append("x-forwarded-for", forwarded)
+			}
+			ctx = grpcmd.NewIncomingContext(ctx, md)
+
+			if _, got := ClientInfo(ctx); got != tt.want {
+				t.Errorf("ClientInfo() ip = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// Test SetTrustedProxies accepts CIDRs and single addresses
+func TestSetTrustedProxies(t *testing.T) {
+	if err := SetTrustedProxies([]string{"10.0.0.0/8", "192.0.2.10", "fd00::/8"}); err != nil {
+		t.Errorf("SetTrustedProxies() error = %v", err)
+	}
+	if err := SetTrustedProxies([]string{"proxy.internal"}); err == nil {
+		t.Errorf("SetTrustedProxies() accepted a host name")
+	}
+}
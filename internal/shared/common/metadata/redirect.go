@@ -0,0 +1,48 @@
+package metadata
+
+import (
+	"context"
+	"net/http"
+
+	"google.golang.org/grpc"
+	grpcmd "google.golang.org/grpc/metadata"
+)
+
+// LocationHeader makes the gateway answer with a 302 redirect to its value
+const LocationHeader = "location"
+
+// SetCookieHeader is mapped to the HTTP Set-Cookie header by the gateway
+const SetCookieHeader = "set-cookie"
+
+// SetRedirect asks the gateway to redirect the browser to url
+func SetRedirect(ctx context.Context, url string) {
+	_ = grpc.SetHeader(ctx, grpcmd.Pairs(LocationHeader, url))
+}
+
+// SetCookie asks the gateway to set cookie on the browser
+func SetCookie(ctx context.Context, cookie *http.Cookie) {
+	_ = grpc.SetHeader(ctx, grpcmd.Pairs(SetCookieHeader, cookie.String()))
+}
+
+// Cookie returns the value of the named cookie sent through the gateway
+func Cookie(ctx context.Context, name string) string {
+	md, ok := grpcmd.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, header := range md.Get("grpcgateway-cookie") {
+		cookies, err := http.ParseCookie(header)
+		if err != nil {
+			continue
+		}
+
+		for _, cookie := range cookies {
+			if cookie.Name == name {
+				return cookie.Value
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,24 @@
+package metadata
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	grpcmd "google.golang.org/grpc/metadata"
+)
+
+// RetryAfterHeader is mapped to the HTTP Retry-After header by the gateway
+const RetryAfterHeader = "retry-after"
+
+// SetRetryAfter tells the caller how long to wait, in whole seconds rounded up
+func SetRetryAfter(ctx context.Context, wait time.Duration) {
+	seconds := int64(math.Ceil(wait.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+
+	_ = grpc.SetHeader(ctx, grpcmd.Pairs(RetryAfterHeader, strconv.FormatInt(seconds, 10)))
+}
@@ -27,6 +27,13 @@ func Validation(msg ...string) *commonpb.MetaData {
 	return Error(422, ResolveMessage("validation error", msg))
 }
 
+// ValidationErrors is a 422 that also lists each failed rule
+func ValidationErrors(msg string, errs ...*commonpb.FieldError) *commonpb.MetaData {
+	metadata := Validation(msg)
+	metadata.Errors = errs
+	return metadata
+}
+
 func Conflict(msg ...string) *commonpb.MetaData {
 	return Error(409, ResolveMessage("conflict", msg))
 }
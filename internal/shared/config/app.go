@@ -3,6 +3,9 @@ package app
 import (
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -12,8 +15,56 @@ type Config struct {
 	GRPCPort    string
 	HTTPPort    string
 	DatabaseUrl string
-	JWTSecret   string
+	JWTKeysDir  string
 	NatsURL     string
+	MfaIssuer   string
+	// Base64 encoded 32 byte key encrypting TOTP secrets at rest
+	MfaSecretKey string
+
+	// How often the JWT key directory is re-read to pick up rotated keys
+	JWTKeysReloadInterval time.Duration
+	// How long access token revocation lookups are cached in process
+	TokenRevocationCacheTTL time.Duration
+	// How often the role → permission map is reloaded from the database
+	RBACCacheRefreshInterval time.Duration
+	// Refuse logins of accounts that did not verify their email address
+	RequireEmailVerification bool
+	// Calling code for phone numbers entered in national format
+	PhoneDefaultCountryCode string
+	// OpenID Connect providers users can sign in with
+	OidcProviders []OidcProviderConfig
+	// Passkeys are disabled while WebauthnRPID is empty
+	WebauthnRPID    string
+	WebauthnRPName  string
+	WebauthnOrigins []string
+	// Proxies (CIDRs or addresses) trusted to report the client address in
+	// X-Forwarded-For; the gateway reaches the gRPC server over loopback
+	TrustedProxies []string
+	// Key signing pagination cursors, shared by every instance behind the same API
+	PaginationCursorSecret string
+
+	// Brute-force protection, 0 disables the account or IP check
+	LoginMaxFailedAttempts  int
+	LoginLockoutDuration    time.Duration
+	LoginMaxLockoutDuration time.Duration
+	LoginMaxIPFailures      int
+	LoginIPWindow           time.Duration
+
+	// Password policy for registration, resets and admin created users
+	PasswordMinLength           int
+	PasswordMaxLength           int
+	PasswordMinCharacterClasses int
+	PasswordRejectPersonalInfo  bool
+	PasswordRejectCommon        bool
+	// A new password must differ from this many previous ones
+	PasswordHistorySize int
+
+	// Algorithm for new password hashes, older hashes are upgraded on login
+	PasswordHashAlgorithm string
+	Argon2Memory          uint32
+	Argon2Iterations      uint32
+	Argon2Parallelism     uint8
+	BcryptCost            int
 }
 
 func Load() *Config {
@@ -27,9 +78,86 @@ func Load() *Config {
 		GRPCPort:    getEnv("GRPC_PORT", "8081"),
 		HTTPPort:    getEnv("HTTP_PORT", "8080"),
 		DatabaseUrl: getEnv("DB_DSN", ""),
-		JWTSecret:   getEnv("JWT_SECRET", ""),
+		JWTKeysDir:  getEnv("JWT_KEYS_DIR", "keys"),
 		NatsURL:     getEnv("NATS_URL", "nats://localhost:4222"),
+		MfaIssuer:   getEnv("MFA_ISSUER", "Golang Template"),
+
+		MfaSecretKey: getEnv("MFA_SECRET_KEY", ""),
+
+		JWTKeysReloadInterval:   getDuration("JWT_KEYS_RELOAD_INTERVAL", time.Minute),
+		TokenRevocationCacheTTL: getDuration("TOKEN_REVOCATION_CACHE_TTL", 30*time.Second),
+
+		RBACCacheRefreshInterval: getDuration("RBAC_CACHE_REFRESH_INTERVAL", 30*time.Second),
+
+		RequireEmailVerification: getBool("REQUIRE_EMAIL_VERIFICATION", false),
+		PhoneDefaultCountryCode:  getEnv("PHONE_DEFAULT_COUNTRY_CODE", "62"),
+		OidcProviders:            getOidcProviders(),
+		WebauthnRPID:             getEnv("WEBAUTHN_RP_ID", ""),
+		WebauthnRPName:           getEnv("WEBAUTHN_RP_NAME", "Golang Template"),
+		WebauthnOrigins:          getList("WEBAUTHN_ORIGINS"),
+		TrustedProxies:           getListOr("TRUSTED_PROXIES", []string{"127.0.0.0/8", "::1/128"}),
+		PaginationCursorSecret:   getEnv("PAGINATION_CURSOR_SECRET", ""),
+
+		LoginMaxFailedAttempts:  getInt("LOGIN_MAX_FAILED_ATTEMPTS", 5),
+		LoginLockoutDuration:    getDuration("LOGIN_LOCKOUT_DURATION", time.Minute),
+		LoginMaxLockoutDuration: getDuration("LOGIN_MAX_LOCKOUT_DURATION", 24*time.Hour),
+		LoginMaxIPFailures:      getInt("LOGIN_MAX_IP_FAILURES", 20),
+		LoginIPWindow:           getDuration("LOGIN_IP_WINDOW", 15*time.Minute),
+
+		PasswordMinLength:           getInt("PASSWORD_MIN_LENGTH", 10),
+		PasswordMaxLength:           getInt("PASSWORD_MAX_LENGTH", 72),
+		PasswordMinCharacterClasses: getInt("PASSWORD_MIN_CHARACTER_CLASSES", 3),
+		PasswordRejectPersonalInfo:  getBool("PASSWORD_REJECT_PERSONAL_INFO", true),
+		PasswordRejectCommon:        getBool("PASSWORD_REJECT_COMMON", true),
+		PasswordHistorySize:         getInt("PASSWORD_HISTORY_SIZE", 5),
+
+		PasswordHashAlgorithm: getEnv("PASSWORD_HASH_ALGORITHM", "argon2id"),
+		Argon2Memory:          uint32(getInt("ARGON2_MEMORY_KIB", 64*1024)),
+		Argon2Iterations:      uint32(getInt("ARGON2_ITERATIONS", 3)),
+		Argon2Parallelism:     uint8(getInt("ARGON2_PARALLELISM", 2)),
+		BcryptCost:            getInt("BCRYPT_COST", 10),
+	}
+}
+
+// OidcProviderConfig is an OpenID Connect provider, served under /auth/oidc/{Name}
+type OidcProviderConfig struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// getOidcProviders reads the providers listed in OIDC_PROVIDERS, each configured
+// by OIDC_<NAME>_ISSUER, _CLIENT_ID, _CLIENT_SECRET, _REDIRECT_URL and _SCOPES
+func getOidcProviders() []OidcProviderConfig {
+	var providers []OidcProviderConfig
+
+	for _, name := range strings.Split(os.Getenv("OIDC_PROVIDERS"), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		provider := OidcProviderConfig{
+			Name:         name,
+			Issuer:       os.Getenv(prefix + "ISSUER"),
+			ClientID:     os.Getenv(prefix + "CLIENT_ID"),
+			ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+			RedirectURL:  os.Getenv(prefix + "REDIRECT_URL"),
+			Scopes:       strings.Fields(strings.ReplaceAll(os.Getenv(prefix+"SCOPES"), ",", " ")),
+		}
+
+		if provider.Issuer == "" || provider.ClientID == "" || provider.RedirectURL == "" {
+			log.Fatalf("OIDC provider %s needs %sISSUER, %sCLIENT_ID and %sREDIRECT_URL", name, prefix, prefix, prefix)
+		}
+
+		providers = append(providers, provider)
 	}
+
+	return providers
 }
 
 func getEnv(key, fallback string) string {
@@ -39,3 +167,66 @@ func getEnv(key, fallback string) string {
 
 	return fallback
 }
+
+func getDuration(key string, fallback time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		log.Fatalf("invalid duration for %s: %v", key, err)
+	}
+
+	return duration
+}
+
+func getBool(key string, fallback bool) bool {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	result, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Fatalf("invalid boolean for %s: %v", key, err)
+	}
+
+	return result
+}
+
+func getInt(key string, fallback int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+
+	result, err := strconv.Atoi(value)
+	if err != nil {
+		log.Fatalf("invalid integer for %s: %v", key, err)
+	}
+
+	return result
+}
+
+// getListOr reads a comma separated list, falling back when it is unset
+func getListOr(key string, fallback []string) []string {
+	if _, ok := os.LookupEnv(key); !ok {
+		return fallback
+	}
+	return getList(key)
+}
+
+// getList reads a comma separated list, skipping empty entries
+func getList(key string) []string {
+	var result []string
+
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
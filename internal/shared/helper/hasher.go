@@ -20,7 +20,22 @@ func (b *BcryptHasher) VerifyPassword(password, hash string) bool {
 	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
 }
 
+// NeedsRehash reports hashes made with another cost
+func (b *BcryptHasher) NeedsRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err == nil && cost != bcrypt.DefaultCost
+}
+
 func (b *BcryptHasher) GenerateRandomToken() (string, error) {
+	return generateRandomToken()
+}
+
+// HashToken creates a SHA256 hash of a token (deterministic, for token lookup)
+func (b *BcryptHasher) HashToken(token string) string {
+	return hashToken(token)
+}
+
+func generateRandomToken() (string, error) {
 	bytes := make([]byte, 32)
 	if _, err := rand.Read(bytes); err != nil {
 		return "", err
@@ -29,8 +44,7 @@ func (b *BcryptHasher) GenerateRandomToken() (string, error) {
 	return base64.RawURLEncoding.EncodeToString(bytes), nil
 }
 
-// HashToken creates a SHA256 hash of a token (deterministic, for token lookup)
-func (b *BcryptHasher) HashToken(token string) string {
+func hashToken(token string) string {
 	hash := sha256.Sum256([]byte(token))
 	return hex.EncodeToString(hash[:])
 }
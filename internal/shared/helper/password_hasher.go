@@ -0,0 +1,186 @@
+package helper
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	AlgorithmArgon2id = "argon2id"
+	AlgorithmBcrypt   = "bcrypt"
+)
+
+var errInvalidArgon2Hash = errors.New("invalid argon2id hash")
+
+// Argon2Params tune argon2id, Memory is in KiB
+type Argon2Params struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultArgon2Params follow the OWASP recommendation (64 MiB, 3 passes)
+var DefaultArgon2Params = Argon2Params{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+type PasswordHasherConfig struct {
+	// Algorithm for new hashes: argon2id or bcrypt
+	Algorithm  string
+	Argon2     Argon2Params
+	BcryptCost int
+}
+
+// PasswordHasher hashes new passwords with the configured algorithm and
+// verifies both argon2id (PHC string format) and bcrypt hashes, detecting the
+// algorithm from the stored hash so both keep working during a migration.
+type PasswordHasher struct {
+	config PasswordHasherConfig
+}
+
+func NewPasswordHasher(config PasswordHasherConfig) (*PasswordHasher, error) {
+	switch config.Algorithm {
+	case AlgorithmArgon2id:
+		if config.Argon2.Memory == 0 || config.Argon2.Iterations == 0 || config.Argon2.Parallelism == 0 {
+			return nil, fmt.Errorf("argon2id memory, iterations and parallelism must be set")
+		}
+		if config.Argon2.SaltLength == 0 {
+			config.Argon2.SaltLength = DefaultArgon2Params.SaltLength
+		}
+		if config.Argon2.KeyLength == 0 {
+			config.Argon2.KeyLength = DefaultArgon2Params.KeyLength
+		}
+	case AlgorithmBcrypt:
+		if config.BcryptCost < bcrypt.MinCost || config.BcryptCost > bcrypt.MaxCost {
+			return nil, fmt.Errorf("bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported password hash algorithm %q", config.Algorithm)
+	}
+
+	return &PasswordHasher{config: config}, nil
+}
+
+func (h *PasswordHasher) HashPassword(password string) (string, error) {
+	if h.config.Algorithm == AlgorithmBcrypt {
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), h.config.BcryptCost)
+		return string(hash), err
+	}
+
+	params := h.config.Argon2
+	salt := make([]byte, params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version,
+		params.Memory,
+		params.Iterations,
+		params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (h *PasswordHasher) VerifyPassword(password, hash string) bool {
+	if !strings.HasPrefix(hash, "$argon2id$") {
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+	}
+
+	params, salt, key, err := decodeArgon2Hash(hash)
+	if err != nil {
+		return false
+	}
+
+	candidate := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+
+	return subtle.ConstantTimeCompare(key, candidate) == 1
+}
+
+// NeedsRehash reports hashes made with another algorithm or other parameters
+// than the configured ones, so they can be upgraded on the next login
+func (h *PasswordHasher) NeedsRehash(hash string) bool {
+	if strings.HasPrefix(hash, "$argon2id$") {
+		if h.config.Algorithm != AlgorithmArgon2id {
+			return true
+		}
+
+		params, salt, _, err := decodeArgon2Hash(hash)
+		if err != nil {
+			return false
+		}
+
+		current := h.config.Argon2
+		return params.Memory != current.Memory ||
+			params.Iterations != current.Iterations ||
+			params.Parallelism != current.Parallelism ||
+			params.KeyLength != current.KeyLength ||
+			uint32(len(salt)) != current.SaltLength
+	}
+
+	cost, err := bcrypt.Cost([]byte(hash))
+	if err != nil {
+		return false
+	}
+
+	return h.config.Algorithm != AlgorithmBcrypt || cost != h.config.BcryptCost
+}
+
+func (h *PasswordHasher) GenerateRandomToken() (string, error) {
+	return generateRandomToken()
+}
+
+// HashToken creates a SHA256 hash of a token (deterministic, for token lookup)
+func (h *PasswordHasher) HashToken(token string) string {
+	return hashToken(token)
+}
+
+// decodeArgon2Hash parses $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>
+func decodeArgon2Hash(hash string) (Argon2Params, []byte, []byte, error) {
+	var params Argon2Params
+
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != AlgorithmArgon2id {
+		return params, nil, nil, errInvalidArgon2Hash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, errInvalidArgon2Hash
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, errInvalidArgon2Hash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, errInvalidArgon2Hash
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, errInvalidArgon2Hash
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+
+	return params, salt, key, nil
+}
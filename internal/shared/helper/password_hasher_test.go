@@ -0,0 +1,109 @@
+package helper
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// fastArgon2Params keep the tests quick, production uses DefaultArgon2Params
+var fastArgon2Params = Argon2Params{Memory: 1024, Iterations: 1, Parallelism: 1}
+
+func newTestHasher(t *testing.T, config PasswordHasherConfig) *PasswordHasher {
+	t.Helper()
+
+	hasher, err := NewPasswordHasher(config)
+	if err != nil {
+		t.Fatalf("NewPasswordHasher() error = %v", err)
+	}
+	return hasher
+}
+
+// Test argon2id hashes use the PHC string format and verify
+func TestPasswordHasher_Argon2id(t *testing.T) {
+	hasher := newTestHasher(t, PasswordHasherConfig{Algorithm: AlgorithmArgon2id, Argon2: fastArgon2Params})
+
+	hash, err := hasher.HashPassword("correct horse")
+	if err != nil {
+		t.Fatalf("HashPassword() error = %v", err)
+	}
+	if !strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$") {
+		t.Errorf("HashPassword() = %s, want PHC argon2id format", hash)
+	}
+
+	if !hasher.VerifyPassword("correct horse", hash) {
+		t.Errorf("VerifyPassword() rejected the correct password")
+	}
+	if hasher.VerifyPassword("wrong horse", hash) {
+		t.Errorf("VerifyPassword() accepted a wrong password")
+	}
+	if hasher.NeedsRehash(hash) {
+		t.Errorf("NeedsRehash() = true for a current hash")
+	}
+}
+
+// Test bcrypt hashes keep verifying and are flagged for an upgrade
+func TestPasswordHasher_Migration(t *testing.T) {
+	argon := newTestHasher(t, PasswordHasherConfig{Algorithm: AlgorithmArgon2id, Argon2: fastArgon2Params})
+
+	legacy, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !argon.VerifyPassword("correct horse", string(legacy)) {
+		t.Errorf("VerifyPassword() rejected a bcrypt hash")
+	}
+	if !argon.NeedsRehash(string(legacy)) {
+		t.Errorf("NeedsRehash() = false for a bcrypt hash under argon2id")
+	}
+
+	// raising the argon2id parameters flags hashes made with the old ones
+	oldHash, _ := argon.HashPassword("correct horse")
+	stronger := newTestHasher(t, PasswordHasherConfig{Algorithm: AlgorithmArgon2id, Argon2: Argon2Params{Memory: 2048, Iterations: 1, Parallelism: 1}})
+	if !stronger.VerifyPassword("correct horse", oldHash) {
+		t.Errorf("VerifyPassword() rejected a hash with older parameters")
+	}
+	if !stronger.NeedsRehash(oldHash) {
+		t.Errorf("NeedsRehash() = false for older argon2id parameters")
+	}
+
+	// bcrypt with another cost
+	bcryptHasher := newTestHasher(t, PasswordHasherConfig{Algorithm: AlgorithmBcrypt, BcryptCost: 5})
+	if !bcryptHasher.NeedsRehash(string(legacy)) {
+		t.Errorf("NeedsRehash() = false for an older bcrypt cost")
+	}
+	if !bcryptHasher.NeedsRehash(oldHash) {
+		t.Errorf("NeedsRehash() = false for argon2id when bcrypt is configured")
+	}
+}
+
+// Test malformed argon2id hashes never verify
+func TestPasswordHasher_InvalidArgon2Hash(t *testing.T) {
+	hasher := newTestHasher(t, PasswordHasherConfig{Algorithm: AlgorithmArgon2id, Argon2: fastArgon2Params})
+
+	for _, hash := range []string{
+		"$argon2id$v=19$m=1024,t=1,p=1$c2FsdA",
+		"$argon2id$v=18$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=x,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=1,p=1$!!!$a2V5",
+	} {
+		if hasher.VerifyPassword("password", hash) {
+			t.Errorf("VerifyPassword() accepted %s", hash)
+		}
+	}
+}
+
+// Test NewPasswordHasher rejects unusable configs
+func TestNewPasswordHasher_InvalidConfig(t *testing.T) {
+	for _, config := range []PasswordHasherConfig{
+		{Algorithm: "md5"},
+		{Algorithm: AlgorithmArgon2id},
+		{Algorithm: AlgorithmBcrypt, BcryptCost: 99},
+	} {
+		if _, err := NewPasswordHasher(config); err == nil {
+			t.Errorf("NewPasswordHasher(%+v) expected error", config)
+		}
+	}
+}
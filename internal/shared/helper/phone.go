@@ -0,0 +1,35 @@
+package helper
+
+import "strings"
+
+// NormalizePhone converts a phone number to E.164 (+628123456789). Numbers in
+// national format (08123456789) get defaultCountryCode, without the plus, in
+// place of the leading zero. ok is false when the result is not a plausible
+// E.164 number.
+func NormalizePhone(raw, defaultCountryCode string) (phone string, ok bool) {
+	digits := strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "").Replace(strings.TrimSpace(raw))
+
+	switch {
+	case strings.HasPrefix(digits, "+"):
+		digits = digits[1:]
+	case strings.HasPrefix(digits, "00"):
+		digits = digits[2:]
+	case strings.HasPrefix(digits, "0") && defaultCountryCode != "":
+		digits = defaultCountryCode + digits[1:]
+	default:
+		return "", false
+	}
+
+	// E.164 allows at most 15 digits and country codes never start with 0
+	if len(digits) < 8 || len(digits) > 15 || digits[0] == '0' {
+		return "", false
+	}
+
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return "", false
+		}
+	}
+
+	return "+" + digits, true
+}
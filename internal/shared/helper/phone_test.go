@@ -0,0 +1,32 @@
+package helper
+
+import "testing"
+
+// Test NormalizePhone accepts international and national formats
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		raw    string
+		want   string
+		wantOk bool
+	}{
+		{raw: "+62 812-3456-7890", want: "+6281234567890", wantOk: true},
+		{raw: "0062 812 3456 7890", want: "+6281234567890", wantOk: true},
+		{raw: "0812 3456 7890", want: "+6281234567890", wantOk: true},
+		{raw: "+1 (415) 555-0100", want: "+14155550100", wantOk: true},
+		{raw: "81234567890"},
+		{raw: "+62 812 abc 7890"},
+		{raw: "+0812345678"},
+		{raw: "+1234567"},
+		{raw: "+1234567890123456"},
+		{raw: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, ok := NormalizePhone(tt.raw, "62")
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("NormalizePhone(%q) = %q, %v, want %q, %v", tt.raw, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
@@ -5,8 +5,12 @@ import "context"
 type contextKey string
 
 const (
-	userIDKey contextKey = "user_id"
-	roleKey   contextKey = "role"
+	userIDKey  contextKey = "user_id"
+	roleKey    contextKey = "role"
+	sessionKey contextKey = "session_id"
+	claimsKey  contextKey = "claims"
+	permsKey   contextKey = "permissions"
+	actorKey   contextKey = "actor_id"
 )
 
 func WithUser(ctx context.Context, userID, role string) context.Context {
@@ -15,6 +19,8 @@ func WithUser(ctx context.Context, userID, role string) context.Context {
 	return ctx
 }
 
+// FromContext returns the effective user. Under impersonation that is the
+// impersonated user; ImpersonatorFromContext returns the admin behind it.
 func FromContext(ctx context.Context) (userID string, role string, ok bool) {
 	userID, ok1 := ctx.Value(userIDKey).(string)
 	role, ok2 := ctx.Value(roleKey).(string)
@@ -25,3 +31,45 @@ func FromContext(ctx context.Context) (userID string, role string, ok bool) {
 
 	return userID, role, true
 }
+
+// WithImpersonator marks the request as made by actorID acting as the user
+func WithImpersonator(ctx context.Context, actorID string) context.Context {
+	return context.WithValue(ctx, actorKey, actorID)
+}
+
+// ImpersonatorFromContext returns the admin impersonating the request's user
+func ImpersonatorFromContext(ctx context.Context) (actorID string, ok bool) {
+	actorID, _ = ctx.Value(actorKey).(string)
+	return actorID, actorID != ""
+}
+
+func WithSession(ctx context.Context, sessionID string) context.Context {
+	return context.WithValue(ctx, sessionKey, sessionID)
+}
+
+// SessionFromContext returns the session (refresh token family) the access token was issued for
+func SessionFromContext(ctx context.Context) string {
+	sessionID, _ := ctx.Value(sessionKey).(string)
+	return sessionID
+}
+
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey, claims)
+}
+
+// ClaimsFromContext returns all verified claims of the request's access token
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey).(*Claims)
+	return claims, ok && claims != nil
+}
+
+// WithPermissions stores the permissions granted to the caller's role
+func WithPermissions(ctx context.Context, permissions []string) context.Context {
+	return context.WithValue(ctx, permsKey, permissions)
+}
+
+// PermissionsFromContext returns the permissions granted to the caller's role
+func PermissionsFromContext(ctx context.Context) []string {
+	permissions, _ := ctx.Value(permsKey).([]string)
+	return permissions
+}
@@ -2,15 +2,50 @@ package auth
 
 import (
 	"context"
+	"errors"
+	"log"
 	"strings"
 
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	commonmd "github.com/nassabiq/golang-template/internal/shared/common/metadata"
+	"github.com/nassabiq/golang-template/internal/shared/tenant"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
-func UnaryServerInterceptor(verifier *JWTVerifier) grpc.UnaryServerInterceptor {
+// ApiKeyAuthenticator resolves a personal access token to its owner
+type ApiKeyAuthenticator interface {
+	AuthenticateApiKey(ctx context.Context, key string) (*domain.ApiKeyIdentity, error)
+}
+
+// PermissionResolver returns the permissions currently granted to a role
+type PermissionResolver interface {
+	Permissions(roleID string) []string
+}
+
+// RoleResolver returns a user's own role, the one they hold outside any organization
+type RoleResolver interface {
+	UserRole(ctx context.Context, userID string) (string, error)
+}
+
+// ImpersonationAuditor records every call an admin makes while impersonating a user
+type ImpersonationAuditor interface {
+	RecordImpersonatedCall(ctx context.Context, actorID, userID, method string, client domain.ClientInfo) error
+}
+
+// UnaryServerInterceptor authenticates requests with either a JWT
+// (Authorization: Bearer <token>) or, when apiKeys is set, a personal access
+// token (Authorization: ApiKey <key> or X-API-Key: <key>). When permissions is
+// set, the caller's role permissions are resolved into the context. RPCs whose
+// policy sets global_role go by the user's own role, looked up with roles,
+// instead of their role in the organization the token acts in.
+// The (auth.v1.policy) option of the called RPC is enforced before the
+// handler runs; RPCs without a policy are refused. Calls made with an
+// impersonation token are logged and, when audit is set, recorded; a call
+// that cannot be recorded is refused.
+func UnaryServerInterceptor(verifier *JWTVerifier, apiKeys ApiKeyAuthenticator, permissions PermissionResolver, roles RoleResolver, audit ImpersonationAuditor) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
@@ -18,8 +53,13 @@ func UnaryServerInterceptor(verifier *JWTVerifier) grpc.UnaryServerInterceptor {
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
 
+		policy, ok := methodPolicy(info.FullMethod)
+		if !ok {
+			return nil, status.Error(codes.PermissionDenied, "no authorization policy")
+		}
+
 		// 🔓 Public endpoints
-		if isPublicMethod(info.FullMethod) {
+		if policy.GetPublic() {
 			return handler(ctx, req)
 		}
 
@@ -28,22 +68,134 @@ func UnaryServerInterceptor(verifier *JWTVerifier) grpc.UnaryServerInterceptor {
 			return nil, status.Error(codes.Unauthenticated, "missing metadata")
 		}
 
-		authHeader := md.Get("authorization")
-		if len(authHeader) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "authorization required")
-		}
+		var claims *Claims
+		var err error
 
-		token := strings.TrimPrefix(authHeader[0], "Bearer ")
-		if token == authHeader[0] {
-			return nil, status.Error(codes.Unauthenticated, "invalid authorization format")
+		if apiKey := extractApiKey(md); apiKey != "" && apiKeys != nil {
+			claims, err = authenticateApiKey(ctx, apiKeys, apiKey)
+		} else {
+			claims, err = verifyBearer(ctx, verifier, md)
 		}
 
-		userID, role, err := verifier.Verify(token)
 		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, err.Error())
+			return nil, err
+		}
+
+		// Service clients act as themselves, not on behalf of a user
+		if claims.ClientID == "" {
+			role := claims.Role
+			inOrganization := claims.OrganizationID != ""
+			// A role held in one organization must not reach past it
+			if policy.GetGlobalRole() && inOrganization {
+				if role, err = userRole(ctx, roles, claims.UserID); err != nil {
+					return nil, err
+				}
+				inOrganization = false
+			}
+			ctx = WithUser(ctx, claims.UserID, role)
+			ctx = WithSession(ctx, claims.SessionID)
+			if permissions != nil {
+				ctx = WithPermissions(ctx, permissions.Permissions(role))
+			}
+			if inOrganization {
+				ctx = tenant.WithOrganization(ctx, claims.OrganizationID)
+			}
+		}
+		ctx = WithClaims(ctx, claims)
+
+		if err := authorize(ctx, policy, claims); err != nil {
+			return nil, err
+		}
+
+		if claims.ActorID != "" {
+			ctx = WithImpersonator(ctx, claims.ActorID)
+			log.Printf("[Auth] impersonated call %s: actor=%s user=%s", info.FullMethod, claims.ActorID, claims.UserID)
+
+			if audit != nil {
+				userAgent, ipAddress := commonmd.ClientInfo(ctx)
+				client := domain.ClientInfo{UserAgent: userAgent, IPAddress: ipAddress}
+
+				if err := audit.RecordImpersonatedCall(ctx, claims.ActorID, claims.UserID, info.FullMethod, client); err != nil {
+					log.Printf("[Auth] impersonation audit failed: %v", err)
+					return nil, status.Error(codes.Unavailable, "audit trail unavailable")
+				}
+			}
 		}
 
-		ctx = WithUser(ctx, userID, role)
 		return handler(ctx, req)
 	}
 }
+
+func userRole(ctx context.Context, roles RoleResolver, userID string) (string, error) {
+	if roles == nil {
+		return "", status.Error(codes.PermissionDenied, "forbidden")
+	}
+
+	role, err := roles.UserRole(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return "", status.Error(codes.Unauthenticated, err.Error())
+		}
+		log.Printf("[Auth] role lookup failed: %v", err)
+		return "", status.Error(codes.Unavailable, "role check unavailable")
+	}
+
+	return role, nil
+}
+
+func verifyBearer(ctx context.Context, verifier *JWTVerifier, md metadata.MD) (*Claims, error) {
+	authHeader := md.Get("authorization")
+	if len(authHeader) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "authorization required")
+	}
+
+	token := strings.TrimPrefix(authHeader[0], "Bearer ")
+	if token == authHeader[0] {
+		return nil, status.Error(codes.Unauthenticated, "invalid authorization format")
+	}
+
+	claims, err := verifier.Verify(ctx, token)
+	if err != nil {
+		if errors.Is(err, ErrRevocationUnavailable) {
+			log.Printf("[Auth] %v", err)
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return claims, nil
+}
+
+func authenticateApiKey(ctx context.Context, apiKeys ApiKeyAuthenticator, key string) (*Claims, error) {
+	identity, err := apiKeys.AuthenticateApiKey(ctx, key)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidApiKey) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		log.Printf("[Auth] api key lookup failed: %v", err)
+		return nil, status.Error(codes.Unavailable, "api key check unavailable")
+	}
+
+	return &Claims{
+		UserID:         identity.UserID,
+		Role:           identity.RoleID,
+		OrganizationID: identity.OrganizationID,
+		ApiKeyID:       identity.KeyID,
+		Scoped:         true,
+		Scopes:         identity.Scopes,
+	}, nil
+}
+
+func extractApiKey(md metadata.MD) string {
+	if values := md.Get("authorization"); len(values) > 0 {
+		if key, ok := strings.CutPrefix(values[0], "ApiKey "); ok {
+			return strings.TrimSpace(key)
+		}
+	}
+
+	if values := md.Get("x-api-key"); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+
+	return ""
+}
@@ -0,0 +1,273 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
+	"github.com/nassabiq/golang-template/internal/shared/tenant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type staticKeys struct {
+	key crypto.PublicKey
+}
+
+func (k staticKeys) PublicKey(kid string) (crypto.PublicKey, bool) {
+	return k.key, kid == "test"
+}
+
+type mockAuditor struct {
+	calls []string
+	err   error
+}
+
+func (m *mockAuditor) RecordImpersonatedCall(ctx context.Context, actorID, userID, method string, client domain.ClientInfo) error {
+	m.calls = append(m.calls, actorID+" "+userID+" "+method)
+	return m.err
+}
+
+// impersonationContext returns incoming metadata carrying a token of user-123 impersonated by admin-123
+func impersonationContext(t *testing.T, private ed25519.PrivateKey) context.Context {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{
+		"jti":  "jti-123",
+		"sub":  "user-123",
+		"role": "role-user",
+		"act":  map[string]string{"sub": "admin-123"},
+		"iat":  time.Now().Unix(),
+		"exp":  time.Now().Add(time.Minute).Unix(),
+	})
+	token.Header["kid"] = "test"
+
+	signed, err := token.SignedString(private)
+	if err != nil {
+		t.Fatalf("SignedString() error = %v", err)
+	}
+
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+signed))
+}
+
+func TestUnaryServerInterceptor_Impersonation(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	verifier := NewJWTVerifier(staticKeys{key: public})
+
+	var userID, actorID string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		userID, _, _ = FromContext(ctx)
+		actorID, _ = ImpersonatorFromContext(ctx)
+		return "ok", nil
+	}
+
+	// Calls are tagged with the impersonator and audited
+	auditor := &mockAuditor{}
+	interceptor := UnaryServerInterceptor(verifier, nil, nil, nil, auditor)
+
+	resp, err := interceptor(impersonationContext(t, private), nil, &grpc.UnaryServerInfo{FullMethod: "/user.v1.UserService/GetMe"}, handler)
+	if err != nil || resp != "ok" {
+		t.Fatalf("impersonated call: resp = %v, err = %v", resp, err)
+	}
+	if userID != "user-123" || actorID != "admin-123" {
+		t.Errorf("impersonated call: user = %q, impersonator = %q", userID, actorID)
+	}
+	if len(auditor.calls) != 1 || auditor.calls[0] != "admin-123 user-123 /user.v1.UserService/GetMe" {
+		t.Errorf("impersonated call: audited %v", auditor.calls)
+	}
+
+	// Policies can refuse impersonation
+	_, err = interceptor(impersonationContext(t, private), nil, &grpc.UnaryServerInfo{FullMethod: "/auth.v1.AuthService/DisableMfa"}, handler)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("denied method: error code = %v, want %v", status.Code(err), codes.PermissionDenied)
+	}
+
+	// Calls that cannot be audited are refused
+	interceptor = UnaryServerInterceptor(verifier, nil, nil, nil, &mockAuditor{err: errors.New("db down")})
+	_, err = interceptor(impersonationContext(t, private), nil, &grpc.UnaryServerInfo{FullMethod: "/user.v1.UserService/GetMe"}, handler)
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("audit failure: error code = %v, want %v", status.Code(err), codes.Unavailable)
+	}
+}
+
+type staticPermissions map[string][]string
+
+func (p staticPermissions) Permissions(roleID string) []string {
+	return p[roleID]
+}
+
+type staticRoles map[string]string
+
+func (r staticRoles) UserRole(ctx context.Context, userID string) (string, error) {
+	role, ok := r[userID]
+	if !ok {
+		return "", domain.ErrUserNotFound
+	}
+	return role, nil
+}
+
+// organizationContext returns incoming metadata carrying a token of userID acting in org-acme with role
+func organizationContext(t *testing.T, private ed25519.PrivateKey, userID, role string) context.Context {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{
+		"jti":    "jti-" + userID,
+		"sub":    userID,
+		"role":   role,
+		"org_id": "org-acme",
+		"iat":    time.Now().Unix(),
+		"exp":    time.Now().Add(time.Minute).Unix(),
+	})
+	token.Header["kid"] = "test"
+
+	signed, err := token.SignedString(private)
+	if err != nil {
+		t.Fatalf("SignedString() error = %v", err)
+	}
+
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+signed))
+}
+
+// Test global RPCs authorize with the user's own role, not their role in the organization
+func TestUnaryServerInterceptor_GlobalRole(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+
+	verifier := NewJWTVerifier(staticKeys{key: public})
+	permissions := staticPermissions{
+		"role-super": {"roles.manage", "users.read"},
+		"role-user":  {},
+	}
+	roles := staticRoles{"user-owner": "role-user", "user-root": "role-super"}
+
+	var organizationID string
+	var inOrganization bool
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		organizationID, inOrganization = tenant.OrganizationFromContext(ctx)
+		return "ok", nil
+	}
+
+	interceptor := UnaryServerInterceptor(verifier, nil, permissions, roles, nil)
+	createRole := &grpc.UnaryServerInfo{FullMethod: "/role.v1.RoleService/Create"}
+	listUsers := &grpc.UnaryServerInfo{FullMethod: "/user.v1.UserService/List"}
+
+	// A super admin role held only in the organization does not manage roles
+	_, err = interceptor(organizationContext(t, private, "user-owner", "role-super"), nil, createRole, handler)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("organization role: error code = %v, want %v", status.Code(err), codes.PermissionDenied)
+	}
+
+	// Organization RPCs keep using the organization role
+	_, err = interceptor(organizationContext(t, private, "user-owner", "role-super"), nil, listUsers, handler)
+	if err != nil || !inOrganization || organizationID != "org-acme" {
+		t.Errorf("organization rpc: err = %v, organization = %q", err, organizationID)
+	}
+
+	// The user's own role counts, and the call leaves the organization
+	_, err = interceptor(organizationContext(t, private, "user-root", "role-user"), nil, createRole, handler)
+	if err != nil || inOrganization {
+		t.Errorf("own role: err = %v, in organization = %v", err, inOrganization)
+	}
+
+	// Without a role lookup global RPCs are refused
+	interceptor = UnaryServerInterceptor(verifier, nil, permissions, nil, nil)
+	_, err = interceptor(organizationContext(t, private, "user-root", "role-user"), nil, createRole, handler)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("no role lookup: error code = %v, want %v", status.Code(err), codes.PermissionDenied)
+	}
+}
+
+type staticApiKeys map[string]*domain.ApiKeyIdentity
+
+func (k staticApiKeys) AuthenticateApiKey(ctx context.Context, key string) (*domain.ApiKeyIdentity, error) {
+	if identity, ok := k[key]; ok {
+		return identity, nil
+	}
+	return nil, domain.ErrInvalidApiKey
+}
+
+// Test a key bound to an organization acts in it with the role it resolved to
+func TestUnaryServerInterceptor_OrganizationApiKey(t *testing.T) {
+	apiKeys := staticApiKeys{
+		"pat_acme":   {KeyID: "key-1", UserID: "user-owner", OrganizationID: "org-acme", RoleID: "role-super", Scopes: []string{domain.ScopeUsersRead}},
+		"pat_global": {KeyID: "key-2", UserID: "user-owner", RoleID: "role-user", Scopes: []string{domain.ScopeUsersRead}},
+	}
+	permissions := staticPermissions{"role-super": {"users.read"}, "role-user": {}}
+
+	var organizationID string
+	var inOrganization bool
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		organizationID, inOrganization = tenant.OrganizationFromContext(ctx)
+		return "ok", nil
+	}
+
+	interceptor := UnaryServerInterceptor(nil, apiKeys, permissions, nil, nil)
+	listUsers := &grpc.UnaryServerInfo{FullMethod: "/user.v1.UserService/List"}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-api-key", "pat_acme"))
+	if _, err := interceptor(ctx, nil, listUsers, handler); err != nil || !inOrganization || organizationID != "org-acme" {
+		t.Errorf("organization key: err = %v, organization = %q", err, organizationID)
+	}
+
+	// A global key goes by the owner's own role, which cannot read users
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-api-key", "pat_global"))
+	if _, err := interceptor(ctx, nil, listUsers, handler); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("global key: error code = %v, want %v", status.Code(err), codes.PermissionDenied)
+	}
+}
+
+type revokedSessions map[string]bool
+
+func (r revokedSessions) IsRevoked(ctx context.Context, jti, userID, organizationID, sessionID string, issuedAt time.Time) (bool, error) {
+	return r[sessionID], nil
+}
+
+// Test an access token of a revoked session is refused while other sessions keep working
+func TestJWTVerifier_RevokedSession(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	verifier := NewJWTVerifier(staticKeys{key: public})
+	verifier.SetRevocationChecker(revokedSessions{"session-laptop": true})
+
+	sign := func(sessionID string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{
+			"jti":  "jti-" + sessionID,
+			"sub":  "user-123",
+			"role": "role-user",
+			"sid":  sessionID,
+			"iat":  time.Now().Unix(),
+			"exp":  time.Now().Add(time.Minute).Unix(),
+		})
+		token.Header["kid"] = "test"
+
+		signed, err := token.SignedString(private)
+		if err != nil {
+			t.Fatalf("SignedString() error = %v", err)
+		}
+		return signed
+	}
+
+	if _, err := verifier.Verify(context.Background(), sign("session-laptop")); !errors.Is(err, ErrTokenRevoked) {
+		t.Errorf("Verify() revoked session error = %v, want %v", err, ErrTokenRevoked)
+	}
+
+	claims, err := verifier.Verify(context.Background(), sign("session-phone"))
+	if err != nil || claims.SessionID != "session-phone" {
+		t.Errorf("Verify() other session = %+v, %v", claims, err)
+	}
+}
@@ -5,3 +5,13 @@ import "context"
 func Guard(ctx context.Context, roles ...string) error {
 	return RequireRole(roles...)(ctx)
 }
+
+// GuardPermission lets users whose role has been granted permission through
+func GuardPermission(ctx context.Context, permission string) error {
+	return RequirePermission(permission)(ctx)
+}
+
+// GuardScope lets either a service client holding scope or a user with permission through
+func GuardScope(ctx context.Context, scope, permission string) error {
+	return RequireScope(scope, permission)(ctx)
+}
@@ -1,42 +1,146 @@
 package auth
 
 import (
+	"context"
+	"crypto"
 	"errors"
+	"strings"
+	"time"
 
 	jwt "github.com/golang-jwt/jwt/v5"
 )
 
+// KeyResolver looks up the public key matching a token's kid header
+type KeyResolver interface {
+	PublicKey(kid string) (crypto.PublicKey, bool)
+}
+
+// RevocationChecker reports whether an otherwise valid access token was revoked
+type RevocationChecker interface {
+	IsRevoked(ctx context.Context, jti, userID, organizationID, sessionID string, issuedAt time.Time) (bool, error)
+}
+
+var (
+	ErrTokenRevoked          = errors.New("token revoked")
+	ErrRevocationUnavailable = errors.New("token revocation check unavailable")
+)
+
 type JWTVerifier struct {
-	secret []byte
+	keys       KeyResolver
+	revocation RevocationChecker
+}
+
+// Claims holds the verified claims of the request's credential
+type Claims struct {
+	ID        string
+	UserID    string
+	Role      string
+	SessionID string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+
+	// Organization the token was issued for; Role is the user's role in it
+	OrganizationID string
+
+	// Set for impersonation tokens: the admin acting as UserID
+	ActorID string
+
+	// When the user last proved their credentials; zero when the token does not say
+	AuthTime time.Time
+
+	// Set for service client tokens, which have no user or role
+	ClientID string
+
+	// Set for API key requests, which are limited to Scopes
+	ApiKeyID string
+	Scoped   bool
+	Scopes   []string
+}
+
+func NewJWTVerifier(keys KeyResolver) *JWTVerifier {
+	return &JWTVerifier{keys: keys}
 }
 
-func NewJWTVerifier(secret string) *JWTVerifier {
-	return &JWTVerifier{secret: []byte(secret)}
+// SetRevocationChecker makes Verify reject revoked tokens
+func (j *JWTVerifier) SetRevocationChecker(checker RevocationChecker) {
+	j.revocation = checker
 }
 
-func (j *JWTVerifier) Verify(tokenString string) (userID, role string, err error) {
+func (j *JWTVerifier) Verify(ctx context.Context, tokenString string) (*Claims, error) {
 	token, err := jwt.Parse(tokenString, func(t *jwt.Token) (interface{}, error) {
-		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("unexpected signing method")
+		kid, _ := t.Header["kid"].(string)
+
+		key, ok := j.keys.PublicKey(kid)
+		if !ok {
+			return nil, errors.New("unknown signing key")
 		}
-		return j.secret, nil
-	})
+		return key, nil
+	}, jwt.WithValidMethods([]string{
+		jwt.SigningMethodRS256.Alg(),
+		jwt.SigningMethodEdDSA.Alg(),
+	}))
 
 	if err != nil || !token.Valid {
-		return "", "", errors.New("invalid token")
+		return nil, errors.New("invalid token")
 	}
 
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
-		return "", "", errors.New("invalid claims")
+		return nil, errors.New("invalid claims")
 	}
 
-	userID = claims["sub"].(string)
-	role = claims["role"].(string)
+	jti, _ := claims["jti"].(string)
+	userID, _ := claims["sub"].(string)
+	role, _ := claims["role"].(string)
+	sessionID, _ := claims["sid"].(string)
+	clientID, _ := claims["client_id"].(string)
+	organizationID, _ := claims["org_id"].(string)
+	scope, _ := claims["scope"].(string)
+
+	result := &Claims{ID: jti}
+
+	if issuedAt, err := claims.GetIssuedAt(); err == nil && issuedAt != nil {
+		result.IssuedAt = issuedAt.Time
+	}
+	if expiresAt, err := claims.GetExpirationTime(); err == nil && expiresAt != nil {
+		result.ExpiresAt = expiresAt.Time
+	}
+
+	// Service tokens are short lived and not tied to a user, so the user
+	// revocation checks below do not apply to them
+	if clientID != "" && role == "" {
+		result.ClientID = clientID
+		result.Scoped = true
+		result.Scopes = strings.Fields(scope)
+		return result, nil
+	}
 
 	if userID == "" || role == "" {
-		return "", "", errors.New("missing claims")
+		return nil, errors.New("missing claims")
+	}
+
+	result.UserID = userID
+	result.Role = role
+	result.SessionID = sessionID
+	result.OrganizationID = organizationID
+
+	if authTime, ok := claims["auth_time"].(float64); ok {
+		result.AuthTime = time.Unix(int64(authTime), 0)
+	}
+
+	if actor, ok := claims["act"].(map[string]interface{}); ok {
+		result.ActorID, _ = actor["sub"].(string)
+	}
+
+	if j.revocation != nil {
+		revoked, err := j.revocation.IsRevoked(ctx, result.ID, result.UserID, result.OrganizationID, result.SessionID, result.IssuedAt)
+		if err != nil {
+			return nil, ErrRevocationUnavailable
+		}
+		if revoked {
+			return nil, ErrTokenRevoked
+		}
 	}
 
-	return userID, role, nil
+	return result, nil
 }
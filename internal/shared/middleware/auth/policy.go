@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	authpb "github.com/nassabiq/golang-template/proto/auth"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// methodPolicy returns the (auth.v1.policy) option declared on the RPC in its proto file
+func methodPolicy(fullMethod string) (*authpb.Policy, bool) {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return nil, false
+	}
+
+	method, ok := descriptor.(protoreflect.MethodDescriptor)
+	if !ok || !proto.HasExtension(method.Options(), authpb.E_Policy) {
+		return nil, false
+	}
+
+	policy, ok := proto.GetExtension(method.Options(), authpb.E_Policy).(*authpb.Policy)
+	return policy, ok && policy != nil
+}
+
+// authorize enforces policy on an authenticated caller. Scoped credentials
+// need the policy's scope; users additionally need every listed permission
+// and, when the policy sets max_auth_age_minutes, a recent auth_time.
+func authorize(ctx context.Context, policy *authpb.Policy, claims *Claims) error {
+	if claims.Scoped && (policy.GetScope() == "" || !slices.Contains(claims.Scopes, policy.GetScope())) {
+		return status.Error(codes.PermissionDenied, "insufficient scope")
+	}
+
+	if policy.GetDenyImpersonation() && claims.ActorID != "" {
+		return status.Error(codes.PermissionDenied, "not allowed while impersonating")
+	}
+
+	// Service clients act as themselves and have no role to check
+	if claims.ClientID != "" {
+		return nil
+	}
+
+	for _, permission := range policy.GetPermissions() {
+		if !HasPermission(ctx, permission) {
+			return status.Error(codes.PermissionDenied, "forbidden")
+		}
+	}
+
+	if minutes := policy.GetMaxAuthAgeMinutes(); minutes > 0 {
+		return RequireRecentAuth(claims, time.Duration(minutes)*time.Minute)
+	}
+
+	return nil
+}
+
+// ReasonReauthenticationRequired is the ErrorInfo reason of calls refused for a
+// stale auth_time; clients react by calling AuthService.Reauthenticate and retrying
+const ReasonReauthenticationRequired = "REAUTHENTICATION_REQUIRED"
+
+// RequireRecentAuth fails unless the user proved their credentials within maxAge.
+// Credentials without an auth_time, such as API keys, never qualify.
+func RequireRecentAuth(claims *Claims, maxAge time.Duration) error {
+	if !claims.AuthTime.IsZero() && time.Since(claims.AuthTime) <= maxAge {
+		return nil
+	}
+
+	st, err := status.New(codes.Unauthenticated, "reauthentication required").WithDetails(&errdetails.ErrorInfo{
+		Reason:   ReasonReauthenticationRequired,
+		Domain:   "auth.v1",
+		Metadata: map[string]string{"max_auth_age_seconds": strconv.Itoa(int(maxAge.Seconds()))},
+	})
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "reauthentication required")
+	}
+
+	return st.Err()
+}
+
+// CheckPolicies fails when a unary RPC registered on the server declares no
+// (auth.v1.policy) option. Call it at startup so a forgotten policy never ships.
+func CheckPolicies(services map[string]grpc.ServiceInfo) error {
+	var missing []string
+
+	for service, info := range services {
+		for _, method := range info.Methods {
+			// The interceptor only guards unary calls
+			if method.IsClientStream || method.IsServerStream {
+				continue
+			}
+
+			fullMethod := "/" + service + "/" + method.Name
+			if _, ok := methodPolicy(fullMethod); !ok {
+				missing = append(missing, fullMethod)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("rpc methods without an auth policy: %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
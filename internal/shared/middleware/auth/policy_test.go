@@ -0,0 +1,149 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	authpb "github.com/nassabiq/golang-template/proto/auth"
+	rolepb "github.com/nassabiq/golang-template/proto/role"
+	userpb "github.com/nassabiq/golang-template/proto/user"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckPolicies(t *testing.T) {
+	server := grpc.NewServer()
+	authpb.RegisterAuthServiceServer(server, authpb.UnimplementedAuthServiceServer{})
+	userpb.RegisterUserServiceServer(server, userpb.UnimplementedUserServiceServer{})
+	rolepb.RegisterRoleServiceServer(server, rolepb.UnimplementedRoleServiceServer{})
+
+	if err := CheckPolicies(server.GetServiceInfo()); err != nil {
+		t.Fatalf("CheckPolicies() error = %v", err)
+	}
+
+	healthpb.RegisterHealthServer(server, health.NewServer())
+
+	err := CheckPolicies(server.GetServiceInfo())
+	if err == nil || !strings.Contains(err.Error(), "/grpc.health.v1.Health/Check") {
+		t.Fatalf("CheckPolicies() error = %v, want the health check reported", err)
+	}
+	if strings.Contains(err.Error(), "Watch") {
+		t.Errorf("CheckPolicies() reported a streaming method: %v", err)
+	}
+}
+
+func TestUnaryServerInterceptor_Policy(t *testing.T) {
+	interceptor := UnaryServerInterceptor(nil, nil, nil, nil, nil)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	// Public RPCs run without credentials or metadata
+	resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/auth.v1.AuthService/Login"}, handler)
+	if err != nil || resp != "ok" {
+		t.Errorf("public method: resp = %v, err = %v", resp, err)
+	}
+
+	// RPCs without a policy are refused
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}, handler)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("method without policy: error code = %v, want %v", status.Code(err), codes.PermissionDenied)
+	}
+
+	// Everything else needs credentials
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/user.v1.UserService/GetMe"}, handler)
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("protected method: error code = %v, want %v", status.Code(err), codes.Unauthenticated)
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	unlockUsers, _ := methodPolicy("/auth.v1.AuthService/UnlockAccount")
+	enrollMfa, _ := methodPolicy("/auth.v1.AuthService/EnrollMfa")
+
+	user := &Claims{UserID: "user-123", Role: "role-1"}
+	apiKey := &Claims{UserID: "user-123", Role: "role-1", Scoped: true, Scopes: []string{"users:write"}}
+	readOnlyKey := &Claims{UserID: "user-123", Role: "role-1", Scoped: true, Scopes: []string{"users:read"}}
+	client := &Claims{ClientID: "billing", Scoped: true, Scopes: []string{"users:write"}}
+	impersonated := &Claims{UserID: "user-123", Role: "role-1", ActorID: "admin-123"}
+
+	granted := WithPermissions(context.Background(), []string{"users.unlock"})
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		policy   *authpb.Policy
+		claims   *Claims
+		wantCode codes.Code
+	}{
+		{name: "user with permission", ctx: granted, policy: unlockUsers, claims: user, wantCode: codes.OK},
+		{name: "user without permission", ctx: context.Background(), policy: unlockUsers, claims: user, wantCode: codes.PermissionDenied},
+		{name: "api key with scope and permission", ctx: granted, policy: unlockUsers, claims: apiKey, wantCode: codes.OK},
+		{name: "api key with scope, owner lacks permission", ctx: context.Background(), policy: unlockUsers, claims: apiKey, wantCode: codes.PermissionDenied},
+		{name: "api key without scope", ctx: granted, policy: unlockUsers, claims: readOnlyKey, wantCode: codes.PermissionDenied},
+		{name: "service client with scope", ctx: context.Background(), policy: unlockUsers, claims: client, wantCode: codes.OK},
+		{name: "login only policy", ctx: context.Background(), policy: enrollMfa, claims: user, wantCode: codes.OK},
+		{name: "scoped credential on unscoped policy", ctx: granted, policy: enrollMfa, claims: apiKey, wantCode: codes.PermissionDenied},
+		{name: "impersonated user", ctx: granted, policy: unlockUsers, claims: impersonated, wantCode: codes.OK},
+		{name: "impersonated user on denied policy", ctx: context.Background(), policy: enrollMfa, claims: impersonated, wantCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authorize(tt.ctx, tt.policy, tt.claims)
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("authorize() error code = %v, want %v", status.Code(err), tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestAuthorize_RecentAuth(t *testing.T) {
+	deleteUsers, _ := methodPolicy("/user.v1.UserService/Delete")
+	granted := WithPermissions(context.Background(), []string{"users.delete"})
+
+	tests := []struct {
+		name       string
+		claims     *Claims
+		wantCode   codes.Code
+		wantReason bool
+	}{
+		{name: "recent login", claims: &Claims{UserID: "user-123", Role: "role-1", AuthTime: time.Now().Add(-time.Minute)}, wantCode: codes.OK},
+		{name: "stale login", claims: &Claims{UserID: "user-123", Role: "role-1", AuthTime: time.Now().Add(-time.Hour)}, wantCode: codes.Unauthenticated, wantReason: true},
+		{name: "token without auth_time", claims: &Claims{UserID: "user-123", Role: "role-1"}, wantCode: codes.Unauthenticated, wantReason: true},
+		{name: "api key", claims: &Claims{UserID: "user-123", Role: "role-1", Scoped: true, Scopes: []string{"users:write"}}, wantCode: codes.Unauthenticated, wantReason: true},
+		{name: "service client", claims: &Claims{ClientID: "billing", Scoped: true, Scopes: []string{"users:write"}}, wantCode: codes.OK},
+		// A missing permission is reported first; logging in again would not help
+		{name: "stale login without permission", claims: &Claims{UserID: "user-123", Role: "role-1"}, wantCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := granted
+			if tt.wantCode == codes.PermissionDenied {
+				ctx = context.Background()
+			}
+
+			err := authorize(ctx, deleteUsers, tt.claims)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("authorize() error code = %v, want %v", status.Code(err), tt.wantCode)
+			}
+
+			var reason string
+			for _, detail := range status.Convert(err).Details() {
+				if info, ok := detail.(*errdetails.ErrorInfo); ok {
+					reason = info.GetReason()
+				}
+			}
+			if (reason == ReasonReauthenticationRequired) != tt.wantReason {
+				t.Errorf("authorize() error reason = %q, want reauthentication required %v", reason, tt.wantReason)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"slices"
 
 	"github.com/nassabiq/golang-template/internal/modules/auth/domain"
 	"google.golang.org/grpc/codes"
@@ -31,3 +32,41 @@ func RequireRole(allowedRoles ...string) func(ctx context.Context) error {
 		return status.Error(codes.PermissionDenied, "forbidden")
 	}
 }
+
+// RequirePermission lets users whose role has been granted permission through
+func RequirePermission(permission string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if _, _, ok := FromContext(ctx); !ok {
+			return status.Error(codes.Unauthenticated, "unauthorized")
+		}
+
+		if !HasPermission(ctx, permission) {
+			return status.Error(codes.PermissionDenied, "forbidden")
+		}
+
+		return nil
+	}
+}
+
+// HasPermission reports whether the caller's role has been granted permission
+func HasPermission(ctx context.Context, permission string) bool {
+	return slices.Contains(PermissionsFromContext(ctx), permission)
+}
+
+// RequireScope authorizes service clients by scope and users by permission.
+// Scoped user credentials (API keys) need both the permission and the scope.
+func RequireScope(scope, permission string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		claims, ok := ClaimsFromContext(ctx)
+
+		if ok && claims.Scoped && !slices.Contains(claims.Scopes, scope) {
+			return status.Error(codes.PermissionDenied, "insufficient scope")
+		}
+
+		if ok && claims.ClientID != "" {
+			return nil
+		}
+
+		return RequirePermission(permission)(ctx)
+	}
+}
@@ -0,0 +1,65 @@
+package pagination
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+)
+
+// Codec turns cursors into opaque tokens and back. Tokens are signed, so a
+// client cannot forge a position to read rows past its filters.
+type Codec struct {
+	key []byte
+}
+
+func NewCodec(key []byte) *Codec {
+	return &Codec{key: key}
+}
+
+// Encode returns an empty token for a nil cursor
+func (codec *Codec) Encode(cursor *Cursor) string {
+	if cursor == nil {
+		return ""
+	}
+
+	payload, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(codec.sign(payload))
+}
+
+// Decode returns a nil cursor for an empty token
+func (codec *Codec) Decode(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, codec.sign(payload)) {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(payload, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
+
+func (codec *Codec) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, codec.key)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
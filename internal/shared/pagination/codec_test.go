@@ -0,0 +1,60 @@
+package pagination
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Test a token decodes back to the cursor it was encoded from
+func TestCodecRoundTrip(t *testing.T) {
+	codec := NewCodec([]byte("secret"))
+	cursor := &Cursor{Order: "u.created_at desc", Value: "2026-10-17T11:00:00.123456Z", ID: "user-1", Backward: true}
+
+	got, err := codec.Decode(codec.Encode(cursor))
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, cursor) {
+		t.Errorf("Decode() = %+v, want %+v", got, cursor)
+	}
+}
+
+// Test an empty token is the first page
+func TestCodecEmptyToken(t *testing.T) {
+	codec := NewCodec([]byte("secret"))
+
+	if token := codec.Encode(nil); token != "" {
+		t.Errorf("Encode(nil) = %q, want empty", token)
+	}
+
+	cursor, err := codec.Decode("")
+	if cursor != nil || err != nil {
+		t.Errorf("Decode(\"\") = %+v, %v, want nil, nil", cursor, err)
+	}
+}
+
+// Test tampered, foreign and malformed tokens are rejected
+func TestCodecRejectsInvalidTokens(t *testing.T) {
+	codec := NewCodec([]byte("secret"))
+	token := codec.Encode(&Cursor{Order: "u.created_at desc", Value: "2026-10-17T11:00:00Z", ID: "user-1"})
+	payload, signature, _ := strings.Cut(token, ".")
+	forged := NewCodec([]byte("other")).Encode(&Cursor{Order: "u.created_at desc", Value: "2026-10-17T11:00:00Z", ID: "user-2"})
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+
+	tests := map[string]string{
+		"other key":         forged,
+		"swapped payload":   forgedPayload + "." + signature,
+		"missing signature": payload,
+		"not base64":        payload + ".!!!",
+		"garbage":           "garbage",
+	}
+
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := codec.Decode(token); err != ErrInvalidCursor {
+				t.Errorf("Decode() error = %v, want ErrInvalidCursor", err)
+			}
+		})
+	}
+}
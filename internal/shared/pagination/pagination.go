@@ -0,0 +1,140 @@
+// Package pagination pages listings by keyset: a page continues from the sort
+// value and id of the row the previous page ended on, so a deep page costs as
+// much as the first one, unlike OFFSET. Clients only see cursors as signed,
+// opaque tokens (see Codec).
+package pagination
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrInvalidCursor is returned for a tampered token, or a cursor issued for another sort order
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is the position a page continues from
+type Cursor struct {
+	// Order the cursor was issued for, a cursor is only valid for the same one
+	Order string `json:"o"`
+	// Value of the sort column and ID of the row the previous page ended on
+	Value string `json:"v"`
+	ID    string `json:"i"`
+	// Backward pages towards the start of the listing
+	Backward bool `json:"b,omitempty"`
+}
+
+// Order sorts a listing by Column, IDColumn breaking ties so every row has a
+// unique position. Both are written into the query as they are, so they must
+// never come from the client.
+type Order struct {
+	Column     string
+	IDColumn   string
+	Descending bool
+}
+
+func (order Order) key() string {
+	if order.Descending {
+		return order.Column + " desc"
+	}
+	return order.Column + " asc"
+}
+
+// Page asks for one page of a listing
+type Page struct {
+	Limit int
+	// Cursor continues from a previous page, nil for the first page
+	Cursor *Cursor
+	// Offset skips rows on a page without a cursor, for clients still paging by offset
+	Offset int
+	// WithTotal asks for the exact number of matching rows, which costs a full count
+	WithTotal bool
+}
+
+// Result holds the cursors around a page
+type Result struct {
+	// Next is nil on the last page, Prev on the first one
+	Next *Cursor
+	Prev *Cursor
+	// Total is only set when the page asked for it
+	Total *int64
+}
+
+// Clauses returns the condition selecting the rows past the cursor, empty on a
+// page without one, and the ORDER BY and LIMIT tail of the page query.
+// Placeholders are numbered after args, and args are returned with the page's
+// values appended. One row more than the limit is fetched, so Trim can tell
+// whether another page follows.
+func (page Page) Clauses(order Order, args []any) (condition string, tail string, _ []any, err error) {
+	descending := order.Descending
+
+	if page.Cursor != nil {
+		if page.Cursor.Order != order.key() {
+			return "", "", nil, ErrInvalidCursor
+		}
+
+		// A backward page is read in reverse from the cursor, and Trim turns it around
+		if page.Cursor.Backward {
+			descending = !descending
+		}
+
+		operator := ">"
+		if descending {
+			operator = "<"
+		}
+
+		args = append(args, page.Cursor.Value, page.Cursor.ID)
+		condition = fmt.Sprintf("(%s, %s) %s ($%d, $%d)", order.Column, order.IDColumn, operator, len(args)-1, len(args))
+	}
+
+	direction := "ASC"
+	if descending {
+		direction = "DESC"
+	}
+
+	args = append(args, page.Limit+1)
+	tail = fmt.Sprintf("ORDER BY %s %s, %s %s LIMIT $%d", order.Column, direction, order.IDColumn, direction, len(args))
+
+	if page.Cursor == nil && page.Offset > 0 {
+		args = append(args, page.Offset)
+		tail += fmt.Sprintf(" OFFSET $%d", len(args))
+	}
+
+	return condition, tail, args, nil
+}
+
+// Trim cuts rows read with Clauses down to the page, in listing order, and
+// returns the cursors around it. key returns a row's sort value, formatted the
+// way the database reads it back, and its id.
+func Trim[T any](page Page, order Order, rows []T, key func(T) (value string, id string)) ([]T, *Result) {
+	more := len(rows) > page.Limit
+	if more {
+		rows = rows[:page.Limit]
+	}
+
+	backward := page.Cursor != nil && page.Cursor.Backward
+	if backward {
+		slices.Reverse(rows)
+	}
+
+	result := &Result{}
+	if len(rows) == 0 {
+		return rows, result
+	}
+
+	cursor := func(row T, backward bool) *Cursor {
+		value, id := key(row)
+		return &Cursor{Order: order.key(), Value: value, ID: id, Backward: backward}
+	}
+
+	// Going backward, the page we came from follows this one
+	if more || backward {
+		result.Next = cursor(rows[len(rows)-1], false)
+	}
+
+	if (backward && more) || (!backward && (page.Cursor != nil || page.Offset > 0)) {
+		result.Prev = cursor(rows[0], true)
+	}
+
+	return rows, result
+}
@@ -0,0 +1,186 @@
+package pagination
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+var byCreatedAt = Order{Column: "u.created_at", IDColumn: "u.id", Descending: true}
+
+// Test the first page reads limit+1 rows in order, with the offset of old clients
+func TestClausesFirstPage(t *testing.T) {
+	page := Page{Limit: 10, Offset: 20}
+
+	condition, tail, args, err := page.Clauses(byCreatedAt, []any{"%ann%"})
+	if err != nil {
+		t.Fatalf("Clauses() error = %v", err)
+	}
+
+	if condition != "" {
+		t.Errorf("condition = %q, want empty", condition)
+	}
+	if want := "ORDER BY u.created_at DESC, u.id DESC LIMIT $2 OFFSET $3"; tail != want {
+		t.Errorf("tail = %q, want %q", tail, want)
+	}
+	if want := []any{"%ann%", 11, 20}; !reflect.DeepEqual(args, want) {
+		t.Errorf("args = %v, want %v", args, want)
+	}
+}
+
+// Test a cursor continues past its row and ignores the offset
+func TestClausesCursor(t *testing.T) {
+	tests := []struct {
+		name          string
+		backward      bool
+		wantCondition string
+		wantTail      string
+	}{
+		{
+			name:          "forward",
+			wantCondition: "(u.created_at, u.id) < ($2, $3)",
+			wantTail:      "ORDER BY u.created_at DESC, u.id DESC LIMIT $4",
+		},
+		{
+			name:          "backward",
+			backward:      true,
+			wantCondition: "(u.created_at, u.id) > ($2, $3)",
+			wantTail:      "ORDER BY u.created_at ASC, u.id ASC LIMIT $4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := Page{Limit: 10, Offset: 20, Cursor: &Cursor{Order: "u.created_at desc", Value: "2026-10-17T11:00:00Z", ID: "user-9", Backward: tt.backward}}
+
+			condition, tail, args, err := page.Clauses(byCreatedAt, []any{"%ann%"})
+			if err != nil {
+				t.Fatalf("Clauses() error = %v", err)
+			}
+
+			if condition != tt.wantCondition {
+				t.Errorf("condition = %q, want %q", condition, tt.wantCondition)
+			}
+			if tail != tt.wantTail {
+				t.Errorf("tail = %q, want %q", tail, tt.wantTail)
+			}
+			if want := []any{"%ann%", "2026-10-17T11:00:00Z", "user-9", 11}; !reflect.DeepEqual(args, want) {
+				t.Errorf("args = %v, want %v", args, want)
+			}
+		})
+	}
+}
+
+// Test a cursor issued for another sort order is rejected
+func TestClausesRejectsCursorForOtherOrder(t *testing.T) {
+	page := Page{Limit: 10, Cursor: &Cursor{Order: "u.name asc", Value: "Ann", ID: "user-9"}}
+
+	if _, _, _, err := page.Clauses(byCreatedAt, nil); err != ErrInvalidCursor {
+		t.Errorf("Clauses() error = %v, want ErrInvalidCursor", err)
+	}
+}
+
+type row struct {
+	value int
+}
+
+func rowKey(r row) (string, string) {
+	return strconv.Itoa(r.value), "id-" + strconv.Itoa(r.value)
+}
+
+func rows(values ...int) []row {
+	list := make([]row, len(values))
+	for i, value := range values {
+		list[i] = row{value: value}
+	}
+	return list
+}
+
+func cursorAt(value int, backward bool) *Cursor {
+	return &Cursor{Order: "u.created_at desc", Value: strconv.Itoa(value), ID: "id-" + strconv.Itoa(value), Backward: backward}
+}
+
+// Test Trim drops the look-ahead row, restores listing order and sets the cursors around the page
+func TestTrim(t *testing.T) {
+	tests := []struct {
+		name     string
+		page     Page
+		fetched  []row
+		want     []row
+		wantNext *Cursor
+		wantPrev *Cursor
+	}{
+		{
+			name:     "first page with more",
+			page:     Page{Limit: 2},
+			fetched:  rows(9, 8, 7),
+			want:     rows(9, 8),
+			wantNext: cursorAt(8, false),
+		},
+		{
+			name:    "only page",
+			page:    Page{Limit: 2},
+			fetched: rows(9, 8),
+			want:    rows(9, 8),
+		},
+		{
+			name:     "offset page",
+			page:     Page{Limit: 2, Offset: 2},
+			fetched:  rows(7, 6),
+			want:     rows(7, 6),
+			wantPrev: cursorAt(7, true),
+		},
+		{
+			name:     "middle page",
+			page:     Page{Limit: 2, Cursor: cursorAt(8, false)},
+			fetched:  rows(7, 6, 5),
+			want:     rows(7, 6),
+			wantNext: cursorAt(6, false),
+			wantPrev: cursorAt(7, true),
+		},
+		{
+			name:     "last page",
+			page:     Page{Limit: 2, Cursor: cursorAt(6, false)},
+			fetched:  rows(5),
+			want:     rows(5),
+			wantPrev: cursorAt(5, true),
+		},
+		{
+			name:     "back to a middle page",
+			page:     Page{Limit: 2, Cursor: cursorAt(5, true)},
+			fetched:  rows(6, 7, 8),
+			want:     rows(7, 6),
+			wantNext: cursorAt(6, false),
+			wantPrev: cursorAt(7, true),
+		},
+		{
+			name:     "back to the first page",
+			page:     Page{Limit: 2, Cursor: cursorAt(7, true)},
+			fetched:  rows(8, 9),
+			want:     rows(9, 8),
+			wantNext: cursorAt(8, false),
+		},
+		{
+			name:    "past the end",
+			page:    Page{Limit: 2, Cursor: cursorAt(1, false)},
+			fetched: rows(),
+			want:    rows(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, result := Trim(tt.page, byCreatedAt, tt.fetched, rowKey)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("rows = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(result.Next, tt.wantNext) {
+				t.Errorf("Next = %+v, want %+v", result.Next, tt.wantNext)
+			}
+			if !reflect.DeepEqual(result.Prev, tt.wantPrev) {
+				t.Errorf("Prev = %+v, want %+v", result.Prev, tt.wantPrev)
+			}
+		})
+	}
+}
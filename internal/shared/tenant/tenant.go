@@ -0,0 +1,20 @@
+// Package tenant carries the organization a request acts in. Repositories of
+// tenant-owned data read it to scope their queries, so one organization can
+// never see another's rows.
+package tenant
+
+import "context"
+
+type contextKey struct{}
+
+// WithOrganization marks ctx as acting in organizationID
+func WithOrganization(ctx context.Context, organizationID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, organizationID)
+}
+
+// OrganizationFromContext returns the organization the request acts in.
+// ok is false for requests outside any organization.
+func OrganizationFromContext(ctx context.Context) (organizationID string, ok bool) {
+	organizationID, _ = ctx.Value(contextKey{}).(string)
+	return organizationID, organizationID != ""
+}
@@ -0,0 +1,60 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_mfa (
+  user_id         VARCHAR(36) PRIMARY KEY,
+  secret          TEXT NOT NULL,
+  enabled         BOOLEAN NOT NULL DEFAULT false,
+  last_used_step  BIGINT NOT NULL DEFAULT 0,
+  confirmed_at    TIMESTAMP NULL,
+  created_at      TIMESTAMP NULL,
+  updated_at      TIMESTAMP NULL,
+
+  CONSTRAINT fk_user_mfa_user
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE
+);
+
+CREATE TABLE mfa_recovery_codes (
+  id          VARCHAR(36) PRIMARY KEY,
+  user_id     VARCHAR(36) NOT NULL,
+  code_hash   TEXT NOT NULL,
+  used        BOOLEAN NOT NULL DEFAULT false,
+  created_at  TIMESTAMP NULL,
+  updated_at  TIMESTAMP NULL,
+
+  CONSTRAINT fk_mfa_recovery_user
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX idx_mfa_recovery_codes_user ON mfa_recovery_codes(user_id);
+
+CREATE TABLE mfa_challenges (
+  id          VARCHAR(36) PRIMARY KEY,
+  user_id     VARCHAR(36) NOT NULL,
+  token_hash  TEXT NOT NULL,
+  attempts    INT NOT NULL DEFAULT 0,
+  used        BOOLEAN NOT NULL DEFAULT false,
+  expires_at  TIMESTAMP NOT NULL,
+  created_at  TIMESTAMP NULL,
+  updated_at  TIMESTAMP NULL,
+
+  CONSTRAINT fk_mfa_challenge_user
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX idx_mfa_challenges_hash ON mfa_challenges(token_hash);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_mfa_challenges_hash;
+DROP TABLE mfa_challenges;
+DROP INDEX idx_mfa_recovery_codes_user;
+DROP TABLE mfa_recovery_codes;
+DROP TABLE user_mfa;
+-- +goose StatementEnd
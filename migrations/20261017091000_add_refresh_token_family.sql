@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE refresh_tokens
+  ADD COLUMN family_id VARCHAR(36) NULL,
+  ADD COLUMN parent_id VARCHAR(36) NULL;
+
+UPDATE refresh_tokens SET family_id = id WHERE family_id IS NULL;
+
+ALTER TABLE refresh_tokens ALTER COLUMN family_id SET NOT NULL;
+
+CREATE INDEX idx_refresh_tokens_family ON refresh_tokens(family_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_refresh_tokens_family;
+ALTER TABLE refresh_tokens
+  DROP COLUMN parent_id,
+  DROP COLUMN family_id;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE refresh_tokens
+  ADD COLUMN user_agent   TEXT NULL,
+  ADD COLUMN ip_address   VARCHAR(45) NULL,
+  ADD COLUMN last_used_at TIMESTAMP NULL;
+
+UPDATE refresh_tokens SET last_used_at = created_at WHERE last_used_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE refresh_tokens
+  DROP COLUMN last_used_at,
+  DROP COLUMN ip_address,
+  DROP COLUMN user_agent;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE revoked_tokens (
+  jti         VARCHAR(64) PRIMARY KEY,
+  user_id     VARCHAR(36) NOT NULL,
+  expires_at  TIMESTAMP NOT NULL,
+  created_at  TIMESTAMP NULL,
+
+  CONSTRAINT fk_revoked_tokens_user
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX idx_revoked_tokens_expires ON revoked_tokens(expires_at);
+
+-- Access tokens issued before this moment are rejected
+ALTER TABLE users ADD COLUMN tokens_valid_after TIMESTAMP NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users DROP COLUMN tokens_valid_after;
+DROP INDEX idx_revoked_tokens_expires;
+DROP TABLE revoked_tokens;
+-- +goose StatementEnd
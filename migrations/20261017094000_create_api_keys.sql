@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE api_keys (
+  id            VARCHAR(36) PRIMARY KEY,
+  user_id       VARCHAR(36) NOT NULL,
+  name          VARCHAR(100) NOT NULL,
+  prefix        VARCHAR(16) NOT NULL,
+  key_hash      TEXT NOT NULL,
+  scopes        TEXT[] NOT NULL DEFAULT '{}',
+  expires_at    TIMESTAMP NOT NULL,
+  last_used_at  TIMESTAMP NULL,
+  revoked_at    TIMESTAMP NULL,
+  created_at    TIMESTAMP NULL,
+  updated_at    TIMESTAMP NULL,
+
+  CONSTRAINT fk_api_keys_user
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_api_keys_hash ON api_keys(key_hash);
+CREATE INDEX idx_api_keys_user ON api_keys(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_api_keys_user;
+DROP INDEX idx_api_keys_hash;
+DROP TABLE api_keys;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE service_clients (
+  id            VARCHAR(36) PRIMARY KEY,
+  client_id     VARCHAR(64) NOT NULL,
+  name          VARCHAR(100) NOT NULL,
+  secret_hash   TEXT NOT NULL,
+  scopes        TEXT[] NOT NULL DEFAULT '{}',
+  revoked_at    TIMESTAMP NULL,
+  created_at    TIMESTAMP NULL,
+  updated_at    TIMESTAMP NULL
+);
+
+CREATE UNIQUE INDEX idx_service_clients_client_id ON service_clients(client_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_service_clients_client_id;
+DROP TABLE service_clients;
+-- +goose StatementEnd
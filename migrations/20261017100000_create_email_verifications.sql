@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN email_verified_at TIMESTAMP NULL;
+
+-- Accounts created before verification existed are trusted as verified
+UPDATE users SET email_verified_at = COALESCE(created_at, NOW());
+
+CREATE TABLE email_verifications (
+  id          VARCHAR(36) PRIMARY KEY,
+  user_id     VARCHAR(36) NOT NULL,
+  token_hash  TEXT NOT NULL,
+  used        BOOLEAN NOT NULL DEFAULT false,
+  expires_at  TIMESTAMP NOT NULL,
+  created_at  TIMESTAMP NULL,
+  updated_at  TIMESTAMP NULL,
+
+  CONSTRAINT fk_email_verification_user
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX idx_email_verifications_hash ON email_verifications(token_hash);
+CREATE INDEX idx_email_verifications_user ON email_verifications(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_email_verifications_hash;
+DROP INDEX idx_email_verifications_user;
+DROP TABLE email_verifications;
+ALTER TABLE users DROP COLUMN email_verified_at;
+-- +goose StatementEnd
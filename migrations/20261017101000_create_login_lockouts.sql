@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE login_lockouts (
+  user_id          VARCHAR(36) PRIMARY KEY,
+  failed_attempts  INT NOT NULL DEFAULT 0,
+  lockout_count    INT NOT NULL DEFAULT 0,
+  locked_until     TIMESTAMP NULL,
+  updated_at       TIMESTAMP NULL,
+
+  CONSTRAINT fk_login_lockouts_user
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE
+);
+
+CREATE TABLE login_ip_failures (
+  ip_address         VARCHAR(64) PRIMARY KEY,
+  failed_attempts    INT NOT NULL DEFAULT 0,
+  window_started_at  TIMESTAMP NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE login_ip_failures;
+DROP TABLE login_lockouts;
+-- +goose StatementEnd
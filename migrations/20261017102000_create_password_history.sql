@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE password_history (
+  id             VARCHAR(36) PRIMARY KEY,
+  user_id        VARCHAR(36) NOT NULL,
+  password_hash  TEXT NOT NULL,
+  created_at     TIMESTAMP NOT NULL,
+
+  CONSTRAINT fk_password_history_user
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX idx_password_history_user_id ON password_history(user_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE password_history;
+-- +goose StatementEnd
@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE permissions (
+  id           VARCHAR(36) PRIMARY KEY,
+  name         VARCHAR(100) NOT NULL UNIQUE,
+  description  TEXT NOT NULL DEFAULT '',
+  created_at   TIMESTAMP NULL,
+  updated_at   TIMESTAMP NULL
+);
+
+CREATE TABLE role_permissions (
+  role_id        VARCHAR(36) NOT NULL,
+  permission_id  VARCHAR(36) NOT NULL,
+  created_at     TIMESTAMP NULL,
+
+  PRIMARY KEY (role_id, permission_id),
+
+  CONSTRAINT fk_role_permissions_role
+    FOREIGN KEY (role_id)
+    REFERENCES roles(id)
+    ON DELETE CASCADE,
+
+  CONSTRAINT fk_role_permissions_permission
+    FOREIGN KEY (permission_id)
+    REFERENCES permissions(id)
+    ON DELETE CASCADE
+);
+
+INSERT INTO permissions (id, name, description, created_at, updated_at) VALUES
+  ('00000000-0000-0000-0001-000000000001', 'users.read', 'List and view users', NOW(), NOW()),
+  ('00000000-0000-0000-0001-000000000002', 'users.create', 'Create users', NOW(), NOW()),
+  ('00000000-0000-0000-0001-000000000003', 'users.update', 'Update users and their role', NOW(), NOW()),
+  ('00000000-0000-0000-0001-000000000004', 'users.delete', 'Delete users', NOW(), NOW()),
+  ('00000000-0000-0000-0001-000000000005', 'users.unlock', 'Unlock accounts locked after failed logins', NOW(), NOW()),
+  ('00000000-0000-0000-0001-000000000006', 'roles.read', 'List roles and permissions', NOW(), NOW()),
+  ('00000000-0000-0000-0001-000000000007', 'roles.manage', 'Create, update and delete roles and grant permissions', NOW(), NOW());
+
+-- admin keeps what RequireRole("admin", "super_admin") allowed before
+INSERT INTO role_permissions (role_id, permission_id, created_at)
+SELECT '00000000-0000-0000-0000-000000000002', id, NOW() FROM permissions
+WHERE name IN ('users.read', 'users.create', 'users.update', 'users.delete', 'users.unlock', 'roles.read');
+
+-- super_admin gets everything
+INSERT INTO role_permissions (role_id, permission_id, created_at)
+SELECT '00000000-0000-0000-0000-000000000003', id, NOW() FROM permissions;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE role_permissions;
+DROP TABLE permissions;
+-- +goose StatementEnd
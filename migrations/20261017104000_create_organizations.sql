@@ -0,0 +1,61 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE organizations (
+  id          VARCHAR(36) PRIMARY KEY,
+  name        VARCHAR(100) NOT NULL,
+  slug        VARCHAR(100) NOT NULL UNIQUE,
+  created_at  TIMESTAMP NULL,
+  updated_at  TIMESTAMP NULL
+);
+
+-- A user's role inside one organization, used instead of users.role_id
+-- while their access token is issued for that organization
+CREATE TABLE organization_members (
+  organization_id  VARCHAR(36) NOT NULL,
+  user_id          VARCHAR(36) NOT NULL,
+  role_id          VARCHAR(36) NOT NULL,
+  created_at       TIMESTAMP NULL,
+  updated_at       TIMESTAMP NULL,
+
+  PRIMARY KEY (organization_id, user_id),
+
+  CONSTRAINT fk_organization_members_organization
+    FOREIGN KEY (organization_id)
+    REFERENCES organizations(id)
+    ON DELETE CASCADE,
+
+  CONSTRAINT fk_organization_members_user
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE,
+
+  CONSTRAINT fk_organization_members_role
+    FOREIGN KEY (role_id)
+    REFERENCES roles(id)
+);
+
+CREATE INDEX idx_organization_members_user ON organization_members(user_id);
+
+-- Refreshing keeps the session in the organization it was switched to
+ALTER TABLE refresh_tokens
+  ADD COLUMN organization_id VARCHAR(36) NULL
+  REFERENCES organizations(id) ON DELETE SET NULL;
+
+INSERT INTO permissions (id, name, description, created_at, updated_at) VALUES
+  ('00000000-0000-0000-0001-000000000008', 'organizations.manage', 'Rename and delete the current organization', NOW(), NOW()),
+  ('00000000-0000-0000-0001-000000000009', 'members.read', 'List members of the current organization', NOW(), NOW()),
+  ('00000000-0000-0000-0001-000000000010', 'members.manage', 'Add, remove and change roles of organization members', NOW(), NOW());
+
+INSERT INTO role_permissions (role_id, permission_id, created_at)
+SELECT r.id, p.id, NOW() FROM roles r, permissions p
+WHERE r.id IN ('00000000-0000-0000-0000-000000000002', '00000000-0000-0000-0000-000000000003')
+  AND p.name IN ('organizations.manage', 'members.read', 'members.manage');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM permissions WHERE name IN ('organizations.manage', 'members.read', 'members.manage');
+ALTER TABLE refresh_tokens DROP COLUMN organization_id;
+DROP TABLE organization_members;
+DROP TABLE organizations;
+-- +goose StatementEnd
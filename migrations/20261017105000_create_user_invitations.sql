@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Invited users stay pending, without a password, until they accept
+ALTER TABLE users ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'active';
+
+CREATE TABLE user_invitations (
+  id               VARCHAR(36) PRIMARY KEY,
+  user_id          VARCHAR(36) NOT NULL,
+  invited_by       VARCHAR(36) NULL,
+  organization_id  VARCHAR(36) NULL,
+  token_hash       TEXT NOT NULL,
+  used             BOOLEAN NOT NULL DEFAULT false,
+  expires_at       TIMESTAMP NOT NULL,
+  created_at       TIMESTAMP NULL,
+  updated_at       TIMESTAMP NULL,
+
+  CONSTRAINT fk_user_invitations_user
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE,
+
+  CONSTRAINT fk_user_invitations_invited_by
+    FOREIGN KEY (invited_by)
+    REFERENCES users(id)
+    ON DELETE SET NULL,
+
+  CONSTRAINT fk_user_invitations_organization
+    FOREIGN KEY (organization_id)
+    REFERENCES organizations(id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX idx_user_invitations_hash ON user_invitations(token_hash);
+CREATE UNIQUE INDEX idx_user_invitations_user ON user_invitations(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM users WHERE status = 'pending';
+DROP TABLE user_invitations;
+ALTER TABLE users DROP COLUMN status;
+-- +goose StatementEnd
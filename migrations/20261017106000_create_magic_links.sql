@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- browser_code_hash binds the link to the browser that asked for it, so a
+-- forwarded mail alone cannot sign anyone in
+CREATE TABLE magic_links (
+  id                 VARCHAR(36) PRIMARY KEY,
+  user_id            VARCHAR(36) NOT NULL,
+  token_hash         TEXT NOT NULL,
+  browser_code_hash  TEXT NOT NULL,
+  used               BOOLEAN NOT NULL DEFAULT false,
+  expires_at         TIMESTAMP NOT NULL,
+  created_at         TIMESTAMP NULL,
+  updated_at         TIMESTAMP NULL,
+
+  CONSTRAINT fk_magic_links_user
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX idx_magic_links_hash ON magic_links(token_hash);
+CREATE INDEX idx_magic_links_user ON magic_links(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_magic_links_hash;
+DROP INDEX idx_magic_links_user;
+DROP TABLE magic_links;
+-- +goose StatementEnd
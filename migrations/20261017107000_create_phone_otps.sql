@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Phone numbers are stored in E.164. Only a verified number is unique, so an
+-- unverified claim cannot lock the real owner out of their number.
+ALTER TABLE users ADD COLUMN phone VARCHAR(16) NULL;
+ALTER TABLE users ADD COLUMN phone_verified_at TIMESTAMP NULL;
+
+CREATE UNIQUE INDEX idx_users_verified_phone ON users(phone) WHERE phone_verified_at IS NOT NULL;
+
+-- phone is the number the code was sent to, it replaces users.phone once verified
+CREATE TABLE phone_otps (
+  id          VARCHAR(36) PRIMARY KEY,
+  user_id     VARCHAR(36) NOT NULL,
+  phone       VARCHAR(16) NOT NULL,
+  purpose     VARCHAR(20) NOT NULL,
+  code_hash   TEXT NOT NULL,
+  attempts    INTEGER NOT NULL DEFAULT 0,
+  used        BOOLEAN NOT NULL DEFAULT false,
+  expires_at  TIMESTAMP NOT NULL,
+  created_at  TIMESTAMP NULL,
+  updated_at  TIMESTAMP NULL,
+
+  CONSTRAINT fk_phone_otps_user
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX idx_phone_otps_user_purpose ON phone_otps(user_id, purpose);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE phone_otps;
+DROP INDEX idx_users_verified_phone;
+ALTER TABLE users DROP COLUMN phone_verified_at;
+ALTER TABLE users DROP COLUMN phone;
+-- +goose StatementEnd
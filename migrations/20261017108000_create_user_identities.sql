@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+-- External OpenID Connect accounts a user signs in with. subject is the
+-- provider's stable user id, email is informational only.
+CREATE TABLE user_identities (
+  id          VARCHAR(36) PRIMARY KEY,
+  user_id     VARCHAR(36) NOT NULL,
+  provider    VARCHAR(50) NOT NULL,
+  subject     VARCHAR(255) NOT NULL,
+  email       VARCHAR(255) NULL,
+  created_at  TIMESTAMP NULL,
+  updated_at  TIMESTAMP NULL,
+
+  CONSTRAINT fk_user_identities_user
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_user_identities_provider_subject ON user_identities(provider, subject);
+CREATE INDEX idx_user_identities_user ON user_identities(user_id);
+
+-- A login in progress: the state parameter, nonce and PKCE verifier sent to
+-- the provider, bound to the starting browser through browser_code_hash
+CREATE TABLE oidc_states (
+  id                 VARCHAR(36) PRIMARY KEY,
+  provider           VARCHAR(50) NOT NULL,
+  state_hash         TEXT NOT NULL,
+  browser_code_hash  TEXT NOT NULL,
+  nonce              TEXT NOT NULL,
+  code_verifier      TEXT NOT NULL,
+  used               BOOLEAN NOT NULL DEFAULT false,
+  expires_at         TIMESTAMP NOT NULL,
+  created_at         TIMESTAMP NULL,
+  updated_at         TIMESTAMP NULL
+);
+
+CREATE INDEX idx_oidc_states_hash ON oidc_states(state_hash);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE oidc_states;
+DROP TABLE user_identities;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Actions admins take on behalf of users, starting with impersonation. No
+-- foreign keys: the trail outlives deleted users.
+CREATE TABLE audit_logs (
+  id          VARCHAR(36) PRIMARY KEY,
+  actor_id    VARCHAR(36) NOT NULL,
+  user_id     VARCHAR(36) NOT NULL,
+  action      VARCHAR(100) NOT NULL,
+  details     TEXT NOT NULL DEFAULT '',
+  ip_address  VARCHAR(45) NOT NULL DEFAULT '',
+  user_agent  TEXT NOT NULL DEFAULT '',
+  created_at  TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_audit_logs_actor ON audit_logs(actor_id, created_at);
+CREATE INDEX idx_audit_logs_user ON audit_logs(user_id, created_at);
+
+-- Only super_admin may impersonate
+INSERT INTO permissions (id, name, description, created_at, updated_at) VALUES
+  ('00000000-0000-0000-0001-000000000011', 'users.impersonate', 'Act as another user for support', NOW(), NOW());
+
+INSERT INTO role_permissions (role_id, permission_id, created_at)
+SELECT '00000000-0000-0000-0000-000000000003', id, NOW() FROM permissions
+WHERE name = 'users.impersonate';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM permissions WHERE name = 'users.impersonate';
+DROP TABLE audit_logs;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Users are suspended or deactivated instead of deleted; the reason of the
+-- last change is kept next to the status, the full history in audit_logs.
+ALTER TABLE users ADD COLUMN status_reason TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN status_changed_at TIMESTAMP NULL;
+ALTER TABLE users ADD CONSTRAINT chk_users_status
+  CHECK (status IN ('pending', 'active', 'suspended', 'deactivated'));
+
+INSERT INTO permissions (id, name, description, created_at, updated_at) VALUES
+  ('00000000-0000-0000-0001-000000000012', 'users.suspend', 'Suspend, deactivate and reactivate users', NOW(), NOW());
+
+INSERT INTO role_permissions (role_id, permission_id, created_at)
+SELECT r.id, p.id, NOW() FROM roles r, permissions p
+WHERE r.id IN ('00000000-0000-0000-0000-000000000002', '00000000-0000-0000-0000-000000000003')
+  AND p.name = 'users.suspend';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM permissions WHERE name = 'users.suspend';
+UPDATE users SET status = 'active' WHERE status IN ('suspended', 'deactivated');
+ALTER TABLE users DROP CONSTRAINT chk_users_status;
+ALTER TABLE users DROP COLUMN status_changed_at;
+ALTER TABLE users DROP COLUMN status_reason;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- When the user last proved their credentials for the session, copied on every
+-- rotation. Existing sessions have none and must reauthenticate for sensitive calls.
+ALTER TABLE refresh_tokens ADD COLUMN authenticated_at TIMESTAMP NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE refresh_tokens DROP COLUMN authenticated_at;
+-- +goose StatementEnd
@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Passkeys and security keys. public_key is the COSE_Key the authenticator
+-- returned at registration; sign_count detects cloned authenticators.
+CREATE TABLE webauthn_credentials (
+  id             VARCHAR(36) PRIMARY KEY,
+  user_id        VARCHAR(36) NOT NULL,
+  credential_id  BYTEA NOT NULL,
+  public_key     BYTEA NOT NULL,
+  sign_count     BIGINT NOT NULL DEFAULT 0,
+  transports     TEXT[] NOT NULL DEFAULT '{}',
+  name           VARCHAR(100) NOT NULL,
+  last_used_at   TIMESTAMP NULL,
+  created_at     TIMESTAMP NULL,
+  updated_at     TIMESTAMP NULL,
+
+  CONSTRAINT fk_webauthn_credentials_user
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_webauthn_credentials_credential_id ON webauthn_credentials(credential_id);
+CREATE INDEX idx_webauthn_credentials_user ON webauthn_credentials(user_id);
+
+-- A registration or assertion waiting for the authenticator. Only the hash of
+-- the challenge is kept; user_id is NULL for a passwordless login.
+CREATE TABLE webauthn_challenges (
+  id              VARCHAR(36) PRIMARY KEY,
+  user_id         VARCHAR(36) NULL,
+  purpose         VARCHAR(20) NOT NULL,
+  challenge_hash  TEXT NOT NULL,
+  used            BOOLEAN NOT NULL DEFAULT false,
+  expires_at      TIMESTAMP NOT NULL,
+  created_at      TIMESTAMP NULL,
+  updated_at      TIMESTAMP NULL,
+
+  CONSTRAINT fk_webauthn_challenges_user
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX idx_webauthn_challenges_hash ON webauthn_challenges(challenge_hash);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE webauthn_challenges;
+DROP TABLE webauthn_credentials;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Devices a user has logged in from. fingerprint hashes the user agent, the
+-- network the IP belongs to (/24 or /48) and the device id the client sent.
+-- A login from an unknown device stores the hash of a single-use token that
+-- revokes the session it opened, mailed to the user in the new device alert.
+CREATE TABLE user_devices (
+  id                       VARCHAR(36) PRIMARY KEY,
+  user_id                  VARCHAR(36) NOT NULL,
+  fingerprint              TEXT NOT NULL,
+  user_agent               TEXT NULL,
+  ip_address               VARCHAR(45) NULL,
+  session_id               VARCHAR(36) NOT NULL,
+  revoke_token_hash        TEXT NULL,
+  revoke_token_expires_at  TIMESTAMP NULL,
+  first_seen_at            TIMESTAMP NOT NULL,
+  last_seen_at             TIMESTAMP NOT NULL,
+
+  CONSTRAINT fk_user_devices_user
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_user_devices_fingerprint ON user_devices(user_id, fingerprint);
+CREATE INDEX idx_user_devices_revoke_token ON user_devices(revoke_token_hash);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_devices;
+-- +goose StatementEnd
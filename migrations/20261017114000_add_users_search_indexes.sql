@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Trigram indexes serve the user list search, a case-insensitive match on any
+-- part of the name or email (ILIKE '%term%'), which a btree index cannot.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_users_name_trgm ON users USING gin (name gin_trgm_ops);
+CREATE INDEX idx_users_email_trgm ON users USING gin (email gin_trgm_ops);
+CREATE INDEX idx_users_status ON users(status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_users_status;
+DROP INDEX idx_users_email_trgm;
+DROP INDEX idx_users_name_trgm;
+-- +goose StatementEnd
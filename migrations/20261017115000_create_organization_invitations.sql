@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+-- An existing user only joins an organization by accepting its invitation
+CREATE TABLE organization_invitations (
+  organization_id  VARCHAR(36) NOT NULL,
+  user_id          VARCHAR(36) NOT NULL,
+  role_id          VARCHAR(36) NOT NULL,
+  invited_by       VARCHAR(36) NULL,
+  expires_at       TIMESTAMP NOT NULL,
+  created_at       TIMESTAMP NULL,
+
+  PRIMARY KEY (organization_id, user_id),
+
+  CONSTRAINT fk_organization_invitations_organization
+    FOREIGN KEY (organization_id)
+    REFERENCES organizations(id)
+    ON DELETE CASCADE,
+
+  CONSTRAINT fk_organization_invitations_user
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE,
+
+  CONSTRAINT fk_organization_invitations_role
+    FOREIGN KEY (role_id)
+    REFERENCES roles(id)
+    ON DELETE CASCADE,
+
+  CONSTRAINT fk_organization_invitations_invited_by
+    FOREIGN KEY (invited_by)
+    REFERENCES users(id)
+    ON DELETE SET NULL
+);
+
+CREATE INDEX idx_organization_invitations_user ON organization_invitations(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE organization_invitations;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Like users.tokens_valid_after, but only for the access tokens a user holds
+-- in one organization, e.g. once they are removed from it
+CREATE TABLE organization_tokens_valid_after (
+  organization_id  VARCHAR(36) NOT NULL,
+  user_id          VARCHAR(36) NOT NULL,
+  valid_after      TIMESTAMP NOT NULL,
+
+  PRIMARY KEY (organization_id, user_id),
+
+  CONSTRAINT fk_organization_tokens_valid_after_organization
+    FOREIGN KEY (organization_id)
+    REFERENCES organizations(id)
+    ON DELETE CASCADE,
+
+  CONSTRAINT fk_organization_tokens_valid_after_user
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE organization_tokens_valid_after;
+-- +goose StatementEnd
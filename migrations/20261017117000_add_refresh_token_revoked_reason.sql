@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Only a rotated token presented again means someone kept a copy; tokens
+-- revoked by a logout, password reset or session revoke are simply over
+ALTER TABLE refresh_tokens ADD COLUMN revoked_reason VARCHAR(20) NULL;
+
+UPDATE refresh_tokens t SET revoked_reason = CASE
+    WHEN EXISTS (SELECT 1 FROM refresh_tokens c WHERE c.parent_id = t.id) THEN 'rotated'
+    ELSE 'ended'
+  END
+WHERE t.revoked = true;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE refresh_tokens DROP COLUMN revoked_reason;
+-- +goose StatementEnd
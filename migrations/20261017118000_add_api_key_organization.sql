@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A key created while acting in an organization acts there with the owner's
+-- role in it; keys created outside any organization stay global
+ALTER TABLE api_keys ADD COLUMN organization_id VARCHAR(36) NULL
+  REFERENCES organizations(id) ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE api_keys DROP COLUMN organization_id;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Access tokens carrying a revoked session's sid are rejected until they expire
+CREATE TABLE revoked_sessions (
+  session_id  VARCHAR(36) PRIMARY KEY,
+  user_id     VARCHAR(36) NOT NULL,
+  expires_at  TIMESTAMP NOT NULL,
+  created_at  TIMESTAMP NULL,
+
+  CONSTRAINT fk_revoked_sessions_user
+    FOREIGN KEY (user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE
+);
+
+CREATE INDEX idx_revoked_sessions_expires ON revoked_sessions(expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_revoked_sessions_expires;
+DROP TABLE revoked_sessions;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Emails are looked up case-insensitively. Not unique: rows differing only in
+-- case may predate the case-insensitive checks and must be merged by hand first.
+CREATE INDEX idx_users_email_lower ON users (lower(email));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_users_email_lower;
+-- +goose StatementEnd
@@ -12,6 +12,8 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -77,12 +79,13 @@ func (x *LoginRequest) GetPassword() string {
 }
 
 type RegisterRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Email                string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Phone                string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
-	Password             string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
-	PasswordConfirmation string                 `protobuf:"bytes,5,opt,name=password_confirmation,json=passwordConfirmation,proto3" json:"password_confirmation,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// Opsional, format internasional (+628123456789) atau nasional (08123456789)
+	Phone                string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Password             string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	PasswordConfirmation string `protobuf:"bytes,5,opt,name=password_confirmation,json=passwordConfirmation,proto3" json:"password_confirmation,omitempty"`
 	unknownFields        protoimpl.UnknownFields
 	sizeCache            protoimpl.SizeCache
 }
@@ -241,28 +244,2281 @@ func (x *LogoutRequest) GetRefreshToken() string {
 }
 
 type AuthResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken  string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// true jika user memiliki MFA aktif, token baru diberikan setelah VerifyMfa
+	MfaRequired bool `protobuf:"varint,3,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	// Token challenge untuk VerifyMfa (berlaku 5 menit)
+	MfaToken string `protobuf:"bytes,4,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	// Organisasi tempat token berlaku, kosong jika user belum punya organisasi
+	OrganizationId string `protobuf:"bytes,5,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Faktor kedua yang bisa menyelesaikan challenge MFA: totp, webauthn
+	MfaMethods    []string `protobuf:"bytes,6,rep,name=mfa_methods,json=mfaMethods,proto3" json:"mfa_methods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthResponse) Reset() {
+	*x = AuthResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthResponse) ProtoMessage() {}
+
+func (x *AuthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
+func (*AuthResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AuthResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *AuthResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetMfaMethods() []string {
+	if x != nil {
+		return x.MfaMethods
+	}
+	return nil
+}
+
+type SwitchOrganizationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID organisasi tujuan
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SwitchOrganizationRequest) Reset() {
+	*x = SwitchOrganizationRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwitchOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchOrganizationRequest) ProtoMessage() {}
+
+func (x *SwitchOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*SwitchOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SwitchOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type ForgotPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForgotPasswordRequest) Reset() {
+	*x = ForgotPasswordRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForgotPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgotPasswordRequest) ProtoMessage() {}
+
+func (x *ForgotPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForgotPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ForgotPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ForgotPasswordRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type AcceptInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Token dari email undangan
+	Token                string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Name                 string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Password             string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	PasswordConfirmation string `protobuf:"bytes,4,opt,name=password_confirmation,json=passwordConfirmation,proto3" json:"password_confirmation,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationRequest) Reset() {
+	*x = AcceptInvitationRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AcceptInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AcceptInvitationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AcceptInvitationRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *AcceptInvitationRequest) GetPasswordConfirmation() string {
+	if x != nil {
+		return x.PasswordConfirmation
+	}
+	return ""
+}
+
+type RequestMagicLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestMagicLinkRequest) Reset() {
+	*x = RequestMagicLinkRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestMagicLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestMagicLinkRequest) ProtoMessage() {}
+
+func (x *RequestMagicLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestMagicLinkRequest.ProtoReflect.Descriptor instead.
+func (*RequestMagicLinkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RequestMagicLinkRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type MagicLinkResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Message string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Kode pengikat browser, dikirim kembali bersama token saat consume
+	BrowserCode   string `protobuf:"bytes,2,opt,name=browser_code,json=browserCode,proto3" json:"browser_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MagicLinkResponse) Reset() {
+	*x = MagicLinkResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MagicLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MagicLinkResponse) ProtoMessage() {}
+
+func (x *MagicLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MagicLinkResponse.ProtoReflect.Descriptor instead.
+func (*MagicLinkResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MagicLinkResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MagicLinkResponse) GetBrowserCode() string {
+	if x != nil {
+		return x.BrowserCode
+	}
+	return ""
+}
+
+type ConsumeMagicLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Token dari email magic link
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	BrowserCode   string `protobuf:"bytes,2,opt,name=browser_code,json=browserCode,proto3" json:"browser_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumeMagicLinkRequest) Reset() {
+	*x = ConsumeMagicLinkRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeMagicLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeMagicLinkRequest) ProtoMessage() {}
+
+func (x *ConsumeMagicLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeMagicLinkRequest.ProtoReflect.Descriptor instead.
+func (*ConsumeMagicLinkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ConsumeMagicLinkRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ConsumeMagicLinkRequest) GetBrowserCode() string {
+	if x != nil {
+		return x.BrowserCode
+	}
+	return ""
+}
+
+type RequestPhoneVerificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Opsional, kosongkan untuk memverifikasi nomor HP yang sudah tersimpan
+	Phone         string `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPhoneVerificationRequest) Reset() {
+	*x = RequestPhoneVerificationRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPhoneVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPhoneVerificationRequest) ProtoMessage() {}
+
+func (x *RequestPhoneVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPhoneVerificationRequest.ProtoReflect.Descriptor instead.
+func (*RequestPhoneVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RequestPhoneVerificationRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type VerifyPhoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPhoneRequest) Reset() {
+	*x = VerifyPhoneRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPhoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPhoneRequest) ProtoMessage() {}
+
+func (x *VerifyPhoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPhoneRequest.ProtoReflect.Descriptor instead.
+func (*VerifyPhoneRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VerifyPhoneRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RequestPhoneLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPhoneLoginRequest) Reset() {
+	*x = RequestPhoneLoginRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPhoneLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPhoneLoginRequest) ProtoMessage() {}
+
+func (x *RequestPhoneLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPhoneLoginRequest.ProtoReflect.Descriptor instead.
+func (*RequestPhoneLoginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RequestPhoneLoginRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type LoginWithPhoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginWithPhoneRequest) Reset() {
+	*x = LoginWithPhoneRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginWithPhoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithPhoneRequest) ProtoMessage() {}
+
+func (x *LoginWithPhoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithPhoneRequest.ProtoReflect.Descriptor instead.
+func (*LoginWithPhoneRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LoginWithPhoneRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *LoginWithPhoneRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type StartOidcLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartOidcLoginRequest) Reset() {
+	*x = StartOidcLoginRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOidcLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOidcLoginRequest) ProtoMessage() {}
+
+func (x *StartOidcLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartOidcLoginRequest.ProtoReflect.Descriptor instead.
+func (*StartOidcLoginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StartOidcLoginRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type StartOidcLoginResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationUrl string                 `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	// Kode pengikat browser, dikirim kembali ke callback jika cookie tidak tersedia
+	BrowserCode   string `protobuf:"bytes,2,opt,name=browser_code,json=browserCode,proto3" json:"browser_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartOidcLoginResponse) Reset() {
+	*x = StartOidcLoginResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOidcLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOidcLoginResponse) ProtoMessage() {}
+
+func (x *StartOidcLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartOidcLoginResponse.ProtoReflect.Descriptor instead.
+func (*StartOidcLoginResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StartOidcLoginResponse) GetAuthorizationUrl() string {
+	if x != nil {
+		return x.AuthorizationUrl
+	}
+	return ""
+}
+
+func (x *StartOidcLoginResponse) GetBrowserCode() string {
+	if x != nil {
+		return x.BrowserCode
+	}
+	return ""
+}
+
+type OidcCallbackRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Provider string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Code     string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	State    string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	// Opsional, default dari cookie oidc_browser_code
+	BrowserCode string `protobuf:"bytes,4,opt,name=browser_code,json=browserCode,proto3" json:"browser_code,omitempty"`
+	// Diisi provider jika user membatalkan login
+	Error         string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OidcCallbackRequest) Reset() {
+	*x = OidcCallbackRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OidcCallbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OidcCallbackRequest) ProtoMessage() {}
+
+func (x *OidcCallbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OidcCallbackRequest.ProtoReflect.Descriptor instead.
+func (*OidcCallbackRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OidcCallbackRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *OidcCallbackRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *OidcCallbackRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *OidcCallbackRequest) GetBrowserCode() string {
+	if x != nil {
+		return x.BrowserCode
+	}
+	return ""
+}
+
+func (x *OidcCallbackRequest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type VerifyEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRequest) ProtoMessage() {}
+
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *VerifyEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ResendVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendVerificationRequest) Reset() {
+	*x = ResendVerificationRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendVerificationRequest) ProtoMessage() {}
+
+func (x *ResendVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendVerificationRequest.ProtoReflect.Descriptor instead.
+func (*ResendVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ResendVerificationRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type MessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageResponse) Reset() {
+	*x = MessageResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageResponse) ProtoMessage() {}
+
+func (x *MessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageResponse.ProtoReflect.Descriptor instead.
+func (*MessageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MessageResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type EnrollMfaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Secret TOTP dalam format base32
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// URI otpauth:// untuk QR code aplikasi authenticator
+	ProvisioningUri string `protobuf:"bytes,2,opt,name=provisioning_uri,json=provisioningUri,proto3" json:"provisioning_uri,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EnrollMfaResponse) Reset() {
+	*x = EnrollMfaResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollMfaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollMfaResponse) ProtoMessage() {}
+
+func (x *EnrollMfaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollMfaResponse.ProtoReflect.Descriptor instead.
+func (*EnrollMfaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *EnrollMfaResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollMfaResponse) GetProvisioningUri() string {
+	if x != nil {
+		return x.ProvisioningUri
+	}
+	return ""
+}
+
+type ConfirmMfaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmMfaRequest) Reset() {
+	*x = ConfirmMfaRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmMfaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmMfaRequest) ProtoMessage() {}
+
+func (x *ConfirmMfaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmMfaRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmMfaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ConfirmMfaRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmMfaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Recovery code sekali pakai, simpan di tempat aman
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmMfaResponse) Reset() {
+	*x = ConfirmMfaResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmMfaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmMfaResponse) ProtoMessage() {}
+
+func (x *ConfirmMfaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmMfaResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmMfaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ConfirmMfaResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type DisableMfaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kode TOTP atau recovery code
+	Code          string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableMfaRequest) Reset() {
+	*x = DisableMfaRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableMfaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableMfaRequest) ProtoMessage() {}
+
+func (x *DisableMfaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableMfaRequest.ProtoReflect.Descriptor instead.
+func (*DisableMfaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DisableMfaRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type VerifyMfaRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MfaToken string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	// Kode TOTP atau recovery code
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMfaRequest) Reset() {
+	*x = VerifyMfaRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMfaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMfaRequest) ProtoMessage() {}
+
+func (x *VerifyMfaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMfaRequest.ProtoReflect.Descriptor instead.
+func (*VerifyMfaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *VerifyMfaRequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *VerifyMfaRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type Session struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID sesi
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// User agent device yang login
+	UserAgent string `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// Alamat IP terakhir
+	IpAddress string `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// Waktu login
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Waktu terakhir sesi digunakan (refresh token)
+	LastUsedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	// Waktu sesi berakhir
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// true untuk sesi yang sedang dipakai request ini
+	Current       bool `protobuf:"varint,7,opt,name=current,proto3" json:"current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_proto_auth_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Session) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Session) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *Session) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RevokeSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type RevokeDeviceSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Token dari email peringatan login dari device baru
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeDeviceSessionRequest) Reset() {
+	*x = RevokeDeviceSessionRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeDeviceSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeDeviceSessionRequest) ProtoMessage() {}
+
+func (x *RevokeDeviceSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeDeviceSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeDeviceSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RevokeDeviceSessionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type Jwk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Key type: RSA atau OKP
+	Kty string `protobuf:"bytes,1,opt,name=kty,proto3" json:"kty,omitempty"`
+	// Key ID, sama dengan header kid pada token
+	Kid string `protobuf:"bytes,2,opt,name=kid,proto3" json:"kid,omitempty"`
+	Use string `protobuf:"bytes,3,opt,name=use,proto3" json:"use,omitempty"`
+	// Algoritma signature: RS256 atau EdDSA
+	Alg string `protobuf:"bytes,4,opt,name=alg,proto3" json:"alg,omitempty"`
+	// Modulus RSA (base64url)
+	N *string `protobuf:"bytes,5,opt,name=n,proto3,oneof" json:"n,omitempty"`
+	// Exponent RSA (base64url)
+	E *string `protobuf:"bytes,6,opt,name=e,proto3,oneof" json:"e,omitempty"`
+	// Curve OKP, selalu Ed25519
+	Crv *string `protobuf:"bytes,7,opt,name=crv,proto3,oneof" json:"crv,omitempty"`
+	// Public key Ed25519 (base64url)
+	X             *string `protobuf:"bytes,8,opt,name=x,proto3,oneof" json:"x,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Jwk) Reset() {
+	*x = Jwk{}
+	mi := &file_proto_auth_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Jwk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Jwk) ProtoMessage() {}
+
+func (x *Jwk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Jwk.ProtoReflect.Descriptor instead.
+func (*Jwk) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Jwk) GetKty() string {
+	if x != nil {
+		return x.Kty
+	}
+	return ""
+}
+
+func (x *Jwk) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *Jwk) GetUse() string {
+	if x != nil {
+		return x.Use
+	}
+	return ""
+}
+
+func (x *Jwk) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *Jwk) GetN() string {
+	if x != nil && x.N != nil {
+		return *x.N
+	}
+	return ""
+}
+
+func (x *Jwk) GetE() string {
+	if x != nil && x.E != nil {
+		return *x.E
+	}
+	return ""
+}
+
+func (x *Jwk) GetCrv() string {
+	if x != nil && x.Crv != nil {
+		return *x.Crv
+	}
+	return ""
+}
+
+func (x *Jwk) GetX() string {
+	if x != nil && x.X != nil {
+		return *x.X
+	}
+	return ""
+}
+
+type JwksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*Jwk                 `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JwksResponse) Reset() {
+	*x = JwksResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JwksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JwksResponse) ProtoMessage() {}
+
+func (x *JwksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JwksResponse.ProtoReflect.Descriptor instead.
+func (*JwksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *JwksResponse) GetKeys() []*Jwk {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type ApiKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID API key
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Nama untuk mengenali key
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Awalan key untuk identifikasi, misal pat_ab12cd34
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Scope yang diizinkan: users:read, users:write, sessions:read, sessions:write
+	Scopes    []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Kosong jika belum pernah digunakan
+	LastUsedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	// Organisasi tempat key bertindak dengan role user di organisasi tersebut, kosong untuk key global
+	OrganizationId string `protobuf:"bytes,8,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_proto_auth_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ApiKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApiKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ApiKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ApiKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type CreateApiKeyRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes []string               `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Masa berlaku dalam hari, default 90 dan maksimal 365
+	ExpiresInDays int32 `protobuf:"varint,3,opt,name=expires_in_days,json=expiresInDays,proto3" json:"expires_in_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyRequest) Reset() {
+	*x = CreateApiKeyRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyRequest) ProtoMessage() {}
+
+func (x *CreateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateApiKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateApiKeyRequest) GetExpiresInDays() int32 {
+	if x != nil {
+		return x.ExpiresInDays
+	}
+	return 0
+}
+
+type CreateApiKeyResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// Key lengkap, hanya ditampilkan sekali
+	Key           string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyResponse) Reset() {
+	*x = CreateApiKeyResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyResponse) ProtoMessage() {}
+
+func (x *CreateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateApiKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ListApiKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeys       []*ApiKey              `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysResponse) Reset() {
+	*x = ListApiKeysResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysResponse) ProtoMessage() {}
+
+func (x *ListApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListApiKeysResponse) GetApiKeys() []*ApiKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+type RevokeApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyRequest) ProtoMessage() {}
+
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RevokeApiKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type TokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Harus client_credentials
+	GrantType    string `protobuf:"bytes,1,opt,name=grant_type,proto3" json:"grant_type,omitempty"`
+	ClientId     string `protobuf:"bytes,2,opt,name=client_id,proto3" json:"client_id,omitempty"`
+	ClientSecret string `protobuf:"bytes,3,opt,name=client_secret,proto3" json:"client_secret,omitempty"`
+	// Scope yang diminta dipisah spasi, default semua scope client
+	Scope         string `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenRequest) Reset() {
+	*x = TokenRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenRequest) ProtoMessage() {}
+
+func (x *TokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenRequest.ProtoReflect.Descriptor instead.
+func (*TokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TokenRequest) GetGrantType() string {
+	if x != nil {
+		return x.GrantType
+	}
+	return ""
+}
+
+func (x *TokenRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *TokenRequest) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *TokenRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+type TokenResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken string                 `protobuf:"bytes,1,opt,name=access_token,proto3" json:"access_token,omitempty"`
+	// Selalu Bearer
+	TokenType string `protobuf:"bytes,2,opt,name=token_type,proto3" json:"token_type,omitempty"`
+	// Masa berlaku token dalam detik
+	ExpiresIn int64 `protobuf:"varint,3,opt,name=expires_in,proto3" json:"expires_in,omitempty"`
+	// Scope yang diberikan, dipisah spasi
+	Scope         string `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenResponse) Reset() {
+	*x = TokenResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenResponse) ProtoMessage() {}
+
+func (x *TokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenResponse.ProtoReflect.Descriptor instead.
+func (*TokenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TokenResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *TokenResponse) GetTokenType() string {
+	if x != nil {
+		return x.TokenType
+	}
+	return ""
+}
+
+func (x *TokenResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *TokenResponse) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+type UnlockAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockAccountRequest) Reset() {
+	*x = UnlockAccountRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockAccountRequest) ProtoMessage() {}
+
+func (x *UnlockAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockAccountRequest.ProtoReflect.Descriptor instead.
+func (*UnlockAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UnlockAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReauthenticateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Password akun, atau kosong jika mengonfirmasi dengan kode MFA
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	// Kode TOTP atau recovery code, untuk user dengan MFA aktif
+	MfaCode       string `protobuf:"bytes,2,opt,name=mfa_code,json=mfaCode,proto3" json:"mfa_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReauthenticateRequest) Reset() {
+	*x = ReauthenticateRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReauthenticateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReauthenticateRequest) ProtoMessage() {}
+
+func (x *ReauthenticateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReauthenticateRequest.ProtoReflect.Descriptor instead.
+func (*ReauthenticateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReauthenticateRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ReauthenticateRequest) GetMfaCode() string {
+	if x != nil {
+		return x.MfaCode
+	}
+	return ""
+}
+
+type ReauthenticateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuthResponse) Reset() {
-	*x = AuthResponse{}
-	mi := &file_proto_auth_auth_proto_msgTypes[4]
+func (x *ReauthenticateResponse) Reset() {
+	*x = ReauthenticateResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReauthenticateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReauthenticateResponse) ProtoMessage() {}
+
+func (x *ReauthenticateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReauthenticateResponse.ProtoReflect.Descriptor instead.
+func (*ReauthenticateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReauthenticateResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+type ImpersonateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonateRequest) Reset() {
+	*x = ImpersonateRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateRequest) ProtoMessage() {}
+
+func (x *ImpersonateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateRequest.ProtoReflect.Descriptor instead.
+func (*ImpersonateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ImpersonateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ImpersonateResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// Umur access token dalam detik
+	ExpiresIn     int64 `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonateResponse) Reset() {
+	*x = ImpersonateResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateResponse) ProtoMessage() {}
+
+func (x *ImpersonateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateResponse.ProtoReflect.Descriptor instead.
+func (*ImpersonateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ImpersonateResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ImpersonateResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+type WebauthnOptionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PublicKeyCredentialCreationOptions atau PublicKeyCredentialRequestOptions
+	// dalam format JSON, dengan challenge dan ID credential base64url
+	PublicKey     *structpb.Struct `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebauthnOptionsResponse) Reset() {
+	*x = WebauthnOptionsResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthResponse) String() string {
+func (x *WebauthnOptionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthResponse) ProtoMessage() {}
+func (*WebauthnOptionsResponse) ProtoMessage() {}
 
-func (x *AuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_auth_proto_msgTypes[4]
+func (x *WebauthnOptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -273,47 +2529,102 @@ func (x *AuthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthResponse.ProtoReflect.Descriptor instead.
-func (*AuthResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_auth_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use WebauthnOptionsResponse.ProtoReflect.Descriptor instead.
+func (*WebauthnOptionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *AuthResponse) GetAccessToken() string {
+func (x *WebauthnOptionsResponse) GetPublicKey() *structpb.Struct {
 	if x != nil {
-		return x.AccessToken
+		return x.PublicKey
 	}
-	return ""
+	return nil
 }
 
-func (x *AuthResponse) GetRefreshToken() string {
+type FinishWebauthnRegistrationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PublicKeyCredential dari navigator.credentials.create dalam format JSON
+	Credential *structpb.Struct `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+	// Nama untuk mengenali passkey, default Passkey
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishWebauthnRegistrationRequest) Reset() {
+	*x = FinishWebauthnRegistrationRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishWebauthnRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishWebauthnRegistrationRequest) ProtoMessage() {}
+
+func (x *FinishWebauthnRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[46]
 	if x != nil {
-		return x.RefreshToken
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishWebauthnRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*FinishWebauthnRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *FinishWebauthnRegistrationRequest) GetCredential() *structpb.Struct {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+func (x *FinishWebauthnRegistrationRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-type ForgotPasswordRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+type WebauthnCredential struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID passkey
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Nama untuk mengenali passkey
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Cara browser menghubungi authenticator, misal internal, usb, hybrid
+	Transports []string               `protobuf:"bytes,3,rep,name=transports,proto3" json:"transports,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Kosong jika belum pernah digunakan
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ForgotPasswordRequest) Reset() {
-	*x = ForgotPasswordRequest{}
-	mi := &file_proto_auth_auth_proto_msgTypes[5]
+func (x *WebauthnCredential) Reset() {
+	*x = WebauthnCredential{}
+	mi := &file_proto_auth_auth_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForgotPasswordRequest) String() string {
+func (x *WebauthnCredential) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForgotPasswordRequest) ProtoMessage() {}
+func (*WebauthnCredential) ProtoMessage() {}
 
-func (x *ForgotPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_auth_proto_msgTypes[5]
+func (x *WebauthnCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -324,41 +2635,68 @@ func (x *ForgotPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForgotPasswordRequest.ProtoReflect.Descriptor instead.
-func (*ForgotPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_auth_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use WebauthnCredential.ProtoReflect.Descriptor instead.
+func (*WebauthnCredential) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ForgotPasswordRequest) GetEmail() string {
+func (x *WebauthnCredential) GetId() string {
 	if x != nil {
-		return x.Email
+		return x.Id
 	}
 	return ""
 }
 
-type ResetPasswordRequest struct {
+func (x *WebauthnCredential) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebauthnCredential) GetTransports() []string {
+	if x != nil {
+		return x.Transports
+	}
+	return nil
+}
+
+func (x *WebauthnCredential) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebauthnCredential) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+type WebauthnCredentialResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	Credential    *WebauthnCredential    `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetPasswordRequest) Reset() {
-	*x = ResetPasswordRequest{}
-	mi := &file_proto_auth_auth_proto_msgTypes[6]
+func (x *WebauthnCredentialResponse) Reset() {
+	*x = WebauthnCredentialResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetPasswordRequest) String() string {
+func (x *WebauthnCredentialResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordRequest) ProtoMessage() {}
+func (*WebauthnCredentialResponse) ProtoMessage() {}
 
-func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_auth_proto_msgTypes[6]
+func (x *WebauthnCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -369,47 +2707,139 @@ func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
-func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_auth_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use WebauthnCredentialResponse.ProtoReflect.Descriptor instead.
+func (*WebauthnCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ResetPasswordRequest) GetToken() string {
+func (x *WebauthnCredentialResponse) GetCredential() *WebauthnCredential {
 	if x != nil {
-		return x.Token
+		return x.Credential
+	}
+	return nil
+}
+
+type BeginWebauthnLoginRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// mfa_token dari response login, kosong untuk login tanpa password
+	MfaToken      string `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginWebauthnLoginRequest) Reset() {
+	*x = BeginWebauthnLoginRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginWebauthnLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginWebauthnLoginRequest) ProtoMessage() {}
+
+func (x *BeginWebauthnLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginWebauthnLoginRequest.ProtoReflect.Descriptor instead.
+func (*BeginWebauthnLoginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BeginWebauthnLoginRequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
 	}
 	return ""
 }
 
-func (x *ResetPasswordRequest) GetNewPassword() string {
+type FinishWebauthnLoginRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PublicKeyCredential dari navigator.credentials.get dalam format JSON
+	Credential *structpb.Struct `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+	// mfa_token yang sama dengan BeginWebauthnLogin
+	MfaToken      string `protobuf:"bytes,2,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishWebauthnLoginRequest) Reset() {
+	*x = FinishWebauthnLoginRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishWebauthnLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishWebauthnLoginRequest) ProtoMessage() {}
+
+func (x *FinishWebauthnLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[50]
 	if x != nil {
-		return x.NewPassword
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishWebauthnLoginRequest.ProtoReflect.Descriptor instead.
+func (*FinishWebauthnLoginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *FinishWebauthnLoginRequest) GetCredential() *structpb.Struct {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+func (x *FinishWebauthnLoginRequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
 	}
 	return ""
 }
 
-type MessageResponse struct {
+type ListWebauthnCredentialsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Credentials   []*WebauthnCredential  `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MessageResponse) Reset() {
-	*x = MessageResponse{}
-	mi := &file_proto_auth_auth_proto_msgTypes[7]
+func (x *ListWebauthnCredentialsResponse) Reset() {
+	*x = ListWebauthnCredentialsResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MessageResponse) String() string {
+func (x *ListWebauthnCredentialsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageResponse) ProtoMessage() {}
+func (*ListWebauthnCredentialsResponse) ProtoMessage() {}
 
-func (x *MessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_auth_proto_msgTypes[7]
+func (x *ListWebauthnCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,14 +2850,58 @@ func (x *MessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessageResponse.ProtoReflect.Descriptor instead.
-func (*MessageResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_auth_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListWebauthnCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebauthnCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *MessageResponse) GetMessage() string {
+func (x *ListWebauthnCredentialsResponse) GetCredentials() []*WebauthnCredential {
 	if x != nil {
-		return x.Message
+		return x.Credentials
+	}
+	return nil
+}
+
+type DeleteWebauthnCredentialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebauthnCredentialRequest) Reset() {
+	*x = DeleteWebauthnCredentialRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebauthnCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebauthnCredentialRequest) ProtoMessage() {}
+
+func (x *DeleteWebauthnCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebauthnCredentialRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebauthnCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteWebauthnCredentialRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
@@ -436,7 +2910,7 @@ var File_proto_auth_auth_proto protoreflect.FileDescriptor
 
 const file_proto_auth_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x15proto/auth/auth.proto\x12\aauth.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"@\n" +
+	"\x15proto/auth/auth.proto\x12\aauth.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17proto/auth/policy.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"@\n" +
 	"\fLoginRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xa2\x01\n" +
@@ -449,58 +2923,498 @@ const file_proto_auth_auth_proto_rawDesc = "" +
 	"\x0eRefreshRequest\x12#\n" +
 	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"4\n" +
 	"\rLogoutRequest\x12#\n" +
-	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"V\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"\xe0\x01\n" +
 	"\fAuthResponse\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"-\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12!\n" +
+	"\fmfa_required\x18\x03 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\x04 \x01(\tR\bmfaToken\x12'\n" +
+	"\x0forganization_id\x18\x05 \x01(\tR\x0eorganizationId\x12\x1f\n" +
+	"\vmfa_methods\x18\x06 \x03(\tR\n" +
+	"mfaMethods\"D\n" +
+	"\x19SwitchOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"-\n" +
 	"\x15ForgotPasswordRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\"O\n" +
 	"\x14ResetPasswordRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
-	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"+\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"\x94\x01\n" +
+	"\x17AcceptInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x123\n" +
+	"\x15password_confirmation\x18\x04 \x01(\tR\x14passwordConfirmation\"/\n" +
+	"\x17RequestMagicLinkRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"P\n" +
+	"\x11MagicLinkResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12!\n" +
+	"\fbrowser_code\x18\x02 \x01(\tR\vbrowserCode\"R\n" +
+	"\x17ConsumeMagicLinkRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
+	"\fbrowser_code\x18\x02 \x01(\tR\vbrowserCode\"7\n" +
+	"\x1fRequestPhoneVerificationRequest\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\"(\n" +
+	"\x12VerifyPhoneRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"0\n" +
+	"\x18RequestPhoneLoginRequest\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\"A\n" +
+	"\x15LoginWithPhoneRequest\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"3\n" +
+	"\x15StartOidcLoginRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\"h\n" +
+	"\x16StartOidcLoginResponse\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\x12!\n" +
+	"\fbrowser_code\x18\x02 \x01(\tR\vbrowserCode\"\x94\x01\n" +
+	"\x13OidcCallbackRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12!\n" +
+	"\fbrowser_code\x18\x04 \x01(\tR\vbrowserCode\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"*\n" +
+	"\x12VerifyEmailRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"1\n" +
+	"\x19ResendVerificationRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"+\n" +
 	"\x0fMessageResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2\x82\x0e\n" +
-	"\vAuthService\x12\x85\x02\n" +
-	"\x05Login\x12\x15.auth.v1.LoginRequest\x1a\x15.auth.v1.AuthResponse\"\xcd\x01\x92A\xb3\x01\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"V\n" +
+	"\x11EnrollMfaResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12)\n" +
+	"\x10provisioning_uri\x18\x02 \x01(\tR\x0fprovisioningUri\"'\n" +
+	"\x11ConfirmMfaRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\";\n" +
+	"\x12ConfirmMfaResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"'\n" +
+	"\x11DisableMfaRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"C\n" +
+	"\x10VerifyMfaRequest\x12\x1b\n" +
+	"\tmfa_token\x18\x01 \x01(\tR\bmfaToken\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\xa5\x02\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x02 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
+	"\acurrent\x18\a \x01(\bR\acurrent\"D\n" +
+	"\x14ListSessionsResponse\x12,\n" +
+	"\bsessions\x18\x01 \x03(\v2\x10.auth.v1.SessionR\bsessions\"5\n" +
+	"\x14RevokeSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"2\n" +
+	"\x1aRevokeDeviceSessionRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xb7\x01\n" +
+	"\x03Jwk\x12\x10\n" +
+	"\x03kty\x18\x01 \x01(\tR\x03kty\x12\x10\n" +
+	"\x03kid\x18\x02 \x01(\tR\x03kid\x12\x10\n" +
+	"\x03use\x18\x03 \x01(\tR\x03use\x12\x10\n" +
+	"\x03alg\x18\x04 \x01(\tR\x03alg\x12\x11\n" +
+	"\x01n\x18\x05 \x01(\tH\x00R\x01n\x88\x01\x01\x12\x11\n" +
+	"\x01e\x18\x06 \x01(\tH\x01R\x01e\x88\x01\x01\x12\x15\n" +
+	"\x03crv\x18\a \x01(\tH\x02R\x03crv\x88\x01\x01\x12\x11\n" +
+	"\x01x\x18\b \x01(\tH\x03R\x01x\x88\x01\x01B\x04\n" +
+	"\x02_nB\x04\n" +
+	"\x02_eB\x06\n" +
+	"\x04_crvB\x04\n" +
+	"\x02_x\"0\n" +
+	"\fJwksResponse\x12 \n" +
+	"\x04keys\x18\x01 \x03(\v2\f.auth.v1.JwkR\x04keys\"\xb9\x02\n" +
+	"\x06ApiKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12<\n" +
+	"\flast_used_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x12'\n" +
+	"\x0forganization_id\x18\b \x01(\tR\x0eorganizationId\"i\n" +
+	"\x13CreateApiKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\x12&\n" +
+	"\x0fexpires_in_days\x18\x03 \x01(\x05R\rexpiresInDays\"R\n" +
+	"\x14CreateApiKeyResponse\x12(\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x0f.auth.v1.ApiKeyR\x06apiKey\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"A\n" +
+	"\x13ListApiKeysResponse\x12*\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\x0f.auth.v1.ApiKeyR\aapiKeys\"%\n" +
+	"\x13RevokeApiKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x88\x01\n" +
+	"\fTokenRequest\x12\x1e\n" +
+	"\n" +
+	"grant_type\x18\x01 \x01(\tR\n" +
+	"grant_type\x12\x1c\n" +
+	"\tclient_id\x18\x02 \x01(\tR\tclient_id\x12$\n" +
+	"\rclient_secret\x18\x03 \x01(\tR\rclient_secret\x12\x14\n" +
+	"\x05scope\x18\x04 \x01(\tR\x05scope\"\x89\x01\n" +
+	"\rTokenResponse\x12\"\n" +
+	"\faccess_token\x18\x01 \x01(\tR\faccess_token\x12\x1e\n" +
+	"\n" +
+	"token_type\x18\x02 \x01(\tR\n" +
+	"token_type\x12\x1e\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\n" +
+	"expires_in\x12\x14\n" +
+	"\x05scope\x18\x04 \x01(\tR\x05scope\"/\n" +
+	"\x14UnlockAccountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"N\n" +
+	"\x15ReauthenticateRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x19\n" +
+	"\bmfa_code\x18\x02 \x01(\tR\amfaCode\";\n" +
+	"\x16ReauthenticateResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\"-\n" +
+	"\x12ImpersonateRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"W\n" +
+	"\x13ImpersonateResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x03R\texpiresIn\"Q\n" +
+	"\x17WebauthnOptionsResponse\x126\n" +
+	"\n" +
+	"public_key\x18\x01 \x01(\v2\x17.google.protobuf.StructR\tpublicKey\"p\n" +
+	"!FinishWebauthnRegistrationRequest\x127\n" +
+	"\n" +
+	"credential\x18\x01 \x01(\v2\x17.google.protobuf.StructR\n" +
+	"credential\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xd1\x01\n" +
+	"\x12WebauthnCredential\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"transports\x18\x03 \x03(\tR\n" +
+	"transports\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"Y\n" +
+	"\x1aWebauthnCredentialResponse\x12;\n" +
+	"\n" +
+	"credential\x18\x01 \x01(\v2\x1b.auth.v1.WebauthnCredentialR\n" +
+	"credential\"8\n" +
+	"\x19BeginWebauthnLoginRequest\x12\x1b\n" +
+	"\tmfa_token\x18\x01 \x01(\tR\bmfaToken\"r\n" +
+	"\x1aFinishWebauthnLoginRequest\x127\n" +
+	"\n" +
+	"credential\x18\x01 \x01(\v2\x17.google.protobuf.StructR\n" +
+	"credential\x12\x1b\n" +
+	"\tmfa_token\x18\x02 \x01(\tR\bmfaToken\"`\n" +
+	"\x1fListWebauthnCredentialsResponse\x12=\n" +
+	"\vcredentials\x18\x01 \x03(\v2\x1b.auth.v1.WebauthnCredentialR\vcredentials\"1\n" +
+	"\x1fDeleteWebauthnCredentialRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id2\xa8}\n" +
+	"\vAuthService\x12\xf4\x02\n" +
+	"\x05Login\x12\x15.auth.v1.LoginRequest\x1a\x15.auth.v1.AuthResponse\"\xbc\x02\x92A\x9c\x02\n" +
 	"\x0eAuthentication\x12\n" +
 	"Login User\x1aXAutentikasi user dengan email dan password, mengembalikan access token dan refresh tokenJ\x17\n" +
 	"\x03200\x12\x10\n" +
 	"\x0eLogin berhasilJ\"\n" +
 	"\x03401\x12\x1b\n" +
-	"\x19Email atau password salah\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/auth/login\x12\x90\x02\n" +
-	"\aRefresh\x12\x17.auth.v1.RefreshRequest\x1a\x15.auth.v1.AuthResponse\"\xd4\x01\x92A\xb8\x01\n" +
+	"\x19Email atau password salahJg\n" +
+	"\x03429\x12`\n" +
+	"^Terlalu banyak percobaan login gagal, akun atau IP dikunci sementara. Lihat header Retry-After\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/auth/login\x12\x96\x02\n" +
+	"\aRefresh\x12\x17.auth.v1.RefreshRequest\x1a\x15.auth.v1.AuthResponse\"\xda\x01\x92A\xb8\x01\n" +
 	"\x0eAuthentication\x12\rRefresh Token\x1aBMendapatkan access token baru menggunakan refresh token yang validJ\"\n" +
 	"\x03200\x12\x1b\n" +
 	"\x19Token berhasil diperbaruiJ/\n" +
 	"\x03401\x12(\n" +
-	"&Refresh token tidak valid atau expired\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/auth/refresh\x12\xcb\x01\n" +
-	"\x06Logout\x12\x16.auth.v1.LogoutRequest\x1a\x18.auth.v1.MessageResponse\"\x8e\x01\x92At\n" +
+	"&Refresh token tidak valid atau expired\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/auth/refresh\x12\xcf\x01\n" +
+	"\x06Logout\x12\x16.auth.v1.LogoutRequest\x1a\x18.auth.v1.MessageResponse\"\x92\x01\x92At\n" +
 	"\x0eAuthentication\x12\vLogout User\x1a;Invalidate refresh token sehingga tidak bisa digunakan lagiJ\x18\n" +
 	"\x03200\x12\x11\n" +
-	"\x0fLogout berhasil\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/auth/logout\x12\x81\x02\n" +
-	"\tLogoutAll\x12\x16.google.protobuf.Empty\x1a\x18.auth.v1.MessageResponse\"\xc1\x01\x92A\xa5\x01\n" +
+	"\x0fLogout berhasil\x8a\xb5\x18\x00\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/auth/logout\x12\x95\x02\n" +
+	"\tLogoutAll\x12\x16.google.protobuf.Empty\x1a\x18.auth.v1.MessageResponse\"\xd5\x01\x92A\xa5\x01\n" +
 	"\x0eAuthentication\x12\x12Logout All Devices\x1aEInvalidate semua refresh token user sehingga logout dari semua deviceJ*\n" +
 	"\x03200\x12#\n" +
 	"!Logout dari semua device berhasilb\f\n" +
 	"\n" +
 	"\n" +
-	"\x06Bearer\x12\x00\x82\xd3\xe4\x93\x02\x12\"\x10/auth/logout-all\x12\xdc\x01\n" +
-	"\bRegister\x12\x18.auth.v1.RegisterRequest\x1a\x18.auth.v1.MessageResponse\"\x9b\x01\x92A\x7f\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x10\x1a\x0esessions:write\x82\xd3\xe4\x93\x02\x12\"\x10/auth/logout-all\x12\xcf\x02\n" +
+	"\bRegister\x12\x18.auth.v1.RegisterRequest\x1a\x18.auth.v1.MessageResponse\"\x8e\x02\x92A\xeb\x01\n" +
 	"\x0eAuthentication\x12\rRegister User\x1a Mendaftarkan user baru ke sistemJ\x1c\n" +
 	"\x03200\x12\x15\n" +
-	"\x13Registrasi berhasilJ\x1e\n" +
-	"\x03409\x12\x17\n" +
-	"\x15Email sudah terdaftar\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/auth/register\x12\x8f\x02\n" +
-	"\x0eForgotPassword\x12\x1e.auth.v1.ForgotPasswordRequest\x1a\x18.auth.v1.MessageResponse\"\xc2\x01\x92A\x9e\x01\n" +
+	"\x13Registrasi berhasilJ\\\n" +
+	"\x03400\x12U\n" +
+	"SPassword tidak memenuhi password policy, detail tiap aturan ada di field violationsJ,\n" +
+	"\x03409\x12%\n" +
+	"#Email atau nomor HP sudah terdaftar\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/auth/register\x12\x95\x02\n" +
+	"\x0eForgotPassword\x12\x1e.auth.v1.ForgotPasswordRequest\x1a\x18.auth.v1.MessageResponse\"\xc8\x01\x92A\x9e\x01\n" +
 	"\x0eAuthentication\x12\x0fForgot Password\x1a<Mengirim email reset password ke alamat email yang terdaftarJ=\n" +
 	"\x03200\x126\n" +
-	"4Email reset password terkirim (jika email terdaftar)\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/auth/forgot-password\x12\x94\x02\n" +
-	"\rResetPassword\x12\x1d.auth.v1.ResetPasswordRequest\x1a\x18.auth.v1.MessageResponse\"\xc9\x01\x92A\xa6\x01\n" +
+	"4Email reset password terkirim (jika email terdaftar)\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/auth/forgot-password\x12\xcd\x02\n" +
+	"\rResetPassword\x12\x1d.auth.v1.ResetPasswordRequest\x1a\x18.auth.v1.MessageResponse\"\x82\x02\x92A\xd9\x01\n" +
 	"\x0eAuthentication\x12\x0eReset Password\x1a7Reset password menggunakan token yang dikirim via emailJ\"\n" +
 	"\x03200\x12\x1b\n" +
-	"\x19Password berhasil diresetJ'\n" +
+	"\x19Password berhasil diresetJZ\n" +
+	"\x03400\x12S\n" +
+	"QToken tidak valid atau expired, atau password baru tidak memenuhi password policy\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/auth/reset-password\x12\xd4\x03\n" +
+	"\x10AcceptInvitation\x12 .auth.v1.AcceptInvitationRequest\x1a\x18.auth.v1.MessageResponse\"\x83\x03\x92A\xd7\x02\n" +
+	"\x0eAuthentication\x12\x11Accept Invitation\x1a\x94\x01Mengaktifkan akun yang diundang menggunakan token dari email undangan. Email dianggap terverifikasi dan user bisa login dengan password yang dipilihJ#\n" +
+	"\x03200\x12\x1c\n" +
+	"\x1aUndangan berhasil diterimaJv\n" +
+	"\x03400\x12o\n" +
+	"mToken tidak valid atau expired, konfirmasi password tidak cocok, atau password tidak memenuhi password policy\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/auth/accept-invitation\x12\xb7\x03\n" +
+	"\x10RequestMagicLink\x12 .auth.v1.RequestMagicLinkRequest\x1a\x1a.auth.v1.MagicLinkResponse\"\xe4\x02\x92A\xbf\x02\n" +
+	"\x0eAuthentication\x12\x12Request Magic Link\x1a\xe3\x01Mengirim link login ke alamat email yang terdaftar. browser_code pada response harus disimpan oleh browser yang meminta dan dikirim kembali saat link dipakai, sehingga link yang diteruskan ke orang lain tidak bisa dipakai loginJ3\n" +
+	"\x03200\x12,\n" +
+	"*Link login terkirim (jika email terdaftar)\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/auth/magic-link\x12\x8b\x04\n" +
+	"\x10ConsumeMagicLink\x12 .auth.v1.ConsumeMagicLinkRequest\x1a\x15.auth.v1.AuthResponse\"\xbd\x03\x92A\x90\x03\n" +
+	"\x0eAuthentication\x12\x12Consume Magic Link\x1a\xcf\x01Menyelesaikan login menggunakan token dari email dan browser_code dari response Request Magic Link. Link hanya bisa dipakai sekali, termasuk jika browser_code salah. Jika MFA aktif, response berisi mfa_tokenJE\n" +
+	"\x03200\x12>\n" +
+	"<Login berhasil, mengembalikan access token dan refresh tokenJQ\n" +
+	"\x03401\x12J\n" +
+	"HToken tidak valid, expired, sudah dipakai, atau browser_code tidak cocok\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/auth/magic-link/consume\x12\xc1\x02\n" +
+	"\vVerifyEmail\x12\x1b.auth.v1.VerifyEmailRequest\x1a\x18.auth.v1.MessageResponse\"\xfa\x01\x92A\xd3\x01\n" +
+	"\x0eAuthentication\x12\fVerify Email\x1adVerifikasi alamat email user menggunakan token yang dikirim setelah registrasi. Token berlaku 24 jamJ$\n" +
+	"\x03200\x12\x1d\n" +
+	"\x1bEmail berhasil diverifikasiJ'\n" +
 	"\x03400\x12 \n" +
-	"\x1eToken tidak valid atau expired\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/auth/reset-passwordB\xc8\x02\x92A\x89\x02\x12\x95\x01\n" +
+	"\x1eToken tidak valid atau expired\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/auth/verify-email\x12\xc5\x02\n" +
+	"\x12ResendVerification\x12\".auth.v1.ResendVerificationRequest\x1a\x18.auth.v1.MessageResponse\"\xf0\x01\x92A\xc2\x01\n" +
+	"\x0eAuthentication\x12\x13Resend Verification\x1aMMengirim ulang link verifikasi ke email yang terdaftar dan belum diverifikasiJL\n" +
+	"\x03200\x12E\n" +
+	"CLink verifikasi dikirim jika email terdaftar dan belum diverifikasi\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/auth/resend-verification\x12\xd1\x04\n" +
+	"\x18RequestPhoneVerification\x12(.auth.v1.RequestPhoneVerificationRequest\x1a\x18.auth.v1.MessageResponse\"\xf0\x03\x92A\xc5\x03\n" +
+	"\x05Phone\x12\x1aRequest Phone Verification\x1a\xcb\x01Mengirim kode OTP via SMS ke nomor HP user, atau ke nomor baru jika phone diisi. Nomor baru baru menggantikan nomor lama setelah diverifikasi. Kode berlaku 5 menit dan hanya bisa diminta sekali per menitJ\x1a\n" +
+	"\x03200\x12\x13\n" +
+	"\x11Kode OTP terkirimJD\n" +
+	"\x03400\x12=\n" +
+	";Format nomor HP tidak valid, atau user belum punya nomor HPJ=\n" +
+	"\x03409\x126\n" +
+	"4Nomor HP sudah terverifikasi, atau dipakai user lainJ#\n" +
+	"\x03429\x12\x1c\n" +
+	"\x1aKode OTP baru saja dikirimb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x00\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/auth/phone/verification\x12\xdf\x02\n" +
+	"\vVerifyPhone\x12\x1b.auth.v1.VerifyPhoneRequest\x1a\x18.auth.v1.MessageResponse\"\x98\x02\x92A\xf3\x01\n" +
+	"\x05Phone\x12\fVerify Phone\x1aIMenandai nomor HP sebagai terverifikasi. Kode hangus setelah 5 kali salahJ'\n" +
+	"\x03200\x12 \n" +
+	"\x1eNomor HP berhasil diverifikasiJ*\n" +
+	"\x03400\x12#\n" +
+	"!Kode OTP tidak valid atau expiredJ.\n" +
+	"\x03409\x12'\n" +
+	"%Nomor HP sudah diverifikasi user lainb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x00\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/auth/phone/verify\x12\xe6\x02\n" +
+	"\x11RequestPhoneLogin\x12!.auth.v1.RequestPhoneLoginRequest\x1a\x18.auth.v1.MessageResponse\"\x93\x02\x92A\xe5\x01\n" +
+	"\x05Phone\x12\x13Request Phone Login\x1anMengirim kode OTP via SMS ke nomor HP yang sudah terverifikasi. Response sama untuk nomor yang tidak terdaftarJ1\n" +
+	"\x03200\x12*\n" +
+	"(Kode OTP terkirim (jika nomor terdaftar)J$\n" +
+	"\x03400\x12\x1d\n" +
+	"\x1bFormat nomor HP tidak valid\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/auth/phone/login/request\x12\xbb\x03\n" +
+	"\x0eLoginWithPhone\x12\x1e.auth.v1.LoginWithPhoneRequest\x1a\x15.auth.v1.AuthResponse\"\xf1\x02\x92A\xcb\x02\n" +
+	"\x05Phone\x12\x10Login With Phone\x1a\x8c\x01Login menggunakan kode OTP dari Request Phone Login. Kode yang salah dihitung sebagai login gagal. Jika MFA aktif, response berisi mfa_tokenJE\n" +
+	"\x03200\x12>\n" +
+	"<Login berhasil, mengembalikan access token dan refresh tokenJ+\n" +
+	"\x03401\x12$\n" +
+	"\"Nomor HP atau kode OTP tidak validJ-\n" +
+	"\x03429\x12&\n" +
+	"$Terlalu banyak percobaan login gagal\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/auth/phone/login\x12\x93\x03\n" +
+	"\x0eStartOidcLogin\x12\x1e.auth.v1.StartOidcLoginRequest\x1a\x1f.auth.v1.StartOidcLoginResponse\"\xbf\x02\x92A\x92\x02\n" +
+	"\x04OIDC\x12\x10Start OIDC Login\x1a\xa5\x01Redirect ke halaman login provider (PKCE). Browser menerima cookie oidc_browser_code; klien non-browser menyimpan browser_code dan mengirimkannya kembali ke callbackJ/\n" +
+	"\x03302\x12(\n" +
+	"&Redirect ke authorization URL providerJ\x1f\n" +
+	"\x03404\x12\x18\n" +
+	"\x16Provider tidak dikenal\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x1d\x12\x1b/auth/oidc/{provider}/start\x12\xfc\x03\n" +
+	"\fOidcCallback\x12\x1c.auth.v1.OidcCallbackRequest\x1a\x15.auth.v1.AuthResponse\"\xb6\x03\x92A\x86\x03\n" +
+	"\x04OIDC\x12\rOIDC Callback\x1a\xbd\x01Menukar authorization code dan memvalidasi ID token. Identitas baru ditautkan ke user dengan email terverifikasi yang sama, atau membuat user baru. Jika MFA aktif, response berisi mfa_tokenJE\n" +
+	"\x03200\x12>\n" +
+	"<Login berhasil, mengembalikan access token dan refresh tokenJ6\n" +
+	"\x03401\x12/\n" +
+	"-State, browser code atau ID token tidak validJ0\n" +
+	"\x03412\x12)\n" +
+	"'Email dari provider belum terverifikasi\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02 \x12\x1e/auth/oidc/{provider}/callback\x12\xc5\x02\n" +
+	"\tEnrollMfa\x12\x16.google.protobuf.Empty\x1a\x1a.auth.v1.EnrollMfaResponse\"\x83\x02\x92A\xe1\x01\n" +
+	"\x03MFA\x12\n" +
+	"Enroll MFA\x1apMembuat secret TOTP baru untuk user. MFA belum aktif sampai dikonfirmasi dengan kode dari aplikasi authenticatorJ4\n" +
+	"\x03200\x12-\n" +
+	"+Secret dan provisioning URI berhasil dibuatJ\x18\n" +
+	"\x03409\x12\x11\n" +
+	"\x0fMFA sudah aktifb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x02 \x01\x82\xd3\xe4\x93\x02\x12\"\x10/auth/mfa/enroll\x12\xbf\x02\n" +
+	"\n" +
+	"ConfirmMfa\x12\x1a.auth.v1.ConfirmMfaRequest\x1a\x1b.auth.v1.ConfirmMfaResponse\"\xf7\x01\x92A\xd1\x01\n" +
+	"\x03MFA\x12\vConfirm MFA\x1anMengaktifkan MFA menggunakan kode TOTP dan mengembalikan recovery code sekali pakai (hanya ditampilkan sekali)J \n" +
+	"\x03200\x12\x19\n" +
+	"\x17MFA berhasil diaktifkanJ\x1d\n" +
+	"\x03400\x12\x16\n" +
+	"\x14Kode MFA tidak validb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x02 \x01\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/auth/mfa/confirm\x12\x8d\x02\n" +
+	"\n" +
+	"DisableMfa\x12\x1a.auth.v1.DisableMfaRequest\x1a\x18.auth.v1.MessageResponse\"\xc8\x01\x92A\xa0\x01\n" +
+	"\x03MFA\x12\vDisable MFA\x1a:Menonaktifkan MFA menggunakan kode TOTP atau recovery codeJ#\n" +
+	"\x03200\x12\x1c\n" +
+	"\x1aMFA berhasil dinonaktifkanJ\x1d\n" +
+	"\x03400\x12\x16\n" +
+	"\x14Kode MFA tidak validb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x04 \x01(\x05\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/auth/mfa/disable\x12\xec\x02\n" +
+	"\tVerifyMfa\x12\x19.auth.v1.VerifyMfaRequest\x1a\x15.auth.v1.AuthResponse\"\xac\x02\x92A\x87\x02\n" +
+	"\x03MFA\x12\n" +
+	"Verify MFA\x1azMenyelesaikan login untuk user dengan MFA aktif menggunakan mfa_token dari response login dan kode TOTP atau recovery codeJJ\n" +
+	"\x03200\x12C\n" +
+	"AVerifikasi berhasil, mengembalikan access token dan refresh tokenJ,\n" +
+	"\x03401\x12%\n" +
+	"#Challenge atau kode MFA tidak valid\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/auth/mfa/verify\x12\xbd\x02\n" +
+	"\fListSessions\x12\x16.google.protobuf.Empty\x1a\x1d.auth.v1.ListSessionsResponse\"\xf5\x01\x92A\xc8\x01\n" +
+	"\aSession\x12\rList Sessions\x1a\x83\x01Menampilkan semua sesi aktif milik user (device, IP, waktu login dan terakhir digunakan). Sesi yang sedang dipakai ditandai currentJ\x1a\n" +
+	"\x03200\x12\x13\n" +
+	"\x11Daftar sesi aktifb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x0f\x1a\rsessions:read\x82\xd3\xe4\x93\x02\x10\x12\x0e/auth/sessions\x12\xc4\x02\n" +
+	"\rRevokeSession\x12\x1d.auth.v1.RevokeSessionRequest\x1a\x18.auth.v1.MessageResponse\"\xf9\x01\x92A\xbe\x01\n" +
+	"\aSession\x12\x0eRevoke Session\x1aVInvalidate refresh token dari sesi tertentu sehingga device tersebut harus login ulangJ\x1e\n" +
+	"\x03200\x12\x17\n" +
+	"\x15Sesi berhasil dicabutJ\x1d\n" +
+	"\x03404\x12\x16\n" +
+	"\x14Sesi tidak ditemukanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x10\x1a\x0esessions:write\x82\xd3\xe4\x93\x02\x1d*\x1b/auth/sessions/{session_id}\x12\xd4\x03\n" +
+	"\x13RevokeDeviceSession\x12#.auth.v1.RevokeDeviceSessionRequest\x1a\x18.auth.v1.MessageResponse\"\xfd\x02\x92A\xd4\x02\n" +
+	"\aSession\x12\x15Revoke Device Session\x1a\xd1\x01Akhiri sesi yang dibuka dari device baru menggunakan token dari email peringatan login. Access token user yang sudah terbit ikut dicabut dan device dilupakan. Token hanya bisa dipakai sekali dan berlaku 7 hariJ%\n" +
+	"\x03200\x12\x1e\n" +
+	"\x1cSesi device berhasil dicabutJ7\n" +
+	"\x03400\x120\n" +
+	".Token tidak valid, sudah dipakai, atau expired\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/auth/devices/revoke\x12\xb5\x02\n" +
+	"\aGetJwks\x12\x16.google.protobuf.Empty\x1a\x15.auth.v1.JwksResponse\"\xfa\x01\x92A\xd2\x01\n" +
+	"\x0eAuthentication\x12\x10JSON Web Key Set\x1atPublic key (RS256/EdDSA) untuk memverifikasi signature access token. Gunakan header kid pada token untuk memilih keyJ8\n" +
+	"\x03200\x121\n" +
+	"/Daftar public key aktif dan yang sudah dirotasi\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x18\x12\x16/.well-known/jwks.json\x12\x9c\x03\n" +
+	"\fCreateApiKey\x12\x1c.auth.v1.CreateApiKeyRequest\x1a\x1d.auth.v1.CreateApiKeyResponse\"\xce\x02\x92A\xa9\x02\n" +
+	"\aAPI Key\x12\x0eCreate API Key\x1a\xb0\x01Membuat API key dengan nama, scope dan masa berlaku. Key hanya ditampilkan sekali pada response ini. Gunakan dengan header 'Authorization: ApiKey {key}' atau 'X-API-Key: {key}'J \n" +
+	"\x03200\x12\x19\n" +
+	"\x17API key berhasil dibuatJ+\n" +
+	"\x03400\x12$\n" +
+	"\"Nama kosong atau scope tidak validb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x04 \x01(\x05\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/auth/api-keys\x12\x99\x02\n" +
+	"\vListApiKeys\x12\x16.google.protobuf.Empty\x1a\x1c.auth.v1.ListApiKeysResponse\"\xd3\x01\x92A\xb5\x01\n" +
+	"\aAPI Key\x12\rList API Keys\x1atMenampilkan API key aktif milik user beserta scope, masa berlaku dan waktu terakhir digunakan. Key tidak ditampilkanJ\x17\n" +
+	"\x03200\x12\x10\n" +
+	"\x0eDaftar API keyb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x00\x82\xd3\xe4\x93\x02\x10\x12\x0e/auth/api-keys\x12\x92\x02\n" +
+	"\fRevokeApiKey\x12\x1c.auth.v1.RevokeApiKeyRequest\x1a\x18.auth.v1.MessageResponse\"\xc9\x01\x92A\xa6\x01\n" +
+	"\aAPI Key\x12\x0eRevoke API Key\x1a8Menonaktifkan API key sehingga tidak bisa digunakan lagiJ!\n" +
+	"\x03200\x12\x1a\n" +
+	"\x18API key berhasil dicabutJ \n" +
+	"\x03404\x12\x19\n" +
+	"\x17API key tidak ditemukanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x00\x82\xd3\xe4\x93\x02\x15*\x13/auth/api-keys/{id}\x12\xe9\x03\n" +
+	"\x05Token\x12\x15.auth.v1.TokenRequest\x1a\x16.auth.v1.TokenResponse\"\xb0\x03\x92A\x8f\x03\n" +
+	"\x06OAuth2\x12\fOAuth2 Token\x1a\xb9\x01Menerbitkan access token untuk service client dengan grant_type=client_credentials. Credential dikirim lewat body atau header 'Authorization: Basic'. Token berisi scope, bukan role user2\x10application/json2!application/x-www-form-urlencodedJ%\n" +
+	"\x03200\x12\x1e\n" +
+	"\x1cAccess token berhasil dibuatJ9\n" +
+	"\x03400\x122\n" +
+	"0grant_type tidak didukung atau scope tidak validJ$\n" +
+	"\x03401\x12\x1d\n" +
+	"\x1bClient ID atau secret salah\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/oauth/token\x12\xda\x02\n" +
+	"\rUnlockAccount\x12\x1d.auth.v1.UnlockAccountRequest\x1a\x18.auth.v1.MessageResponse\"\x8f\x02\x92A\xc6\x01\n" +
+	"\x0eAuthentication\x12\x0eUnlock Account\x1aXMenghapus lockout dan mereset jumlah percobaan login gagal milik user. Hanya untuk adminJ\x1d\n" +
+	"\x03200\x12\x16\n" +
+	"\x14Akun berhasil dibukaJ\x1d\n" +
+	"\x03404\x12\x16\n" +
+	"\x14User tidak ditemukanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x1d\x12\fusers.unlock\x1a\vusers:write0\x01\x82\xd3\xe4\x93\x02\x1e\"\x1c/auth/users/{user_id}/unlock\x12\x88\x05\n" +
+	"\vImpersonate\x12\x1b.auth.v1.ImpersonateRequest\x1a\x1c.auth.v1.ImpersonateResponse\"\xbd\x04\x92A\xf3\x03\n" +
+	"\x0eAuthentication\x12\vImpersonate\x1a\xaa\x02Menerbitkan access token berumur pendek (tanpa refresh token) untuk bertindak sebagai user lain. Token membawa claim act berisi id admin; setiap pemanggilan dicatat di log dan audit trail. Token ini tidak bisa mengubah password, MFA, membuat API key, atau pindah organisasi. Hanya untuk super_adminJ#\n" +
+	"\x03200\x12\x1c\n" +
+	"\x1aAccess token impersonationJU\n" +
+	"\x03403\x12N\n" +
+	"LUser tidak bisa di-impersonate (diri sendiri, super_admin, atau belum aktif)J\x1d\n" +
+	"\x03404\x12\x16\n" +
+	"\x14User tidak ditemukanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x19\x12\x11users.impersonate \x01(\x050\x01\x82\xd3\xe4\x93\x02#\"!/auth/users/{user_id}/impersonate\x12\xcb\x03\n" +
+	"\x12SwitchOrganization\x12\".auth.v1.SwitchOrganizationRequest\x1a\x15.auth.v1.AuthResponse\"\xf9\x02\x92A\xcb\x02\n" +
+	"\x0eAuthentication\x12\x13Switch Organization\x1a\xae\x01Menerbitkan access dan refresh token baru untuk organisasi yang dipilih. Role di token menjadi role user di organisasi tersebut. Refresh token session lama tidak berlaku lagiJ4\n" +
+	"\x03200\x12-\n" +
+	"+Token untuk organisasi baru berhasil dibuatJ/\n" +
+	"\x03403\x12(\n" +
+	"&User bukan anggota organisasi tersebutb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x02 \x01\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/auth/switch-organization\x12\xe8\x05\n" +
+	"\x0eReauthenticate\x12\x1e.auth.v1.ReauthenticateRequest\x1a\x1f.auth.v1.ReauthenticateResponse\"\x94\x05\x92A\xeb\x04\n" +
+	"\x0eAuthentication\x12\x0eReauthenticate\x1a\xa5\x03Menerbitkan access token baru untuk session yang sama dengan claim auth_time saat ini, setelah user mengonfirmasi password atau kode MFA (TOTP atau recovery code). Operasi sensitif (ubah user, hapus user, nonaktifkan MFA, buat API key, impersonate) menolak token yang auth_time-nya lebih lama dari 5 menit dengan UNAUTHENTICATED dan ErrorInfo reason REAUTHENTICATION_REQUIRED. Percobaan gagal dihitung seperti login gagalJ+\n" +
+	"\x03200\x12$\n" +
+	"\"Access token dengan auth_time baruJ%\n" +
+	"\x03401\x12\x1e\n" +
+	"\x1cPassword atau kode MFA salahJ?\n" +
+	"\x03429\x128\n" +
+	"6Terlalu banyak percobaan gagal, akun dikunci sementarab\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x02 \x01\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/auth/reauthenticate\x12\x86\x04\n" +
+	"\x19BeginWebauthnRegistration\x12\x16.google.protobuf.Empty\x1a .auth.v1.WebauthnOptionsResponse\"\xae\x03\x92A\xfd\x02\n" +
+	"\aPasskey\x12\x1aBegin Passkey Registration\x1a\xcf\x01Mengembalikan PublicKeyCredentialCreationOptions dalam format JSON untuk navigator.credentials.create. Challenge berlaku 5 menit dan hanya bisa dipakai sekali. Authenticator yang sudah terdaftar dikecualikanJ3\n" +
+	"\x03200\x12,\n" +
+	"*Options untuk navigator.credentials.createJA\n" +
+	"\x03401\x12:\n" +
+	"8Token tidak valid atau auth_time lebih lama dari 5 menitb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x04 \x01(\x05\x82\xd3\xe4\x93\x02\x1f\"\x1d/auth/webauthn/register/begin\x12\xc7\x04\n" +
+	"\x1aFinishWebauthnRegistration\x12*.auth.v1.FinishWebauthnRegistrationRequest\x1a#.auth.v1.WebauthnCredentialResponse\"\xd7\x03\x92A\xa2\x03\n" +
+	"\aPasskey\x12\x1bFinish Passkey Registration\x1a\xf1\x01Memverifikasi PublicKeyCredential hasil navigator.credentials.create lalu menyimpan public key, sign count dan transports. Setelah terdaftar, login dengan password, magic link, SMS OTP atau OpenID Connect meminta passkey sebagai faktor keduaJ\x1a\n" +
+	"\x03200\x12\x13\n" +
+	"\x11Passkey terdaftarJ:\n" +
+	"\x03400\x123\n" +
+	"1Response authenticator atau challenge tidak validJ \n" +
+	"\x03409\x12\x19\n" +
+	"\x17Passkey sudah terdaftarb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x04 \x01(\x05\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/auth/webauthn/register/finish\x12\xbe\x04\n" +
+	"\x12BeginWebauthnLogin\x12\".auth.v1.BeginWebauthnLoginRequest\x1a .auth.v1.WebauthnOptionsResponse\"\xe1\x03\x92A\xb2\x03\n" +
+	"\aPasskey\x12\x13Begin Passkey Login\x1a\xbb\x02Mengembalikan PublicKeyCredentialRequestOptions dalam format JSON untuk navigator.credentials.get. Tanpa mfa_token user memilih passkey apa saja untuk situs ini (login tanpa password, verifikasi user wajib). Dengan mfa_token dari response login, hanya passkey milik user tersebut yang diizinkan sebagai faktor keduaJ0\n" +
+	"\x03200\x12)\n" +
+	"'Options untuk navigator.credentials.getJ\"\n" +
+	"\x03401\x12\x1b\n" +
+	"\x19Challenge MFA tidak valid\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/auth/webauthn/login/begin\x12\x95\x04\n" +
+	"\x13FinishWebauthnLogin\x12#.auth.v1.FinishWebauthnLoginRequest\x1a\x15.auth.v1.AuthResponse\"\xc1\x03\x92A\x91\x03\n" +
+	"\aPasskey\x12\x14Finish Passkey Login\x1a\xbb\x01Memverifikasi assertion hasil navigator.credentials.get dan menerbitkan access token dan refresh token. Sign count yang tidak bertambah ditolak karena authenticator kemungkinan digandakanJE\n" +
+	"\x03200\x12>\n" +
+	"<Login berhasil, mengembalikan access token dan refresh tokenJ<\n" +
+	"\x03401\x125\n" +
+	"3Assertion, challenge atau challenge MFA tidak validJ-\n" +
+	"\x03403\x12&\n" +
+	"$Akun ditangguhkan atau dinonaktifkan\x8a\xb5\x18\x02\b\x01\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/auth/webauthn/login/finish\x12\xfd\x01\n" +
+	"\x17ListWebauthnCredentials\x12\x16.google.protobuf.Empty\x1a(.auth.v1.ListWebauthnCredentialsResponse\"\x9f\x01\x92Av\n" +
+	"\aPasskey\x12\rList Passkeys\x1aNMenampilkan passkey milik user beserta transports dan waktu terakhir digunakanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x00\x82\xd3\xe4\x93\x02\x1c\x12\x1a/auth/webauthn/credentials\x12\x9f\x02\n" +
+	"\x18DeleteWebauthnCredential\x12(.auth.v1.DeleteWebauthnCredentialRequest\x1a\x18.auth.v1.MessageResponse\"\xbe\x01\x92A\x8b\x01\n" +
+	"\aPasskey\x12\x0eDelete Passkey\x1a@Menghapus passkey sehingga tidak bisa digunakan untuk login lagiJ \n" +
+	"\x03404\x12\x19\n" +
+	"\x17Passkey tidak ditemukanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x04 \x01(\x05\x82\xd3\xe4\x93\x02!*\x1f/auth/webauthn/credentials/{id}B\xc8\x02\x92A\x89\x02\x12\x95\x01\n" +
 	"\x12Authentication API\x12VAPI untuk autentikasi user termasuk login, register, refresh token, dan reset password\"\"\n" +
 	"\vAPI Support\x1a\x13support@example.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZG\n" +
 	"E\n" +
@@ -518,38 +3432,168 @@ func file_proto_auth_auth_proto_rawDescGZIP() []byte {
 	return file_proto_auth_auth_proto_rawDescData
 }
 
-var file_proto_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_proto_auth_auth_proto_goTypes = []any{
-	(*LoginRequest)(nil),          // 0: auth.v1.LoginRequest
-	(*RegisterRequest)(nil),       // 1: auth.v1.RegisterRequest
-	(*RefreshRequest)(nil),        // 2: auth.v1.RefreshRequest
-	(*LogoutRequest)(nil),         // 3: auth.v1.LogoutRequest
-	(*AuthResponse)(nil),          // 4: auth.v1.AuthResponse
-	(*ForgotPasswordRequest)(nil), // 5: auth.v1.ForgotPasswordRequest
-	(*ResetPasswordRequest)(nil),  // 6: auth.v1.ResetPasswordRequest
-	(*MessageResponse)(nil),       // 7: auth.v1.MessageResponse
-	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
+	(*LoginRequest)(nil),                      // 0: auth.v1.LoginRequest
+	(*RegisterRequest)(nil),                   // 1: auth.v1.RegisterRequest
+	(*RefreshRequest)(nil),                    // 2: auth.v1.RefreshRequest
+	(*LogoutRequest)(nil),                     // 3: auth.v1.LogoutRequest
+	(*AuthResponse)(nil),                      // 4: auth.v1.AuthResponse
+	(*SwitchOrganizationRequest)(nil),         // 5: auth.v1.SwitchOrganizationRequest
+	(*ForgotPasswordRequest)(nil),             // 6: auth.v1.ForgotPasswordRequest
+	(*ResetPasswordRequest)(nil),              // 7: auth.v1.ResetPasswordRequest
+	(*AcceptInvitationRequest)(nil),           // 8: auth.v1.AcceptInvitationRequest
+	(*RequestMagicLinkRequest)(nil),           // 9: auth.v1.RequestMagicLinkRequest
+	(*MagicLinkResponse)(nil),                 // 10: auth.v1.MagicLinkResponse
+	(*ConsumeMagicLinkRequest)(nil),           // 11: auth.v1.ConsumeMagicLinkRequest
+	(*RequestPhoneVerificationRequest)(nil),   // 12: auth.v1.RequestPhoneVerificationRequest
+	(*VerifyPhoneRequest)(nil),                // 13: auth.v1.VerifyPhoneRequest
+	(*RequestPhoneLoginRequest)(nil),          // 14: auth.v1.RequestPhoneLoginRequest
+	(*LoginWithPhoneRequest)(nil),             // 15: auth.v1.LoginWithPhoneRequest
+	(*StartOidcLoginRequest)(nil),             // 16: auth.v1.StartOidcLoginRequest
+	(*StartOidcLoginResponse)(nil),            // 17: auth.v1.StartOidcLoginResponse
+	(*OidcCallbackRequest)(nil),               // 18: auth.v1.OidcCallbackRequest
+	(*VerifyEmailRequest)(nil),                // 19: auth.v1.VerifyEmailRequest
+	(*ResendVerificationRequest)(nil),         // 20: auth.v1.ResendVerificationRequest
+	(*MessageResponse)(nil),                   // 21: auth.v1.MessageResponse
+	(*EnrollMfaResponse)(nil),                 // 22: auth.v1.EnrollMfaResponse
+	(*ConfirmMfaRequest)(nil),                 // 23: auth.v1.ConfirmMfaRequest
+	(*ConfirmMfaResponse)(nil),                // 24: auth.v1.ConfirmMfaResponse
+	(*DisableMfaRequest)(nil),                 // 25: auth.v1.DisableMfaRequest
+	(*VerifyMfaRequest)(nil),                  // 26: auth.v1.VerifyMfaRequest
+	(*Session)(nil),                           // 27: auth.v1.Session
+	(*ListSessionsResponse)(nil),              // 28: auth.v1.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),              // 29: auth.v1.RevokeSessionRequest
+	(*RevokeDeviceSessionRequest)(nil),        // 30: auth.v1.RevokeDeviceSessionRequest
+	(*Jwk)(nil),                               // 31: auth.v1.Jwk
+	(*JwksResponse)(nil),                      // 32: auth.v1.JwksResponse
+	(*ApiKey)(nil),                            // 33: auth.v1.ApiKey
+	(*CreateApiKeyRequest)(nil),               // 34: auth.v1.CreateApiKeyRequest
+	(*CreateApiKeyResponse)(nil),              // 35: auth.v1.CreateApiKeyResponse
+	(*ListApiKeysResponse)(nil),               // 36: auth.v1.ListApiKeysResponse
+	(*RevokeApiKeyRequest)(nil),               // 37: auth.v1.RevokeApiKeyRequest
+	(*TokenRequest)(nil),                      // 38: auth.v1.TokenRequest
+	(*TokenResponse)(nil),                     // 39: auth.v1.TokenResponse
+	(*UnlockAccountRequest)(nil),              // 40: auth.v1.UnlockAccountRequest
+	(*ReauthenticateRequest)(nil),             // 41: auth.v1.ReauthenticateRequest
+	(*ReauthenticateResponse)(nil),            // 42: auth.v1.ReauthenticateResponse
+	(*ImpersonateRequest)(nil),                // 43: auth.v1.ImpersonateRequest
+	(*ImpersonateResponse)(nil),               // 44: auth.v1.ImpersonateResponse
+	(*WebauthnOptionsResponse)(nil),           // 45: auth.v1.WebauthnOptionsResponse
+	(*FinishWebauthnRegistrationRequest)(nil), // 46: auth.v1.FinishWebauthnRegistrationRequest
+	(*WebauthnCredential)(nil),                // 47: auth.v1.WebauthnCredential
+	(*WebauthnCredentialResponse)(nil),        // 48: auth.v1.WebauthnCredentialResponse
+	(*BeginWebauthnLoginRequest)(nil),         // 49: auth.v1.BeginWebauthnLoginRequest
+	(*FinishWebauthnLoginRequest)(nil),        // 50: auth.v1.FinishWebauthnLoginRequest
+	(*ListWebauthnCredentialsResponse)(nil),   // 51: auth.v1.ListWebauthnCredentialsResponse
+	(*DeleteWebauthnCredentialRequest)(nil),   // 52: auth.v1.DeleteWebauthnCredentialRequest
+	(*timestamppb.Timestamp)(nil),             // 53: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                   // 54: google.protobuf.Struct
+	(*emptypb.Empty)(nil),                     // 55: google.protobuf.Empty
 }
 var file_proto_auth_auth_proto_depIdxs = []int32{
-	0, // 0: auth.v1.AuthService.Login:input_type -> auth.v1.LoginRequest
-	2, // 1: auth.v1.AuthService.Refresh:input_type -> auth.v1.RefreshRequest
-	3, // 2: auth.v1.AuthService.Logout:input_type -> auth.v1.LogoutRequest
-	8, // 3: auth.v1.AuthService.LogoutAll:input_type -> google.protobuf.Empty
-	1, // 4: auth.v1.AuthService.Register:input_type -> auth.v1.RegisterRequest
-	5, // 5: auth.v1.AuthService.ForgotPassword:input_type -> auth.v1.ForgotPasswordRequest
-	6, // 6: auth.v1.AuthService.ResetPassword:input_type -> auth.v1.ResetPasswordRequest
-	4, // 7: auth.v1.AuthService.Login:output_type -> auth.v1.AuthResponse
-	4, // 8: auth.v1.AuthService.Refresh:output_type -> auth.v1.AuthResponse
-	7, // 9: auth.v1.AuthService.Logout:output_type -> auth.v1.MessageResponse
-	7, // 10: auth.v1.AuthService.LogoutAll:output_type -> auth.v1.MessageResponse
-	7, // 11: auth.v1.AuthService.Register:output_type -> auth.v1.MessageResponse
-	7, // 12: auth.v1.AuthService.ForgotPassword:output_type -> auth.v1.MessageResponse
-	7, // 13: auth.v1.AuthService.ResetPassword:output_type -> auth.v1.MessageResponse
-	7, // [7:14] is the sub-list for method output_type
-	0, // [0:7] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	53, // 0: auth.v1.Session.created_at:type_name -> google.protobuf.Timestamp
+	53, // 1: auth.v1.Session.last_used_at:type_name -> google.protobuf.Timestamp
+	53, // 2: auth.v1.Session.expires_at:type_name -> google.protobuf.Timestamp
+	27, // 3: auth.v1.ListSessionsResponse.sessions:type_name -> auth.v1.Session
+	31, // 4: auth.v1.JwksResponse.keys:type_name -> auth.v1.Jwk
+	53, // 5: auth.v1.ApiKey.created_at:type_name -> google.protobuf.Timestamp
+	53, // 6: auth.v1.ApiKey.expires_at:type_name -> google.protobuf.Timestamp
+	53, // 7: auth.v1.ApiKey.last_used_at:type_name -> google.protobuf.Timestamp
+	33, // 8: auth.v1.CreateApiKeyResponse.api_key:type_name -> auth.v1.ApiKey
+	33, // 9: auth.v1.ListApiKeysResponse.api_keys:type_name -> auth.v1.ApiKey
+	54, // 10: auth.v1.WebauthnOptionsResponse.public_key:type_name -> google.protobuf.Struct
+	54, // 11: auth.v1.FinishWebauthnRegistrationRequest.credential:type_name -> google.protobuf.Struct
+	53, // 12: auth.v1.WebauthnCredential.created_at:type_name -> google.protobuf.Timestamp
+	53, // 13: auth.v1.WebauthnCredential.last_used_at:type_name -> google.protobuf.Timestamp
+	47, // 14: auth.v1.WebauthnCredentialResponse.credential:type_name -> auth.v1.WebauthnCredential
+	54, // 15: auth.v1.FinishWebauthnLoginRequest.credential:type_name -> google.protobuf.Struct
+	47, // 16: auth.v1.ListWebauthnCredentialsResponse.credentials:type_name -> auth.v1.WebauthnCredential
+	0,  // 17: auth.v1.AuthService.Login:input_type -> auth.v1.LoginRequest
+	2,  // 18: auth.v1.AuthService.Refresh:input_type -> auth.v1.RefreshRequest
+	3,  // 19: auth.v1.AuthService.Logout:input_type -> auth.v1.LogoutRequest
+	55, // 20: auth.v1.AuthService.LogoutAll:input_type -> google.protobuf.Empty
+	1,  // 21: auth.v1.AuthService.Register:input_type -> auth.v1.RegisterRequest
+	6,  // 22: auth.v1.AuthService.ForgotPassword:input_type -> auth.v1.ForgotPasswordRequest
+	7,  // 23: auth.v1.AuthService.ResetPassword:input_type -> auth.v1.ResetPasswordRequest
+	8,  // 24: auth.v1.AuthService.AcceptInvitation:input_type -> auth.v1.AcceptInvitationRequest
+	9,  // 25: auth.v1.AuthService.RequestMagicLink:input_type -> auth.v1.RequestMagicLinkRequest
+	11, // 26: auth.v1.AuthService.ConsumeMagicLink:input_type -> auth.v1.ConsumeMagicLinkRequest
+	19, // 27: auth.v1.AuthService.VerifyEmail:input_type -> auth.v1.VerifyEmailRequest
+	20, // 28: auth.v1.AuthService.ResendVerification:input_type -> auth.v1.ResendVerificationRequest
+	12, // 29: auth.v1.AuthService.RequestPhoneVerification:input_type -> auth.v1.RequestPhoneVerificationRequest
+	13, // 30: auth.v1.AuthService.VerifyPhone:input_type -> auth.v1.VerifyPhoneRequest
+	14, // 31: auth.v1.AuthService.RequestPhoneLogin:input_type -> auth.v1.RequestPhoneLoginRequest
+	15, // 32: auth.v1.AuthService.LoginWithPhone:input_type -> auth.v1.LoginWithPhoneRequest
+	16, // 33: auth.v1.AuthService.StartOidcLogin:input_type -> auth.v1.StartOidcLoginRequest
+	18, // 34: auth.v1.AuthService.OidcCallback:input_type -> auth.v1.OidcCallbackRequest
+	55, // 35: auth.v1.AuthService.EnrollMfa:input_type -> google.protobuf.Empty
+	23, // 36: auth.v1.AuthService.ConfirmMfa:input_type -> auth.v1.ConfirmMfaRequest
+	25, // 37: auth.v1.AuthService.DisableMfa:input_type -> auth.v1.DisableMfaRequest
+	26, // 38: auth.v1.AuthService.VerifyMfa:input_type -> auth.v1.VerifyMfaRequest
+	55, // 39: auth.v1.AuthService.ListSessions:input_type -> google.protobuf.Empty
+	29, // 40: auth.v1.AuthService.RevokeSession:input_type -> auth.v1.RevokeSessionRequest
+	30, // 41: auth.v1.AuthService.RevokeDeviceSession:input_type -> auth.v1.RevokeDeviceSessionRequest
+	55, // 42: auth.v1.AuthService.GetJwks:input_type -> google.protobuf.Empty
+	34, // 43: auth.v1.AuthService.CreateApiKey:input_type -> auth.v1.CreateApiKeyRequest
+	55, // 44: auth.v1.AuthService.ListApiKeys:input_type -> google.protobuf.Empty
+	37, // 45: auth.v1.AuthService.RevokeApiKey:input_type -> auth.v1.RevokeApiKeyRequest
+	38, // 46: auth.v1.AuthService.Token:input_type -> auth.v1.TokenRequest
+	40, // 47: auth.v1.AuthService.UnlockAccount:input_type -> auth.v1.UnlockAccountRequest
+	43, // 48: auth.v1.AuthService.Impersonate:input_type -> auth.v1.ImpersonateRequest
+	5,  // 49: auth.v1.AuthService.SwitchOrganization:input_type -> auth.v1.SwitchOrganizationRequest
+	41, // 50: auth.v1.AuthService.Reauthenticate:input_type -> auth.v1.ReauthenticateRequest
+	55, // 51: auth.v1.AuthService.BeginWebauthnRegistration:input_type -> google.protobuf.Empty
+	46, // 52: auth.v1.AuthService.FinishWebauthnRegistration:input_type -> auth.v1.FinishWebauthnRegistrationRequest
+	49, // 53: auth.v1.AuthService.BeginWebauthnLogin:input_type -> auth.v1.BeginWebauthnLoginRequest
+	50, // 54: auth.v1.AuthService.FinishWebauthnLogin:input_type -> auth.v1.FinishWebauthnLoginRequest
+	55, // 55: auth.v1.AuthService.ListWebauthnCredentials:input_type -> google.protobuf.Empty
+	52, // 56: auth.v1.AuthService.DeleteWebauthnCredential:input_type -> auth.v1.DeleteWebauthnCredentialRequest
+	4,  // 57: auth.v1.AuthService.Login:output_type -> auth.v1.AuthResponse
+	4,  // 58: auth.v1.AuthService.Refresh:output_type -> auth.v1.AuthResponse
+	21, // 59: auth.v1.AuthService.Logout:output_type -> auth.v1.MessageResponse
+	21, // 60: auth.v1.AuthService.LogoutAll:output_type -> auth.v1.MessageResponse
+	21, // 61: auth.v1.AuthService.Register:output_type -> auth.v1.MessageResponse
+	21, // 62: auth.v1.AuthService.ForgotPassword:output_type -> auth.v1.MessageResponse
+	21, // 63: auth.v1.AuthService.ResetPassword:output_type -> auth.v1.MessageResponse
+	21, // 64: auth.v1.AuthService.AcceptInvitation:output_type -> auth.v1.MessageResponse
+	10, // 65: auth.v1.AuthService.RequestMagicLink:output_type -> auth.v1.MagicLinkResponse
+	4,  // 66: auth.v1.AuthService.ConsumeMagicLink:output_type -> auth.v1.AuthResponse
+	21, // 67: auth.v1.AuthService.VerifyEmail:output_type -> auth.v1.MessageResponse
+	21, // 68: auth.v1.AuthService.ResendVerification:output_type -> auth.v1.MessageResponse
+	21, // 69: auth.v1.AuthService.RequestPhoneVerification:output_type -> auth.v1.MessageResponse
+	21, // 70: auth.v1.AuthService.VerifyPhone:output_type -> auth.v1.MessageResponse
+	21, // 71: auth.v1.AuthService.RequestPhoneLogin:output_type -> auth.v1.MessageResponse
+	4,  // 72: auth.v1.AuthService.LoginWithPhone:output_type -> auth.v1.AuthResponse
+	17, // 73: auth.v1.AuthService.StartOidcLogin:output_type -> auth.v1.StartOidcLoginResponse
+	4,  // 74: auth.v1.AuthService.OidcCallback:output_type -> auth.v1.AuthResponse
+	22, // 75: auth.v1.AuthService.EnrollMfa:output_type -> auth.v1.EnrollMfaResponse
+	24, // 76: auth.v1.AuthService.ConfirmMfa:output_type -> auth.v1.ConfirmMfaResponse
+	21, // 77: auth.v1.AuthService.DisableMfa:output_type -> auth.v1.MessageResponse
+	4,  // 78: auth.v1.AuthService.VerifyMfa:output_type -> auth.v1.AuthResponse
+	28, // 79: auth.v1.AuthService.ListSessions:output_type -> auth.v1.ListSessionsResponse
+	21, // 80: auth.v1.AuthService.RevokeSession:output_type -> auth.v1.MessageResponse
+	21, // 81: auth.v1.AuthService.RevokeDeviceSession:output_type -> auth.v1.MessageResponse
+	32, // 82: auth.v1.AuthService.GetJwks:output_type -> auth.v1.JwksResponse
+	35, // 83: auth.v1.AuthService.CreateApiKey:output_type -> auth.v1.CreateApiKeyResponse
+	36, // 84: auth.v1.AuthService.ListApiKeys:output_type -> auth.v1.ListApiKeysResponse
+	21, // 85: auth.v1.AuthService.RevokeApiKey:output_type -> auth.v1.MessageResponse
+	39, // 86: auth.v1.AuthService.Token:output_type -> auth.v1.TokenResponse
+	21, // 87: auth.v1.AuthService.UnlockAccount:output_type -> auth.v1.MessageResponse
+	44, // 88: auth.v1.AuthService.Impersonate:output_type -> auth.v1.ImpersonateResponse
+	4,  // 89: auth.v1.AuthService.SwitchOrganization:output_type -> auth.v1.AuthResponse
+	42, // 90: auth.v1.AuthService.Reauthenticate:output_type -> auth.v1.ReauthenticateResponse
+	45, // 91: auth.v1.AuthService.BeginWebauthnRegistration:output_type -> auth.v1.WebauthnOptionsResponse
+	48, // 92: auth.v1.AuthService.FinishWebauthnRegistration:output_type -> auth.v1.WebauthnCredentialResponse
+	45, // 93: auth.v1.AuthService.BeginWebauthnLogin:output_type -> auth.v1.WebauthnOptionsResponse
+	4,  // 94: auth.v1.AuthService.FinishWebauthnLogin:output_type -> auth.v1.AuthResponse
+	51, // 95: auth.v1.AuthService.ListWebauthnCredentials:output_type -> auth.v1.ListWebauthnCredentialsResponse
+	21, // 96: auth.v1.AuthService.DeleteWebauthnCredential:output_type -> auth.v1.MessageResponse
+	57, // [57:97] is the sub-list for method output_type
+	17, // [17:57] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_proto_auth_auth_proto_init() }
@@ -557,13 +3601,15 @@ func file_proto_auth_auth_proto_init() {
 	if File_proto_auth_auth_proto != nil {
 		return
 	}
+	file_proto_auth_policy_proto_init()
+	file_proto_auth_auth_proto_msgTypes[31].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_auth_auth_proto_rawDesc), len(file_proto_auth_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
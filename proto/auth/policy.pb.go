@@ -0,0 +1,206 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: proto/auth/policy.proto
+
+package auth_proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Policy menentukan siapa yang boleh memanggil sebuah RPC. Setiap RPC wajib
+// punya policy; gRPC server menolak start jika ada RPC tanpa policy.
+// Policy kosong ({}) berarti cukup login (token atau API key yang valid).
+type Policy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RPC bisa dipanggil tanpa credential
+	Public bool `protobuf:"varint,1,opt,name=public,proto3" json:"public,omitempty"`
+	// Permission yang wajib dimiliki role pemanggil (semuanya), contoh: users.delete
+	Permissions []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// Scope yang wajib dimiliki credential ber-scope (API key, service client).
+	// RPC tanpa scope tidak bisa dipanggil dengan credential ber-scope.
+	Scope string `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+	// Tolak token impersonation (admin yang bertindak sebagai user lain), untuk
+	// RPC yang mengubah password, MFA, atau menerbitkan credential baru
+	DenyImpersonation bool `protobuf:"varint,4,opt,name=deny_impersonation,json=denyImpersonation,proto3" json:"deny_impersonation,omitempty"`
+	// Wajib login ulang (password atau MFA, lihat AuthService.Reauthenticate)
+	// dalam N menit terakhir. Jika tidak, RPC ditolak dengan UNAUTHENTICATED dan
+	// ErrorInfo reason REAUTHENTICATION_REQUIRED. API key tidak bisa memenuhinya.
+	MaxAuthAgeMinutes int32 `protobuf:"varint,5,opt,name=max_auth_age_minutes,json=maxAuthAgeMinutes,proto3" json:"max_auth_age_minutes,omitempty"`
+	// Permission dihitung dari role user sendiri (users.role_id), bukan role-nya
+	// di organisasi tempat token bertindak. Untuk RPC yang berlaku lintas
+	// organisasi, mis. kelola role, impersonation dan unlock akun
+	GlobalRole    bool `protobuf:"varint,6,opt,name=global_role,json=globalRole,proto3" json:"global_role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Policy) Reset() {
+	*x = Policy{}
+	mi := &file_proto_auth_policy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Policy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Policy) ProtoMessage() {}
+
+func (x *Policy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_policy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Policy.ProtoReflect.Descriptor instead.
+func (*Policy) Descriptor() ([]byte, []int) {
+	return file_proto_auth_policy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Policy) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *Policy) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *Policy) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *Policy) GetDenyImpersonation() bool {
+	if x != nil {
+		return x.DenyImpersonation
+	}
+	return false
+}
+
+func (x *Policy) GetMaxAuthAgeMinutes() int32 {
+	if x != nil {
+		return x.MaxAuthAgeMinutes
+	}
+	return 0
+}
+
+func (x *Policy) GetGlobalRole() bool {
+	if x != nil {
+		return x.GlobalRole
+	}
+	return false
+}
+
+var file_proto_auth_policy_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*Policy)(nil),
+		Field:         50001,
+		Name:          "auth.v1.policy",
+		Tag:           "bytes,50001,opt,name=policy",
+		Filename:      "proto/auth/policy.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// optional auth.v1.Policy policy = 50001;
+	E_Policy = &file_proto_auth_policy_proto_extTypes[0]
+)
+
+var File_proto_auth_policy_proto protoreflect.FileDescriptor
+
+const file_proto_auth_policy_proto_rawDesc = "" +
+	"\n" +
+	"\x17proto/auth/policy.proto\x12\aauth.v1\x1a google/protobuf/descriptor.proto\"\xd9\x01\n" +
+	"\x06Policy\x12\x16\n" +
+	"\x06public\x18\x01 \x01(\bR\x06public\x12 \n" +
+	"\vpermissions\x18\x02 \x03(\tR\vpermissions\x12\x14\n" +
+	"\x05scope\x18\x03 \x01(\tR\x05scope\x12-\n" +
+	"\x12deny_impersonation\x18\x04 \x01(\bR\x11denyImpersonation\x12/\n" +
+	"\x14max_auth_age_minutes\x18\x05 \x01(\x05R\x11maxAuthAgeMinutes\x12\x1f\n" +
+	"\vglobal_role\x18\x06 \x01(\bR\n" +
+	"globalRole:I\n" +
+	"\x06policy\x12\x1e.google.protobuf.MethodOptions\x18ц\x03 \x01(\v2\x0f.auth.v1.PolicyR\x06policyB;Z9github.com/nassabiq/golang-template/proto/auth;auth_protob\x06proto3"
+
+var (
+	file_proto_auth_policy_proto_rawDescOnce sync.Once
+	file_proto_auth_policy_proto_rawDescData []byte
+)
+
+func file_proto_auth_policy_proto_rawDescGZIP() []byte {
+	file_proto_auth_policy_proto_rawDescOnce.Do(func() {
+		file_proto_auth_policy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_auth_policy_proto_rawDesc), len(file_proto_auth_policy_proto_rawDesc)))
+	})
+	return file_proto_auth_policy_proto_rawDescData
+}
+
+var file_proto_auth_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_auth_policy_proto_goTypes = []any{
+	(*Policy)(nil),                     // 0: auth.v1.Policy
+	(*descriptorpb.MethodOptions)(nil), // 1: google.protobuf.MethodOptions
+}
+var file_proto_auth_policy_proto_depIdxs = []int32{
+	1, // 0: auth.v1.policy:extendee -> google.protobuf.MethodOptions
+	0, // 1: auth.v1.policy:type_name -> auth.v1.Policy
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	1, // [1:2] is the sub-list for extension type_name
+	0, // [0:1] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_auth_policy_proto_init() }
+func file_proto_auth_policy_proto_init() {
+	if File_proto_auth_policy_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_auth_policy_proto_rawDesc), len(file_proto_auth_policy_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_auth_policy_proto_goTypes,
+		DependencyIndexes: file_proto_auth_policy_proto_depIdxs,
+		MessageInfos:      file_proto_auth_policy_proto_msgTypes,
+		ExtensionInfos:    file_proto_auth_policy_proto_extTypes,
+	}.Build()
+	File_proto_auth_policy_proto = out.File
+	file_proto_auth_policy_proto_goTypes = nil
+	file_proto_auth_policy_proto_depIdxs = nil
+}
@@ -0,0 +1,1531 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: proto/organization/organization.proto
+
+package organization_proto
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "github.com/nassabiq/golang-template/proto/auth"
+	common "github.com/nassabiq/golang-template/proto/common"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Organization entity
+type Organization struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID organisasi
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Nama organisasi
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Slug organisasi (unik)
+	Slug string `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Timestamp pembuatan
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Timestamp update terakhir
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	mi := &file_proto_organization_organization_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Organization) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Organization) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Organization) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Organization) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Organization) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Member entity
+type Member struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID organisasi
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// UUID user
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Nama user
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Email user
+	Email string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	// Role user di organisasi ini
+	RoleId string `protobuf:"bytes,5,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	// Timestamp bergabung
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Timestamp update terakhir
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Member) Reset() {
+	*x = Member{}
+	mi := &file_proto_organization_organization_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Member) ProtoMessage() {}
+
+func (x *Member) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Member.ProtoReflect.Descriptor instead.
+func (*Member) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Member) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *Member) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Member) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Member) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Member) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *Member) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Member) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Undangan bergabung ke organisasi
+type MemberInvitation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID organisasi
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// Nama organisasi
+	OrganizationName string `protobuf:"bytes,2,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	// UUID user yang diundang
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Role user di organisasi setelah menerima undangan
+	RoleId string `protobuf:"bytes,4,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	// UUID user yang mengundang
+	InvitedBy string `protobuf:"bytes,5,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	// Batas waktu menerima undangan
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Timestamp undangan dibuat
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberInvitation) Reset() {
+	*x = MemberInvitation{}
+	mi := &file_proto_organization_organization_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberInvitation) ProtoMessage() {}
+
+func (x *MemberInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberInvitation.ProtoReflect.Descriptor instead.
+func (*MemberInvitation) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MemberInvitation) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *MemberInvitation) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
+}
+
+func (x *MemberInvitation) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MemberInvitation) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *MemberInvitation) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *MemberInvitation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *MemberInvitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationRequest) Reset() {
+	*x = ListOrganizationRequest{}
+	mi := &file_proto_organization_organization_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationRequest) ProtoMessage() {}
+
+func (x *ListOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*ListOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{3}
+}
+
+type GetByIDRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID organisasi yang dicari
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByIDRequest) Reset() {
+	*x = GetByIDRequest{}
+	mi := &file_proto_organization_organization_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByIDRequest) ProtoMessage() {}
+
+func (x *GetByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetByIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetByIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateOrganizationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Nama organisasi
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Slug organisasi (huruf kecil, angka dan tanda hubung, harus unik)
+	Slug          string `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationRequest) Reset() {
+	*x = CreateOrganizationRequest{}
+	mi := &file_proto_organization_organization_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationRequest) ProtoMessage() {}
+
+func (x *CreateOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateOrganizationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateOrganizationRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type UpdateOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Slug          *string                `protobuf:"bytes,3,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationRequest) Reset() {
+	*x = UpdateOrganizationRequest{}
+	mi := &file_proto_organization_organization_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationRequest) ProtoMessage() {}
+
+func (x *UpdateOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateOrganizationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationRequest) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+type DeleteOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrganizationRequest) Reset() {
+	*x = DeleteOrganizationRequest{}
+	mi := &file_proto_organization_organization_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrganizationRequest) ProtoMessage() {}
+
+func (x *DeleteOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteOrganizationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID organisasi
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListMemberRequest) Reset() {
+	*x = ListMemberRequest{}
+	mi := &file_proto_organization_organization_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemberRequest) ProtoMessage() {}
+
+func (x *ListMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemberRequest.ProtoReflect.Descriptor instead.
+func (*ListMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListMemberRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type InviteMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID organisasi
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// UUID user yang diundang
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Role user di organisasi setelah menerima undangan
+	RoleId        string `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteMemberRequest) Reset() {
+	*x = InviteMemberRequest{}
+	mi := &file_proto_organization_organization_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteMemberRequest) ProtoMessage() {}
+
+func (x *InviteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteMemberRequest.ProtoReflect.Descriptor instead.
+func (*InviteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InviteMemberRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *InviteMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InviteMemberRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+type ListInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationRequest) Reset() {
+	*x = ListInvitationRequest{}
+	mi := &file_proto_organization_organization_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationRequest) ProtoMessage() {}
+
+func (x *ListInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{10}
+}
+
+type InvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID organisasi yang mengundang
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InvitationRequest) Reset() {
+	*x = InvitationRequest{}
+	mi := &file_proto_organization_organization_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitationRequest) ProtoMessage() {}
+
+func (x *InvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitationRequest.ProtoReflect.Descriptor instead.
+func (*InvitationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *InvitationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type UpdateMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID organisasi
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// UUID user
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Role baru user di organisasi
+	RoleId        string `protobuf:"bytes,3,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemberRequest) Reset() {
+	*x = UpdateMemberRequest{}
+	mi := &file_proto_organization_organization_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberRequest) ProtoMessage() {}
+
+func (x *UpdateMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateMemberRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UpdateMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateMemberRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+type RemoveMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID organisasi
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// UUID user yang dikeluarkan
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMemberRequest) Reset() {
+	*x = RemoveMemberRequest{}
+	mi := &file_proto_organization_organization_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberRequest) ProtoMessage() {}
+
+func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoveMemberRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *RemoveMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organizations []*Organization        `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	Metadata      *common.MetaData       `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationResponse) Reset() {
+	*x = ListOrganizationResponse{}
+	mi := &file_proto_organization_organization_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationResponse) ProtoMessage() {}
+
+func (x *ListOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*ListOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListOrganizationResponse) GetOrganizations() []*Organization {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+func (x *ListOrganizationResponse) GetMetadata() *common.MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ListMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*Member              `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Metadata      *common.MetaData       `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemberResponse) Reset() {
+	*x = ListMemberResponse{}
+	mi := &file_proto_organization_organization_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemberResponse) ProtoMessage() {}
+
+func (x *ListMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemberResponse.ProtoReflect.Descriptor instead.
+func (*ListMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListMemberResponse) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListMemberResponse) GetMetadata() *common.MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type OrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.MetaData       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Data          *Organization          `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrganizationResponse) Reset() {
+	*x = OrganizationResponse{}
+	mi := &file_proto_organization_organization_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationResponse) ProtoMessage() {}
+
+func (x *OrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationResponse.ProtoReflect.Descriptor instead.
+func (*OrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *OrganizationResponse) GetMetadata() *common.MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *OrganizationResponse) GetData() *Organization {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type MemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.MetaData       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Data          *Member                `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberResponse) Reset() {
+	*x = MemberResponse{}
+	mi := &file_proto_organization_organization_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberResponse) ProtoMessage() {}
+
+func (x *MemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberResponse.ProtoReflect.Descriptor instead.
+func (*MemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MemberResponse) GetMetadata() *common.MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *MemberResponse) GetData() *Member {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type MemberInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.MetaData       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Data          *MemberInvitation      `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberInvitationResponse) Reset() {
+	*x = MemberInvitationResponse{}
+	mi := &file_proto_organization_organization_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberInvitationResponse) ProtoMessage() {}
+
+func (x *MemberInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberInvitationResponse.ProtoReflect.Descriptor instead.
+func (*MemberInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MemberInvitationResponse) GetMetadata() *common.MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *MemberInvitationResponse) GetData() *MemberInvitation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*MemberInvitation    `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	Metadata      *common.MetaData       `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationResponse) Reset() {
+	*x = ListInvitationResponse{}
+	mi := &file_proto_organization_organization_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationResponse) ProtoMessage() {}
+
+func (x *ListInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListInvitationResponse) GetInvitations() []*MemberInvitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+func (x *ListInvitationResponse) GetMetadata() *common.MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type DeleteOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.MetaData       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrganizationResponse) Reset() {
+	*x = DeleteOrganizationResponse{}
+	mi := &file_proto_organization_organization_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrganizationResponse) ProtoMessage() {}
+
+func (x *DeleteOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_organization_organization_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_organization_organization_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteOrganizationResponse) GetMetadata() *common.MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+var File_proto_organization_organization_proto protoreflect.FileDescriptor
+
+const file_proto_organization_organization_proto_rawDesc = "" +
+	"\n" +
+	"%proto/organization/organization.proto\x12\x0forganization.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17proto/auth/policy.proto\x1a\x19proto/common/common.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xbc\x01\n" +
+	"\fOrganization\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x83\x02\n" +
+	"\x06Member\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x17\n" +
+	"\arole_id\x18\x05 \x01(\tR\x06roleId\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xaf\x02\n" +
+	"\x10MemberInvitation\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12+\n" +
+	"\x11organization_name\x18\x02 \x01(\tR\x10organizationName\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x04 \x01(\tR\x06roleId\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\x05 \x01(\tR\tinvitedBy\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x19\n" +
+	"\x17ListOrganizationRequest\" \n" +
+	"\x0eGetByIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
+	"\x19CreateOrganizationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\"o\n" +
+	"\x19UpdateOrganizationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x17\n" +
+	"\x04slug\x18\x03 \x01(\tH\x01R\x04slug\x88\x01\x01B\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_slug\"+\n" +
+	"\x19DeleteOrganizationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"<\n" +
+	"\x11ListMemberRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"p\n" +
+	"\x13InviteMemberRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x03 \x01(\tR\x06roleId\"\x17\n" +
+	"\x15ListInvitationRequest\"<\n" +
+	"\x11InvitationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"p\n" +
+	"\x13UpdateMemberRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\arole_id\x18\x03 \x01(\tR\x06roleId\"W\n" +
+	"\x13RemoveMemberRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x90\x01\n" +
+	"\x18ListOrganizationResponse\x12C\n" +
+	"\rorganizations\x18\x01 \x03(\v2\x1d.organization.v1.OrganizationR\rorganizations\x12/\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x13.common.v1.MetaDataR\bmetadata\"x\n" +
+	"\x12ListMemberResponse\x121\n" +
+	"\amembers\x18\x01 \x03(\v2\x17.organization.v1.MemberR\amembers\x12/\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x13.common.v1.MetaDataR\bmetadata\"z\n" +
+	"\x14OrganizationResponse\x12/\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x13.common.v1.MetaDataR\bmetadata\x121\n" +
+	"\x04data\x18\x02 \x01(\v2\x1d.organization.v1.OrganizationR\x04data\"n\n" +
+	"\x0eMemberResponse\x12/\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x13.common.v1.MetaDataR\bmetadata\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.organization.v1.MemberR\x04data\"\x82\x01\n" +
+	"\x18MemberInvitationResponse\x12/\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x13.common.v1.MetaDataR\bmetadata\x125\n" +
+	"\x04data\x18\x02 \x01(\v2!.organization.v1.MemberInvitationR\x04data\"\x8e\x01\n" +
+	"\x16ListInvitationResponse\x12C\n" +
+	"\vinvitations\x18\x01 \x03(\v2!.organization.v1.MemberInvitationR\vinvitations\x12/\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x13.common.v1.MetaDataR\bmetadata\"M\n" +
+	"\x1aDeleteOrganizationResponse\x12/\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x13.common.v1.MetaDataR\bmetadata2\x8b$\n" +
+	"\x13OrganizationService\x12\x98\x02\n" +
+	"\x04List\x12(.organization.v1.ListOrganizationRequest\x1a).organization.v1.ListOrganizationResponse\"\xba\x01\x92A\x9c\x01\n" +
+	"\rOrganizations\x12\x12List Organizations\x1a9Mendapatkan daftar organisasi tempat user menjadi anggotaJ.\n" +
+	"\x03200\x12'\n" +
+	"%Daftar organisasi berhasil didapatkanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x00\x82\xd3\xe4\x93\x02\x10\x12\x0e/organizations\x12\xc7\x02\n" +
+	"\aGetByID\x12\x1f.organization.v1.GetByIDRequest\x1a%.organization.v1.OrganizationResponse\"\xf3\x01\x92A\xd0\x01\n" +
+	"\rOrganizations\x12\x16Get Organization by ID\x1aLMendapatkan detail organisasi. Hanya anggota organisasi yang bisa melihatnyaJ&\n" +
+	"\x03200\x12\x1f\n" +
+	"\x1dOrganisasi berhasil ditemukanJ#\n" +
+	"\x03404\x12\x1c\n" +
+	"\x1aOrganisasi tidak ditemukanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x00\x82\xd3\xe4\x93\x02\x15\x12\x13/organizations/{id}\x12\xdb\x02\n" +
+	"\x06Create\x12*.organization.v1.CreateOrganizationRequest\x1a%.organization.v1.OrganizationResponse\"\xfd\x01\x92A\xdc\x01\n" +
+	"\rOrganizations\x12\x13Create Organization\x1aKMembuat organisasi baru. Pembuat otomatis menjadi admin organisasi tersebutJ#\n" +
+	"\x03200\x12\x1c\n" +
+	"\x1aOrganisasi berhasil dibuatJ\x19\n" +
+	"\x03400\x12\x12\n" +
+	"\x10Data tidak validJ\x1b\n" +
+	"\x03409\x12\x14\n" +
+	"\x12Slug sudah dipakaib\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x00\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/organizations\x12\xa9\x03\n" +
+	"\x06Update\x12*.organization.v1.UpdateOrganizationRequest\x1a%.organization.v1.OrganizationResponse\"\xcb\x02\x92A\x8f\x02\n" +
+	"\rOrganizations\x12\x13Update Organization\x1aVMengubah nama atau slug organisasi yang sedang aktif (permission organizations.manage)J%\n" +
+	"\x03200\x12\x1e\n" +
+	"\x1cOrganisasi berhasil diupdateJ?\n" +
+	"\x03404\x128\n" +
+	"6Organisasi tidak ditemukan atau bukan organisasi aktifJ\x1b\n" +
+	"\x03409\x12\x14\n" +
+	"\x12Slug sudah dipakaib\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x16\x12\x14organizations.manage\x82\xd3\xe4\x93\x02\x18:\x01*2\x13/organizations/{id}\x12\x97\x03\n" +
+	"\x06Delete\x12*.organization.v1.DeleteOrganizationRequest\x1a+.organization.v1.DeleteOrganizationResponse\"\xb3\x02\x92A\xfa\x01\n" +
+	"\rOrganizations\x12\x13Delete Organization\x1a_Menghapus organisasi yang sedang aktif beserta keanggotaannya (permission organizations.manage)J$\n" +
+	"\x03200\x12\x1d\n" +
+	"\x1bOrganisasi berhasil dihapusJ?\n" +
+	"\x03404\x128\n" +
+	"6Organisasi tidak ditemukan atau bukan organisasi aktifb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x16\x12\x14organizations.manage\x82\xd3\xe4\x93\x02\x15*\x13/organizations/{id}\x12\x8b\x03\n" +
+	"\vListMembers\x12\".organization.v1.ListMemberRequest\x1a#.organization.v1.ListMemberResponse\"\xb2\x02\x92A\xec\x01\n" +
+	"\rOrganizations\x12\fList Members\x1aQMendapatkan daftar anggota organisasi yang sedang aktif (permission members.read)J+\n" +
+	"\x03200\x12$\n" +
+	"\"Daftar anggota berhasil didapatkanJ?\n" +
+	"\x03404\x128\n" +
+	"6Organisasi tidak ditemukan atau bukan organisasi aktifb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x0e\x12\fmembers.read\x82\xd3\xe4\x93\x02*\x12(/organizations/{organization_id}/members\x12\xa5\x04\n" +
+	"\fInviteMember\x12$.organization.v1.InviteMemberRequest\x1a).organization.v1.MemberInvitationResponse\"\xc3\x03\x92A\xf4\x02\n" +
+	"\rOrganizations\x12\rInvite Member\x1a\x95\x01Mengundang user ke organisasi yang sedang aktif dengan role tertentu (permission members.manage). User baru menjadi anggota setelah menerima undanganJ!\n" +
+	"\x03200\x12\x1a\n" +
+	"\x18Undangan berhasil dibuatJ%\n" +
+	"\x03400\x12\x1e\n" +
+	"\x1cUser atau role tidak dikenalJ?\n" +
+	"\x03404\x128\n" +
+	"6Organisasi tidak ditemukan atau bukan organisasi aktifJ#\n" +
+	"\x03409\x12\x1c\n" +
+	"\x1aUser sudah menjadi anggotab\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x10\x12\x0emembers.manage\x82\xd3\xe4\x93\x021:\x01*\",/organizations/{organization_id}/invitations\x12\xb0\x02\n" +
+	"\x0fListInvitations\x12&.organization.v1.ListInvitationRequest\x1a'.organization.v1.ListInvitationResponse\"\xcb\x01\x92A\xa2\x01\n" +
+	"\rOrganizations\x12\x10List Invitations\x1aCMendapatkan daftar undangan organisasi yang belum dijawab oleh userJ,\n" +
+	"\x03200\x12%\n" +
+	"#Daftar undangan berhasil didapatkanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x00\x82\xd3\xe4\x93\x02\x1b\x12\x19/organization-invitations\x12\xfa\x02\n" +
+	"\x10AcceptInvitation\x12\".organization.v1.InvitationRequest\x1a\x1f.organization.v1.MemberResponse\"\xa0\x02\x92A\xdb\x01\n" +
+	"\rOrganizations\x12\x11Accept Invitation\x1aMMenerima undangan organisasi. User bergabung dengan role yang ada di undanganJ \n" +
+	"\x03200\x12\x19\n" +
+	"\x17User berhasil bergabungJ8\n" +
+	"\x03404\x121\n" +
+	"/Undangan tidak ditemukan atau sudah kedaluwarsab\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x00\x82\xd3\xe4\x93\x027:\x01*\"2/organization-invitations/{organization_id}/accept\x12\xce\x02\n" +
+	"\x11DeclineInvitation\x12\".organization.v1.InvitationRequest\x1a+.organization.v1.DeleteOrganizationResponse\"\xe7\x01\x92A\xac\x01\n" +
+	"\rOrganizations\x12\x12Decline Invitation\x1a\x1bMenolak undangan organisasiJ\"\n" +
+	"\x03200\x12\x1b\n" +
+	"\x19Undangan berhasil ditolakJ8\n" +
+	"\x03404\x121\n" +
+	"/Undangan tidak ditemukan atau sudah kedaluwarsab\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x00\x82\xd3\xe4\x93\x02-*+/organization-invitations/{organization_id}\x12\xb1\x03\n" +
+	"\fUpdateMember\x12$.organization.v1.UpdateMemberRequest\x1a\x1f.organization.v1.MemberResponse\"\xd9\x02\x92A\x84\x02\n" +
+	"\rOrganizations\x12\rUpdate Member\x1apMengubah role anggota di organisasi yang sedang aktif (permission members.manage). Berlaku pada token berikutnyaJ%\n" +
+	"\x03200\x12\x1e\n" +
+	"\x1cRole anggota berhasil diubahJ\x1b\n" +
+	"\x03400\x12\x14\n" +
+	"\x12Role tidak dikenalJ \n" +
+	"\x03404\x12\x19\n" +
+	"\x17Anggota tidak ditemukanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x10\x12\x0emembers.manage\x82\xd3\xe4\x93\x027:\x01*22/organizations/{organization_id}/members/{user_id}\x12\xff\x02\n" +
+	"\fRemoveMember\x12$.organization.v1.RemoveMemberRequest\x1a+.organization.v1.DeleteOrganizationResponse\"\x9b\x02\x92A\xc9\x01\n" +
+	"\rOrganizations\x12\rRemove Member\x1aRMengeluarkan anggota dari organisasi yang sedang aktif (permission members.manage)J%\n" +
+	"\x03200\x12\x1e\n" +
+	"\x1cAnggota berhasil dikeluarkanJ \n" +
+	"\x03404\x12\x19\n" +
+	"\x17Anggota tidak ditemukanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x10\x12\x0emembers.manage\x82\xd3\xe4\x93\x024*2/organizations/{organization_id}/members/{user_id}B\xba\x02\x92A\xeb\x01\x12j\n" +
+	"\x10Organization API\x12-API untuk manajemen organisasi dan anggotanya\"\"\n" +
+	"\vAPI Support\x1a\x13support@example.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZG\n" +
+	"E\n" +
+	"\x06Bearer\x12;\b\x02\x12&Enter 'Bearer {token}' to authenticate\x1a\rAuthorization \x02b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00ZIgithub.com/nassabiq/golang-template/proto/organization;organization_protob\x06proto3"
+
+var (
+	file_proto_organization_organization_proto_rawDescOnce sync.Once
+	file_proto_organization_organization_proto_rawDescData []byte
+)
+
+func file_proto_organization_organization_proto_rawDescGZIP() []byte {
+	file_proto_organization_organization_proto_rawDescOnce.Do(func() {
+		file_proto_organization_organization_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_organization_organization_proto_rawDesc), len(file_proto_organization_organization_proto_rawDesc)))
+	})
+	return file_proto_organization_organization_proto_rawDescData
+}
+
+var file_proto_organization_organization_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_proto_organization_organization_proto_goTypes = []any{
+	(*Organization)(nil),               // 0: organization.v1.Organization
+	(*Member)(nil),                     // 1: organization.v1.Member
+	(*MemberInvitation)(nil),           // 2: organization.v1.MemberInvitation
+	(*ListOrganizationRequest)(nil),    // 3: organization.v1.ListOrganizationRequest
+	(*GetByIDRequest)(nil),             // 4: organization.v1.GetByIDRequest
+	(*CreateOrganizationRequest)(nil),  // 5: organization.v1.CreateOrganizationRequest
+	(*UpdateOrganizationRequest)(nil),  // 6: organization.v1.UpdateOrganizationRequest
+	(*DeleteOrganizationRequest)(nil),  // 7: organization.v1.DeleteOrganizationRequest
+	(*ListMemberRequest)(nil),          // 8: organization.v1.ListMemberRequest
+	(*InviteMemberRequest)(nil),        // 9: organization.v1.InviteMemberRequest
+	(*ListInvitationRequest)(nil),      // 10: organization.v1.ListInvitationRequest
+	(*InvitationRequest)(nil),          // 11: organization.v1.InvitationRequest
+	(*UpdateMemberRequest)(nil),        // 12: organization.v1.UpdateMemberRequest
+	(*RemoveMemberRequest)(nil),        // 13: organization.v1.RemoveMemberRequest
+	(*ListOrganizationResponse)(nil),   // 14: organization.v1.ListOrganizationResponse
+	(*ListMemberResponse)(nil),         // 15: organization.v1.ListMemberResponse
+	(*OrganizationResponse)(nil),       // 16: organization.v1.OrganizationResponse
+	(*MemberResponse)(nil),             // 17: organization.v1.MemberResponse
+	(*MemberInvitationResponse)(nil),   // 18: organization.v1.MemberInvitationResponse
+	(*ListInvitationResponse)(nil),     // 19: organization.v1.ListInvitationResponse
+	(*DeleteOrganizationResponse)(nil), // 20: organization.v1.DeleteOrganizationResponse
+	(*timestamppb.Timestamp)(nil),      // 21: google.protobuf.Timestamp
+	(*common.MetaData)(nil),            // 22: common.v1.MetaData
+}
+var file_proto_organization_organization_proto_depIdxs = []int32{
+	21, // 0: organization.v1.Organization.created_at:type_name -> google.protobuf.Timestamp
+	21, // 1: organization.v1.Organization.updated_at:type_name -> google.protobuf.Timestamp
+	21, // 2: organization.v1.Member.created_at:type_name -> google.protobuf.Timestamp
+	21, // 3: organization.v1.Member.updated_at:type_name -> google.protobuf.Timestamp
+	21, // 4: organization.v1.MemberInvitation.expires_at:type_name -> google.protobuf.Timestamp
+	21, // 5: organization.v1.MemberInvitation.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 6: organization.v1.ListOrganizationResponse.organizations:type_name -> organization.v1.Organization
+	22, // 7: organization.v1.ListOrganizationResponse.metadata:type_name -> common.v1.MetaData
+	1,  // 8: organization.v1.ListMemberResponse.members:type_name -> organization.v1.Member
+	22, // 9: organization.v1.ListMemberResponse.metadata:type_name -> common.v1.MetaData
+	22, // 10: organization.v1.OrganizationResponse.metadata:type_name -> common.v1.MetaData
+	0,  // 11: organization.v1.OrganizationResponse.data:type_name -> organization.v1.Organization
+	22, // 12: organization.v1.MemberResponse.metadata:type_name -> common.v1.MetaData
+	1,  // 13: organization.v1.MemberResponse.data:type_name -> organization.v1.Member
+	22, // 14: organization.v1.MemberInvitationResponse.metadata:type_name -> common.v1.MetaData
+	2,  // 15: organization.v1.MemberInvitationResponse.data:type_name -> organization.v1.MemberInvitation
+	2,  // 16: organization.v1.ListInvitationResponse.invitations:type_name -> organization.v1.MemberInvitation
+	22, // 17: organization.v1.ListInvitationResponse.metadata:type_name -> common.v1.MetaData
+	22, // 18: organization.v1.DeleteOrganizationResponse.metadata:type_name -> common.v1.MetaData
+	3,  // 19: organization.v1.OrganizationService.List:input_type -> organization.v1.ListOrganizationRequest
+	4,  // 20: organization.v1.OrganizationService.GetByID:input_type -> organization.v1.GetByIDRequest
+	5,  // 21: organization.v1.OrganizationService.Create:input_type -> organization.v1.CreateOrganizationRequest
+	6,  // 22: organization.v1.OrganizationService.Update:input_type -> organization.v1.UpdateOrganizationRequest
+	7,  // 23: organization.v1.OrganizationService.Delete:input_type -> organization.v1.DeleteOrganizationRequest
+	8,  // 24: organization.v1.OrganizationService.ListMembers:input_type -> organization.v1.ListMemberRequest
+	9,  // 25: organization.v1.OrganizationService.InviteMember:input_type -> organization.v1.InviteMemberRequest
+	10, // 26: organization.v1.OrganizationService.ListInvitations:input_type -> organization.v1.ListInvitationRequest
+	11, // 27: organization.v1.OrganizationService.AcceptInvitation:input_type -> organization.v1.InvitationRequest
+	11, // 28: organization.v1.OrganizationService.DeclineInvitation:input_type -> organization.v1.InvitationRequest
+	12, // 29: organization.v1.OrganizationService.UpdateMember:input_type -> organization.v1.UpdateMemberRequest
+	13, // 30: organization.v1.OrganizationService.RemoveMember:input_type -> organization.v1.RemoveMemberRequest
+	14, // 31: organization.v1.OrganizationService.List:output_type -> organization.v1.ListOrganizationResponse
+	16, // 32: organization.v1.OrganizationService.GetByID:output_type -> organization.v1.OrganizationResponse
+	16, // 33: organization.v1.OrganizationService.Create:output_type -> organization.v1.OrganizationResponse
+	16, // 34: organization.v1.OrganizationService.Update:output_type -> organization.v1.OrganizationResponse
+	20, // 35: organization.v1.OrganizationService.Delete:output_type -> organization.v1.DeleteOrganizationResponse
+	15, // 36: organization.v1.OrganizationService.ListMembers:output_type -> organization.v1.ListMemberResponse
+	18, // 37: organization.v1.OrganizationService.InviteMember:output_type -> organization.v1.MemberInvitationResponse
+	19, // 38: organization.v1.OrganizationService.ListInvitations:output_type -> organization.v1.ListInvitationResponse
+	17, // 39: organization.v1.OrganizationService.AcceptInvitation:output_type -> organization.v1.MemberResponse
+	20, // 40: organization.v1.OrganizationService.DeclineInvitation:output_type -> organization.v1.DeleteOrganizationResponse
+	17, // 41: organization.v1.OrganizationService.UpdateMember:output_type -> organization.v1.MemberResponse
+	20, // 42: organization.v1.OrganizationService.RemoveMember:output_type -> organization.v1.DeleteOrganizationResponse
+	31, // [31:43] is the sub-list for method output_type
+	19, // [19:31] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_proto_organization_organization_proto_init() }
+func file_proto_organization_organization_proto_init() {
+	if File_proto_organization_organization_proto != nil {
+		return
+	}
+	file_proto_organization_organization_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_organization_organization_proto_rawDesc), len(file_proto_organization_organization_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_organization_organization_proto_goTypes,
+		DependencyIndexes: file_proto_organization_organization_proto_depIdxs,
+		MessageInfos:      file_proto_organization_organization_proto_msgTypes,
+	}.Build()
+	File_proto_organization_organization_proto = out.File
+	file_proto_organization_organization_proto_goTypes = nil
+	file_proto_organization_organization_proto_depIdxs = nil
+}
@@ -0,0 +1,986 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: proto/role/role.proto
+
+package role_proto
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "github.com/nassabiq/golang-template/proto/auth"
+	common "github.com/nassabiq/golang-template/proto/common"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Role entity
+type Role struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID role
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Nama role (unik)
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Permission yang dimiliki role
+	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	// Timestamp pembuatan
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Timestamp update terakhir
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_proto_role_role_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_role_role_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_proto_role_role_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Role) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *Role) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Role) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Permission entity
+type Permission struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID permission
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Nama permission, contoh: users.delete
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Penjelasan permission
+	Description   string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Permission) Reset() {
+	*x = Permission{}
+	mi := &file_proto_role_role_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Permission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Permission) ProtoMessage() {}
+
+func (x *Permission) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_role_role_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Permission.ProtoReflect.Descriptor instead.
+func (*Permission) Descriptor() ([]byte, []int) {
+	return file_proto_role_role_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Permission) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Permission) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Permission) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ListRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoleRequest) Reset() {
+	*x = ListRoleRequest{}
+	mi := &file_proto_role_role_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoleRequest) ProtoMessage() {}
+
+func (x *ListRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_role_role_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoleRequest.ProtoReflect.Descriptor instead.
+func (*ListRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_role_role_proto_rawDescGZIP(), []int{2}
+}
+
+type GetByIDRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID role yang dicari
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByIDRequest) Reset() {
+	*x = GetByIDRequest{}
+	mi := &file_proto_role_role_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByIDRequest) ProtoMessage() {}
+
+func (x *GetByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_role_role_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetByIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_role_role_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetByIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateRoleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Nama role (harus unik)
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Permission awal role
+	Permissions   []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoleRequest) Reset() {
+	*x = CreateRoleRequest{}
+	mi := &file_proto_role_role_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleRequest) ProtoMessage() {}
+
+func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_role_role_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_role_role_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type UpdateRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoleRequest) Reset() {
+	*x = UpdateRoleRequest{}
+	mi := &file_proto_role_role_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleRequest) ProtoMessage() {}
+
+func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_role_role_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_role_role_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateRoleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRoleRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type DeleteRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleRequest) Reset() {
+	*x = DeleteRoleRequest{}
+	mi := &file_proto_role_role_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleRequest) ProtoMessage() {}
+
+func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_role_role_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_role_role_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteRoleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GrantPermissionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID role
+	RoleId string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	// Nama permission yang diberikan
+	Permission    string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantPermissionRequest) Reset() {
+	*x = GrantPermissionRequest{}
+	mi := &file_proto_role_role_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantPermissionRequest) ProtoMessage() {}
+
+func (x *GrantPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_role_role_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantPermissionRequest.ProtoReflect.Descriptor instead.
+func (*GrantPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_role_role_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GrantPermissionRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *GrantPermissionRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+type RevokePermissionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID role
+	RoleId string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	// Nama permission yang dicabut
+	Permission    string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokePermissionRequest) Reset() {
+	*x = RevokePermissionRequest{}
+	mi := &file_proto_role_role_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokePermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokePermissionRequest) ProtoMessage() {}
+
+func (x *RevokePermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_role_role_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokePermissionRequest.ProtoReflect.Descriptor instead.
+func (*RevokePermissionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_role_role_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RevokePermissionRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *RevokePermissionRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+type ListRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*Role                `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	Metadata      *common.MetaData       `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoleResponse) Reset() {
+	*x = ListRoleResponse{}
+	mi := &file_proto_role_role_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoleResponse) ProtoMessage() {}
+
+func (x *ListRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_role_role_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoleResponse.ProtoReflect.Descriptor instead.
+func (*ListRoleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_role_role_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListRoleResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *ListRoleResponse) GetMetadata() *common.MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type ListPermissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Permissions   []*Permission          `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Metadata      *common.MetaData       `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPermissionResponse) Reset() {
+	*x = ListPermissionResponse{}
+	mi := &file_proto_role_role_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPermissionResponse) ProtoMessage() {}
+
+func (x *ListPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_role_role_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPermissionResponse.ProtoReflect.Descriptor instead.
+func (*ListPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_role_role_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListPermissionResponse) GetPermissions() []*Permission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *ListPermissionResponse) GetMetadata() *common.MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type RoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.MetaData       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Data          *Role                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleResponse) Reset() {
+	*x = RoleResponse{}
+	mi := &file_proto_role_role_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleResponse) ProtoMessage() {}
+
+func (x *RoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_role_role_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleResponse.ProtoReflect.Descriptor instead.
+func (*RoleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_role_role_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RoleResponse) GetMetadata() *common.MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *RoleResponse) GetData() *Role {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.MetaData       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoleResponse) Reset() {
+	*x = DeleteRoleResponse{}
+	mi := &file_proto_role_role_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleResponse) ProtoMessage() {}
+
+func (x *DeleteRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_role_role_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_role_role_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteRoleResponse) GetMetadata() *common.MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_proto_role_role_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_role_role_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_proto_role_role_proto_rawDescGZIP(), []int{13}
+}
+
+var File_proto_role_role_proto protoreflect.FileDescriptor
+
+const file_proto_role_role_proto_rawDesc = "" +
+	"\n" +
+	"\x15proto/role/role.proto\x12\arole.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17proto/auth/policy.proto\x1a\x19proto/common/common.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xc2\x01\n" +
+	"\x04Role\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vpermissions\x18\x03 \x03(\tR\vpermissions\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"R\n" +
+	"\n" +
+	"Permission\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"\x11\n" +
+	"\x0fListRoleRequest\" \n" +
+	"\x0eGetByIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"I\n" +
+	"\x11CreateRoleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vpermissions\x18\x02 \x03(\tR\vpermissions\"E\n" +
+	"\x11UpdateRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_name\"#\n" +
+	"\x11DeleteRoleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Q\n" +
+	"\x16GrantPermissionRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\tR\n" +
+	"permission\"R\n" +
+	"\x17RevokePermissionRequest\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x02 \x01(\tR\n" +
+	"permission\"h\n" +
+	"\x10ListRoleResponse\x12#\n" +
+	"\x05roles\x18\x01 \x03(\v2\r.role.v1.RoleR\x05roles\x12/\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x13.common.v1.MetaDataR\bmetadata\"\x80\x01\n" +
+	"\x16ListPermissionResponse\x125\n" +
+	"\vpermissions\x18\x01 \x03(\v2\x13.role.v1.PermissionR\vpermissions\x12/\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x13.common.v1.MetaDataR\bmetadata\"b\n" +
+	"\fRoleResponse\x12/\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x13.common.v1.MetaDataR\bmetadata\x12!\n" +
+	"\x04data\x18\x02 \x01(\v2\r.role.v1.RoleR\x04data\"E\n" +
+	"\x12DeleteRoleResponse\x12/\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x13.common.v1.MetaDataR\bmetadata\"\a\n" +
+	"\x05Empty2\x89\x16\n" +
+	"\vRoleService\x12\x9f\x02\n" +
+	"\x04List\x12\x18.role.v1.ListRoleRequest\x1a\x19.role.v1.ListRoleResponse\"\xe1\x01\x92A\xbf\x01\n" +
+	"\x05Roles\x12\n" +
+	"List Roles\x1aFMendapatkan daftar role beserta permission-nya (permission roles.read)J(\n" +
+	"\x03200\x12!\n" +
+	"\x1fDaftar role berhasil didapatkanJ*\n" +
+	"\x03403\x12#\n" +
+	"!Tidak punya permission roles.readb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\f\x12\n" +
+	"roles.read\x82\xd3\xe4\x93\x02\b\x12\x06/roles\x12\x89\x02\n" +
+	"\aGetByID\x12\x17.role.v1.GetByIDRequest\x1a\x15.role.v1.RoleResponse\"\xcd\x01\x92A\xa6\x01\n" +
+	"\x05Roles\x12\x0eGet Role by ID\x1a>Mendapatkan detail role berdasarkan ID (permission roles.read)J \n" +
+	"\x03200\x12\x19\n" +
+	"\x17Role berhasil ditemukanJ\x1d\n" +
+	"\x03404\x12\x16\n" +
+	"\x14Role tidak ditemukanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\f\x12\n" +
+	"roles.read\x82\xd3\xe4\x93\x02\r\x12\v/roles/{id}\x12\xd1\x03\n" +
+	"\x06Create\x12\x1a.role.v1.CreateRoleRequest\x1a\x15.role.v1.RoleResponse\"\x93\x03\x92A\xea\x02\n" +
+	"\x05Roles\x12\vCreate Role\x1a\x7fMembuat role baru beserta permission awalnya (permission roles.manage). Permission yang diberikan harus dimiliki oleh pemanggilJ\x1d\n" +
+	"\x03200\x12\x16\n" +
+	"\x14Role berhasil dibuatJ7\n" +
+	"\x03400\x120\n" +
+	".Data tidak valid atau permission tidak dikenalJK\n" +
+	"\x03403\x12D\n" +
+	"BTidak punya permission roles.manage atau permission yang diberikanJ \n" +
+	"\x03409\x12\x19\n" +
+	"\x17Nama role sudah dipakaib\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x10\x12\froles.manage0\x01\x82\xd3\xe4\x93\x02\v:\x01*\"\x06/roles\x12\x9e\x02\n" +
+	"\x06Update\x12\x1a.role.v1.UpdateRoleRequest\x1a\x15.role.v1.RoleResponse\"\xe0\x01\x92A\xb2\x01\n" +
+	"\x05Roles\x12\vUpdate Role\x1a,Mengubah nama role (permission roles.manage)J\x1f\n" +
+	"\x03200\x12\x18\n" +
+	"\x16Role berhasil diupdateJ\x1d\n" +
+	"\x03404\x12\x16\n" +
+	"\x14Role tidak ditemukanJ \n" +
+	"\x03409\x12\x19\n" +
+	"\x17Nama role sudah dipakaib\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x10\x12\froles.manage0\x01\x82\xd3\xe4\x93\x02\x10:\x01*2\v/roles/{id}\x12\xe9\x02\n" +
+	"\x06Delete\x12\x1a.role.v1.DeleteRoleRequest\x1a\x1b.role.v1.DeleteRoleResponse\"\xa5\x02\x92A\xfa\x01\n" +
+	"\x05Roles\x12\vDelete Role\x1aiMenghapus role (permission roles.manage). Role bawaan dan role yang masih dipakai user tidak bisa dihapusJ\x1e\n" +
+	"\x03200\x12\x17\n" +
+	"\x15Role berhasil dihapusJ\x1d\n" +
+	"\x03404\x12\x16\n" +
+	"\x14Role tidak ditemukanJ,\n" +
+	"\x03409\x12%\n" +
+	"#Role bawaan atau masih dipakai userb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x10\x12\froles.manage0\x01\x82\xd3\xe4\x93\x02\r*\v/roles/{id}\x12\xc9\x02\n" +
+	"\x0fListPermissions\x12\x0e.role.v1.Empty\x1a\x1f.role.v1.ListPermissionResponse\"\x84\x02\x92A\xdc\x01\n" +
+	"\x05Roles\x12\x10List Permissions\x1aWMendapatkan daftar semua permission yang bisa diberikan ke role (permission roles.read)J.\n" +
+	"\x03200\x12'\n" +
+	"%Daftar permission berhasil didapatkanJ*\n" +
+	"\x03403\x12#\n" +
+	"!Tidak punya permission roles.readb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\f\x12\n" +
+	"roles.read\x82\xd3\xe4\x93\x02\x0e\x12\f/permissions\x12\xde\x03\n" +
+	"\x0fGrantPermission\x12\x1f.role.v1.GrantPermissionRequest\x1a\x15.role.v1.RoleResponse\"\x92\x03\x92A\xd3\x02\n" +
+	"\x05Roles\x12\x10Grant Permission\x1asMemberikan permission ke role (permission roles.manage). Pemanggil hanya bisa memberikan permission yang dia milikiJ&\n" +
+	"\x03200\x12\x1f\n" +
+	"\x1dPermission berhasil diberikanJ!\n" +
+	"\x03400\x12\x1a\n" +
+	"\x18Permission tidak dikenalJK\n" +
+	"\x03403\x12D\n" +
+	"BTidak punya permission roles.manage atau permission yang diberikanJ\x1d\n" +
+	"\x03404\x12\x16\n" +
+	"\x14Role tidak ditemukanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x10\x12\froles.manage0\x01\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/roles/{role_id}/permissions\x12\xbd\x02\n" +
+	"\x10RevokePermission\x12 .role.v1.RevokePermissionRequest\x1a\x15.role.v1.RoleResponse\"\xef\x01\x92A\xa6\x01\n" +
+	"\x05Roles\x12\x11Revoke Permission\x1a7Mencabut permission dari role (permission roles.manage)J$\n" +
+	"\x03200\x12\x1d\n" +
+	"\x1bPermission berhasil dicabutJ\x1d\n" +
+	"\x03404\x12\x16\n" +
+	"\x14Role tidak ditemukanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x10\x12\froles.manage0\x01\x82\xd3\xe4\x93\x02+*)/roles/{role_id}/permissions/{permission}B\xa7\x02\x92A\xe8\x01\x12g\n" +
+	"\x13Role Management API\x12'API untuk manajemen role dan permission\"\"\n" +
+	"\vAPI Support\x1a\x13support@example.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZG\n" +
+	"E\n" +
+	"\x06Bearer\x12;\b\x02\x12&Enter 'Bearer {token}' to authenticate\x1a\rAuthorization \x02b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00Z9github.com/nassabiq/golang-template/proto/role;role_protob\x06proto3"
+
+var (
+	file_proto_role_role_proto_rawDescOnce sync.Once
+	file_proto_role_role_proto_rawDescData []byte
+)
+
+func file_proto_role_role_proto_rawDescGZIP() []byte {
+	file_proto_role_role_proto_rawDescOnce.Do(func() {
+		file_proto_role_role_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_role_role_proto_rawDesc), len(file_proto_role_role_proto_rawDesc)))
+	})
+	return file_proto_role_role_proto_rawDescData
+}
+
+var file_proto_role_role_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_proto_role_role_proto_goTypes = []any{
+	(*Role)(nil),                    // 0: role.v1.Role
+	(*Permission)(nil),              // 1: role.v1.Permission
+	(*ListRoleRequest)(nil),         // 2: role.v1.ListRoleRequest
+	(*GetByIDRequest)(nil),          // 3: role.v1.GetByIDRequest
+	(*CreateRoleRequest)(nil),       // 4: role.v1.CreateRoleRequest
+	(*UpdateRoleRequest)(nil),       // 5: role.v1.UpdateRoleRequest
+	(*DeleteRoleRequest)(nil),       // 6: role.v1.DeleteRoleRequest
+	(*GrantPermissionRequest)(nil),  // 7: role.v1.GrantPermissionRequest
+	(*RevokePermissionRequest)(nil), // 8: role.v1.RevokePermissionRequest
+	(*ListRoleResponse)(nil),        // 9: role.v1.ListRoleResponse
+	(*ListPermissionResponse)(nil),  // 10: role.v1.ListPermissionResponse
+	(*RoleResponse)(nil),            // 11: role.v1.RoleResponse
+	(*DeleteRoleResponse)(nil),      // 12: role.v1.DeleteRoleResponse
+	(*Empty)(nil),                   // 13: role.v1.Empty
+	(*timestamppb.Timestamp)(nil),   // 14: google.protobuf.Timestamp
+	(*common.MetaData)(nil),         // 15: common.v1.MetaData
+}
+var file_proto_role_role_proto_depIdxs = []int32{
+	14, // 0: role.v1.Role.created_at:type_name -> google.protobuf.Timestamp
+	14, // 1: role.v1.Role.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: role.v1.ListRoleResponse.roles:type_name -> role.v1.Role
+	15, // 3: role.v1.ListRoleResponse.metadata:type_name -> common.v1.MetaData
+	1,  // 4: role.v1.ListPermissionResponse.permissions:type_name -> role.v1.Permission
+	15, // 5: role.v1.ListPermissionResponse.metadata:type_name -> common.v1.MetaData
+	15, // 6: role.v1.RoleResponse.metadata:type_name -> common.v1.MetaData
+	0,  // 7: role.v1.RoleResponse.data:type_name -> role.v1.Role
+	15, // 8: role.v1.DeleteRoleResponse.metadata:type_name -> common.v1.MetaData
+	2,  // 9: role.v1.RoleService.List:input_type -> role.v1.ListRoleRequest
+	3,  // 10: role.v1.RoleService.GetByID:input_type -> role.v1.GetByIDRequest
+	4,  // 11: role.v1.RoleService.Create:input_type -> role.v1.CreateRoleRequest
+	5,  // 12: role.v1.RoleService.Update:input_type -> role.v1.UpdateRoleRequest
+	6,  // 13: role.v1.RoleService.Delete:input_type -> role.v1.DeleteRoleRequest
+	13, // 14: role.v1.RoleService.ListPermissions:input_type -> role.v1.Empty
+	7,  // 15: role.v1.RoleService.GrantPermission:input_type -> role.v1.GrantPermissionRequest
+	8,  // 16: role.v1.RoleService.RevokePermission:input_type -> role.v1.RevokePermissionRequest
+	9,  // 17: role.v1.RoleService.List:output_type -> role.v1.ListRoleResponse
+	11, // 18: role.v1.RoleService.GetByID:output_type -> role.v1.RoleResponse
+	11, // 19: role.v1.RoleService.Create:output_type -> role.v1.RoleResponse
+	11, // 20: role.v1.RoleService.Update:output_type -> role.v1.RoleResponse
+	12, // 21: role.v1.RoleService.Delete:output_type -> role.v1.DeleteRoleResponse
+	10, // 22: role.v1.RoleService.ListPermissions:output_type -> role.v1.ListPermissionResponse
+	11, // 23: role.v1.RoleService.GrantPermission:output_type -> role.v1.RoleResponse
+	11, // 24: role.v1.RoleService.RevokePermission:output_type -> role.v1.RoleResponse
+	17, // [17:25] is the sub-list for method output_type
+	9,  // [9:17] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_proto_role_role_proto_init() }
+func file_proto_role_role_proto_init() {
+	if File_proto_role_role_proto != nil {
+		return
+	}
+	file_proto_role_role_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_role_role_proto_rawDesc), len(file_proto_role_role_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_role_role_proto_goTypes,
+		DependencyIndexes: file_proto_role_role_proto_depIdxs,
+		MessageInfos:      file_proto_role_role_proto_msgTypes,
+	}.Build()
+	File_proto_role_role_proto = out.File
+	file_proto_role_role_proto_goTypes = nil
+	file_proto_role_role_proto_depIdxs = nil
+}
@@ -8,6 +8,7 @@ package user_proto
 
 import (
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "github.com/nassabiq/golang-template/proto/auth"
 	common "github.com/nassabiq/golang-template/proto/common"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -39,7 +40,11 @@ type User struct {
 	// Timestamp pembuatan
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Timestamp update terakhir
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Status akun: pending, active, suspended atau deactivated
+	Status string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	// Alasan perubahan status terakhir
+	StatusReason  string `protobuf:"bytes,8,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -116,15 +121,31 @@ func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *User) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
 // Filter untuk list user
 type UserFilter struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Search by name atau email
+	// Cari sebagian nama atau email, tidak case-sensitive
 	Search *string `protobuf:"bytes,1,opt,name=search,proto3,oneof" json:"search,omitempty"`
-	// Filter by role
+	// Filter by role, berupa ID atau nama role
 	Role *string `protobuf:"bytes,2,opt,name=role,proto3,oneof" json:"role,omitempty"`
-	// Filter by active status
-	IsActive      *bool `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	// true hanya user aktif, false hanya user yang tidak aktif
+	IsActive *bool `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	// Filter by status: pending, active, suspended atau deactivated
+	Status        *string `protobuf:"bytes,4,opt,name=status,proto3,oneof" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -180,15 +201,30 @@ func (x *UserFilter) GetIsActive() bool {
 	return false
 }
 
+func (x *UserFilter) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
 // Request untuk list user
 type ListUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Jumlah data per halaman (default: 10)
 	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	// Offset untuk pagination
+	// Offset untuk pagination, diabaikan jika cursor diisi. Gunakan cursor
 	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
 	// Filter opsional
-	Filter        *UserFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Filter *UserFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Urutkan berdasarkan created_at (default), updated_at, name atau email
+	SortBy string `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	// asc atau desc. Default asc untuk name dan email, desc untuk created_at dan updated_at
+	SortOrder string `protobuf:"bytes,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	// next_cursor atau prev_cursor dari response sebelumnya. Filter dan urutan harus sama
+	Cursor string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Hitung jumlah total data. Mahal untuk data besar, minta hanya jika perlu
+	IncludeTotal  bool `protobuf:"varint,7,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -244,6 +280,34 @@ func (x *ListUserRequest) GetFilter() *UserFilter {
 	return nil
 }
 
+func (x *ListUserRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListUserRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *ListUserRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListUserRequest) GetIncludeTotal() bool {
+	if x != nil {
+		return x.IncludeTotal
+	}
+	return false
+}
+
 type GetByIDRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// UUID user yang dicari
@@ -473,6 +537,60 @@ func (x *DeleteUserRequest) GetId() string {
 	return ""
 }
 
+type ChangeUserStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID user
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Alasan perubahan status, disimpan di audit log
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUserStatusRequest) Reset() {
+	*x = ChangeUserStatusRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUserStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserStatusRequest) ProtoMessage() {}
+
+func (x *ChangeUserStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUserStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChangeUserStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChangeUserStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 // Response untuk list user
 type ListUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -485,7 +603,7 @@ type ListUserResponse struct {
 
 func (x *ListUserResponse) Reset() {
 	*x = ListUserResponse{}
-	mi := &file_proto_user_user_proto_msgTypes[7]
+	mi := &file_proto_user_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -497,7 +615,7 @@ func (x *ListUserResponse) String() string {
 func (*ListUserResponse) ProtoMessage() {}
 
 func (x *ListUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[7]
+	mi := &file_proto_user_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -510,7 +628,7 @@ func (x *ListUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserResponse.ProtoReflect.Descriptor instead.
 func (*ListUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{7}
+	return file_proto_user_user_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListUserResponse) GetUsers() []*User {
@@ -544,7 +662,7 @@ type UserResponse struct {
 
 func (x *UserResponse) Reset() {
 	*x = UserResponse{}
-	mi := &file_proto_user_user_proto_msgTypes[8]
+	mi := &file_proto_user_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -556,7 +674,7 @@ func (x *UserResponse) String() string {
 func (*UserResponse) ProtoMessage() {}
 
 func (x *UserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[8]
+	mi := &file_proto_user_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -569,7 +687,7 @@ func (x *UserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
 func (*UserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{8}
+	return file_proto_user_user_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UserResponse) GetMetadata() *common.MetaData {
@@ -595,7 +713,7 @@ type DeleteUserResponse struct {
 
 func (x *DeleteUserResponse) Reset() {
 	*x = DeleteUserResponse{}
-	mi := &file_proto_user_user_proto_msgTypes[9]
+	mi := &file_proto_user_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -607,7 +725,7 @@ func (x *DeleteUserResponse) String() string {
 func (*DeleteUserResponse) ProtoMessage() {}
 
 func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[9]
+	mi := &file_proto_user_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -620,7 +738,7 @@ func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
 func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{9}
+	return file_proto_user_user_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteUserResponse) GetMetadata() *common.MetaData {
@@ -630,6 +748,390 @@ func (x *DeleteUserResponse) GetMetadata() *common.MetaData {
 	return nil
 }
 
+// Undangan user yang belum diterima
+type Invitation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID undangan
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// UUID user pending yang diundang
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Email tujuan undangan
+	Email string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// Role user setelah menerima undangan
+	Role string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	// UUID admin yang mengundang
+	InvitedBy string `protobuf:"bytes,5,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	// Batas waktu undangan bisa diterima
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Timestamp pembuatan
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	mi := &file_proto_user_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Invitation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invitation) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Invitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Invitation) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Invitation) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *Invitation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Invitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type InviteUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Email user yang diundang (harus unik)
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// Role ID user
+	RoleId        string `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteUserRequest) Reset() {
+	*x = InviteUserRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteUserRequest) ProtoMessage() {}
+
+func (x *InviteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteUserRequest.ProtoReflect.Descriptor instead.
+func (*InviteUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *InviteUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InviteUserRequest) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+type ListInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationRequest) Reset() {
+	*x = ListInvitationRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationRequest) ProtoMessage() {}
+
+func (x *ListInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{13}
+}
+
+type ResendInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID undangan
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendInvitationRequest) Reset() {
+	*x = ResendInvitationRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendInvitationRequest) ProtoMessage() {}
+
+func (x *ResendInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendInvitationRequest.ProtoReflect.Descriptor instead.
+func (*ResendInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ResendInvitationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID undangan
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationRequest) Reset() {
+	*x = RevokeInvitationRequest{}
+	mi := &file_proto_user_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RevokeInvitationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type InvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *common.MetaData       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Data          *Invitation            `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvitationResponse) Reset() {
+	*x = InvitationResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitationResponse) ProtoMessage() {}
+
+func (x *InvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitationResponse.ProtoReflect.Descriptor instead.
+func (*InvitationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *InvitationResponse) GetMetadata() *common.MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *InvitationResponse) GetData() *Invitation {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*Invitation          `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	Metadata      *common.MetaData       `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationResponse) Reset() {
+	*x = ListInvitationResponse{}
+	mi := &file_proto_user_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationResponse) ProtoMessage() {}
+
+func (x *ListInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListInvitationResponse) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+func (x *ListInvitationResponse) GetMetadata() *common.MetaData {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 type Empty struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -638,7 +1140,7 @@ type Empty struct {
 
 func (x *Empty) Reset() {
 	*x = Empty{}
-	mi := &file_proto_user_user_proto_msgTypes[10]
+	mi := &file_proto_user_user_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -650,7 +1152,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_user_proto_msgTypes[10]
+	mi := &file_proto_user_user_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -663,14 +1165,14 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_proto_user_user_proto_rawDescGZIP(), []int{10}
+	return file_proto_user_user_proto_rawDescGZIP(), []int{18}
 }
 
 var File_proto_user_user_proto protoreflect.FileDescriptor
 
 const file_proto_user_user_proto_rawDesc = "" +
 	"\n" +
-	"\x15proto/user/user.proto\x12\auser.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19proto/common/common.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xca\x01\n" +
+	"\x15proto/user/user.proto\x12\auser.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17proto/auth/policy.proto\x1a\x19proto/common/common.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x87\x02\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -679,20 +1181,29 @@ const file_proto_user_user_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x86\x01\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12#\n" +
+	"\rstatus_reason\x18\b \x01(\tR\fstatusReason\"\xae\x01\n" +
 	"\n" +
 	"UserFilter\x12\x1b\n" +
 	"\x06search\x18\x01 \x01(\tH\x00R\x06search\x88\x01\x01\x12\x17\n" +
 	"\x04role\x18\x02 \x01(\tH\x01R\x04role\x88\x01\x01\x12 \n" +
-	"\tis_active\x18\x03 \x01(\bH\x02R\bisActive\x88\x01\x01B\t\n" +
+	"\tis_active\x18\x03 \x01(\bH\x02R\bisActive\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\tH\x03R\x06status\x88\x01\x01B\t\n" +
 	"\a_searchB\a\n" +
 	"\x05_roleB\f\n" +
 	"\n" +
-	"_is_active\"l\n" +
+	"_is_activeB\t\n" +
+	"\a_status\"\xe1\x01\n" +
 	"\x0fListUserRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12+\n" +
-	"\x06filter\x18\x03 \x01(\v2\x13.user.v1.UserFilterR\x06filter\" \n" +
+	"\x06filter\x18\x03 \x01(\v2\x13.user.v1.UserFilterR\x06filter\x12\x17\n" +
+	"\asort_by\x18\x04 \x01(\tR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x05 \x01(\tR\tsortOrder\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursor\x12#\n" +
+	"\rinclude_total\x18\a \x01(\bR\fincludeTotal\" \n" +
 	"\x0eGetByIDRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"r\n" +
 	"\x11CreateUserRequest\x12\x12\n" +
@@ -710,7 +1221,10 @@ const file_proto_user_user_proto_rawDesc = "" +
 	"\n" +
 	"\b_role_id\"#\n" +
 	"\x11DeleteUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x9f\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"A\n" +
+	"\x17ChangeUserStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x9f\x01\n" +
 	"\x10ListUserResponse\x12#\n" +
 	"\x05users\x18\x01 \x03(\v2\r.user.v1.UserR\x05users\x12/\n" +
 	"\bmetadata\x18\x02 \x01(\v2\x13.common.v1.MetaDataR\bmetadata\x125\n" +
@@ -721,10 +1235,36 @@ const file_proto_user_user_proto_rawDesc = "" +
 	"\bmetadata\x18\x01 \x01(\v2\x13.common.v1.MetaDataR\bmetadata\x12!\n" +
 	"\x04data\x18\x02 \x01(\v2\r.user.v1.UserR\x04data\"E\n" +
 	"\x12DeleteUserResponse\x12/\n" +
-	"\bmetadata\x18\x01 \x01(\v2\x13.common.v1.MetaDataR\bmetadata\"\a\n" +
-	"\x05Empty2\x80\v\n" +
-	"\vUserService\x12\xfc\x01\n" +
-	"\x04List\x12\x18.user.v1.ListUserRequest\x1a\x19.user.v1.ListUserResponse\"\xbe\x01\x92A\xac\x01\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x13.common.v1.MetaDataR\bmetadata\"\xf4\x01\n" +
+	"\n" +
+	"Invitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\x05 \x01(\tR\tinvitedBy\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"B\n" +
+	"\x11InviteUserRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\tR\x06roleId\"\x17\n" +
+	"\x15ListInvitationRequest\")\n" +
+	"\x17ResendInvitationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\")\n" +
+	"\x17RevokeInvitationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"n\n" +
+	"\x12InvitationResponse\x12/\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x13.common.v1.MetaDataR\bmetadata\x12'\n" +
+	"\x04data\x18\x02 \x01(\v2\x13.user.v1.InvitationR\x04data\"\x80\x01\n" +
+	"\x16ListInvitationResponse\x125\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x13.user.v1.InvitationR\vinvitations\x12/\n" +
+	"\bmetadata\x18\x02 \x01(\v2\x13.common.v1.MetaDataR\bmetadata\"\a\n" +
+	"\x05Empty2\xf4\"\n" +
+	"\vUserService\x12\x98\x02\n" +
+	"\x04List\x12\x18.user.v1.ListUserRequest\x1a\x19.user.v1.ListUserResponse\"\xda\x01\x92A\xac\x01\n" +
 	"\x05Users\x12\n" +
 	"List Users\x1a4Mendapatkan daftar user dengan pagination dan filterJ(\n" +
 	"\x03200\x12!\n" +
@@ -733,8 +1273,10 @@ const file_proto_user_user_proto_rawDesc = "" +
 	" Unauthorized - Token tidak validb\f\n" +
 	"\n" +
 	"\n" +
-	"\x06Bearer\x12\x00\x82\xd3\xe4\x93\x02\b\x12\x06/users\x12\xed\x01\n" +
-	"\x05GetMe\x12\x0e.user.v1.Empty\x1a\x15.user.v1.UserResponse\"\xbc\x01\x92A\xa7\x01\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x18\x12\n" +
+	"users.read\x1a\n" +
+	"users:read\x82\xd3\xe4\x93\x02\b\x12\x06/users\x12\xfd\x01\n" +
+	"\x05GetMe\x12\x0e.user.v1.Empty\x1a\x15.user.v1.UserResponse\"\xcc\x01\x92A\xa7\x01\n" +
 	"\x05Users\x12\x10Get Current User\x1a)Mendapatkan profil user yang sedang loginJ(\n" +
 	"\x03200\x12!\n" +
 	"\x1fProfil user berhasil didapatkanJ)\n" +
@@ -742,8 +1284,9 @@ const file_proto_user_user_proto_rawDesc = "" +
 	" Unauthorized - Token tidak validb\f\n" +
 	"\n" +
 	"\n" +
-	"\x06Bearer\x12\x00\x82\xd3\xe4\x93\x02\v\x12\t/users/me\x12\xe1\x01\n" +
-	"\aGetByID\x12\x17.user.v1.GetByIDRequest\x1a\x15.user.v1.UserResponse\"\xa5\x01\x92A\x8e\x01\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\f\x1a\n" +
+	"users:read\x82\xd3\xe4\x93\x02\v\x12\t/users/me\x12\xfd\x01\n" +
+	"\aGetByID\x12\x17.user.v1.GetByIDRequest\x1a\x15.user.v1.UserResponse\"\xc1\x01\x92A\x8e\x01\n" +
 	"\x05Users\x12\x0eGet User by ID\x1a&Mendapatkan detail user berdasarkan IDJ \n" +
 	"\x03200\x12\x19\n" +
 	"\x17User berhasil ditemukanJ\x1d\n" +
@@ -751,9 +1294,11 @@ const file_proto_user_user_proto_rawDesc = "" +
 	"\x14User tidak ditemukanb\f\n" +
 	"\n" +
 	"\n" +
-	"\x06Bearer\x12\x00\x82\xd3\xe4\x93\x02\r\x12\v/users/{id}\x12\xef\x01\n" +
-	"\x06Create\x12\x1a.user.v1.CreateUserRequest\x1a\x15.user.v1.UserResponse\"\xb1\x01\x92A\x9c\x01\n" +
-	"\x05Users\x12\vCreate User\x1a\x1eMembuat user baru (admin only)J\x1d\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x18\x12\n" +
+	"users.read\x1a\n" +
+	"users:read\x82\xd3\xe4\x93\x02\r\x12\v/users/{id}\x12\xde\x02\n" +
+	"\x06Create\x12\x1a.user.v1.CreateUserRequest\x1a\x15.user.v1.UserResponse\"\xa0\x02\x92A\xec\x01\n" +
+	"\x05Users\x12\vCreate User\x1anMembuat user baru dengan password yang ditentukan admin. Gunakan InviteUser agar user memilih password sendiriJ\x1d\n" +
 	"\x03200\x12\x16\n" +
 	"\x14User berhasil dibuatJ\x19\n" +
 	"\x03400\x12\x12\n" +
@@ -762,8 +1307,8 @@ const file_proto_user_user_proto_rawDesc = "" +
 	"\x15Email sudah terdaftarb\f\n" +
 	"\n" +
 	"\n" +
-	"\x06Bearer\x12\x00\x82\xd3\xe4\x93\x02\v:\x01*\"\x06/users\x12\xcf\x01\n" +
-	"\x06Update\x12\x1a.user.v1.UpdateUserRequest\x1a\x15.user.v1.UserResponse\"\x91\x01\x92Ax\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x1b\x12\fusers.create\x1a\vusers:write\x82\xd3\xe4\x93\x02\v:\x01*\"\x06/users\x12\xf0\x01\n" +
+	"\x06Update\x12\x1a.user.v1.UpdateUserRequest\x1a\x15.user.v1.UserResponse\"\xb2\x01\x92Ax\n" +
 	"\x05Users\x12\vUpdate User\x1a\x14Mengupdate data userJ\x1f\n" +
 	"\x03200\x12\x18\n" +
 	"\x16User berhasil diupdateJ\x1d\n" +
@@ -771,8 +1316,8 @@ const file_proto_user_user_proto_rawDesc = "" +
 	"\x14User tidak ditemukanb\f\n" +
 	"\n" +
 	"\n" +
-	"\x06Bearer\x12\x00\x82\xd3\xe4\x93\x02\x10:\x01*\x1a\v/users/{id}\x12\xd9\x01\n" +
-	"\x06Delete\x12\x1a.user.v1.DeleteUserRequest\x1a\x1b.user.v1.DeleteUserResponse\"\x95\x01\x92A\x7f\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x1d\x12\fusers.update\x1a\vusers:write(\x05\x82\xd3\xe4\x93\x02\x10:\x01*\x1a\v/users/{id}\x12\xfa\x01\n" +
+	"\x06Delete\x12\x1a.user.v1.DeleteUserRequest\x1a\x1b.user.v1.DeleteUserResponse\"\xb6\x01\x92A\x7f\n" +
 	"\x05Users\x12\vDelete User\x1a\x1cMenghapus user (soft delete)J\x1e\n" +
 	"\x03200\x12\x17\n" +
 	"\x15User berhasil dihapusJ\x1d\n" +
@@ -780,7 +1325,81 @@ const file_proto_user_user_proto_rawDesc = "" +
 	"\x14User tidak ditemukanb\f\n" +
 	"\n" +
 	"\n" +
-	"\x06Bearer\x12\x00\x82\xd3\xe4\x93\x02\r*\v/users/{id}B\xb1\x02\x92A\xf2\x01\x12q\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x1d\x12\fusers.delete\x1a\vusers:write(\x05\x82\xd3\xe4\x93\x02\r*\v/users/{id}\x12\xc6\x03\n" +
+	"\n" +
+	"InviteUser\x12\x1a.user.v1.InviteUserRequest\x1a\x1b.user.v1.InvitationResponse\"\xfe\x02\x92A\xbe\x02\n" +
+	"\x05Users\x12\vInvite User\x1a\xba\x01Mengundang user baru lewat email. User dibuat dengan status pending dan memilih nama serta password sendiri saat menerima undangan (AuthService.AcceptInvitation). Undangan berlaku 72 jamJ\"\n" +
+	"\x03200\x12\x1b\n" +
+	"\x19Undangan berhasil dikirimJ\x19\n" +
+	"\x03400\x12\x12\n" +
+	"\x10Data tidak validJ\x1e\n" +
+	"\x03409\x12\x17\n" +
+	"\x15Email sudah terdaftarb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x1b\x12\fusers.create\x1a\vusers:write\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/users/invitations\x12\xb2\x02\n" +
+	"\x0fListInvitations\x12\x1e.user.v1.ListInvitationRequest\x1a\x1f.user.v1.ListInvitationResponse\"\xdd\x01\x92A\xa3\x01\n" +
+	"\x05Users\x12\x10List Invitations\x1aLMendapatkan daftar undangan yang belum diterima, termasuk yang sudah expiredJ,\n" +
+	"\x03200\x12%\n" +
+	"#Daftar undangan berhasil didapatkanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x18\x12\n" +
+	"users.read\x1a\n" +
+	"users:read\x82\xd3\xe4\x93\x02\x14\x12\x12/users/invitations\x12\x85\x03\n" +
+	"\x10ResendInvitation\x12 .user.v1.ResendInvitationRequest\x1a\x1b.user.v1.InvitationResponse\"\xb1\x02\x92A\xe5\x01\n" +
+	"\x05Users\x12\x11Resend Invitation\x1anMengirim ulang email undangan dengan token baru. Token lama tidak berlaku lagi dan masa berlaku dihitung ulangJ(\n" +
+	"\x03200\x12!\n" +
+	"\x1fUndangan berhasil dikirim ulangJ!\n" +
+	"\x03404\x12\x1a\n" +
+	"\x18Undangan tidak ditemukanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x1b\x12\fusers.create\x1a\vusers:write\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/users/invitations/{id}/resend\x12\xbd\x02\n" +
+	"\x10RevokeInvitation\x12 .user.v1.RevokeInvitationRequest\x1a\x1b.user.v1.DeleteUserResponse\"\xe9\x01\x92A\xa7\x01\n" +
+	"\x05Users\x12\x11Revoke Invitation\x1a3Membatalkan undangan dan menghapus user pending-nyaJ%\n" +
+	"\x03200\x12\x1e\n" +
+	"\x1cUndangan berhasil dibatalkanJ!\n" +
+	"\x03404\x12\x1a\n" +
+	"\x18Undangan tidak ditemukanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x1b\x12\fusers.create\x1a\vusers:write\x82\xd3\xe4\x93\x02\x19*\x17/users/invitations/{id}\x12\xcd\x03\n" +
+	"\aSuspend\x12 .user.v1.ChangeUserStatusRequest\x1a\x15.user.v1.UserResponse\"\x88\x03\x92A\xc6\x02\n" +
+	"\x05Users\x12\fSuspend User\x1a\xa3\x01Menangguhkan user aktif dengan alasan tertentu. User tidak bisa login, semua sesi (refresh token) dicabut dan access token yang sudah terbit langsung tidak berlakuJ#\n" +
+	"\x03200\x12\x1c\n" +
+	"\x1aUser berhasil ditangguhkanJ\x1d\n" +
+	"\x03404\x12\x16\n" +
+	"\x14User tidak ditemukanJ7\n" +
+	"\x03409\x120\n" +
+	".Status user tidak bisa diubah ke status tujuanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x1c\x12\rusers.suspend\x1a\vusers:write\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/users/{id}/suspend\x12\xc8\x03\n" +
+	"\n" +
+	"Reactivate\x12 .user.v1.ChangeUserStatusRequest\x1a\x15.user.v1.UserResponse\"\x80\x03\x92A\xbb\x02\n" +
+	"\x05Users\x12\x0fReactivate User\x1a\x8f\x01Mengaktifkan kembali user yang ditangguhkan atau dinonaktifkan. Token yang terbit sebelum perubahan tetap tidak berlaku, user harus login ulangJ)\n" +
+	"\x03200\x12\"\n" +
+	" User berhasil diaktifkan kembaliJ\x1d\n" +
+	"\x03404\x12\x16\n" +
+	"\x14User tidak ditemukanJ7\n" +
+	"\x03409\x120\n" +
+	".Status user tidak bisa diubah ke status tujuanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x1c\x12\rusers.suspend\x1a\vusers:write\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/users/{id}/reactivate\x12\x97\x03\n" +
+	"\n" +
+	"Deactivate\x12 .user.v1.ChangeUserStatusRequest\x1a\x15.user.v1.UserResponse\"\xcf\x02\x92A\x8a\x02\n" +
+	"\x05Users\x12\x0fDeactivate User\x1adMenonaktifkan akun user tanpa menghapus data dan riwayatnya. Semua sesi dicabut seperti pada SuspendJ$\n" +
+	"\x03200\x12\x1d\n" +
+	"\x1bUser berhasil dinonaktifkanJ\x1d\n" +
+	"\x03404\x12\x16\n" +
+	"\x14User tidak ditemukanJ7\n" +
+	"\x03409\x120\n" +
+	".Status user tidak bisa diubah ke status tujuanb\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00\x8a\xb5\x18\x1c\x12\rusers.suspend\x1a\vusers:write\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/users/{id}/deactivateB\xb1\x02\x92A\xf2\x01\x12q\n" +
 	"\x13User Management API\x121API untuk manajemen user termasuk CRUD operations\"\"\n" +
 	"\vAPI Support\x1a\x13support@example.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/jsonZG\n" +
 	"E\n" +
@@ -801,50 +1420,78 @@ func file_proto_user_user_proto_rawDescGZIP() []byte {
 	return file_proto_user_user_proto_rawDescData
 }
 
-var file_proto_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_proto_user_user_proto_goTypes = []any{
-	(*User)(nil),                  // 0: user.v1.User
-	(*UserFilter)(nil),            // 1: user.v1.UserFilter
-	(*ListUserRequest)(nil),       // 2: user.v1.ListUserRequest
-	(*GetByIDRequest)(nil),        // 3: user.v1.GetByIDRequest
-	(*CreateUserRequest)(nil),     // 4: user.v1.CreateUserRequest
-	(*UpdateUserRequest)(nil),     // 5: user.v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),     // 6: user.v1.DeleteUserRequest
-	(*ListUserResponse)(nil),      // 7: user.v1.ListUserResponse
-	(*UserResponse)(nil),          // 8: user.v1.UserResponse
-	(*DeleteUserResponse)(nil),    // 9: user.v1.DeleteUserResponse
-	(*Empty)(nil),                 // 10: user.v1.Empty
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
-	(*common.MetaData)(nil),       // 12: common.v1.MetaData
-	(*common.Pagination)(nil),     // 13: common.v1.Pagination
+	(*User)(nil),                    // 0: user.v1.User
+	(*UserFilter)(nil),              // 1: user.v1.UserFilter
+	(*ListUserRequest)(nil),         // 2: user.v1.ListUserRequest
+	(*GetByIDRequest)(nil),          // 3: user.v1.GetByIDRequest
+	(*CreateUserRequest)(nil),       // 4: user.v1.CreateUserRequest
+	(*UpdateUserRequest)(nil),       // 5: user.v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),       // 6: user.v1.DeleteUserRequest
+	(*ChangeUserStatusRequest)(nil), // 7: user.v1.ChangeUserStatusRequest
+	(*ListUserResponse)(nil),        // 8: user.v1.ListUserResponse
+	(*UserResponse)(nil),            // 9: user.v1.UserResponse
+	(*DeleteUserResponse)(nil),      // 10: user.v1.DeleteUserResponse
+	(*Invitation)(nil),              // 11: user.v1.Invitation
+	(*InviteUserRequest)(nil),       // 12: user.v1.InviteUserRequest
+	(*ListInvitationRequest)(nil),   // 13: user.v1.ListInvitationRequest
+	(*ResendInvitationRequest)(nil), // 14: user.v1.ResendInvitationRequest
+	(*RevokeInvitationRequest)(nil), // 15: user.v1.RevokeInvitationRequest
+	(*InvitationResponse)(nil),      // 16: user.v1.InvitationResponse
+	(*ListInvitationResponse)(nil),  // 17: user.v1.ListInvitationResponse
+	(*Empty)(nil),                   // 18: user.v1.Empty
+	(*timestamppb.Timestamp)(nil),   // 19: google.protobuf.Timestamp
+	(*common.MetaData)(nil),         // 20: common.v1.MetaData
+	(*common.Pagination)(nil),       // 21: common.v1.Pagination
 }
 var file_proto_user_user_proto_depIdxs = []int32{
-	11, // 0: user.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: user.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 0: user.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	19, // 1: user.v1.User.updated_at:type_name -> google.protobuf.Timestamp
 	1,  // 2: user.v1.ListUserRequest.filter:type_name -> user.v1.UserFilter
 	0,  // 3: user.v1.ListUserResponse.users:type_name -> user.v1.User
-	12, // 4: user.v1.ListUserResponse.metadata:type_name -> common.v1.MetaData
-	13, // 5: user.v1.ListUserResponse.pagination:type_name -> common.v1.Pagination
-	12, // 6: user.v1.UserResponse.metadata:type_name -> common.v1.MetaData
+	20, // 4: user.v1.ListUserResponse.metadata:type_name -> common.v1.MetaData
+	21, // 5: user.v1.ListUserResponse.pagination:type_name -> common.v1.Pagination
+	20, // 6: user.v1.UserResponse.metadata:type_name -> common.v1.MetaData
 	0,  // 7: user.v1.UserResponse.data:type_name -> user.v1.User
-	12, // 8: user.v1.DeleteUserResponse.metadata:type_name -> common.v1.MetaData
-	2,  // 9: user.v1.UserService.List:input_type -> user.v1.ListUserRequest
-	10, // 10: user.v1.UserService.GetMe:input_type -> user.v1.Empty
-	3,  // 11: user.v1.UserService.GetByID:input_type -> user.v1.GetByIDRequest
-	4,  // 12: user.v1.UserService.Create:input_type -> user.v1.CreateUserRequest
-	5,  // 13: user.v1.UserService.Update:input_type -> user.v1.UpdateUserRequest
-	6,  // 14: user.v1.UserService.Delete:input_type -> user.v1.DeleteUserRequest
-	7,  // 15: user.v1.UserService.List:output_type -> user.v1.ListUserResponse
-	8,  // 16: user.v1.UserService.GetMe:output_type -> user.v1.UserResponse
-	8,  // 17: user.v1.UserService.GetByID:output_type -> user.v1.UserResponse
-	8,  // 18: user.v1.UserService.Create:output_type -> user.v1.UserResponse
-	8,  // 19: user.v1.UserService.Update:output_type -> user.v1.UserResponse
-	9,  // 20: user.v1.UserService.Delete:output_type -> user.v1.DeleteUserResponse
-	15, // [15:21] is the sub-list for method output_type
-	9,  // [9:15] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	20, // 8: user.v1.DeleteUserResponse.metadata:type_name -> common.v1.MetaData
+	19, // 9: user.v1.Invitation.expires_at:type_name -> google.protobuf.Timestamp
+	19, // 10: user.v1.Invitation.created_at:type_name -> google.protobuf.Timestamp
+	20, // 11: user.v1.InvitationResponse.metadata:type_name -> common.v1.MetaData
+	11, // 12: user.v1.InvitationResponse.data:type_name -> user.v1.Invitation
+	11, // 13: user.v1.ListInvitationResponse.invitations:type_name -> user.v1.Invitation
+	20, // 14: user.v1.ListInvitationResponse.metadata:type_name -> common.v1.MetaData
+	2,  // 15: user.v1.UserService.List:input_type -> user.v1.ListUserRequest
+	18, // 16: user.v1.UserService.GetMe:input_type -> user.v1.Empty
+	3,  // 17: user.v1.UserService.GetByID:input_type -> user.v1.GetByIDRequest
+	4,  // 18: user.v1.UserService.Create:input_type -> user.v1.CreateUserRequest
+	5,  // 19: user.v1.UserService.Update:input_type -> user.v1.UpdateUserRequest
+	6,  // 20: user.v1.UserService.Delete:input_type -> user.v1.DeleteUserRequest
+	12, // 21: user.v1.UserService.InviteUser:input_type -> user.v1.InviteUserRequest
+	13, // 22: user.v1.UserService.ListInvitations:input_type -> user.v1.ListInvitationRequest
+	14, // 23: user.v1.UserService.ResendInvitation:input_type -> user.v1.ResendInvitationRequest
+	15, // 24: user.v1.UserService.RevokeInvitation:input_type -> user.v1.RevokeInvitationRequest
+	7,  // 25: user.v1.UserService.Suspend:input_type -> user.v1.ChangeUserStatusRequest
+	7,  // 26: user.v1.UserService.Reactivate:input_type -> user.v1.ChangeUserStatusRequest
+	7,  // 27: user.v1.UserService.Deactivate:input_type -> user.v1.ChangeUserStatusRequest
+	8,  // 28: user.v1.UserService.List:output_type -> user.v1.ListUserResponse
+	9,  // 29: user.v1.UserService.GetMe:output_type -> user.v1.UserResponse
+	9,  // 30: user.v1.UserService.GetByID:output_type -> user.v1.UserResponse
+	9,  // 31: user.v1.UserService.Create:output_type -> user.v1.UserResponse
+	9,  // 32: user.v1.UserService.Update:output_type -> user.v1.UserResponse
+	10, // 33: user.v1.UserService.Delete:output_type -> user.v1.DeleteUserResponse
+	16, // 34: user.v1.UserService.InviteUser:output_type -> user.v1.InvitationResponse
+	17, // 35: user.v1.UserService.ListInvitations:output_type -> user.v1.ListInvitationResponse
+	16, // 36: user.v1.UserService.ResendInvitation:output_type -> user.v1.InvitationResponse
+	10, // 37: user.v1.UserService.RevokeInvitation:output_type -> user.v1.DeleteUserResponse
+	9,  // 38: user.v1.UserService.Suspend:output_type -> user.v1.UserResponse
+	9,  // 39: user.v1.UserService.Reactivate:output_type -> user.v1.UserResponse
+	9,  // 40: user.v1.UserService.Deactivate:output_type -> user.v1.UserResponse
+	28, // [28:41] is the sub-list for method output_type
+	15, // [15:28] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_proto_user_user_proto_init() }
@@ -860,7 +1507,7 @@ func file_proto_user_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_user_user_proto_rawDesc), len(file_proto_user_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,12 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_List_FullMethodName    = "/user.v1.UserService/List"
-	UserService_GetMe_FullMethodName   = "/user.v1.UserService/GetMe"
-	UserService_GetByID_FullMethodName = "/user.v1.UserService/GetByID"
-	UserService_Create_FullMethodName  = "/user.v1.UserService/Create"
-	UserService_Update_FullMethodName  = "/user.v1.UserService/Update"
-	UserService_Delete_FullMethodName  = "/user.v1.UserService/Delete"
+	UserService_List_FullMethodName             = "/user.v1.UserService/List"
+	UserService_GetMe_FullMethodName            = "/user.v1.UserService/GetMe"
+	UserService_GetByID_FullMethodName          = "/user.v1.UserService/GetByID"
+	UserService_Create_FullMethodName           = "/user.v1.UserService/Create"
+	UserService_Update_FullMethodName           = "/user.v1.UserService/Update"
+	UserService_Delete_FullMethodName           = "/user.v1.UserService/Delete"
+	UserService_InviteUser_FullMethodName       = "/user.v1.UserService/InviteUser"
+	UserService_ListInvitations_FullMethodName  = "/user.v1.UserService/ListInvitations"
+	UserService_ResendInvitation_FullMethodName = "/user.v1.UserService/ResendInvitation"
+	UserService_RevokeInvitation_FullMethodName = "/user.v1.UserService/RevokeInvitation"
+	UserService_Suspend_FullMethodName          = "/user.v1.UserService/Suspend"
+	UserService_Reactivate_FullMethodName       = "/user.v1.UserService/Reactivate"
+	UserService_Deactivate_FullMethodName       = "/user.v1.UserService/Deactivate"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -43,6 +50,20 @@ type UserServiceClient interface {
 	Update(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserResponse, error)
 	// Delete user
 	Delete(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	// Invite user by email
+	InviteUser(ctx context.Context, in *InviteUserRequest, opts ...grpc.CallOption) (*InvitationResponse, error)
+	// List pending invitations
+	ListInvitations(ctx context.Context, in *ListInvitationRequest, opts ...grpc.CallOption) (*ListInvitationResponse, error)
+	// Resend invitation
+	ResendInvitation(ctx context.Context, in *ResendInvitationRequest, opts ...grpc.CallOption) (*InvitationResponse, error)
+	// Revoke invitation
+	RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	// Suspend user
+	Suspend(ctx context.Context, in *ChangeUserStatusRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	// Reactivate user
+	Reactivate(ctx context.Context, in *ChangeUserStatusRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	// Deactivate user
+	Deactivate(ctx context.Context, in *ChangeUserStatusRequest, opts ...grpc.CallOption) (*UserResponse, error)
 }
 
 type userServiceClient struct {
@@ -113,6 +134,76 @@ func (c *userServiceClient) Delete(ctx context.Context, in *DeleteUserRequest, o
 	return out, nil
 }
 
+func (c *userServiceClient) InviteUser(ctx context.Context, in *InviteUserRequest, opts ...grpc.CallOption) (*InvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvitationResponse)
+	err := c.cc.Invoke(ctx, UserService_InviteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListInvitations(ctx context.Context, in *ListInvitationRequest, opts ...grpc.CallOption) (*ListInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvitationResponse)
+	err := c.cc.Invoke(ctx, UserService_ListInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ResendInvitation(ctx context.Context, in *ResendInvitationRequest, opts ...grpc.CallOption) (*InvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvitationResponse)
+	err := c.cc.Invoke(ctx, UserService_ResendInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Suspend(ctx context.Context, in *ChangeUserStatusRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, UserService_Suspend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Reactivate(ctx context.Context, in *ChangeUserStatusRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, UserService_Reactivate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Deactivate(ctx context.Context, in *ChangeUserStatusRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, UserService_Deactivate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -129,6 +220,20 @@ type UserServiceServer interface {
 	Update(context.Context, *UpdateUserRequest) (*UserResponse, error)
 	// Delete user
 	Delete(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	// Invite user by email
+	InviteUser(context.Context, *InviteUserRequest) (*InvitationResponse, error)
+	// List pending invitations
+	ListInvitations(context.Context, *ListInvitationRequest) (*ListInvitationResponse, error)
+	// Resend invitation
+	ResendInvitation(context.Context, *ResendInvitationRequest) (*InvitationResponse, error)
+	// Revoke invitation
+	RevokeInvitation(context.Context, *RevokeInvitationRequest) (*DeleteUserResponse, error)
+	// Suspend user
+	Suspend(context.Context, *ChangeUserStatusRequest) (*UserResponse, error)
+	// Reactivate user
+	Reactivate(context.Context, *ChangeUserStatusRequest) (*UserResponse, error)
+	// Deactivate user
+	Deactivate(context.Context, *ChangeUserStatusRequest) (*UserResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -157,6 +262,27 @@ func (UnimplementedUserServiceServer) Update(context.Context, *UpdateUserRequest
 func (UnimplementedUserServiceServer) Delete(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedUserServiceServer) InviteUser(context.Context, *InviteUserRequest) (*InvitationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InviteUser not implemented")
+}
+func (UnimplementedUserServiceServer) ListInvitations(context.Context, *ListInvitationRequest) (*ListInvitationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInvitations not implemented")
+}
+func (UnimplementedUserServiceServer) ResendInvitation(context.Context, *ResendInvitationRequest) (*InvitationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResendInvitation not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeInvitation(context.Context, *RevokeInvitationRequest) (*DeleteUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeInvitation not implemented")
+}
+func (UnimplementedUserServiceServer) Suspend(context.Context, *ChangeUserStatusRequest) (*UserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Suspend not implemented")
+}
+func (UnimplementedUserServiceServer) Reactivate(context.Context, *ChangeUserStatusRequest) (*UserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Reactivate not implemented")
+}
+func (UnimplementedUserServiceServer) Deactivate(context.Context, *ChangeUserStatusRequest) (*UserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Deactivate not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -286,6 +412,132 @@ func _UserService_Delete_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_InviteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).InviteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_InviteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).InviteUser(ctx, req.(*InviteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListInvitations(ctx, req.(*ListInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResendInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResendInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResendInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResendInvitation(ctx, req.(*ResendInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeInvitation(ctx, req.(*RevokeInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Suspend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Suspend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Suspend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Suspend(ctx, req.(*ChangeUserStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Reactivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Reactivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Reactivate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Reactivate(ctx, req.(*ChangeUserStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Deactivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Deactivate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Deactivate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Deactivate(ctx, req.(*ChangeUserStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -317,6 +569,34 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _UserService_Delete_Handler,
 		},
+		{
+			MethodName: "InviteUser",
+			Handler:    _UserService_InviteUser_Handler,
+		},
+		{
+			MethodName: "ListInvitations",
+			Handler:    _UserService_ListInvitations_Handler,
+		},
+		{
+			MethodName: "ResendInvitation",
+			Handler:    _UserService_ResendInvitation_Handler,
+		},
+		{
+			MethodName: "RevokeInvitation",
+			Handler:    _UserService_RevokeInvitation_Handler,
+		},
+		{
+			MethodName: "Suspend",
+			Handler:    _UserService_Suspend_Handler,
+		},
+		{
+			MethodName: "Reactivate",
+			Handler:    _UserService_Reactivate_Handler,
+		},
+		{
+			MethodName: "Deactivate",
+			Handler:    _UserService_Deactivate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/user/user.proto",
@@ -79,5 +79,6 @@ echo "   └── proto/$MODULE/$MODULE.proto"
 echo ""
 echo "🗃️  Don't forget to:"
 echo "   1. Create the database migration: make migrate-create name=create_${MODULE}s_table"
-echo "   2. Generate proto: buf generate"
-echo "   3. Register the module in your server/main.go"
+echo "   2. Seed the ${MODULE}s.read/create/update/delete permissions and grant them to roles"
+echo "   3. Generate proto: buf generate"
+echo "   4. Register the module in your server/main.go, passing the handler the pagination cursor codec"
@@ -1,9 +1,13 @@
 package domain
 
-import "context"
+import (
+	"context"
+
+	"github.com/nassabiq/golang-template/internal/shared/pagination"
+)
 
 type {{MODULE}}Repository interface {
-	List(ctx context.Context, limit int, offset int) ([]{{MODULE}}, error)
+	List(ctx context.Context, page pagination.Page) ([]{{MODULE}}, *pagination.Result, error)
 	FindByID(ctx context.Context, id string) (*{{MODULE}}, error)
 	Create(ctx context.Context, request *{{MODULE}}Create) (*{{MODULE}}, error)
 	Update(ctx context.Context, request *{{MODULE}}Update) (*{{MODULE}}, error)
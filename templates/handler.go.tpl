@@ -9,28 +9,25 @@ import (
 	"github.com/nassabiq/golang-template/internal/modules/{{MODULE}}/usecase"
 	"github.com/nassabiq/golang-template/internal/shared/common/response"
 	"github.com/nassabiq/golang-template/internal/shared/helper"
-	middleware "github.com/nassabiq/golang-template/internal/shared/middleware/auth"
+	"github.com/nassabiq/golang-template/internal/shared/pagination"
+	commonpb "github.com/nassabiq/golang-template/proto/common"
 	proto "github.com/nassabiq/golang-template/proto/{{MODULE}}"
 )
 
 type {{MODULE}}Handler struct {
 	proto.Unimplemented{{MODULE}}ServiceServer
 	usecase usecase.{{MODULE}}Usecase
+	cursors *pagination.Codec
 }
 
-func New{{MODULE}}Handler(usecase usecase.{{MODULE}}Usecase) *{{MODULE}}Handler {
+func New{{MODULE}}Handler(usecase usecase.{{MODULE}}Usecase, cursors *pagination.Codec) *{{MODULE}}Handler {
 	return &{{MODULE}}Handler{
 		usecase: usecase,
+		cursors: cursors,
 	}
 }
 
 func (handler *{{MODULE}}Handler) GetByID(ctx context.Context, req *proto.GetByIDRequest) (*proto.{{MODULE}}Response, error) {
-	if err := middleware.RequireRole("admin", "super_admin")(ctx); err != nil {
-		return &proto.{{MODULE}}Response{
-			Metadata: response.Forbidden(),
-		}, nil
-	}
-
 	{{MODULE|lower}}, err := handler.usecase.GetByID(ctx, req.GetId())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -54,14 +51,29 @@ func (handler *{{MODULE}}Handler) GetByID(ctx context.Context, req *proto.GetByI
 }
 
 func (handler *{{MODULE}}Handler) List(ctx context.Context, req *proto.List{{MODULE}}Request) (*proto.List{{MODULE}}Response, error) {
-	if err := middleware.RequireRole("admin", "super_admin")(ctx); err != nil {
+	cursor, err := handler.cursors.Decode(req.GetCursor())
+	if err != nil {
 		return &proto.List{{MODULE}}Response{
-			Metadata: response.Forbidden(),
+			Metadata: response.Validation(err.Error()),
 			Data:     []*proto.{{MODULE}}{},
 		}, nil
 	}
 
-	{{MODULE|lower}}s, err := handler.usecase.List(ctx, int(req.Limit), int(req.Offset))
+	page := pagination.Page{
+		Limit:     int(req.GetLimit()),
+		Offset:    int(req.GetOffset()),
+		Cursor:    cursor,
+		WithTotal: req.GetIncludeTotal(),
+	}
+
+	{{MODULE|lower}}s, result, err := handler.usecase.List(ctx, page)
+
+	if errors.Is(err, pagination.ErrInvalidCursor) {
+		return &proto.List{{MODULE}}Response{
+			Metadata: response.Validation(err.Error()),
+			Data:     []*proto.{{MODULE}}{},
+		}, nil
+	}
 
 	if err != nil {
 		return &proto.List{{MODULE}}Response{
@@ -70,8 +82,20 @@ func (handler *{{MODULE}}Handler) List(ctx context.Context, req *proto.List{{MOD
 		}, nil
 	}
 
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = 10
+	}
+
 	resp := &proto.List{{MODULE}}Response{
 		Metadata: response.Success(200, "success"),
+		Pagination: &commonpb.Pagination{
+			Limit:      limit,
+			Offset:     req.GetOffset(),
+			Total:      result.Total,
+			NextCursor: handler.cursors.Encode(result.Next),
+			PrevCursor: handler.cursors.Encode(result.Prev),
+		},
 	}
 
 	for _, item := range {{MODULE|lower}}s {
@@ -85,12 +109,6 @@ func (handler *{{MODULE}}Handler) List(ctx context.Context, req *proto.List{{MOD
 }
 
 func (handler *{{MODULE}}Handler) Create(ctx context.Context, req *proto.Create{{MODULE}}Request) (*proto.{{MODULE}}Response, error) {
-	if err := middleware.RequireRole("admin", "super_admin")(ctx); err != nil {
-		return &proto.{{MODULE}}Response{
-			Metadata: response.Forbidden(),
-		}, nil
-	}
-
 	request := &dto.Create{{MODULE}}Dto{
 		Name: req.Name,
 	}
@@ -118,12 +136,6 @@ func (handler *{{MODULE}}Handler) Create(ctx context.Context, req *proto.Create{
 }
 
 func (handler *{{MODULE}}Handler) Update(ctx context.Context, req *proto.Update{{MODULE}}Request) (*proto.{{MODULE}}Response, error) {
-	if err := middleware.RequireRole("admin", "super_admin")(ctx); err != nil {
-		return &proto.{{MODULE}}Response{
-			Metadata: response.Forbidden(),
-		}, nil
-	}
-
 	updateDto := &dto.Update{{MODULE}}Dto{
 		ID: req.GetId(),
 	}
@@ -161,12 +173,6 @@ func (handler *{{MODULE}}Handler) Update(ctx context.Context, req *proto.Update{
 }
 
 func (handler *{{MODULE}}Handler) Delete(ctx context.Context, req *proto.Delete{{MODULE}}Request) (*proto.Delete{{MODULE}}Response, error) {
-	if err := middleware.RequireRole("admin", "super_admin")(ctx); err != nil {
-		return &proto.Delete{{MODULE}}Response{
-			Metadata: response.Forbidden(),
-		}, nil
-	}
-
 	{{MODULE|lower}}, err := handler.usecase.GetByID(ctx, req.GetId())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
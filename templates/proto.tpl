@@ -3,6 +3,7 @@ syntax = "proto3";
 package {{MODULE}}.v1;
 
 import "google/api/annotations.proto";
+import "proto/auth/policy.proto";
 import "proto/common/common.proto";
 
 option go_package = "github.com/nassabiq/golang-template/proto/{{MODULE}}";
@@ -10,18 +11,21 @@ option go_package = "github.com/nassabiq/golang-template/proto/{{MODULE}}";
 service {{MODULE}}Service {
 
   rpc List(List{{MODULE}}Request) returns (List{{MODULE}}Response) {
+    option (auth.v1.policy) = { permissions: "{{MODULE|lower}}s.read" };
     option (google.api.http) = {
       get: "/{{MODULE|lower}}s"
     };
   }
 
   rpc GetByID(GetByIDRequest) returns ({{MODULE}}Response) {
+    option (auth.v1.policy) = { permissions: "{{MODULE|lower}}s.read" };
     option (google.api.http) = {
       get: "/{{MODULE|lower}}s/{id}"
     };
   }
 
   rpc Create(Create{{MODULE}}Request) returns ({{MODULE}}Response) {
+    option (auth.v1.policy) = { permissions: "{{MODULE|lower}}s.create" };
     option (google.api.http) = {
       post: "/{{MODULE|lower}}s"
       body: "*"
@@ -29,6 +33,7 @@ service {{MODULE}}Service {
   }
 
   rpc Update(Update{{MODULE}}Request) returns ({{MODULE}}Response) {
+    option (auth.v1.policy) = { permissions: "{{MODULE|lower}}s.update" };
     option (google.api.http) = {
       put: "/{{MODULE|lower}}s/{id}"
       body: "*"
@@ -36,6 +41,7 @@ service {{MODULE}}Service {
   }
 
   rpc Delete(Delete{{MODULE}}Request) returns (Delete{{MODULE}}Response) {
+    option (auth.v1.policy) = { permissions: "{{MODULE|lower}}s.delete" };
     option (google.api.http) = {
       delete: "/{{MODULE|lower}}s/{id}"
     };
@@ -51,7 +57,11 @@ message {{MODULE}} {
 // REQUEST
 message List{{MODULE}}Request {
   int32 limit = 1;
+  // Diabaikan jika cursor diisi
   int32 offset = 2;
+  // next_cursor atau prev_cursor dari response sebelumnya
+  string cursor = 3;
+  bool include_total = 4;
 }
 
 message GetByIDRequest {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/nassabiq/golang-template/internal/modules/{{MODULE}}/domain"
+	"github.com/nassabiq/golang-template/internal/shared/pagination"
 )
 
 type {{MODULE}}Repository struct {
@@ -52,15 +53,33 @@ func (r *{{MODULE}}Repository) Create(ctx context.Context, request *domain.{{MOD
 	}, nil
 }
 
-func (r *{{MODULE}}Repository) List(ctx context.Context, limit int, offset int) ([]domain.{{MODULE}}, error) {
-	rows, err := r.db.QueryContext(
-		ctx,
-		"SELECT id, name, created_at, updated_at FROM {{MODULE|lower}}s ORDER BY created_at DESC LIMIT $1 OFFSET $2",
-		limit, offset,
-	)
+// {{MODULE|lower}}Order lists newest first; cursors are only valid for this order
+var {{MODULE|lower}}Order = pagination.Order{Column: "created_at", IDColumn: "id", Descending: true}
+
+func (r *{{MODULE}}Repository) List(ctx context.Context, page pagination.Page) ([]domain.{{MODULE}}, *pagination.Result, error) {
+	var total *int64
+	if page.WithTotal {
+		var count int64
+		if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM {{MODULE|lower}}s").Scan(&count); err != nil {
+			return nil, nil, err
+		}
+		total = &count
+	}
 
+	keyset, tail, args, err := page.Clauses({{MODULE|lower}}Order, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	query := "SELECT id, name, created_at, updated_at FROM {{MODULE|lower}}s"
+	if keyset != "" {
+		query += " WHERE " + keyset
+	}
+
+	rows, err := r.db.QueryContext(ctx, query+" "+tail, args...)
+
+	if err != nil {
+		return nil, nil, err
 	}
 	defer rows.Close()
 
@@ -69,16 +88,21 @@ func (r *{{MODULE}}Repository) List(ctx context.Context, limit int, offset int)
 		var {{MODULE|lower}} domain.{{MODULE}}
 		err := rows.Scan(&{{MODULE|lower}}.ID, &{{MODULE|lower}}.Name, &{{MODULE|lower}}.CreatedAt, &{{MODULE|lower}}.UpdatedAt)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		{{MODULE|lower}}s = append({{MODULE|lower}}s, {{MODULE|lower}})
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return {{MODULE|lower}}s, nil
+	{{MODULE|lower}}s, result := pagination.Trim(page, {{MODULE|lower}}Order, {{MODULE|lower}}s, func(item domain.{{MODULE}}) (string, string) {
+		return item.CreatedAt.UTC().Format(time.RFC3339Nano), item.ID
+	})
+	result.Total = total
+
+	return {{MODULE|lower}}s, result, nil
 }
 
 func (r *{{MODULE}}Repository) Update(ctx context.Context, request *domain.{{MODULE}}Update) (*domain.{{MODULE}}, error) {
@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/nassabiq/golang-template/internal/modules/{{MODULE}}/domain"
 	"github.com/nassabiq/golang-template/internal/modules/{{MODULE}}/dto"
+	"github.com/nassabiq/golang-template/internal/shared/pagination"
 )
 
 type {{MODULE}}Usecase struct {
@@ -18,12 +19,12 @@ func New{{MODULE}}Usecase(repository domain.{{MODULE}}Repository) *{{MODULE}}Use
 	}
 }
 
-func (usecase *{{MODULE}}Usecase) List(ctx context.Context, limit int, offset int) ([]domain.{{MODULE}}, error) {
-	if limit <= 0 {
-		limit = 10
+func (usecase *{{MODULE}}Usecase) List(ctx context.Context, page pagination.Page) ([]domain.{{MODULE}}, *pagination.Result, error) {
+	if page.Limit <= 0 {
+		page.Limit = 10
 	}
 
-	return usecase.repository.List(ctx, limit, offset)
+	return usecase.repository.List(ctx, page)
 }
 
 func (usecase *{{MODULE}}Usecase) GetByID(ctx context.Context, id string) (*domain.{{MODULE}}, error) {
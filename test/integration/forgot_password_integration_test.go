@@ -217,7 +217,7 @@ func (n *natsEventBus) Publish(subject string, payload []byte) error {
 
 type mockTokenService struct{}
 
-func (m *mockTokenService) GenerateAccessToken(userID, role string) (string, error) {
+func (m *mockTokenService) GenerateAccessToken(claims domain.AccessTokenClaims) (string, error) {
 	return "mock-access-token", nil
 }
 
@@ -225,6 +225,14 @@ func (m *mockTokenService) GenerateRefreshToken() (plain string, hash string, er
 	return "mock-refresh-plain", "mock-refresh-hash", nil
 }
 
+func (m *mockTokenService) GenerateServiceToken(claims domain.ServiceTokenClaims) (string, time.Duration, error) {
+	return "mock-service-token", 15 * time.Minute, nil
+}
+
+func (m *mockTokenService) PublicKeys() []domain.JSONWebKey {
+	return nil
+}
+
 func getEnv(key, fallback string) string {
 	if value := os.Getenv(key); value != "" {
 		return value